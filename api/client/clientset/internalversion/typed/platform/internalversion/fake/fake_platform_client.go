@@ -30,6 +30,10 @@ type FakePlatform struct {
 	*testing.Fake
 }
 
+func (c *FakePlatform) AuditPolicies() internalversion.AuditPolicyInterface {
+	return &FakeAuditPolicies{c}
+}
+
 func (c *FakePlatform) CSIOperators() internalversion.CSIOperatorInterface {
 	return &FakeCSIOperators{c}
 }
@@ -54,6 +58,14 @@ func (c *FakePlatform) ClusterGroupAPIResourceItemses() internalversion.ClusterG
 	return &FakeClusterGroupAPIResourceItemses{c}
 }
 
+func (c *FakePlatform) ClusterSyncs() internalversion.ClusterSyncInterface {
+	return &FakeClusterSyncs{c}
+}
+
+func (c *FakePlatform) ClusterTemplates() internalversion.ClusterTemplateInterface {
+	return &FakeClusterTemplates{c}
+}
+
 func (c *FakePlatform) ConfigMaps() internalversion.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
@@ -62,10 +74,26 @@ func (c *FakePlatform) CronHPAs() internalversion.CronHPAInterface {
 	return &FakeCronHPAs{c}
 }
 
+func (c *FakePlatform) EtcdSnapshots() internalversion.EtcdSnapshotInterface {
+	return &FakeEtcdSnapshots{c}
+}
+
+func (c *FakePlatform) Hosts() internalversion.HostInterface {
+	return &FakeHosts{c}
+}
+
 func (c *FakePlatform) Machines() internalversion.MachineInterface {
 	return &FakeMachines{c}
 }
 
+func (c *FakePlatform) MachinePools() internalversion.MachinePoolInterface {
+	return &FakeMachinePools{c}
+}
+
+func (c *FakePlatform) MultiClusterDeployments() internalversion.MultiClusterDeploymentInterface {
+	return &FakeMultiClusterDeployments{c}
+}
+
 func (c *FakePlatform) PersistentEvents() internalversion.PersistentEventInterface {
 	return &FakePersistentEvents{c}
 }
@@ -74,6 +102,10 @@ func (c *FakePlatform) Registries() internalversion.RegistryInterface {
 	return &FakeRegistries{c}
 }
 
+func (c *FakePlatform) SSHCredentials() internalversion.SSHCredentialInterface {
+	return &FakeSSHCredentials{c}
+}
+
 func (c *FakePlatform) TappControllers() internalversion.TappControllerInterface {
 	return &FakeTappControllers{c}
 }
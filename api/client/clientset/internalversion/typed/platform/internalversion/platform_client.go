@@ -27,17 +27,25 @@ import (
 
 type PlatformInterface interface {
 	RESTClient() rest.Interface
+	AuditPoliciesGetter
 	CSIOperatorsGetter
 	ClustersGetter
 	ClusterAddonsGetter
 	ClusterAddonTypesGetter
 	ClusterCredentialsGetter
 	ClusterGroupAPIResourceItemsesGetter
+	ClusterSyncsGetter
+	ClusterTemplatesGetter
 	ConfigMapsGetter
 	CronHPAsGetter
+	EtcdSnapshotsGetter
+	HostsGetter
 	MachinesGetter
+	MachinePoolsGetter
+	MultiClusterDeploymentsGetter
 	PersistentEventsGetter
 	RegistriesGetter
+	SSHCredentialsGetter
 	TappControllersGetter
 }
 
@@ -46,6 +54,10 @@ type PlatformClient struct {
 	restClient rest.Interface
 }
 
+func (c *PlatformClient) AuditPolicies() AuditPolicyInterface {
+	return newAuditPolicies(c)
+}
+
 func (c *PlatformClient) CSIOperators() CSIOperatorInterface {
 	return newCSIOperators(c)
 }
@@ -70,6 +82,14 @@ func (c *PlatformClient) ClusterGroupAPIResourceItemses() ClusterGroupAPIResourc
 	return newClusterGroupAPIResourceItemses(c)
 }
 
+func (c *PlatformClient) ClusterSyncs() ClusterSyncInterface {
+	return newClusterSyncs(c)
+}
+
+func (c *PlatformClient) ClusterTemplates() ClusterTemplateInterface {
+	return newClusterTemplates(c)
+}
+
 func (c *PlatformClient) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
@@ -78,10 +98,26 @@ func (c *PlatformClient) CronHPAs() CronHPAInterface {
 	return newCronHPAs(c)
 }
 
+func (c *PlatformClient) EtcdSnapshots() EtcdSnapshotInterface {
+	return newEtcdSnapshots(c)
+}
+
+func (c *PlatformClient) Hosts() HostInterface {
+	return newHosts(c)
+}
+
 func (c *PlatformClient) Machines() MachineInterface {
 	return newMachines(c)
 }
 
+func (c *PlatformClient) MachinePools() MachinePoolInterface {
+	return newMachinePools(c)
+}
+
+func (c *PlatformClient) MultiClusterDeployments() MultiClusterDeploymentInterface {
+	return newMultiClusterDeployments(c)
+}
+
 func (c *PlatformClient) PersistentEvents() PersistentEventInterface {
 	return newPersistentEvents(c)
 }
@@ -90,6 +126,10 @@ func (c *PlatformClient) Registries() RegistryInterface {
 	return newRegistries(c)
 }
 
+func (c *PlatformClient) SSHCredentials() SSHCredentialInterface {
+	return newSSHCredentials(c)
+}
+
 func (c *PlatformClient) TappControllers() TappControllerInterface {
 	return newTappControllers(c)
 }
@@ -30,6 +30,10 @@ type FakePlatformV1 struct {
 	*testing.Fake
 }
 
+func (c *FakePlatformV1) AuditPolicies() v1.AuditPolicyInterface {
+	return &FakeAuditPolicies{c}
+}
+
 func (c *FakePlatformV1) CSIOperators() v1.CSIOperatorInterface {
 	return &FakeCSIOperators{c}
 }
@@ -54,6 +58,14 @@ func (c *FakePlatformV1) ClusterGroupAPIResourceItemses() v1.ClusterGroupAPIReso
 	return &FakeClusterGroupAPIResourceItemses{c}
 }
 
+func (c *FakePlatformV1) ClusterSyncs() v1.ClusterSyncInterface {
+	return &FakeClusterSyncs{c}
+}
+
+func (c *FakePlatformV1) ClusterTemplates() v1.ClusterTemplateInterface {
+	return &FakeClusterTemplates{c}
+}
+
 func (c *FakePlatformV1) ConfigMaps() v1.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
@@ -62,10 +74,26 @@ func (c *FakePlatformV1) CronHPAs() v1.CronHPAInterface {
 	return &FakeCronHPAs{c}
 }
 
+func (c *FakePlatformV1) EtcdSnapshots() v1.EtcdSnapshotInterface {
+	return &FakeEtcdSnapshots{c}
+}
+
+func (c *FakePlatformV1) Hosts() v1.HostInterface {
+	return &FakeHosts{c}
+}
+
 func (c *FakePlatformV1) Machines() v1.MachineInterface {
 	return &FakeMachines{c}
 }
 
+func (c *FakePlatformV1) MachinePools() v1.MachinePoolInterface {
+	return &FakeMachinePools{c}
+}
+
+func (c *FakePlatformV1) MultiClusterDeployments() v1.MultiClusterDeploymentInterface {
+	return &FakeMultiClusterDeployments{c}
+}
+
 func (c *FakePlatformV1) PersistentEvents() v1.PersistentEventInterface {
 	return &FakePersistentEvents{c}
 }
@@ -74,6 +102,10 @@ func (c *FakePlatformV1) Registries() v1.RegistryInterface {
 	return &FakeRegistries{c}
 }
 
+func (c *FakePlatformV1) SSHCredentials() v1.SSHCredentialInterface {
+	return &FakeSSHCredentials{c}
+}
+
 func (c *FakePlatformV1) TappControllers() v1.TappControllerInterface {
 	return &FakeTappControllers{c}
 }
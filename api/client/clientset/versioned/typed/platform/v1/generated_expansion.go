@@ -20,6 +20,8 @@
 
 package v1
 
+type AuditPolicyExpansion interface{}
+
 type CSIOperatorExpansion interface{}
 
 type ClusterExpansion interface{}
@@ -32,14 +34,28 @@ type ClusterCredentialExpansion interface{}
 
 type ClusterGroupAPIResourceItemsExpansion interface{}
 
+type ClusterSyncExpansion interface{}
+
+type ClusterTemplateExpansion interface{}
+
 type ConfigMapExpansion interface{}
 
 type CronHPAExpansion interface{}
 
+type EtcdSnapshotExpansion interface{}
+
+type HostExpansion interface{}
+
 type MachineExpansion interface{}
 
+type MachinePoolExpansion interface{}
+
+type MultiClusterDeploymentExpansion interface{}
+
 type PersistentEventExpansion interface{}
 
 type RegistryExpansion interface{}
 
+type SSHCredentialExpansion interface{}
+
 type TappControllerExpansion interface{}
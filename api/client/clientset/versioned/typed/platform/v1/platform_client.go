@@ -28,17 +28,25 @@ import (
 
 type PlatformV1Interface interface {
 	RESTClient() rest.Interface
+	AuditPoliciesGetter
 	CSIOperatorsGetter
 	ClustersGetter
 	ClusterAddonsGetter
 	ClusterAddonTypesGetter
 	ClusterCredentialsGetter
 	ClusterGroupAPIResourceItemsesGetter
+	ClusterSyncsGetter
+	ClusterTemplatesGetter
 	ConfigMapsGetter
 	CronHPAsGetter
+	EtcdSnapshotsGetter
+	HostsGetter
 	MachinesGetter
+	MachinePoolsGetter
+	MultiClusterDeploymentsGetter
 	PersistentEventsGetter
 	RegistriesGetter
+	SSHCredentialsGetter
 	TappControllersGetter
 }
 
@@ -47,6 +55,10 @@ type PlatformV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *PlatformV1Client) AuditPolicies() AuditPolicyInterface {
+	return newAuditPolicies(c)
+}
+
 func (c *PlatformV1Client) CSIOperators() CSIOperatorInterface {
 	return newCSIOperators(c)
 }
@@ -71,6 +83,14 @@ func (c *PlatformV1Client) ClusterGroupAPIResourceItemses() ClusterGroupAPIResou
 	return newClusterGroupAPIResourceItemses(c)
 }
 
+func (c *PlatformV1Client) ClusterSyncs() ClusterSyncInterface {
+	return newClusterSyncs(c)
+}
+
+func (c *PlatformV1Client) ClusterTemplates() ClusterTemplateInterface {
+	return newClusterTemplates(c)
+}
+
 func (c *PlatformV1Client) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
@@ -79,10 +99,26 @@ func (c *PlatformV1Client) CronHPAs() CronHPAInterface {
 	return newCronHPAs(c)
 }
 
+func (c *PlatformV1Client) EtcdSnapshots() EtcdSnapshotInterface {
+	return newEtcdSnapshots(c)
+}
+
+func (c *PlatformV1Client) Hosts() HostInterface {
+	return newHosts(c)
+}
+
 func (c *PlatformV1Client) Machines() MachineInterface {
 	return newMachines(c)
 }
 
+func (c *PlatformV1Client) MachinePools() MachinePoolInterface {
+	return newMachinePools(c)
+}
+
+func (c *PlatformV1Client) MultiClusterDeployments() MultiClusterDeploymentInterface {
+	return newMultiClusterDeployments(c)
+}
+
 func (c *PlatformV1Client) PersistentEvents() PersistentEventInterface {
 	return newPersistentEvents(c)
 }
@@ -91,6 +127,10 @@ func (c *PlatformV1Client) Registries() RegistryInterface {
 	return newRegistries(c)
 }
 
+func (c *PlatformV1Client) SSHCredentials() SSHCredentialInterface {
+	return newSSHCredentials(c)
+}
+
 func (c *PlatformV1Client) TappControllers() TappControllerInterface {
 	return newTappControllers(c)
 }
@@ -162,22 +162,38 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Templates().Informer()}, nil
 
 		// Group=platform.tkestack.io, Version=v1
+	case platformv1.SchemeGroupVersion.WithResource("auditpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().AuditPolicies().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("csioperators"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().CSIOperators().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("clusters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().Clusters().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("clustercredentials"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().ClusterCredentials().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("clustersyncs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().ClusterSyncs().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("clustertemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().ClusterTemplates().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().ConfigMaps().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("cronhpas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().CronHPAs().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("etcdsnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().EtcdSnapshots().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("hosts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().Hosts().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("machines"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().Machines().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("machinepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().MachinePools().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("multiclusterdeployments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().MultiClusterDeployments().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("persistentevents"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().PersistentEvents().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("registries"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().Registries().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("sshcredentials"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().SSHCredentials().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("tappcontrollers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().TappControllers().Informer()}, nil
 
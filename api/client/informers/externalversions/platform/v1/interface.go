@@ -26,22 +26,38 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AuditPolicies returns a AuditPolicyInformer.
+	AuditPolicies() AuditPolicyInformer
 	// CSIOperators returns a CSIOperatorInformer.
 	CSIOperators() CSIOperatorInformer
 	// Clusters returns a ClusterInformer.
 	Clusters() ClusterInformer
 	// ClusterCredentials returns a ClusterCredentialInformer.
 	ClusterCredentials() ClusterCredentialInformer
+	// ClusterSyncs returns a ClusterSyncInformer.
+	ClusterSyncs() ClusterSyncInformer
+	// ClusterTemplates returns a ClusterTemplateInformer.
+	ClusterTemplates() ClusterTemplateInformer
 	// ConfigMaps returns a ConfigMapInformer.
 	ConfigMaps() ConfigMapInformer
 	// CronHPAs returns a CronHPAInformer.
 	CronHPAs() CronHPAInformer
+	// EtcdSnapshots returns a EtcdSnapshotInformer.
+	EtcdSnapshots() EtcdSnapshotInformer
+	// Hosts returns a HostInformer.
+	Hosts() HostInformer
 	// Machines returns a MachineInformer.
 	Machines() MachineInformer
+	// MachinePools returns a MachinePoolInformer.
+	MachinePools() MachinePoolInformer
+	// MultiClusterDeployments returns a MultiClusterDeploymentInformer.
+	MultiClusterDeployments() MultiClusterDeploymentInformer
 	// PersistentEvents returns a PersistentEventInformer.
 	PersistentEvents() PersistentEventInformer
 	// Registries returns a RegistryInformer.
 	Registries() RegistryInformer
+	// SSHCredentials returns a SSHCredentialInformer.
+	SSHCredentials() SSHCredentialInformer
 	// TappControllers returns a TappControllerInformer.
 	TappControllers() TappControllerInformer
 }
@@ -57,6 +73,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AuditPolicies returns a AuditPolicyInformer.
+func (v *version) AuditPolicies() AuditPolicyInformer {
+	return &auditPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // CSIOperators returns a CSIOperatorInformer.
 func (v *version) CSIOperators() CSIOperatorInformer {
 	return &cSIOperatorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -72,6 +93,16 @@ func (v *version) ClusterCredentials() ClusterCredentialInformer {
 	return &clusterCredentialInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterSyncs returns a ClusterSyncInformer.
+func (v *version) ClusterSyncs() ClusterSyncInformer {
+	return &clusterSyncInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ClusterTemplates returns a ClusterTemplateInformer.
+func (v *version) ClusterTemplates() ClusterTemplateInformer {
+	return &clusterTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ConfigMaps returns a ConfigMapInformer.
 func (v *version) ConfigMaps() ConfigMapInformer {
 	return &configMapInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -82,11 +113,31 @@ func (v *version) CronHPAs() CronHPAInformer {
 	return &cronHPAInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// EtcdSnapshots returns a EtcdSnapshotInformer.
+func (v *version) EtcdSnapshots() EtcdSnapshotInformer {
+	return &etcdSnapshotInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Hosts returns a HostInformer.
+func (v *version) Hosts() HostInformer {
+	return &hostInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Machines returns a MachineInformer.
 func (v *version) Machines() MachineInformer {
 	return &machineInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// MachinePools returns a MachinePoolInformer.
+func (v *version) MachinePools() MachinePoolInformer {
+	return &machinePoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// MultiClusterDeployments returns a MultiClusterDeploymentInformer.
+func (v *version) MultiClusterDeployments() MultiClusterDeploymentInformer {
+	return &multiClusterDeploymentInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // PersistentEvents returns a PersistentEventInformer.
 func (v *version) PersistentEvents() PersistentEventInformer {
 	return &persistentEventInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -97,6 +148,11 @@ func (v *version) Registries() RegistryInformer {
 	return &registryInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// SSHCredentials returns a SSHCredentialInformer.
+func (v *version) SSHCredentials() SSHCredentialInformer {
+	return &sSHCredentialInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // TappControllers returns a TappControllerInformer.
 func (v *version) TappControllers() TappControllerInformer {
 	return &tappControllerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -164,22 +164,38 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Templates().Informer()}, nil
 
 		// Group=platform.tkestack.io, Version=internalVersion
+	case platform.SchemeGroupVersion.WithResource("auditpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().AuditPolicies().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("csioperators"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().CSIOperators().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("clusters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().Clusters().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("clustercredentials"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().ClusterCredentials().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("clustersyncs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().ClusterSyncs().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("clustertemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().ClusterTemplates().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().ConfigMaps().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("cronhpas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().CronHPAs().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("etcdsnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().EtcdSnapshots().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("hosts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().Hosts().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("machines"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().Machines().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("machinepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().MachinePools().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("multiclusterdeployments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().MultiClusterDeployments().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("persistentevents"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().PersistentEvents().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("registries"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().Registries().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("sshcredentials"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().SSHCredentials().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("tappcontrollers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().TappControllers().Informer()}, nil
 
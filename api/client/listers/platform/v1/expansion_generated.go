@@ -20,6 +20,10 @@
 
 package v1
 
+// AuditPolicyListerExpansion allows custom methods to be added to
+// AuditPolicyLister.
+type AuditPolicyListerExpansion interface{}
+
 // CSIOperatorListerExpansion allows custom methods to be added to
 // CSIOperatorLister.
 type CSIOperatorListerExpansion interface{}
@@ -40,6 +44,14 @@ type ClusterCredentialListerExpansion interface{}
 // ClusterGroupAPIResourceItemsLister.
 type ClusterGroupAPIResourceItemsListerExpansion interface{}
 
+// ClusterSyncListerExpansion allows custom methods to be added to
+// ClusterSyncLister.
+type ClusterSyncListerExpansion interface{}
+
+// ClusterTemplateListerExpansion allows custom methods to be added to
+// ClusterTemplateLister.
+type ClusterTemplateListerExpansion interface{}
+
 // ConfigMapListerExpansion allows custom methods to be added to
 // ConfigMapLister.
 type ConfigMapListerExpansion interface{}
@@ -48,10 +60,26 @@ type ConfigMapListerExpansion interface{}
 // CronHPALister.
 type CronHPAListerExpansion interface{}
 
+// EtcdSnapshotListerExpansion allows custom methods to be added to
+// EtcdSnapshotLister.
+type EtcdSnapshotListerExpansion interface{}
+
+// HostListerExpansion allows custom methods to be added to
+// HostLister.
+type HostListerExpansion interface{}
+
 // MachineListerExpansion allows custom methods to be added to
 // MachineLister.
 type MachineListerExpansion interface{}
 
+// MachinePoolListerExpansion allows custom methods to be added to
+// MachinePoolLister.
+type MachinePoolListerExpansion interface{}
+
+// MultiClusterDeploymentListerExpansion allows custom methods to be added to
+// MultiClusterDeploymentLister.
+type MultiClusterDeploymentListerExpansion interface{}
+
 // PersistentEventListerExpansion allows custom methods to be added to
 // PersistentEventLister.
 type PersistentEventListerExpansion interface{}
@@ -60,6 +88,10 @@ type PersistentEventListerExpansion interface{}
 // RegistryLister.
 type RegistryListerExpansion interface{}
 
+// SSHCredentialListerExpansion allows custom methods to be added to
+// SSHCredentialLister.
+type SSHCredentialListerExpansion interface{}
+
 // TappControllerListerExpansion allows custom methods to be added to
 // TappControllerLister.
 type TappControllerListerExpansion interface{}
@@ -31,14 +31,15 @@ import (
 
 func (in *ClusterMachine) SSH() (*ssh.SSH, error) {
 	sshConfig := &ssh.Config{
-		User:        in.Username,
-		Host:        in.IP,
-		Port:        int(in.Port),
-		Password:    string(in.Password),
-		PrivateKey:  in.PrivateKey,
-		PassPhrase:  in.PassPhrase,
-		DialTimeOut: time.Second,
-		Retry:       0,
+		User:           in.Username,
+		Host:           in.IP,
+		Port:           int(in.Port),
+		Password:       string(in.Password),
+		PrivateKey:     in.PrivateKey,
+		PassPhrase:     in.PassPhrase,
+		CredentialName: in.CredentialName,
+		DialTimeOut:    time.Second,
+		Retry:          0,
 	}
 	switch in.Proxy.Type {
 	case SSHJumpServer:
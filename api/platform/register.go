@@ -65,6 +65,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Cluster{},
 		&ClusterList{},
 		&ClusterApplyOptions{},
+		&ClusterApplyResult{},
 
 		&ClusterCredential{},
 		&ClusterCredentialList{},
@@ -101,6 +102,26 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterGroupAPIResourceItems{},
 		&ClusterGroupAPIResourceItemsList{},
 		&ClusterGroupAPIResourceOptions{},
+
+		&EtcdSnapshot{},
+		&EtcdSnapshotList{},
+		&EtcdSnapshotRestoreOptions{},
+		&ClusterPlan{},
+
+		&MachinePool{},
+		&MachinePoolList{},
+		&SSHCredential{},
+		&SSHCredentialList{},
+		&Host{},
+		&HostList{},
+		&ClusterTemplate{},
+		&ClusterTemplateList{},
+		&AuditPolicy{},
+		&AuditPolicyList{},
+		&ClusterSync{},
+		&ClusterSyncList{},
+		&MultiClusterDeployment{},
+		&MultiClusterDeploymentList{},
 	)
 	return nil
 }
@@ -78,6 +78,12 @@ type ClusterMachine struct {
 	Labels     map[string]string
 	Taints     []corev1.Taint
 	Proxy      ClusterMachineProxy
+	// +optional
+	CredentialName string
+	// ContainerRuntimeConfig is merged over the container runtime config of
+	// the cluster.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig
 }
 
 // ClusterMachine is the proxy definition of ClusterMachine.
@@ -118,6 +124,17 @@ const (
 	AnywhereUpgradeRetryComponentAnno = "tkestack.io/anywhere-upgrade-retry-component"
 	// AnywhereUpgradeRetryComponentAnno describe anywhere upgrade stats
 	AnywhereUpgradeStatsAnno = "tkestack.io/anywhere-upgrade-stats"
+	// WorkerUpgradeAnno controls the rolling upgrade of worker machines, value can be Paused or Aborted.
+	// Remove it to resume the rolling upgrade.
+	WorkerUpgradeAnno = "platform.tkestack.io/worker-upgrade"
+	// WorkerUpgradePaused pauses marking next worker machine to be upgraded.
+	WorkerUpgradePaused = "Paused"
+	// WorkerUpgradeAborted stops the rolling upgrade and clears need upgrade label of remaining machines.
+	WorkerUpgradeAborted = "Aborted"
+	// ReplaceMasterAnno marks the master with the IP in value to be replaced by the
+	// master added to spec.machines together, the old master is removed after the
+	// new one joins.
+	ReplaceMasterAnno = "platform.tkestack.io/replace-master"
 	// ClusterNameLable contains related cluster's name for no-cluster resources
 	ClusterNameLable = "tkestack.io/cluster-name"
 	// HubAPIServerAnno describe hub cluster api server url
@@ -130,6 +147,13 @@ const (
 	AnywhereValidateAnno = "tkestack.io/anywhere-validate"
 	// LocationBasedImagePrefixAnno is exist, the cluster will use it as k8s images prefix
 	LocationBaseImagePrefixAnno = "tkestack.io/location-based-image-prefix"
+	// MachinePoolLabel contains the name of the machine pool a machine belongs to
+	MachinePoolLabel = "platform.tkestack.io/machinepool"
+	// MachineDeleteAnnotation marks a machine of pool to be deleted first
+	// when the pool is scaled down.
+	MachineDeleteAnnotation = "platform.tkestack.io/delete-machine"
+	// MachineHostLabel contains the name of the host a machine of pool runs on
+	MachineHostLabel = "platform.tkestack.io/host"
 )
 
 // KubeVendorType describe the kubernetes provider of the cluster
@@ -220,8 +244,79 @@ type ClusterSpec struct {
 	// AppVersion is the overall version of system components
 	// +optional
 	AppVersion string
+	// TemplateRef is the cluster template whose defaults were merged into the
+	// spec when the cluster was created.
+	// +optional
+	TemplateRef *ClusterTemplateRef
+	// ContainerRuntimeConfig configures containerd on the nodes of the cluster.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig
+}
+
+// ClusterTemplateRef references a revision of a cluster template.
+type ClusterTemplateRef struct {
+	Name string
+	// Revision is the revision of the template merged into the cluster. It is
+	// set by the server to the current revision on creation if it is empty,
+	// an earlier revision kept in the status of the template pins the cluster
+	// to it.
+	// +optional
+	Revision int64
+}
+
+// ContainerRuntimeConfig is the declarative configuration of containerd.
+type ContainerRuntimeConfig struct {
+	// RegistryMirrors are the mirror endpoints of registries.
+	// +optional
+	RegistryMirrors []RegistryMirror
+	// InsecureRegistries are the registries accessed without verifying TLS.
+	// +optional
+	InsecureRegistries []string
+	// Snapshotter is the snapshotter of containerd, overlayfs if empty.
+	// +optional
+	Snapshotter string
+	// CgroupDriver is the cgroup driver of containerd and kubelet, systemd if
+	// empty. It can only be set on the cluster.
+	// +optional
+	CgroupDriver CgroupDriver
+	// RuntimeClasses are the runtime handlers added to containerd, a
+	// RuntimeClass object is created in the cluster for each of them. They can
+	// only be set on the cluster.
+	// +optional
+	RuntimeClasses []RuntimeClass
+}
+
+// RegistryMirror is the mirror endpoints of a registry.
+type RegistryMirror struct {
+	// Host of the registry, e.g. docker.io.
+	Host      string
+	Endpoints []string
+}
+
+// RuntimeClass is a runtime handler of containerd.
+type RuntimeClass struct {
+	// Name of the runtime handler and the RuntimeClass object.
+	Name string
+	Type RuntimeType
 }
 
+// RuntimeType is the type of a runtime handler.
+type RuntimeType string
+
+const (
+	RuntimeRunc   RuntimeType = "runc"
+	RuntimeKata   RuntimeType = "kata"
+	RuntimeGVisor RuntimeType = "gvisor"
+)
+
+// CgroupDriver is the cgroup driver of container runtime and kubelet.
+type CgroupDriver string
+
+const (
+	CgroupDriverSystemd  CgroupDriver = "systemd"
+	CgroupDriverCgroupfs CgroupDriver = "cgroupfs"
+)
+
 // ClusterStatus represents information about the status of a cluster.
 type ClusterStatus struct {
 	// +optional
@@ -277,6 +372,103 @@ type ClusterStatus struct {
 	// ComponentPhase is the status of components, contains "deployed", "pending-upgrade", "failed" status
 	// +optional
 	ComponentPhase ComponentPhase
+	// The bounded execution history of provider handlers.
+	// +optional
+	HandlerHistory []HandlerRecord
+	// The expiration of the certificates on the masters.
+	// +optional
+	Certificates []ClusterCertificate
+}
+
+// ClusterCertificate records the expiration of a certificate on a master.
+type ClusterCertificate struct {
+	// Name of the certificate, such as apiserver or etcd-server.
+	Name string
+	// IP of the master which the certificate is on.
+	Node string
+	// +optional
+	NotAfter metav1.Time
+}
+
+// HandlerRecord records the execution of a provider handler.
+type HandlerRecord struct {
+	// Name of the handler.
+	Name string
+	// The time when the first attempt started.
+	// +optional
+	StartTime metav1.Time
+	// The time when the last attempt ended.
+	// +optional
+	EndTime metav1.Time
+	// The number of attempts.
+	// +optional
+	Attempts int32
+	// The error of the last attempt, empty if the handler succeeded.
+	// +optional
+	LastError string
+	// The ssh commands of the last failed attempt with their exit codes and
+	// redacted excerpts of stderr and stdout, the content of files read is
+	// never recorded.
+	// +optional
+	Output string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterPlan is the plan of the operation which would be done on a cluster,
+// returned by the plan subresource of cluster without mutating any host.
+type ClusterPlan struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Phase is the phase the cluster would enter for the operation.
+	// +optional
+	Phase ClusterPhase
+	// Steps are the handlers which would be executed in order.
+	// +optional
+	Steps []ClusterPlanStep
+	// Checks are the results of preflight checks on machines.
+	// +optional
+	Checks []ClusterPlanCheck
+	// Configs are the rendered config files which would be written to machines.
+	// +optional
+	Configs []ClusterPlanConfig
+}
+
+// ClusterPlanStep is a handler which would be executed by a cluster operation.
+type ClusterPlanStep struct {
+	// Name of the handler.
+	Name string
+	// Skipped is true if the handler is skipped by skipConditions of cluster.
+	// +optional
+	Skipped bool
+}
+
+// ClusterPlanCheck is the result of a check on a machine.
+type ClusterPlanCheck struct {
+	// Name of the check.
+	Name string
+	// IP of the machine checked.
+	// +optional
+	IP string
+	// Passed is true if the check passed.
+	// +optional
+	Passed bool
+	// A human readable message of warnings or errors found by the check.
+	// +optional
+	Message string
+}
+
+// ClusterPlanConfig is a rendered config file which would be written to machines.
+type ClusterPlanConfig struct {
+	// Path of the config file on machines.
+	Path string
+	// IPs of the machines the config file would be written to.
+	// +optional
+	IPs []string
+	// Content of the config file.
+	// +optional
+	Content string
 }
 
 // FinalizerName is the name identifying a finalizer during cluster lifecycle.
@@ -288,11 +480,28 @@ const (
 
 	// MachineFinalize is an internal finalizer values to Machine.
 	MachineFinalize FinalizerName = "machine"
+
+	// EtcdSnapshotFinalize is an internal finalizer values to EtcdSnapshot.
+	EtcdSnapshotFinalize FinalizerName = "etcdsnapshot"
+
+	// MachinePoolFinalize is an internal finalizer values to MachinePool.
+	MachinePoolFinalize FinalizerName = "machinepool"
 )
 
 // NetworkType defines the network type of cluster.
 type NetworkType string
 
+const (
+	// NetworkGalaxy indicates the cluster network is provided by galaxy.
+	NetworkGalaxy NetworkType = "galaxy"
+	// NetworkCilium indicates the cluster network is provided by cilium.
+	NetworkCilium NetworkType = "cilium"
+	// NetworkCalico indicates the cluster network is provided by calico.
+	NetworkCalico NetworkType = "calico"
+	// NetworkFlannel indicates the cluster network is provided by flannel.
+	NetworkFlannel NetworkType = "flannel"
+)
+
 // GPUType defines the gpu type of cluster.
 type GPUType string
 
@@ -448,6 +657,10 @@ type ClusterCredential struct {
 	// ImpersonateUserExtra contains additional information for impersonated user.
 	// +optional
 	ImpersonateUserExtra ImpersonateUserExtra
+	// S3SecretAccessKeys are the secret access keys of the S3 etcd snapshot
+	// targets of the cluster, keyed by access key ID.
+	// +optional
+	S3SecretAccessKeys map[string][]byte
 }
 
 type ImpersonateUserExtra map[string]string
@@ -575,6 +788,15 @@ type ClusterFeature struct {
 	// Upgrade control upgrade process.
 	// +optional
 	Upgrade Upgrade
+	// EtcdBackup controls scheduled snapshots of the local etcd.
+	// +optional
+	EtcdBackup *EtcdBackup
+	// Autoscaling scales machine pools of the cluster by unschedulable pods.
+	// +optional
+	Autoscaling *ClusterAutoscaling
+	// MetalLB deploys metallb to serve Services of type LoadBalancer.
+	// +optional
+	MetalLB *MetalLB
 }
 
 type BootstrapApps []BootstapApp
@@ -593,6 +815,7 @@ type App struct {
 type HA struct {
 	TKEHA        *TKEHA
 	ThirdPartyHA *ThirdPartyHA
+	KubeVIPHA    *KubeVIPHA
 }
 
 type TKEHA struct {
@@ -605,6 +828,76 @@ type ThirdPartyHA struct {
 	VPort int32
 }
 
+// KubeVIPHA announces the control-plane VIP by kube-vip static pods on masters.
+type KubeVIPHA struct {
+	VIP string
+	// Interface is the network interface the VIP is bound to, defaults to
+	// the network device of cluster.
+	// +optional
+	Interface string
+	// Mode is how the VIP is announced, one of ARP and BGP, defaults to ARP.
+	// +optional
+	Mode KubeVIPMode
+	// BGP configures the peering of masters in BGP mode.
+	// +optional
+	BGP *BGPConfig
+}
+
+// KubeVIPMode defines how kube-vip announces the VIP.
+type KubeVIPMode string
+
+const (
+	// KubeVIPARP announces the VIP by gratuitous ARP from the leader.
+	KubeVIPARP KubeVIPMode = "ARP"
+	// KubeVIPBGP advertises the VIP to BGP peers from every master.
+	KubeVIPBGP KubeVIPMode = "BGP"
+)
+
+// BGPConfig is the BGP speaker configuration of nodes.
+type BGPConfig struct {
+	// ASN is the AS number of nodes.
+	ASN int32
+	// Peers are the routers the nodes peer with.
+	Peers []BGPPeer
+}
+
+// BGPPeer is a router nodes peer with.
+type BGPPeer struct {
+	Address string
+	ASN     int32
+	// +optional
+	Password string
+}
+
+// MetalLB is the load balancer of Services of type LoadBalancer.
+type MetalLB struct {
+	// AddressPools are the addresses assigned to LoadBalancer Services.
+	AddressPools []MetalLBAddressPool
+	// BGP configures the peering of nodes for pools in BGP protocol.
+	// +optional
+	BGP *BGPConfig
+}
+
+// MetalLBAddressPool is a range of addresses announced by the same protocol.
+type MetalLBAddressPool struct {
+	Name string
+	// Protocol is one of layer2 and bgp, defaults to layer2.
+	// +optional
+	Protocol MetalLBProtocol
+	// Addresses are CIDRs or ranges in the form of "first-last".
+	Addresses []string
+}
+
+// MetalLBProtocol defines how metallb announces the addresses.
+type MetalLBProtocol string
+
+const (
+	// MetalLBLayer2 announces the addresses by ARP/NDP.
+	MetalLBLayer2 MetalLBProtocol = "layer2"
+	// MetalLBBGP advertises the addresses to BGP peers.
+	MetalLBBGP MetalLBProtocol = "bgp"
+)
+
 type File struct {
 	Src string // Only support regular file
 	Dst string
@@ -698,6 +991,92 @@ type ExternalEtcd struct {
 	KeyFile string
 }
 
+// EtcdBackup describes the scheduled snapshot policy of the local etcd.
+type EtcdBackup struct {
+	// IntervalInSecond is the interval between two scheduled snapshots.
+	// Zero means no scheduled snapshot will be taken.
+	// +optional
+	IntervalInSecond int32
+	// MaxBackups is the maximum number of scheduled snapshots to retain.
+	// +optional
+	MaxBackups int32
+	// Target is where the snapshots are stored.
+	Target EtcdSnapshotTarget
+}
+
+// ClusterAutoscaling configures the autoscaler of machine pools of a cluster.
+type ClusterAutoscaling struct {
+	// NodeGroups are the machine pools scaled by the autoscaler.
+	// +optional
+	NodeGroups []AutoscalingNodeGroup
+	// ScaleDownUnneededTime is how long a node should be unneeded before it
+	// is scaled down, defaults to 10m.
+	// +optional
+	ScaleDownUnneededTime *metav1.Duration
+	// ScaleDownUtilizationThreshold is the percent of requested cpu and
+	// memory to allocatable of a node, under which the node is considered
+	// unneeded. Defaults to 50.
+	// +optional
+	ScaleDownUtilizationThreshold int32
+}
+
+// AutoscalingNodeGroup is a machine pool scaled by the autoscaler within bounds.
+type AutoscalingNodeGroup struct {
+	// MachinePool is the name of machine pool.
+	MachinePool string
+	// MinReplicas is the lower bound of replicas of machine pool.
+	// +optional
+	MinReplicas int32
+	// MaxReplicas is the upper bound of replicas of machine pool.
+	MaxReplicas int32
+}
+
+// EtcdSnapshotTarget describes where an etcd snapshot is stored.
+// Exactly one of its members must be specified.
+type EtcdSnapshotTarget struct {
+	// Local stores the snapshots on a master machine.
+	// +optional
+	Local *LocalSnapshotTarget
+	// S3 stores the snapshots in an S3 compatible object storage.
+	// +optional
+	S3 *S3SnapshotTarget
+	// Registry stores the snapshots in the filesystem of tke-registry.
+	// +optional
+	Registry *RegistrySnapshotTarget
+}
+
+// LocalSnapshotTarget stores the snapshots in a directory of a master machine.
+type LocalSnapshotTarget struct {
+	// IP of the master machine, defaults to the first master of the cluster.
+	// +optional
+	IP string
+	// Dir is the directory which stores the snapshots.
+	// +optional
+	Dir string
+}
+
+// S3SnapshotTarget stores the snapshots in an S3 compatible object storage.
+type S3SnapshotTarget struct {
+	Endpoint string
+	// +optional
+	Region string
+	Bucket string
+	// +optional
+	Prefix string
+	// AccessKeyID of the target, its secret access key is kept in the
+	// S3SecretAccessKeys of the cluster credential.
+	AccessKeyID string
+	// +optional
+	Insecure bool
+}
+
+// RegistrySnapshotTarget stores the snapshots in the filesystem of tke-registry.
+type RegistrySnapshotTarget struct {
+	// Prefix is the path prefix relative to the root directory of the registry filesystem.
+	// +optional
+	Prefix string
+}
+
 type Upgrade struct {
 	// Upgrade mode, default value is Auto.
 	Mode UpgradeMode
@@ -726,6 +1105,15 @@ type UpgradeStrategy struct {
 	// But not all pod running as cows, a few running as pets.
 	// If your pod can not accept be expelled from current node, this value should be false.
 	DrainNodeBeforeUpgrade bool
+	// The number of worker machines upgraded first as a canary batch.
+	// 0 means no canary batch.
+	CanaryMachines int32
+	// Whether pause the worker upgrade after the canary batch is upgraded.
+	// Remove the worker upgrade annotation of cluster to resume.
+	PauseAfterCanary bool
+	// Whether roll back kubelet and kubeadm binaries of a worker node when its upgrade failed.
+	// default value is true.
+	RollbackOnFailure bool
 }
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -870,6 +1258,68 @@ type ClusterApplyOptions struct {
 	metav1.TypeMeta
 	// +optional
 	NotUpdate bool
+	// ServerSide applies the objects by server-side apply instead of
+	// create-or-update, and the response is a ClusterApplyResult.
+	// +optional
+	ServerSide bool
+	// FieldManager is the name of the manager making the server-side apply,
+	// defaults to tke-apply.
+	// +optional
+	FieldManager string
+	// Force takes the ownership of conflicting fields from other managers.
+	// +optional
+	Force bool
+	// ApplySet labels the applied objects as members of the named set, so
+	// that they can be pruned by a later apply.
+	// +optional
+	ApplySet string
+	// Prune deletes the members of the apply set which are no longer in the
+	// payload. It requires ApplySet.
+	// +optional
+	Prune bool
+	// DryRun submits the apply and prune without persisting them.
+	// +optional
+	DryRun bool
+}
+
+// ClusterApplyAction is the action taken on an object by an apply.
+type ClusterApplyAction string
+
+// These are valid actions of an applied object.
+const (
+	ClusterApplyActionCreated    ClusterApplyAction = "Created"
+	ClusterApplyActionConfigured ClusterApplyAction = "Configured"
+	ClusterApplyActionUnchanged  ClusterApplyAction = "Unchanged"
+	ClusterApplyActionPruned     ClusterApplyAction = "Pruned"
+	ClusterApplyActionFailed     ClusterApplyAction = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterApplyResult is the result of a server-side apply to a cluster.
+type ClusterApplyResult struct {
+	metav1.TypeMeta
+	// DryRun indicates that nothing was persisted.
+	// +optional
+	DryRun bool
+	// Items is the list of the applied and pruned objects.
+	// +optional
+	Items []ClusterApplyObjectResult
+}
+
+// ClusterApplyObjectResult is the result of applying or pruning one object.
+type ClusterApplyObjectResult struct {
+	// +optional
+	Group   string
+	Version string
+	Kind    string
+	// +optional
+	Namespace string
+	Name      string
+	Action    ClusterApplyAction
+	// Message is the error message when the action is Failed.
+	// +optional
+	Message string
 }
 
 // +genclient
@@ -1272,6 +1722,16 @@ type MachineSpec struct {
 	PassPhrase  []byte
 	Labels      map[string]string
 	Taints      []corev1.Taint
+	// +optional
+	KubeletExtraArgs map[string]string
+	// +optional
+	DockerExtraArgs map[string]string
+	// +optional
+	CredentialName string
+	// ContainerRuntimeConfig is merged over the container runtime config of
+	// the cluster.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig
 }
 
 // MachineStatus represents information about the status of an machine.
@@ -1299,8 +1759,50 @@ type MachineStatus struct {
 	// Set of ids/uuids to uniquely identify the node.
 	// +optional
 	MachineInfo MachineSystemInfo
+	// The latest kubernetes upgrade of the machine.
+	// +optional
+	Upgrade *MachineUpgradeStatus
+	// The bounded execution history of provider handlers.
+	// +optional
+	HandlerHistory []HandlerRecord
+}
+
+// MachineUpgradeStatus represents the kubernetes upgrade progress of a worker machine.
+type MachineUpgradeStatus struct {
+	// +optional
+	Phase MachineUpgradePhase
+	// The kubelet version before upgrade.
+	// +optional
+	FromVersion string
+	// The target kubernetes version.
+	// +optional
+	ToVersion string
+	// Whether the machine belongs to the canary batch.
+	// +optional
+	Canary bool
+	// +optional
+	StartTime metav1.Time
+	// +optional
+	FinishTime metav1.Time
+	// A human readable message indicating details about the upgrade.
+	// +optional
+	Message string
 }
 
+// MachineUpgradePhase defines the phase of machine upgrade.
+type MachineUpgradePhase string
+
+const (
+	// MachineUpgradeRunning means the machine is being upgraded.
+	MachineUpgradeRunning MachineUpgradePhase = "Upgrading"
+	// MachineUpgradeSucceeded means the machine is upgraded and passed the health gate.
+	MachineUpgradeSucceeded MachineUpgradePhase = "Succeeded"
+	// MachineUpgradeFailed means the upgrade failed and binaries were not rolled back.
+	MachineUpgradeFailed MachineUpgradePhase = "Failed"
+	// MachineUpgradeRolledBack means the upgrade failed and binaries were rolled back.
+	MachineUpgradeRolledBack MachineUpgradePhase = "RolledBack"
+)
+
 // MachineSystemInfo is a set of ids/uuids to uniquely identify the node.
 type MachineSystemInfo struct {
 	// MachineID reported by the node. For unique machine identification
@@ -1529,3 +2031,848 @@ type ClusterGroupAPIResourceItem struct {
 type ClusterGroupAPIResourceOptions struct {
 	metav1.TypeMeta
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EtcdSnapshot is a snapshot of the local etcd of a cluster.
+type EtcdSnapshot struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired identities of the EtcdSnapshot.
+	// +optional
+	Spec EtcdSnapshotSpec
+	// +optional
+	Status EtcdSnapshotStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EtcdSnapshotList is the whole list of all etcd snapshots.
+type EtcdSnapshotList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of etcd snapshots
+	Items []EtcdSnapshot
+}
+
+// EtcdSnapshotSpec is a description of an etcd snapshot.
+type EtcdSnapshotSpec struct {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
+	// +optional
+	Finalizers  []FinalizerName
+	TenantID    string
+	ClusterName string
+	// Type is the way the snapshot was created, one of Scheduled or Manual.
+	// +optional
+	Type EtcdSnapshotType
+	// Target is where the snapshot is stored, defaults to the target of the cluster etcd backup feature.
+	// +optional
+	Target EtcdSnapshotTarget
+}
+
+// EtcdSnapshotStatus represents information about the status of an etcd snapshot.
+type EtcdSnapshotStatus struct {
+	// +optional
+	Phase EtcdSnapshotPhase
+	// Location is the path of the snapshot in the target.
+	// +optional
+	Location string
+	// SizeInBytes is the size of the snapshot.
+	// +optional
+	SizeInBytes int64
+	// Revision of the etcd when the snapshot was taken.
+	// +optional
+	Revision int64
+	// +optional
+	StartTime metav1.Time
+	// +optional
+	CompletionTime metav1.Time
+	// LastRestoreTime is the last time the cluster was restored from the snapshot.
+	// +optional
+	LastRestoreTime metav1.Time
+	// A human readable message indicating details about why the snapshot is in this condition.
+	// +optional
+	Message string
+	// A brief CamelCase message indicating details about why the snapshot is in this state.
+	// +optional
+	Reason string
+}
+
+// EtcdSnapshotType defines how an etcd snapshot was created.
+type EtcdSnapshotType string
+
+const (
+	// EtcdSnapshotScheduled is created by the etcd backup feature of cluster.
+	EtcdSnapshotScheduled EtcdSnapshotType = "Scheduled"
+	// EtcdSnapshotManual is created by user, it is never pruned by retention.
+	EtcdSnapshotManual EtcdSnapshotType = "Manual"
+)
+
+// EtcdSnapshotPhase defines the phase of etcd snapshot.
+type EtcdSnapshotPhase string
+
+const (
+	// EtcdSnapshotPending means the snapshot has not been taken.
+	EtcdSnapshotPending EtcdSnapshotPhase = "Pending"
+	// EtcdSnapshotRunning means the snapshot is being taken.
+	EtcdSnapshotRunning EtcdSnapshotPhase = "Running"
+	// EtcdSnapshotCompleted means the snapshot is stored in the target.
+	EtcdSnapshotCompleted EtcdSnapshotPhase = "Completed"
+	// EtcdSnapshotFailed means the snapshot could not be taken.
+	EtcdSnapshotFailed EtcdSnapshotPhase = "Failed"
+	// EtcdSnapshotRestoring means the cluster is being restored from the snapshot.
+	EtcdSnapshotRestoring EtcdSnapshotPhase = "Restoring"
+	// EtcdSnapshotTerminating means the snapshot is being removed from the target.
+	EtcdSnapshotTerminating EtcdSnapshotPhase = "Terminating"
+)
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EtcdSnapshotRestoreOptions is the query options to restore a cluster from an etcd snapshot.
+type EtcdSnapshotRestoreOptions struct {
+	metav1.TypeMeta
+	// Confirm must be the name of the cluster to restore.
+	Confirm string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachinePool is a group of machines of a cluster that share the same settings.
+type MachinePool struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired identities of the MachinePool.
+	// +optional
+	Spec MachinePoolSpec
+	// +optional
+	Status MachinePoolStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachinePoolList is the whole list of all machine pools.
+type MachinePoolList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of machine pools
+	Items []MachinePool
+}
+
+// MachinePoolSpec is a description of a machine pool.
+type MachinePoolSpec struct {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
+	// +optional
+	Finalizers  []FinalizerName
+	TenantID    string
+	ClusterName string
+	// Type is the machine provider type of the machines, defaults to Baremetal.
+	// +optional
+	Type string
+	// Replicas is the desired number of machines in the pool.
+	// +optional
+	Replicas int32
+	// HostSelector selects the hosts of the inventory the machines of the pool
+	// are drawn from, the machines login the hosts by their ssh credentials.
+	// +optional
+	HostSelector *metav1.LabelSelector
+	// Template describes the machines that will be created, changes to it only
+	// apply to machines created afterwards.
+	// +optional
+	Template MachineTemplateSpec
+}
+
+// MachineTemplateSpec describes the settings shared by the machines of a pool.
+type MachineTemplateSpec struct {
+	// +optional
+	Labels map[string]string
+	// If specified, the node's taints.
+	// +optional
+	Taints []corev1.Taint
+	// +optional
+	KubeletExtraArgs map[string]string
+	// +optional
+	DockerExtraArgs map[string]string
+	// Capacity is the allocatable resources of a machine, which the
+	// autoscaler compares with the requests of unschedulable pods. Defaults
+	// to the allocatable of a running node of the pool.
+	// +optional
+	Capacity corev1.ResourceList
+	// ContainerRuntimeConfig is merged over the container runtime config of
+	// the cluster on the machines of the pool.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig
+}
+
+// MachinePoolStatus represents information about the status of a machine pool.
+type MachinePoolStatus struct {
+	// +optional
+	Phase MachinePoolPhase
+	// Replicas is the number of machines in the pool.
+	// +optional
+	Replicas int32
+	// ReadyReplicas is the number of running machines in the pool.
+	// +optional
+	ReadyReplicas int32
+	// AvailableHosts is the number of hosts of the inventory not used by any machine.
+	// +optional
+	AvailableHosts int32
+	// A human readable message indicating details about why the pool is in this condition.
+	// +optional
+	Message string
+	// A brief CamelCase message indicating details about why the pool is in this state.
+	// +optional
+	Reason string
+}
+
+// MachinePoolPhase defines the phase of machine pool.
+type MachinePoolPhase string
+
+const (
+	// MachinePoolRunning means the pool has the desired number of machines.
+	MachinePoolRunning MachinePoolPhase = "Running"
+	// MachinePoolScaling means machines are being added to or removed from the pool.
+	MachinePoolScaling MachinePoolPhase = "Scaling"
+	// MachinePoolTerminating means the machines of the pool are being removed.
+	MachinePoolTerminating MachinePoolPhase = "Terminating"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCredential is a ssh login secret that can be referenced by name from
+// hosts, cluster machines and machines.
+type SSHCredential struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired identities of the SSHCredential.
+	// +optional
+	Spec SSHCredentialSpec
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCredentialList is the whole list of all ssh credentials.
+type SSHCredentialList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of ssh credentials
+	Items []SSHCredential
+}
+
+// SSHCredentialSpec is a description of a ssh credential.
+type SSHCredentialSpec struct {
+	TenantID string
+	Username string
+	// +optional
+	Password []byte
+	// +optional
+	PrivateKey []byte
+	// +optional
+	PassPhrase []byte
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Host is a server of the inventory that can be used as a machine of clusters.
+type Host struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired identities of the Host.
+	// +optional
+	Spec HostSpec
+	// +optional
+	Status HostStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HostList is the whole list of all hosts.
+type HostList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of hosts
+	Items []Host
+}
+
+// HostSpec is a description of a host.
+type HostSpec struct {
+	TenantID string
+	IP       string
+	Port     int32
+	// CredentialName is the name of the SSHCredential used to login the host.
+	CredentialName string
+	// +optional
+	Labels map[string]string
+}
+
+// HostStatus represents information about the status of a host.
+type HostStatus struct {
+	// +optional
+	Phase HostPhase
+	// The last time the host was probed.
+	// +optional
+	LastProbeTime metav1.Time
+	// +optional
+	MachineInfo MachineSystemInfo
+	// Capacity represents the total resources of the host, such as cpu and memory.
+	// +optional
+	Capacity ResourceList
+	// A human readable message indicating details about why the host is in this condition.
+	// +optional
+	Message string
+	// A brief CamelCase message indicating details about why the host is in this state.
+	// +optional
+	Reason string
+}
+
+// HostPhase defines the phase of host.
+type HostPhase string
+
+const (
+	// HostPending means the host has not been probed yet.
+	HostPending HostPhase = "Pending"
+	// HostReachable means the host can be logged in with its credential.
+	HostReachable HostPhase = "Reachable"
+	// HostUnreachable means the last probe of the host failed.
+	HostUnreachable HostPhase = "Unreachable"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterTemplate holds defaults shared by clusters created from it.
+type ClusterTemplate struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the defaults of the ClusterTemplate.
+	// +optional
+	Spec ClusterTemplateSpec
+	// +optional
+	Status ClusterTemplateStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterTemplateList is the whole list of all cluster templates.
+type ClusterTemplateList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of cluster templates
+	Items []ClusterTemplate
+}
+
+// ClusterTemplateSpec is a description of a cluster template, every field is
+// only used as a default for the same field of the cluster spec. The cluster
+// CIDR is not a part of templates, every cluster sets its own.
+type ClusterTemplateSpec struct {
+	TenantID string
+	// +optional
+	DisplayName string
+	// +optional
+	Type string
+	// +optional
+	Version string
+	// +optional
+	NetworkType NetworkType
+	// +optional
+	ServiceCIDR *string
+	// +optional
+	Features ClusterFeature
+	// +optional
+	Properties ClusterProperty
+	// +optional
+	DockerExtraArgs map[string]string
+	// +optional
+	KubeletExtraArgs map[string]string
+	// +optional
+	APIServerExtraArgs map[string]string
+	// +optional
+	ControllerManagerExtraArgs map[string]string
+	// +optional
+	SchedulerExtraArgs map[string]string
+	// +optional
+	NetworkArgs map[string]string
+	// +optional
+	BootstrapApps BootstrapApps
+}
+
+// ClusterTemplateStatus represents information about the status of a cluster template.
+type ClusterTemplateStatus struct {
+	// Revision is increased by the server every time the spec changes.
+	// +optional
+	Revision int64
+	// Revisions are the latest revisions of the spec kept by the server,
+	// including the current one. They are never changed once recorded.
+	// +optional
+	Revisions []ClusterTemplateRevision
+}
+
+// ClusterTemplateRevision is a recorded revision of the spec of a cluster template.
+type ClusterTemplateRevision struct {
+	Revision int64
+	Spec     ClusterTemplateSpec
+	// CreationTimestamp is the time when the revision was recorded.
+	// +optional
+	CreationTimestamp metav1.Time
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditPolicy is the kubernetes audit policy applied to the masters of a cluster.
+type AuditPolicy struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired audit policy of the cluster.
+	// +optional
+	Spec AuditPolicySpec
+	// +optional
+	Status AuditPolicyStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditPolicyList is the whole list of all audit policies.
+type AuditPolicyList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of audit policies
+	Items []AuditPolicy
+}
+
+// AuditPolicySpec is a description of an audit policy, it mirrors the
+// audit.k8s.io/v1 Policy. An empty rule list means the default policy shipped
+// with the provider is used.
+type AuditPolicySpec struct {
+	TenantID    string
+	ClusterName string
+	// OmitStages is a list of stages for which no events are created.
+	// +optional
+	OmitStages []AuditStage
+	// Rules specify the audit Level a request should be recorded at, the
+	// first matching rule wins.
+	// +optional
+	Rules []AuditPolicyRule
+}
+
+// AuditPolicyRule maps requests based off metadata to an audit Level.
+type AuditPolicyRule struct {
+	Level AuditLevel
+	// +optional
+	Users []string
+	// +optional
+	UserGroups []string
+	// +optional
+	Verbs []string
+	// +optional
+	Resources []AuditGroupResources
+	// +optional
+	Namespaces []string
+	// +optional
+	NonResourceURLs []string
+	// +optional
+	OmitStages []AuditStage
+}
+
+// AuditGroupResources represents resource kinds in an API group.
+type AuditGroupResources struct {
+	// +optional
+	Group string
+	// +optional
+	Resources []string
+	// +optional
+	ResourceNames []string
+}
+
+// AuditLevel defines the amount of information logged during auditing.
+type AuditLevel string
+
+// These are valid audit levels.
+const (
+	// AuditLevelNone disables auditing.
+	AuditLevelNone AuditLevel = "None"
+	// AuditLevelMetadata provides the basic level of auditing.
+	AuditLevelMetadata AuditLevel = "Metadata"
+	// AuditLevelRequest provides Metadata level of auditing, and additionally
+	// logs the request object.
+	AuditLevelRequest AuditLevel = "Request"
+	// AuditLevelRequestResponse provides Request level of auditing, and additionally
+	// logs the response object.
+	AuditLevelRequestResponse AuditLevel = "RequestResponse"
+)
+
+// AuditStage defines the stages in request handling that audit events may be generated.
+type AuditStage string
+
+// These are valid audit stages.
+const (
+	AuditStageRequestReceived  AuditStage = "RequestReceived"
+	AuditStageResponseStarted  AuditStage = "ResponseStarted"
+	AuditStageResponseComplete AuditStage = "ResponseComplete"
+	AuditStagePanic            AuditStage = "Panic"
+)
+
+// AuditPolicyStatus represents information about the status of an audit policy.
+type AuditPolicyStatus struct {
+	// +optional
+	Phase AuditPolicyPhase
+	// ObservedGeneration is the generation of the spec last rolled to the masters.
+	// +optional
+	ObservedGeneration int64
+	// +optional
+	Message string
+	// +optional
+	LastTransitionTime metav1.Time
+}
+
+// AuditPolicyPhase defines the phase of audit policy rollout.
+type AuditPolicyPhase string
+
+const (
+	// AuditPolicyPending means the policy has not been rolled to the masters yet.
+	AuditPolicyPending AuditPolicyPhase = "Pending"
+	// AuditPolicyApplied means every master runs with the policy.
+	AuditPolicyApplied AuditPolicyPhase = "Applied"
+	// AuditPolicyFailed means the last rollout failed and was rolled back.
+	AuditPolicyFailed AuditPolicyPhase = "Failed"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterSync keeps the objects of clusters in sync with the manifests in a
+// git repository.
+type ClusterSync struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the source and the target clusters of the sync.
+	// +optional
+	Spec ClusterSyncSpec
+	// +optional
+	Status ClusterSyncStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterSyncList is the whole list of all cluster syncs.
+type ClusterSyncList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of cluster syncs
+	Items []ClusterSync
+}
+
+// ClusterSyncSpec is a description of a cluster sync.
+type ClusterSyncSpec struct {
+	TenantID string
+	// Source is the git repository the manifests are rendered from.
+	Source ClusterSyncSource
+	// ClusterNames are the names of the target clusters.
+	// +optional
+	ClusterNames []string
+	// ClusterSelector selects the target clusters of the tenant by labels, in
+	// addition to ClusterNames.
+	// +optional
+	ClusterSelector *metav1.LabelSelector
+	// Interval is the period of fetching the source and correcting the drift
+	// of the target clusters, defaults to 5 minutes.
+	// +optional
+	Interval metav1.Duration
+	// Prune deletes the objects which have been removed from the source.
+	// +optional
+	Prune bool
+	// Suspend stops syncing until it is unset.
+	// +optional
+	Suspend bool
+}
+
+// ClusterSyncSource is a directory in a branch of a git repository. The
+// directory is rendered by kustomize if it contains a kustomization file,
+// otherwise the yaml and json files under it are applied as plain manifests.
+type ClusterSyncSource struct {
+	// URL of the repository, any url accepted by git fetch.
+	URL string
+	// Branch defaults to master.
+	// +optional
+	Branch string
+	// Path is the directory relative to the root of the repository.
+	// +optional
+	Path string
+}
+
+// ClusterSyncStatus represents information about the status of a cluster sync.
+type ClusterSyncStatus struct {
+	// +optional
+	Phase ClusterSyncPhase
+	// ObservedGeneration is the generation of the spec last synced.
+	// +optional
+	ObservedGeneration int64
+	// Revision is the commit of the source last rendered.
+	// +optional
+	Revision string
+	// +optional
+	LastSyncTime metav1.Time
+	// +optional
+	Message string
+	// Clusters is the sync status of every target cluster.
+	// +optional
+	Clusters []ClusterSyncClusterStatus
+}
+
+// ClusterSyncClusterStatus is the sync status of a target cluster.
+type ClusterSyncClusterStatus struct {
+	ClusterName string
+	// +optional
+	Phase ClusterSyncPhase
+	// Revision is the commit of the source last applied to the cluster.
+	// +optional
+	Revision string
+	// Drift lists the objects which differed from the source at the last
+	// sync and were corrected, at most 50 of them.
+	// +optional
+	Drift []ClusterSyncObject
+	// +optional
+	LastSyncTime metav1.Time
+	// +optional
+	Message string
+}
+
+// ClusterSyncObject is an object which differed from the source.
+type ClusterSyncObject struct {
+	// +optional
+	Group string
+	Kind  string
+	// +optional
+	Namespace string
+	Name      string
+	// Action is the action which brought the object back in sync.
+	Action ClusterApplyAction
+}
+
+// ClusterSyncPhase defines the phase of a cluster sync.
+type ClusterSyncPhase string
+
+const (
+	// ClusterSyncPending means the source has not been synced yet.
+	ClusterSyncPending ClusterSyncPhase = "Pending"
+	// ClusterSyncSynced means the last sync succeeded.
+	ClusterSyncSynced ClusterSyncPhase = "Synced"
+	// ClusterSyncFailed means the last sync failed.
+	ClusterSyncFailed ClusterSyncPhase = "Failed"
+	// ClusterSyncSuspended means the sync is suspended.
+	ClusterSyncSuspended ClusterSyncPhase = "Suspended"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiClusterDeployment propagates a deployment to several clusters and
+// splits its replicas among them.
+type MultiClusterDeployment struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the deployment and its placement.
+	// +optional
+	Spec MultiClusterDeploymentSpec
+	// +optional
+	Status MultiClusterDeploymentStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiClusterDeploymentList is the whole list of all multi cluster deployments.
+type MultiClusterDeploymentList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of multi cluster deployments
+	Items []MultiClusterDeployment
+}
+
+// MultiClusterDeploymentSpec is a description of a multi cluster deployment.
+// A deployment with the same name is created in the namespace of every target
+// cluster.
+type MultiClusterDeploymentSpec struct {
+	TenantID string
+	// Namespace of the deployments in the target clusters, it must exist.
+	Namespace string
+	// Replicas is the total number of pods of all target clusters, defaults
+	// to 1.
+	// +optional
+	Replicas *int32
+	// Selector is the label query over pods of the deployments, it must match
+	// the labels of the template.
+	Selector *metav1.LabelSelector
+	// Template describes the pods of the deployments.
+	Template corev1.PodTemplateSpec
+	// Placement selects the target clusters.
+	Placement MultiClusterPlacement
+	// Overrides customize the deployment of individual target clusters.
+	// +optional
+	Overrides []MultiClusterOverride
+}
+
+// MultiClusterPlacement selects the target clusters of a multi cluster
+// deployment and weighs them for splitting the replicas.
+type MultiClusterPlacement struct {
+	// ClusterNames are the names of the target clusters.
+	// +optional
+	ClusterNames []string
+	// ClusterSelector selects the target clusters of the tenant by labels, in
+	// addition to ClusterNames.
+	// +optional
+	ClusterSelector *metav1.LabelSelector
+	// Weights of the target clusters when splitting the replicas, clusters
+	// not listed weigh 1.
+	// +optional
+	Weights []MultiClusterWeight
+}
+
+// MultiClusterWeight is the weight of a target cluster.
+type MultiClusterWeight struct {
+	ClusterName string
+	// Weight is relative to the weights of the other target clusters, a
+	// cluster weighing 0 gets no replicas.
+	Weight int32
+}
+
+// MultiClusterOverride customizes the deployment of a target cluster.
+type MultiClusterOverride struct {
+	ClusterName string
+	// Replicas of the cluster instead of its share of the weighted split, the
+	// rest of the total replicas is split among the other clusters.
+	// +optional
+	Replicas *int32
+	// Containers override the containers of the template by name.
+	// +optional
+	Containers []MultiClusterContainerOverride
+}
+
+// MultiClusterContainerOverride overrides a container of the template.
+type MultiClusterContainerOverride struct {
+	// Name of the container in the template.
+	Name string
+	// Image replaces the image of the container.
+	// +optional
+	Image string
+	// Env is merged into the environment variables of the container by name.
+	// +optional
+	Env []corev1.EnvVar
+}
+
+// MultiClusterDeploymentStatus represents information about the status of a
+// multi cluster deployment, aggregated from the deployments of the target
+// clusters.
+type MultiClusterDeploymentStatus struct {
+	// +optional
+	Phase MultiClusterDeploymentPhase
+	// ObservedGeneration is the generation of the spec last propagated.
+	// +optional
+	ObservedGeneration int64
+	// Replicas is the total number of desired pods of all target clusters.
+	// +optional
+	Replicas int32
+	// +optional
+	UpdatedReplicas int32
+	// +optional
+	ReadyReplicas int32
+	// +optional
+	AvailableReplicas int32
+	// +optional
+	LastSyncTime metav1.Time
+	// +optional
+	Message string
+	// Clusters is the status of the deployment of every target cluster.
+	// +optional
+	Clusters []MultiClusterDeploymentClusterStatus
+}
+
+// MultiClusterDeploymentClusterStatus is the status of the deployment of a
+// target cluster.
+type MultiClusterDeploymentClusterStatus struct {
+	ClusterName string
+	// +optional
+	Phase MultiClusterDeploymentPhase
+	// Replicas is the number of desired pods of the cluster.
+	// +optional
+	Replicas int32
+	// +optional
+	UpdatedReplicas int32
+	// +optional
+	ReadyReplicas int32
+	// +optional
+	AvailableReplicas int32
+	// +optional
+	Message string
+}
+
+// MultiClusterDeploymentPhase defines the phase of a multi cluster deployment.
+type MultiClusterDeploymentPhase string
+
+const (
+	// MultiClusterDeploymentPending means the deployment has not been
+	// propagated yet.
+	MultiClusterDeploymentPending MultiClusterDeploymentPhase = "Pending"
+	// MultiClusterDeploymentProgressing means the deployments are rolling out.
+	MultiClusterDeploymentProgressing MultiClusterDeploymentPhase = "Progressing"
+	// MultiClusterDeploymentAvailable means the deployments have rolled out
+	// and all replicas are available.
+	MultiClusterDeploymentAvailable MultiClusterDeploymentPhase = "Available"
+	// MultiClusterDeploymentFailed means the deployment failed to be
+	// propagated to some clusters.
+	MultiClusterDeploymentFailed MultiClusterDeploymentPhase = "Failed"
+)
+
+const (
+	// MultiClusterDeploymentLabel is the label of the deployments propagated
+	// to the target clusters, whose value is the name of the multi cluster
+	// deployment.
+	MultiClusterDeploymentLabel = "platform.tkestack.io/multiclusterdeployment"
+	// MultiClusterDeploymentFinalizer is the finalizer of multi cluster
+	// deployments, removed once the deployments are deleted from the target
+	// clusters.
+	MultiClusterDeploymentFinalizer = "platform.tkestack.io/multiclusterdeployment"
+)
@@ -19,6 +19,7 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -33,15 +34,26 @@ import (
 )
 
 func (in *ClusterMachine) SSH() (*ssh.SSH, error) {
+	return ssh.New(in.sshConfig())
+}
+
+// SSHWithContext returns the ssh client of machine, which records the output
+// of commands to the recorder carried by ctx.
+func (in *ClusterMachine) SSHWithContext(ctx context.Context) (*ssh.SSH, error) {
+	return ssh.NewWithContext(ctx, in.sshConfig())
+}
+
+func (in *ClusterMachine) sshConfig() *ssh.Config {
 	sshConfig := &ssh.Config{
-		User:        in.Username,
-		Host:        in.IP,
-		Port:        int(in.Port),
-		Password:    string(in.Password),
-		PrivateKey:  in.PrivateKey,
-		PassPhrase:  in.PassPhrase,
-		DialTimeOut: time.Second,
-		Retry:       0,
+		User:           in.Username,
+		Host:           in.IP,
+		Port:           int(in.Port),
+		Password:       string(in.Password),
+		PrivateKey:     in.PrivateKey,
+		PassPhrase:     in.PassPhrase,
+		CredentialName: in.CredentialName,
+		DialTimeOut:    time.Second,
+		Retry:          0,
 	}
 	switch in.Proxy.Type {
 	case SSHJumpServer:
@@ -62,7 +74,7 @@ func (in *ClusterMachine) SSH() (*ssh.SSH, error) {
 		proxy.DialTimeOut = time.Second
 		sshConfig.Proxy = proxy
 	}
-	return ssh.New(sshConfig)
+	return sshConfig
 }
 
 func (in *Cluster) Address(addrType AddressType) *ClusterAddress {
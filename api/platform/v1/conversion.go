@@ -34,6 +34,14 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForTappController,
 		AddFieldLabelConversionsForCSIOperator,
 		AddFieldLabelConversionsForCronHPA,
+		AddFieldLabelConversionsForEtcdSnapshot,
+		AddFieldLabelConversionsForMachinePool,
+		AddFieldLabelConversionsForSSHCredential,
+		AddFieldLabelConversionsForHost,
+		AddFieldLabelConversionsForClusterTemplate,
+		AddFieldLabelConversionsForAuditPolicy,
+		AddFieldLabelConversionsForClusterSync,
+		AddFieldLabelConversionsForMultiClusterDeployment,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -54,6 +62,7 @@ func AddFieldLabelConversionsForCluster(scheme *runtime.Scheme) error {
 			case "spec.tenantID",
 				"spec.version",
 				"spec.type",
+				"spec.templateRef.name",
 				"status.locked",
 				"status.version",
 				"status.phase",
@@ -198,3 +207,146 @@ func AddFieldLabelConversionsForCronHPA(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForEtcdSnapshot adds a conversion function to convert
+// field selectors of EtcdSnapshot from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForEtcdSnapshot(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("EtcdSnapshot"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.type",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForMachinePool adds a conversion function to convert
+// field selectors of MachinePool from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForMachinePool(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("MachinePool"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.type",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForSSHCredential adds a conversion function to convert
+// field selectors of SSHCredential from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForSSHCredential(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("SSHCredential"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForHost adds a conversion function to convert
+// field selectors of Host from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForHost(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("Host"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.ip",
+				"spec.credentialName",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForClusterTemplate adds a conversion function to convert
+// field selectors of ClusterTemplate from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForClusterTemplate(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("ClusterTemplate"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.type",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForAuditPolicy adds a conversion function to convert
+// field selectors of AuditPolicy from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForAuditPolicy(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("AuditPolicy"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForClusterSync adds a conversion function to convert
+// field selectors of ClusterSync from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForClusterSync(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("ClusterSync"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForMultiClusterDeployment adds a conversion function
+// to convert field selectors of MultiClusterDeployment from the given version
+// to internal version representation.
+func AddFieldLabelConversionsForMultiClusterDeployment(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("MultiClusterDeployment"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.namespace",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
@@ -19,6 +19,9 @@
 package v1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -35,6 +38,10 @@ func SetDefaults_ClusterSpec(obj *ClusterSpec) {
 		maxUnready := intstr.FromInt(0)
 		obj.Features.Upgrade.Strategy.MaxUnready = &maxUnready
 	}
+	if obj.Features.Upgrade.Strategy.RollbackOnFailure == nil {
+		rollbackOnFailure := true
+		obj.Features.Upgrade.Strategy.RollbackOnFailure = &rollbackOnFailure
+	}
 }
 
 func SetDefaults_ClusterStatus(obj *ClusterStatus) {
@@ -58,6 +65,48 @@ func SetDefaults_MachineStatus(obj *MachineStatus) {
 	}
 }
 
+func SetDefaults_EtcdSnapshotStatus(obj *EtcdSnapshotStatus) {
+	if obj.Phase == "" {
+		obj.Phase = EtcdSnapshotPending
+	}
+}
+
+func SetDefaults_EtcdSnapshotSpec(obj *EtcdSnapshotSpec) {
+	if obj.Type == "" {
+		obj.Type = EtcdSnapshotManual
+	}
+}
+
+func SetDefaults_EtcdBackup(obj *EtcdBackup) {
+	if obj.MaxBackups == 0 {
+		obj.MaxBackups = 7
+	}
+}
+
+func SetDefaults_MachinePoolSpec(obj *MachinePoolSpec) {
+	if obj.Type == "" {
+		obj.Type = "Baremetal"
+	}
+}
+
+func SetDefaults_MachinePoolStatus(obj *MachinePoolStatus) {
+	if obj.Phase == "" {
+		obj.Phase = MachinePoolScaling
+	}
+}
+
+func SetDefaults_HostSpec(obj *HostSpec) {
+	if obj.Port == 0 {
+		obj.Port = 22
+	}
+}
+
+func SetDefaults_HostStatus(obj *HostStatus) {
+	if obj.Phase == "" {
+		obj.Phase = HostPending
+	}
+}
+
 func SetDefaults_ConfigMap(obj *ConfigMap) {
 	if obj.Data == nil {
 		obj.Data = make(map[string]string)
@@ -96,3 +145,31 @@ func SetDefaults_ClusterFeature(obj *ClusterFeature) {
 		obj.ContainerRuntime = Docker
 	}
 }
+
+func SetDefaults_ClusterSyncSpec(obj *ClusterSyncSpec) {
+	if obj.Source.Branch == "" {
+		obj.Source.Branch = "master"
+	}
+	if obj.Interval.Duration == 0 {
+		obj.Interval = metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
+func SetDefaults_ClusterSyncStatus(obj *ClusterSyncStatus) {
+	if obj.Phase == "" {
+		obj.Phase = ClusterSyncPending
+	}
+}
+
+func SetDefaults_MultiClusterDeploymentSpec(obj *MultiClusterDeploymentSpec) {
+	if obj.Replicas == nil {
+		replicas := int32(1)
+		obj.Replicas = &replicas
+	}
+}
+
+func SetDefaults_MultiClusterDeploymentStatus(obj *MultiClusterDeploymentStatus) {
+	if obj.Phase == "" {
+		obj.Phase = MultiClusterDeploymentPending
+	}
+}
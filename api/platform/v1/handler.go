@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package v1
+
+// MaxHandlerHistory is the max number of handler records kept in status.
+const MaxHandlerHistory = 30
+
+// RecordHandler records the execution of a provider handler in handler history of cluster.
+func (in *Cluster) RecordHandler(record HandlerRecord) {
+	in.Status.HandlerHistory = appendHandlerRecord(in.Status.HandlerHistory, record)
+}
+
+// RecordHandler records the execution of a provider handler in handler history of machine.
+func (in *Machine) RecordHandler(record HandlerRecord) {
+	in.Status.HandlerHistory = appendHandlerRecord(in.Status.HandlerHistory, record)
+}
+
+// LastHandlerFailed reports whether the last record of history is a failed run of handler.
+func LastHandlerFailed(history []HandlerRecord, name string) bool {
+	n := len(history)
+	return n > 0 && history[n-1].Name == name && history[n-1].LastError != ""
+}
+
+// appendHandlerRecord appends record to history. A retry of the last failed
+// handler is merged into the last record, which keeps the start time of the
+// first attempt and counts attempts. The oldest records are dropped to keep
+// at most MaxHandlerHistory records.
+func appendHandlerRecord(history []HandlerRecord, record HandlerRecord) []HandlerRecord {
+	if record.Attempts == 0 {
+		record.Attempts = 1
+	}
+	if LastHandlerFailed(history, record.Name) {
+		last := history[len(history)-1]
+		record.StartTime = last.StartTime
+		record.Attempts += last.Attempts
+		history = history[:len(history)-1]
+	}
+	history = append(history, record)
+	if len(history) > MaxHandlerHistory {
+		history = append([]HandlerRecord(nil), history[len(history)-MaxHandlerHistory:]...)
+	}
+
+	return history
+}
@@ -19,6 +19,7 @@
 package v1
 
 import (
+	"context"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,17 +27,27 @@ import (
 )
 
 func (in *MachineSpec) SSH() (*ssh.SSH, error) {
-	sshConfig := &ssh.Config{
-		User:        in.Username,
-		Host:        in.IP,
-		Port:        int(in.Port),
-		Password:    string(in.Password),
-		PrivateKey:  in.PrivateKey,
-		PassPhrase:  in.PassPhrase,
-		DialTimeOut: time.Second,
-		Retry:       0,
+	return ssh.New(in.sshConfig())
+}
+
+// SSHWithContext returns the ssh client of machine, which records the output
+// of commands to the recorder carried by ctx.
+func (in *MachineSpec) SSHWithContext(ctx context.Context) (*ssh.SSH, error) {
+	return ssh.NewWithContext(ctx, in.sshConfig())
+}
+
+func (in *MachineSpec) sshConfig() *ssh.Config {
+	return &ssh.Config{
+		User:           in.Username,
+		Host:           in.IP,
+		Port:           int(in.Port),
+		Password:       string(in.Password),
+		PrivateKey:     in.PrivateKey,
+		PassPhrase:     in.PassPhrase,
+		CredentialName: in.CredentialName,
+		DialTimeOut:    time.Second,
+		Retry:          0,
 	}
-	return ssh.New(sshConfig)
 }
 
 func (in *Machine) GetCondition(conditionType string) *MachineCondition {
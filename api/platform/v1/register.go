@@ -53,6 +53,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Cluster{},
 		&ClusterList{},
 		&ClusterApplyOptions{},
+		&ClusterApplyResult{},
 
 		&ClusterCredential{},
 		&ClusterCredentialList{},
@@ -89,6 +90,26 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterGroupAPIResourceItems{},
 		&ClusterGroupAPIResourceItemsList{},
 		&ClusterGroupAPIResourceOptions{},
+
+		&EtcdSnapshot{},
+		&EtcdSnapshotList{},
+		&EtcdSnapshotRestoreOptions{},
+		&ClusterPlan{},
+
+		&MachinePool{},
+		&MachinePoolList{},
+		&SSHCredential{},
+		&SSHCredentialList{},
+		&Host{},
+		&HostList{},
+		&ClusterTemplate{},
+		&ClusterTemplateList{},
+		&AuditPolicy{},
+		&AuditPolicyList{},
+		&ClusterSync{},
+		&ClusterSyncList{},
+		&MultiClusterDeployment{},
+		&MultiClusterDeploymentList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
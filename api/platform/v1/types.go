@@ -85,6 +85,14 @@ type ClusterMachine struct {
 	Taints []corev1.Taint `json:"taints,omitempty" protobuf:"bytes,8,opt,name=taints"`
 	// +optional
 	Proxy ClusterMachineProxy `json:"proxy,omitempty" protobuf:"bytes,9,opt,name=proxy"`
+	// CredentialName is the name of the SSHCredential used to login the machine,
+	// it takes precedence over the inline username, password and private key.
+	// +optional
+	CredentialName string `json:"credentialName,omitempty" protobuf:"bytes,10,opt,name=credentialName"`
+	// ContainerRuntimeConfig is merged over the container runtime config of
+	// the cluster.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig `json:"containerRuntimeConfig,omitempty" protobuf:"bytes,11,opt,name=containerRuntimeConfig"`
 }
 
 // ClusterMachine is the proxy definition of ClusterMachine.
@@ -129,6 +137,17 @@ const (
 	AnywhereUpgradeRetryComponentAnno = "tkestack.io/anywhere-upgrade-retry-component"
 	// AnywhereUpgradeRetryComponentAnno describe anywhere upgrade stats
 	AnywhereUpgradeStatsAnno = "tkestack.io/anywhere-upgrade-stats"
+	// WorkerUpgradeAnno controls the rolling upgrade of worker machines, value can be Paused or Aborted.
+	// Remove it to resume the rolling upgrade.
+	WorkerUpgradeAnno = "platform.tkestack.io/worker-upgrade"
+	// WorkerUpgradePaused pauses marking next worker machine to be upgraded.
+	WorkerUpgradePaused = "Paused"
+	// WorkerUpgradeAborted stops the rolling upgrade and clears need upgrade label of remaining machines.
+	WorkerUpgradeAborted = "Aborted"
+	// ReplaceMasterAnno marks the master with the IP in value to be replaced by the
+	// master added to spec.machines together, the old master is removed after the
+	// new one joins.
+	ReplaceMasterAnno = "platform.tkestack.io/replace-master"
 	// ClusterNameLable contains related cluster's name for no-cluster resources
 	ClusterNameLable = "tkestack.io/cluster-name"
 	// HubAPIServerAnno describe hub cluster api server url
@@ -141,6 +160,13 @@ const (
 	AnywhereValidateAnno = "tkestack.io/anywhere-validate"
 	// LocationBasedImagePrefixAnno is exist, the cluster will use it as k8s images prefix
 	LocationBasedImagePrefixAnno = "tkestack.io/location-based-image-prefix"
+	// MachinePoolLabel contains the name of the machine pool a machine belongs to
+	MachinePoolLabel = "platform.tkestack.io/machinepool"
+	// MachineDeleteAnnotation marks a machine of pool to be deleted first
+	// when the pool is scaled down.
+	MachineDeleteAnnotation = "platform.tkestack.io/delete-machine"
+	// MachineHostLabel contains the name of the host a machine of pool runs on
+	MachineHostLabel = "platform.tkestack.io/host"
 )
 
 // KubeVendorType describe the kubernetes provider of the cluster
@@ -231,8 +257,79 @@ type ClusterSpec struct {
 	// AppVersion is the overall version of system components
 	// +optional
 	AppVersion string `json:"appVersion,omitempty" protobuf:"bytes,27,opt,name=appVersion"`
+	// TemplateRef is the cluster template whose defaults were merged into the
+	// spec when the cluster was created.
+	// +optional
+	TemplateRef *ClusterTemplateRef `json:"templateRef,omitempty" protobuf:"bytes,28,opt,name=templateRef"`
+	// ContainerRuntimeConfig configures containerd on the nodes of the cluster.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig `json:"containerRuntimeConfig,omitempty" protobuf:"bytes,29,opt,name=containerRuntimeConfig"`
+}
+
+// ClusterTemplateRef references a revision of a cluster template.
+type ClusterTemplateRef struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Revision is the revision of the template merged into the cluster. It is
+	// set by the server to the current revision on creation if it is empty,
+	// an earlier revision kept in the status of the template pins the cluster
+	// to it.
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,2,opt,name=revision"`
+}
+
+// ContainerRuntimeConfig is the declarative configuration of containerd.
+type ContainerRuntimeConfig struct {
+	// RegistryMirrors are the mirror endpoints of registries.
+	// +optional
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty" protobuf:"bytes,1,rep,name=registryMirrors"`
+	// InsecureRegistries are the registries accessed without verifying TLS.
+	// +optional
+	InsecureRegistries []string `json:"insecureRegistries,omitempty" protobuf:"bytes,2,rep,name=insecureRegistries"`
+	// Snapshotter is the snapshotter of containerd, overlayfs if empty.
+	// +optional
+	Snapshotter string `json:"snapshotter,omitempty" protobuf:"bytes,3,opt,name=snapshotter"`
+	// CgroupDriver is the cgroup driver of containerd and kubelet, systemd if
+	// empty. It can only be set on the cluster.
+	// +optional
+	CgroupDriver CgroupDriver `json:"cgroupDriver,omitempty" protobuf:"bytes,4,opt,name=cgroupDriver,casttype=CgroupDriver"`
+	// RuntimeClasses are the runtime handlers added to containerd, a
+	// RuntimeClass object is created in the cluster for each of them. They can
+	// only be set on the cluster.
+	// +optional
+	RuntimeClasses []RuntimeClass `json:"runtimeClasses,omitempty" protobuf:"bytes,5,rep,name=runtimeClasses"`
+}
+
+// RegistryMirror is the mirror endpoints of a registry.
+type RegistryMirror struct {
+	// Host of the registry, e.g. docker.io.
+	Host      string   `json:"host" protobuf:"bytes,1,opt,name=host"`
+	Endpoints []string `json:"endpoints" protobuf:"bytes,2,rep,name=endpoints"`
+}
+
+// RuntimeClass is a runtime handler of containerd.
+type RuntimeClass struct {
+	// Name of the runtime handler and the RuntimeClass object.
+	Name string      `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Type RuntimeType `json:"type" protobuf:"bytes,2,opt,name=type,casttype=RuntimeType"`
 }
 
+// RuntimeType is the type of a runtime handler.
+type RuntimeType string
+
+const (
+	RuntimeRunc   RuntimeType = "runc"
+	RuntimeKata   RuntimeType = "kata"
+	RuntimeGVisor RuntimeType = "gvisor"
+)
+
+// CgroupDriver is the cgroup driver of container runtime and kubelet.
+type CgroupDriver string
+
+const (
+	CgroupDriverSystemd  CgroupDriver = "systemd"
+	CgroupDriverCgroupfs CgroupDriver = "cgroupfs"
+)
+
 // ClusterStatus represents information about the status of a cluster.
 type ClusterStatus struct {
 	// +optional
@@ -288,6 +385,103 @@ type ClusterStatus struct {
 	// ComponentPhase is the status of components, contains "deployed", "pending-upgrade", "failed" status
 	// +optional
 	ComponentPhase ComponentPhase `json:"componentPhase,omitempty" protobuf:"bytes,22,opt,name=componentPhase"`
+	// The bounded execution history of provider handlers.
+	// +optional
+	HandlerHistory []HandlerRecord `json:"handlerHistory,omitempty" protobuf:"bytes,23,rep,name=handlerHistory"`
+	// The expiration of the certificates on the masters.
+	// +optional
+	Certificates []ClusterCertificate `json:"certificates,omitempty" protobuf:"bytes,24,rep,name=certificates"`
+}
+
+// ClusterCertificate records the expiration of a certificate on a master.
+type ClusterCertificate struct {
+	// Name of the certificate, such as apiserver or etcd-server.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// IP of the master which the certificate is on.
+	Node string `json:"node" protobuf:"bytes,2,opt,name=node"`
+	// +optional
+	NotAfter metav1.Time `json:"notAfter,omitempty" protobuf:"bytes,3,opt,name=notAfter"`
+}
+
+// HandlerRecord records the execution of a provider handler.
+type HandlerRecord struct {
+	// Name of the handler.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// The time when the first attempt started.
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty" protobuf:"bytes,2,opt,name=startTime"`
+	// The time when the last attempt ended.
+	// +optional
+	EndTime metav1.Time `json:"endTime,omitempty" protobuf:"bytes,3,opt,name=endTime"`
+	// The number of attempts.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty" protobuf:"varint,4,opt,name=attempts"`
+	// The error of the last attempt, empty if the handler succeeded.
+	// +optional
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,5,opt,name=lastError"`
+	// The ssh commands of the last failed attempt with their exit codes and
+	// redacted excerpts of stderr and stdout, the content of files read is
+	// never recorded.
+	// +optional
+	Output string `json:"output,omitempty" protobuf:"bytes,6,opt,name=output"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterPlan is the plan of the operation which would be done on a cluster,
+// returned by the plan subresource of cluster without mutating any host.
+type ClusterPlan struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Phase is the phase the cluster would enter for the operation.
+	// +optional
+	Phase ClusterPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=ClusterPhase"`
+	// Steps are the handlers which would be executed in order.
+	// +optional
+	Steps []ClusterPlanStep `json:"steps,omitempty" protobuf:"bytes,3,rep,name=steps"`
+	// Checks are the results of preflight checks on machines.
+	// +optional
+	Checks []ClusterPlanCheck `json:"checks,omitempty" protobuf:"bytes,4,rep,name=checks"`
+	// Configs are the rendered config files which would be written to machines.
+	// +optional
+	Configs []ClusterPlanConfig `json:"configs,omitempty" protobuf:"bytes,5,rep,name=configs"`
+}
+
+// ClusterPlanStep is a handler which would be executed by a cluster operation.
+type ClusterPlanStep struct {
+	// Name of the handler.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Skipped is true if the handler is skipped by skipConditions of cluster.
+	// +optional
+	Skipped bool `json:"skipped,omitempty" protobuf:"varint,2,opt,name=skipped"`
+}
+
+// ClusterPlanCheck is the result of a check on a machine.
+type ClusterPlanCheck struct {
+	// Name of the check.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// IP of the machine checked.
+	// +optional
+	IP string `json:"ip,omitempty" protobuf:"bytes,2,opt,name=ip"`
+	// Passed is true if the check passed.
+	// +optional
+	Passed bool `json:"passed,omitempty" protobuf:"varint,3,opt,name=passed"`
+	// A human readable message of warnings or errors found by the check.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// ClusterPlanConfig is a rendered config file which would be written to machines.
+type ClusterPlanConfig struct {
+	// Path of the config file on machines.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// IPs of the machines the config file would be written to.
+	// +optional
+	IPs []string `json:"ips,omitempty" protobuf:"bytes,2,rep,name=ips"`
+	// Content of the config file.
+	// +optional
+	Content string `json:"content,omitempty" protobuf:"bytes,3,opt,name=content"`
 }
 
 // FinalizerName is the name identifying a finalizer during cluster lifecycle.
@@ -299,11 +493,28 @@ const (
 
 	// MachineFinalize is an internal finalizer values to Machine.
 	MachineFinalize FinalizerName = "machine"
+
+	// EtcdSnapshotFinalize is an internal finalizer values to EtcdSnapshot.
+	EtcdSnapshotFinalize FinalizerName = "etcdsnapshot"
+
+	// MachinePoolFinalize is an internal finalizer values to MachinePool.
+	MachinePoolFinalize FinalizerName = "machinepool"
 )
 
 // NetworkType defines the network type of cluster.
 type NetworkType string
 
+const (
+	// NetworkGalaxy indicates the cluster network is provided by galaxy.
+	NetworkGalaxy NetworkType = "galaxy"
+	// NetworkCilium indicates the cluster network is provided by cilium.
+	NetworkCilium NetworkType = "cilium"
+	// NetworkCalico indicates the cluster network is provided by calico.
+	NetworkCalico NetworkType = "calico"
+	// NetworkFlannel indicates the cluster network is provided by flannel.
+	NetworkFlannel NetworkType = "flannel"
+)
+
 // GPUType defines the gpu type of cluster.
 type GPUType string
 
@@ -463,6 +674,10 @@ type ClusterCredential struct {
 	// ImpersonateUserExtra contains additional information for impersonated user.
 	// +optional
 	ImpersonateUserExtra ImpersonateUserExtra `json:"as-user-extra,omitempty" protobuf:"bytes,18,opt,name=asUserExtra"`
+	// S3SecretAccessKeys are the secret access keys of the S3 etcd snapshot
+	// targets of the cluster, keyed by access key ID.
+	// +optional
+	S3SecretAccessKeys map[string][]byte `json:"s3SecretAccessKeys,omitempty" protobuf:"bytes,19,rep,name=s3SecretAccessKeys"`
 }
 
 type ImpersonateUserExtra map[string]string
@@ -590,6 +805,15 @@ type ClusterFeature struct {
 	// Upgrade control upgrade process.
 	// +optional
 	Upgrade Upgrade `json:"upgrade,omitempty" protobuf:"bytes,22,opt,name=upgrade"`
+	// EtcdBackup controls scheduled snapshots of the local etcd.
+	// +optional
+	EtcdBackup *EtcdBackup `json:"etcdBackup,omitempty" protobuf:"bytes,23,opt,name=etcdBackup"`
+	// Autoscaling scales machine pools of the cluster by unschedulable pods.
+	// +optional
+	Autoscaling *ClusterAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,24,opt,name=autoscaling"`
+	// MetalLB deploys metallb to serve Services of type LoadBalancer.
+	// +optional
+	MetalLB *MetalLB `json:"metalLB,omitempty" protobuf:"bytes,25,opt,name=metalLB"`
 }
 
 type BootstrapApps []BootstrapApp
@@ -608,6 +832,7 @@ type App struct {
 type HA struct {
 	TKEHA        *TKEHA        `json:"tke,omitempty" protobuf:"bytes,1,opt,name=tke"`
 	ThirdPartyHA *ThirdPartyHA `json:"thirdParty,omitempty" protobuf:"bytes,2,opt,name=thirdParty"`
+	KubeVIPHA    *KubeVIPHA    `json:"kubeVIP,omitempty" protobuf:"bytes,3,opt,name=kubeVIP"`
 }
 
 type TKEHA struct {
@@ -620,6 +845,76 @@ type ThirdPartyHA struct {
 	VPort int32  `json:"vport" protobuf:"bytes,2,name=vport"`
 }
 
+// KubeVIPHA announces the control-plane VIP by kube-vip static pods on masters.
+type KubeVIPHA struct {
+	VIP string `json:"vip" protobuf:"bytes,1,name=vip"`
+	// Interface is the network interface the VIP is bound to, defaults to
+	// the network device of cluster.
+	// +optional
+	Interface string `json:"interface,omitempty" protobuf:"bytes,2,opt,name=interface"`
+	// Mode is how the VIP is announced, one of ARP and BGP, defaults to ARP.
+	// +optional
+	Mode KubeVIPMode `json:"mode,omitempty" protobuf:"bytes,3,opt,name=mode,casttype=KubeVIPMode"`
+	// BGP configures the peering of masters in BGP mode.
+	// +optional
+	BGP *BGPConfig `json:"bgp,omitempty" protobuf:"bytes,4,opt,name=bgp"`
+}
+
+// KubeVIPMode defines how kube-vip announces the VIP.
+type KubeVIPMode string
+
+const (
+	// KubeVIPARP announces the VIP by gratuitous ARP from the leader.
+	KubeVIPARP KubeVIPMode = "ARP"
+	// KubeVIPBGP advertises the VIP to BGP peers from every master.
+	KubeVIPBGP KubeVIPMode = "BGP"
+)
+
+// BGPConfig is the BGP speaker configuration of nodes.
+type BGPConfig struct {
+	// ASN is the AS number of nodes.
+	ASN int32 `json:"asn" protobuf:"varint,1,opt,name=asn"`
+	// Peers are the routers the nodes peer with.
+	Peers []BGPPeer `json:"peers" protobuf:"bytes,2,rep,name=peers"`
+}
+
+// BGPPeer is a router nodes peer with.
+type BGPPeer struct {
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	ASN     int32  `json:"asn" protobuf:"varint,2,opt,name=asn"`
+	// +optional
+	Password string `json:"password,omitempty" protobuf:"bytes,3,opt,name=password"`
+}
+
+// MetalLB is the load balancer of Services of type LoadBalancer.
+type MetalLB struct {
+	// AddressPools are the addresses assigned to LoadBalancer Services.
+	AddressPools []MetalLBAddressPool `json:"addressPools" protobuf:"bytes,1,rep,name=addressPools"`
+	// BGP configures the peering of nodes for pools in BGP protocol.
+	// +optional
+	BGP *BGPConfig `json:"bgp,omitempty" protobuf:"bytes,2,opt,name=bgp"`
+}
+
+// MetalLBAddressPool is a range of addresses announced by the same protocol.
+type MetalLBAddressPool struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Protocol is one of layer2 and bgp, defaults to layer2.
+	// +optional
+	Protocol MetalLBProtocol `json:"protocol,omitempty" protobuf:"bytes,2,opt,name=protocol,casttype=MetalLBProtocol"`
+	// Addresses are CIDRs or ranges in the form of "first-last".
+	Addresses []string `json:"addresses" protobuf:"bytes,3,rep,name=addresses"`
+}
+
+// MetalLBProtocol defines how metallb announces the addresses.
+type MetalLBProtocol string
+
+const (
+	// MetalLBLayer2 announces the addresses by ARP/NDP.
+	MetalLBLayer2 MetalLBProtocol = "layer2"
+	// MetalLBBGP advertises the addresses to BGP peers.
+	MetalLBBGP MetalLBProtocol = "bgp"
+)
+
 type File struct {
 	Src string `json:"src" protobuf:"bytes,1,name=src"` // Only support regular file
 	Dst string `json:"dst" protobuf:"bytes,2,name=dst"`
@@ -718,6 +1013,92 @@ type ExternalEtcd struct {
 	KeyFile string `json:"keyFile" protobuf:"bytes,4,opt,name=keyFile"`
 }
 
+// EtcdBackup describes the scheduled snapshot policy of the local etcd.
+type EtcdBackup struct {
+	// IntervalInSecond is the interval between two scheduled snapshots.
+	// Zero means no scheduled snapshot will be taken.
+	// +optional
+	IntervalInSecond int32 `json:"intervalInSecond,omitempty" protobuf:"varint,1,opt,name=intervalInSecond"`
+	// MaxBackups is the maximum number of scheduled snapshots to retain.
+	// +optional
+	MaxBackups int32 `json:"maxBackups,omitempty" protobuf:"varint,2,opt,name=maxBackups"`
+	// Target is where the snapshots are stored.
+	Target EtcdSnapshotTarget `json:"target" protobuf:"bytes,3,opt,name=target"`
+}
+
+// ClusterAutoscaling configures the autoscaler of machine pools of a cluster.
+type ClusterAutoscaling struct {
+	// NodeGroups are the machine pools scaled by the autoscaler.
+	// +optional
+	NodeGroups []AutoscalingNodeGroup `json:"nodeGroups,omitempty" protobuf:"bytes,1,rep,name=nodeGroups"`
+	// ScaleDownUnneededTime is how long a node should be unneeded before it
+	// is scaled down, defaults to 10m.
+	// +optional
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty" protobuf:"bytes,2,opt,name=scaleDownUnneededTime"`
+	// ScaleDownUtilizationThreshold is the percent of requested cpu and
+	// memory to allocatable of a node, under which the node is considered
+	// unneeded. Defaults to 50.
+	// +optional
+	ScaleDownUtilizationThreshold int32 `json:"scaleDownUtilizationThreshold,omitempty" protobuf:"varint,3,opt,name=scaleDownUtilizationThreshold"`
+}
+
+// AutoscalingNodeGroup is a machine pool scaled by the autoscaler within bounds.
+type AutoscalingNodeGroup struct {
+	// MachinePool is the name of machine pool.
+	MachinePool string `json:"machinePool" protobuf:"bytes,1,opt,name=machinePool"`
+	// MinReplicas is the lower bound of replicas of machine pool.
+	// +optional
+	MinReplicas int32 `json:"minReplicas,omitempty" protobuf:"varint,2,opt,name=minReplicas"`
+	// MaxReplicas is the upper bound of replicas of machine pool.
+	MaxReplicas int32 `json:"maxReplicas" protobuf:"varint,3,opt,name=maxReplicas"`
+}
+
+// EtcdSnapshotTarget describes where an etcd snapshot is stored.
+// Exactly one of its members must be specified.
+type EtcdSnapshotTarget struct {
+	// Local stores the snapshots on a master machine.
+	// +optional
+	Local *LocalSnapshotTarget `json:"local,omitempty" protobuf:"bytes,1,opt,name=local"`
+	// S3 stores the snapshots in an S3 compatible object storage.
+	// +optional
+	S3 *S3SnapshotTarget `json:"s3,omitempty" protobuf:"bytes,2,opt,name=s3"`
+	// Registry stores the snapshots in the filesystem of tke-registry.
+	// +optional
+	Registry *RegistrySnapshotTarget `json:"registry,omitempty" protobuf:"bytes,3,opt,name=registry"`
+}
+
+// LocalSnapshotTarget stores the snapshots in a directory of a master machine.
+type LocalSnapshotTarget struct {
+	// IP of the master machine, defaults to the first master of the cluster.
+	// +optional
+	IP string `json:"ip,omitempty" protobuf:"bytes,1,opt,name=ip"`
+	// Dir is the directory which stores the snapshots.
+	// +optional
+	Dir string `json:"dir,omitempty" protobuf:"bytes,2,opt,name=dir"`
+}
+
+// S3SnapshotTarget stores the snapshots in an S3 compatible object storage.
+type S3SnapshotTarget struct {
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+	// +optional
+	Region string `json:"region,omitempty" protobuf:"bytes,2,opt,name=region"`
+	Bucket string `json:"bucket" protobuf:"bytes,3,opt,name=bucket"`
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,4,opt,name=prefix"`
+	// AccessKeyID of the target, its secret access key is kept in the
+	// S3SecretAccessKeys of the cluster credential.
+	AccessKeyID string `json:"accessKeyID" protobuf:"bytes,5,opt,name=accessKeyID"`
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,7,opt,name=insecure"`
+}
+
+// RegistrySnapshotTarget stores the snapshots in the filesystem of tke-registry.
+type RegistrySnapshotTarget struct {
+	// Prefix is the path prefix relative to the root directory of the registry filesystem.
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,1,opt,name=prefix"`
+}
+
 type Upgrade struct {
 	// Upgrade mode, default value is Auto.
 	// +optional
@@ -750,6 +1131,18 @@ type UpgradeStrategy struct {
 	// If your pod can not accept be expelled from current node, this value should be false.
 	// +optional
 	DrainNodeBeforeUpgrade *bool `json:"drainNodeBeforeUpgrade,omitempty" protobuf:"varint,2,opt,name=drainNodeBeforeUpgrade"`
+	// The number of worker machines upgraded first as a canary batch.
+	// 0 means no canary batch.
+	// +optional
+	CanaryMachines int32 `json:"canaryMachines,omitempty" protobuf:"varint,3,opt,name=canaryMachines"`
+	// Whether pause the worker upgrade after the canary batch is upgraded.
+	// Remove the worker upgrade annotation of cluster to resume.
+	// +optional
+	PauseAfterCanary bool `json:"pauseAfterCanary,omitempty" protobuf:"varint,4,opt,name=pauseAfterCanary"`
+	// Whether roll back kubelet and kubeadm binaries of a worker node when its upgrade failed.
+	// default value is true.
+	// +optional
+	RollbackOnFailure *bool `json:"rollbackOnFailure,omitempty" protobuf:"varint,5,opt,name=rollbackOnFailure"`
 }
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -894,6 +1287,68 @@ type ClusterApplyOptions struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
 	NotUpdate bool `json:"notUpdate,omitempty" protobuf:"varint,1,opt,name=notUpdate"`
+	// ServerSide applies the objects by server-side apply instead of
+	// create-or-update, and the response is a ClusterApplyResult.
+	// +optional
+	ServerSide bool `json:"serverSide,omitempty" protobuf:"varint,2,opt,name=serverSide"`
+	// FieldManager is the name of the manager making the server-side apply,
+	// defaults to tke-apply.
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty" protobuf:"bytes,3,opt,name=fieldManager"`
+	// Force takes the ownership of conflicting fields from other managers.
+	// +optional
+	Force bool `json:"force,omitempty" protobuf:"varint,4,opt,name=force"`
+	// ApplySet labels the applied objects as members of the named set, so
+	// that they can be pruned by a later apply.
+	// +optional
+	ApplySet string `json:"applySet,omitempty" protobuf:"bytes,5,opt,name=applySet"`
+	// Prune deletes the members of the apply set which are no longer in the
+	// payload. It requires ApplySet.
+	// +optional
+	Prune bool `json:"prune,omitempty" protobuf:"varint,6,opt,name=prune"`
+	// DryRun submits the apply and prune without persisting them.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,7,opt,name=dryRun"`
+}
+
+// ClusterApplyAction is the action taken on an object by an apply.
+type ClusterApplyAction string
+
+// These are valid actions of an applied object.
+const (
+	ClusterApplyActionCreated    ClusterApplyAction = "Created"
+	ClusterApplyActionConfigured ClusterApplyAction = "Configured"
+	ClusterApplyActionUnchanged  ClusterApplyAction = "Unchanged"
+	ClusterApplyActionPruned     ClusterApplyAction = "Pruned"
+	ClusterApplyActionFailed     ClusterApplyAction = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterApplyResult is the result of a server-side apply to a cluster.
+type ClusterApplyResult struct {
+	metav1.TypeMeta `json:",inline"`
+	// DryRun indicates that nothing was persisted.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,1,opt,name=dryRun"`
+	// Items is the list of the applied and pruned objects.
+	// +optional
+	Items []ClusterApplyObjectResult `json:"items,omitempty" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterApplyObjectResult is the result of applying or pruning one object.
+type ClusterApplyObjectResult struct {
+	// +optional
+	Group   string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	Kind    string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+	// +optional
+	Namespace string             `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	Name      string             `json:"name" protobuf:"bytes,5,opt,name=name"`
+	Action    ClusterApplyAction `json:"action" protobuf:"bytes,6,opt,name=action,casttype=ClusterApplyAction"`
+	// Message is the error message when the action is Failed.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
 }
 
 // +genclient
@@ -1302,6 +1757,20 @@ type MachineSpec struct {
 	// If specified, the node's taints.
 	// +optional
 	Taints []corev1.Taint `json:"taints,omitempty" protobuf:"bytes,12,opt,name=taints"`
+	// KubeletExtraArgs is merged over the cluster kubelet extra args when the machine joins.
+	// +optional
+	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty" protobuf:"bytes,13,name=kubeletExtraArgs"`
+	// DockerExtraArgs is merged over the cluster docker extra args when the machine joins.
+	// +optional
+	DockerExtraArgs map[string]string `json:"dockerExtraArgs,omitempty" protobuf:"bytes,14,name=dockerExtraArgs"`
+	// CredentialName is the name of the SSHCredential used to login the machine,
+	// it takes precedence over the inline username, password and private key.
+	// +optional
+	CredentialName string `json:"credentialName,omitempty" protobuf:"bytes,15,opt,name=credentialName"`
+	// ContainerRuntimeConfig is merged over the container runtime config of
+	// the cluster.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig `json:"containerRuntimeConfig,omitempty" protobuf:"bytes,16,opt,name=containerRuntimeConfig"`
 }
 
 // MachineStatus represents information about the status of an machine.
@@ -1328,8 +1797,50 @@ type MachineStatus struct {
 	// Set of ids/uuids to uniquely identify the node.
 	// +optional
 	MachineInfo MachineSystemInfo `json:"machineInfo,omitempty" protobuf:"bytes,7,opt,name=machineInfo"`
+	// The latest kubernetes upgrade of the machine.
+	// +optional
+	Upgrade *MachineUpgradeStatus `json:"upgrade,omitempty" protobuf:"bytes,8,opt,name=upgrade"`
+	// The bounded execution history of provider handlers.
+	// +optional
+	HandlerHistory []HandlerRecord `json:"handlerHistory,omitempty" protobuf:"bytes,9,rep,name=handlerHistory"`
+}
+
+// MachineUpgradeStatus represents the kubernetes upgrade progress of a worker machine.
+type MachineUpgradeStatus struct {
+	// +optional
+	Phase MachineUpgradePhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=MachineUpgradePhase"`
+	// The kubelet version before upgrade.
+	// +optional
+	FromVersion string `json:"fromVersion,omitempty" protobuf:"bytes,2,opt,name=fromVersion"`
+	// The target kubernetes version.
+	// +optional
+	ToVersion string `json:"toVersion,omitempty" protobuf:"bytes,3,opt,name=toVersion"`
+	// Whether the machine belongs to the canary batch.
+	// +optional
+	Canary bool `json:"canary,omitempty" protobuf:"varint,4,opt,name=canary"`
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty" protobuf:"bytes,5,opt,name=startTime"`
+	// +optional
+	FinishTime metav1.Time `json:"finishTime,omitempty" protobuf:"bytes,6,opt,name=finishTime"`
+	// A human readable message indicating details about the upgrade.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
 }
 
+// MachineUpgradePhase defines the phase of machine upgrade.
+type MachineUpgradePhase string
+
+const (
+	// MachineUpgradeRunning means the machine is being upgraded.
+	MachineUpgradeRunning MachineUpgradePhase = "Upgrading"
+	// MachineUpgradeSucceeded means the machine is upgraded and passed the health gate.
+	MachineUpgradeSucceeded MachineUpgradePhase = "Succeeded"
+	// MachineUpgradeFailed means the upgrade failed and binaries were not rolled back.
+	MachineUpgradeFailed MachineUpgradePhase = "Failed"
+	// MachineUpgradeRolledBack means the upgrade failed and binaries were rolled back.
+	MachineUpgradeRolledBack MachineUpgradePhase = "RolledBack"
+)
+
 // MachineSystemInfo is a set of ids/uuids to uniquely identify the node.
 type MachineSystemInfo struct {
 	// MachineID reported by the node. For unique machine identification
@@ -1559,3 +2070,848 @@ type ClusterGroupAPIResourceItem struct {
 type ClusterGroupAPIResourceOptions struct {
 	metav1.TypeMeta `json:",inline"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EtcdSnapshot is a snapshot of the local etcd of a cluster.
+type EtcdSnapshot struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the desired identities of the EtcdSnapshot.
+	// +optional
+	Spec EtcdSnapshotSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status EtcdSnapshotStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EtcdSnapshotList is the whole list of all etcd snapshots.
+type EtcdSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of etcd snapshots
+	Items []EtcdSnapshot `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// EtcdSnapshotSpec is a description of an etcd snapshot.
+type EtcdSnapshotSpec struct {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
+	// +optional
+	Finalizers  []FinalizerName `json:"finalizers,omitempty" protobuf:"bytes,1,rep,name=finalizers,casttype=FinalizerName"`
+	TenantID    string          `json:"tenantID,omitempty" protobuf:"bytes,2,opt,name=tenantID"`
+	ClusterName string          `json:"clusterName" protobuf:"bytes,3,opt,name=clusterName"`
+	// Type is the way the snapshot was created, one of Scheduled or Manual.
+	// +optional
+	Type EtcdSnapshotType `json:"type,omitempty" protobuf:"bytes,4,opt,name=type,casttype=EtcdSnapshotType"`
+	// Target is where the snapshot is stored, defaults to the target of the cluster etcd backup feature.
+	// +optional
+	Target EtcdSnapshotTarget `json:"target,omitempty" protobuf:"bytes,5,opt,name=target"`
+}
+
+// EtcdSnapshotStatus represents information about the status of an etcd snapshot.
+type EtcdSnapshotStatus struct {
+	// +optional
+	Phase EtcdSnapshotPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=EtcdSnapshotPhase"`
+	// Location is the path of the snapshot in the target.
+	// +optional
+	Location string `json:"location,omitempty" protobuf:"bytes,2,opt,name=location"`
+	// SizeInBytes is the size of the snapshot.
+	// +optional
+	SizeInBytes int64 `json:"sizeInBytes,omitempty" protobuf:"varint,3,opt,name=sizeInBytes"`
+	// Revision of the etcd when the snapshot was taken.
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,4,opt,name=revision"`
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty" protobuf:"bytes,5,opt,name=startTime"`
+	// +optional
+	CompletionTime metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,6,opt,name=completionTime"`
+	// LastRestoreTime is the last time the cluster was restored from the snapshot.
+	// +optional
+	LastRestoreTime metav1.Time `json:"lastRestoreTime,omitempty" protobuf:"bytes,7,opt,name=lastRestoreTime"`
+	// A human readable message indicating details about why the snapshot is in this condition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+	// A brief CamelCase message indicating details about why the snapshot is in this state.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,9,opt,name=reason"`
+}
+
+// EtcdSnapshotType defines how an etcd snapshot was created.
+type EtcdSnapshotType string
+
+const (
+	// EtcdSnapshotScheduled is created by the etcd backup feature of cluster.
+	EtcdSnapshotScheduled EtcdSnapshotType = "Scheduled"
+	// EtcdSnapshotManual is created by user, it is never pruned by retention.
+	EtcdSnapshotManual EtcdSnapshotType = "Manual"
+)
+
+// EtcdSnapshotPhase defines the phase of etcd snapshot.
+type EtcdSnapshotPhase string
+
+const (
+	// EtcdSnapshotPending means the snapshot has not been taken.
+	EtcdSnapshotPending EtcdSnapshotPhase = "Pending"
+	// EtcdSnapshotRunning means the snapshot is being taken.
+	EtcdSnapshotRunning EtcdSnapshotPhase = "Running"
+	// EtcdSnapshotCompleted means the snapshot is stored in the target.
+	EtcdSnapshotCompleted EtcdSnapshotPhase = "Completed"
+	// EtcdSnapshotFailed means the snapshot could not be taken.
+	EtcdSnapshotFailed EtcdSnapshotPhase = "Failed"
+	// EtcdSnapshotRestoring means the cluster is being restored from the snapshot.
+	EtcdSnapshotRestoring EtcdSnapshotPhase = "Restoring"
+	// EtcdSnapshotTerminating means the snapshot is being removed from the target.
+	EtcdSnapshotTerminating EtcdSnapshotPhase = "Terminating"
+)
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EtcdSnapshotRestoreOptions is the query options to restore a cluster from an etcd snapshot.
+type EtcdSnapshotRestoreOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Confirm must be the name of the cluster to restore.
+	Confirm string `json:"confirm,omitempty" protobuf:"bytes,1,opt,name=confirm"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachinePool is a group of machines of a cluster that share the same settings.
+type MachinePool struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the desired identities of the MachinePool.
+	// +optional
+	Spec MachinePoolSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status MachinePoolStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachinePoolList is the whole list of all machine pools.
+type MachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of machine pools
+	Items []MachinePool `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MachinePoolSpec is a description of a machine pool.
+type MachinePoolSpec struct {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
+	// +optional
+	Finalizers  []FinalizerName `json:"finalizers,omitempty" protobuf:"bytes,1,rep,name=finalizers,casttype=FinalizerName"`
+	TenantID    string          `json:"tenantID,omitempty" protobuf:"bytes,2,opt,name=tenantID"`
+	ClusterName string          `json:"clusterName" protobuf:"bytes,3,opt,name=clusterName"`
+	// Type is the machine provider type of the machines, defaults to Baremetal.
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,4,opt,name=type"`
+	// Replicas is the desired number of machines in the pool.
+	// +optional
+	Replicas int32 `json:"replicas" protobuf:"varint,5,opt,name=replicas"`
+	// HostSelector selects the hosts of the inventory the machines of the pool
+	// are drawn from, the machines login the hosts by their ssh credentials.
+	// +optional
+	HostSelector *metav1.LabelSelector `json:"hostSelector,omitempty" protobuf:"bytes,6,opt,name=hostSelector"`
+	// Template describes the machines that will be created, changes to it only
+	// apply to machines created afterwards.
+	// +optional
+	Template MachineTemplateSpec `json:"template,omitempty" protobuf:"bytes,7,opt,name=template"`
+}
+
+// MachineTemplateSpec describes the settings shared by the machines of a pool.
+type MachineTemplateSpec struct {
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
+	// If specified, the node's taints.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty" protobuf:"bytes,2,rep,name=taints"`
+	// +optional
+	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty" protobuf:"bytes,3,name=kubeletExtraArgs"`
+	// +optional
+	DockerExtraArgs map[string]string `json:"dockerExtraArgs,omitempty" protobuf:"bytes,4,name=dockerExtraArgs"`
+	// Capacity is the allocatable resources of a machine, which the
+	// autoscaler compares with the requests of unschedulable pods. Defaults
+	// to the allocatable of a running node of the pool.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty" protobuf:"bytes,5,rep,name=capacity,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// ContainerRuntimeConfig is merged over the container runtime config of
+	// the cluster on the machines of the pool.
+	// +optional
+	ContainerRuntimeConfig *ContainerRuntimeConfig `json:"containerRuntimeConfig,omitempty" protobuf:"bytes,6,opt,name=containerRuntimeConfig"`
+}
+
+// MachinePoolStatus represents information about the status of a machine pool.
+type MachinePoolStatus struct {
+	// +optional
+	Phase MachinePoolPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=MachinePoolPhase"`
+	// Replicas is the number of machines in the pool.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty" protobuf:"varint,2,opt,name=replicas"`
+	// ReadyReplicas is the number of running machines in the pool.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty" protobuf:"varint,3,opt,name=readyReplicas"`
+	// AvailableHosts is the number of hosts of the inventory not used by any machine.
+	// +optional
+	AvailableHosts int32 `json:"availableHosts,omitempty" protobuf:"varint,4,opt,name=availableHosts"`
+	// A human readable message indicating details about why the pool is in this condition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// A brief CamelCase message indicating details about why the pool is in this state.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,6,opt,name=reason"`
+}
+
+// MachinePoolPhase defines the phase of machine pool.
+type MachinePoolPhase string
+
+const (
+	// MachinePoolRunning means the pool has the desired number of machines.
+	MachinePoolRunning MachinePoolPhase = "Running"
+	// MachinePoolScaling means machines are being added to or removed from the pool.
+	MachinePoolScaling MachinePoolPhase = "Scaling"
+	// MachinePoolTerminating means the machines of the pool are being removed.
+	MachinePoolTerminating MachinePoolPhase = "Terminating"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCredential is a ssh login secret that can be referenced by name from
+// hosts, cluster machines and machines.
+type SSHCredential struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the desired identities of the SSHCredential.
+	// +optional
+	Spec SSHCredentialSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCredentialList is the whole list of all ssh credentials.
+type SSHCredentialList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of ssh credentials
+	Items []SSHCredential `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// SSHCredentialSpec is a description of a ssh credential.
+type SSHCredentialSpec struct {
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,1,opt,name=tenantID"`
+	Username string `json:"username" protobuf:"bytes,2,opt,name=username"`
+	// +optional
+	Password []byte `json:"password,omitempty" protobuf:"bytes,3,opt,name=password"`
+	// +optional
+	PrivateKey []byte `json:"privateKey,omitempty" protobuf:"bytes,4,opt,name=privateKey"`
+	// +optional
+	PassPhrase []byte `json:"passPhrase,omitempty" protobuf:"bytes,5,opt,name=passPhrase"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Host is a server of the inventory that can be used as a machine of clusters.
+type Host struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the desired identities of the Host.
+	// +optional
+	Spec HostSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status HostStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HostList is the whole list of all hosts.
+type HostList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of hosts
+	Items []Host `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// HostSpec is a description of a host.
+type HostSpec struct {
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,1,opt,name=tenantID"`
+	IP       string `json:"ip" protobuf:"bytes,2,opt,name=ip"`
+	Port     int32  `json:"port" protobuf:"varint,3,opt,name=port"`
+	// CredentialName is the name of the SSHCredential used to login the host.
+	CredentialName string `json:"credentialName" protobuf:"bytes,4,opt,name=credentialName"`
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,5,opt,name=labels"`
+}
+
+// HostStatus represents information about the status of a host.
+type HostStatus struct {
+	// +optional
+	Phase HostPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=HostPhase"`
+	// The last time the host was probed.
+	// +optional
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty" protobuf:"bytes,2,opt,name=lastProbeTime"`
+	// +optional
+	MachineInfo MachineSystemInfo `json:"machineInfo,omitempty" protobuf:"bytes,3,opt,name=machineInfo"`
+	// Capacity represents the total resources of the host, such as cpu and memory.
+	// +optional
+	Capacity ResourceList `json:"capacity,omitempty" protobuf:"bytes,4,rep,name=capacity,casttype=ResourceList"`
+	// A human readable message indicating details about why the host is in this condition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// A brief CamelCase message indicating details about why the host is in this state.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,6,opt,name=reason"`
+}
+
+// HostPhase defines the phase of host.
+type HostPhase string
+
+const (
+	// HostPending means the host has not been probed yet.
+	HostPending HostPhase = "Pending"
+	// HostReachable means the host can be logged in with its credential.
+	HostReachable HostPhase = "Reachable"
+	// HostUnreachable means the last probe of the host failed.
+	HostUnreachable HostPhase = "Unreachable"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterTemplate holds defaults shared by clusters created from it.
+type ClusterTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the defaults of the ClusterTemplate.
+	// +optional
+	Spec ClusterTemplateSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status ClusterTemplateStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterTemplateList is the whole list of all cluster templates.
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of cluster templates
+	Items []ClusterTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterTemplateSpec is a description of a cluster template, every field is
+// only used as a default for the same field of the cluster spec. The cluster
+// CIDR is not a part of templates, every cluster sets its own.
+type ClusterTemplateSpec struct {
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,1,opt,name=tenantID"`
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,2,opt,name=displayName"`
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,4,opt,name=version"`
+	// +optional
+	NetworkType NetworkType `json:"networkType,omitempty" protobuf:"bytes,5,opt,name=networkType,casttype=NetworkType"`
+	// +optional
+	ServiceCIDR *string `json:"serviceCIDR,omitempty" protobuf:"bytes,7,opt,name=serviceCIDR"`
+	// +optional
+	Features ClusterFeature `json:"features,omitempty" protobuf:"bytes,8,opt,name=features,casttype=ClusterFeature"`
+	// +optional
+	Properties ClusterProperty `json:"properties,omitempty" protobuf:"bytes,9,opt,name=properties,casttype=ClusterProperty"`
+	// +optional
+	DockerExtraArgs map[string]string `json:"dockerExtraArgs,omitempty" protobuf:"bytes,10,name=dockerExtraArgs"`
+	// +optional
+	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty" protobuf:"bytes,11,name=kubeletExtraArgs"`
+	// +optional
+	APIServerExtraArgs map[string]string `json:"apiServerExtraArgs,omitempty" protobuf:"bytes,12,name=apiServerExtraArgs"`
+	// +optional
+	ControllerManagerExtraArgs map[string]string `json:"controllerManagerExtraArgs,omitempty" protobuf:"bytes,13,name=controllerManagerExtraArgs"`
+	// +optional
+	SchedulerExtraArgs map[string]string `json:"schedulerExtraArgs,omitempty" protobuf:"bytes,14,name=schedulerExtraArgs"`
+	// +optional
+	NetworkArgs map[string]string `json:"networkArgs,omitempty" protobuf:"bytes,15,name=networkArgs"`
+	// +optional
+	BootstrapApps BootstrapApps `json:"bootstrapApps,omitempty" protobuf:"bytes,16,opt,name=bootstrapApps"`
+}
+
+// ClusterTemplateStatus represents information about the status of a cluster template.
+type ClusterTemplateStatus struct {
+	// Revision is increased by the server every time the spec changes.
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,1,opt,name=revision"`
+	// Revisions are the latest revisions of the spec kept by the server,
+	// including the current one. They are never changed once recorded.
+	// +optional
+	Revisions []ClusterTemplateRevision `json:"revisions,omitempty" protobuf:"bytes,2,rep,name=revisions"`
+}
+
+// ClusterTemplateRevision is a recorded revision of the spec of a cluster template.
+type ClusterTemplateRevision struct {
+	Revision int64               `json:"revision" protobuf:"varint,1,opt,name=revision"`
+	Spec     ClusterTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// CreationTimestamp is the time when the revision was recorded.
+	// +optional
+	CreationTimestamp metav1.Time `json:"creationTimestamp,omitempty" protobuf:"bytes,3,opt,name=creationTimestamp"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditPolicy is the kubernetes audit policy applied to the masters of a cluster.
+type AuditPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the desired audit policy of the cluster.
+	// +optional
+	Spec AuditPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status AuditPolicyStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditPolicyList is the whole list of all audit policies.
+type AuditPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of audit policies
+	Items []AuditPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// AuditPolicySpec is a description of an audit policy, it mirrors the
+// audit.k8s.io/v1 Policy. An empty rule list means the default policy shipped
+// with the provider is used.
+type AuditPolicySpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// OmitStages is a list of stages for which no events are created.
+	// +optional
+	OmitStages []AuditStage `json:"omitStages,omitempty" protobuf:"bytes,3,rep,name=omitStages,casttype=AuditStage"`
+	// Rules specify the audit Level a request should be recorded at, the
+	// first matching rule wins.
+	// +optional
+	Rules []AuditPolicyRule `json:"rules,omitempty" protobuf:"bytes,4,rep,name=rules"`
+}
+
+// AuditPolicyRule maps requests based off metadata to an audit Level.
+type AuditPolicyRule struct {
+	Level AuditLevel `json:"level" protobuf:"bytes,1,opt,name=level,casttype=AuditLevel"`
+	// +optional
+	Users []string `json:"users,omitempty" protobuf:"bytes,2,rep,name=users"`
+	// +optional
+	UserGroups []string `json:"userGroups,omitempty" protobuf:"bytes,3,rep,name=userGroups"`
+	// +optional
+	Verbs []string `json:"verbs,omitempty" protobuf:"bytes,4,rep,name=verbs"`
+	// +optional
+	Resources []AuditGroupResources `json:"resources,omitempty" protobuf:"bytes,5,rep,name=resources"`
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,6,rep,name=namespaces"`
+	// +optional
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty" protobuf:"bytes,7,rep,name=nonResourceURLs"`
+	// +optional
+	OmitStages []AuditStage `json:"omitStages,omitempty" protobuf:"bytes,8,rep,name=omitStages,casttype=AuditStage"`
+}
+
+// AuditGroupResources represents resource kinds in an API group.
+type AuditGroupResources struct {
+	// +optional
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// +optional
+	Resources []string `json:"resources,omitempty" protobuf:"bytes,2,rep,name=resources"`
+	// +optional
+	ResourceNames []string `json:"resourceNames,omitempty" protobuf:"bytes,3,rep,name=resourceNames"`
+}
+
+// AuditLevel defines the amount of information logged during auditing.
+type AuditLevel string
+
+// These are valid audit levels.
+const (
+	// AuditLevelNone disables auditing.
+	AuditLevelNone AuditLevel = "None"
+	// AuditLevelMetadata provides the basic level of auditing.
+	AuditLevelMetadata AuditLevel = "Metadata"
+	// AuditLevelRequest provides Metadata level of auditing, and additionally
+	// logs the request object.
+	AuditLevelRequest AuditLevel = "Request"
+	// AuditLevelRequestResponse provides Request level of auditing, and additionally
+	// logs the response object.
+	AuditLevelRequestResponse AuditLevel = "RequestResponse"
+)
+
+// AuditStage defines the stages in request handling that audit events may be generated.
+type AuditStage string
+
+// These are valid audit stages.
+const (
+	AuditStageRequestReceived  AuditStage = "RequestReceived"
+	AuditStageResponseStarted  AuditStage = "ResponseStarted"
+	AuditStageResponseComplete AuditStage = "ResponseComplete"
+	AuditStagePanic            AuditStage = "Panic"
+)
+
+// AuditPolicyStatus represents information about the status of an audit policy.
+type AuditPolicyStatus struct {
+	// +optional
+	Phase AuditPolicyPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=AuditPolicyPhase"`
+	// ObservedGeneration is the generation of the spec last rolled to the masters.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+}
+
+// AuditPolicyPhase defines the phase of audit policy rollout.
+type AuditPolicyPhase string
+
+const (
+	// AuditPolicyPending means the policy has not been rolled to the masters yet.
+	AuditPolicyPending AuditPolicyPhase = "Pending"
+	// AuditPolicyApplied means every master runs with the policy.
+	AuditPolicyApplied AuditPolicyPhase = "Applied"
+	// AuditPolicyFailed means the last rollout failed and was rolled back.
+	AuditPolicyFailed AuditPolicyPhase = "Failed"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterSync keeps the objects of clusters in sync with the manifests in a
+// git repository.
+type ClusterSync struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the source and the target clusters of the sync.
+	// +optional
+	Spec ClusterSyncSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status ClusterSyncStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterSyncList is the whole list of all cluster syncs.
+type ClusterSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of cluster syncs
+	Items []ClusterSync `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterSyncSpec is a description of a cluster sync.
+type ClusterSyncSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// Source is the git repository the manifests are rendered from.
+	Source ClusterSyncSource `json:"source" protobuf:"bytes,2,opt,name=source"`
+	// ClusterNames are the names of the target clusters.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty" protobuf:"bytes,3,rep,name=clusterNames"`
+	// ClusterSelector selects the target clusters of the tenant by labels, in
+	// addition to ClusterNames.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty" protobuf:"bytes,4,opt,name=clusterSelector"`
+	// Interval is the period of fetching the source and correcting the drift
+	// of the target clusters, defaults to 5 minutes.
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty" protobuf:"bytes,5,opt,name=interval"`
+	// Prune deletes the objects which have been removed from the source.
+	// +optional
+	Prune bool `json:"prune,omitempty" protobuf:"varint,6,opt,name=prune"`
+	// Suspend stops syncing until it is unset.
+	// +optional
+	Suspend bool `json:"suspend,omitempty" protobuf:"varint,7,opt,name=suspend"`
+}
+
+// ClusterSyncSource is a directory in a branch of a git repository. The
+// directory is rendered by kustomize if it contains a kustomization file,
+// otherwise the yaml and json files under it are applied as plain manifests.
+type ClusterSyncSource struct {
+	// URL of the repository, any url accepted by git fetch.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Branch defaults to master.
+	// +optional
+	Branch string `json:"branch,omitempty" protobuf:"bytes,2,opt,name=branch"`
+	// Path is the directory relative to the root of the repository.
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+}
+
+// ClusterSyncStatus represents information about the status of a cluster sync.
+type ClusterSyncStatus struct {
+	// +optional
+	Phase ClusterSyncPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ClusterSyncPhase"`
+	// ObservedGeneration is the generation of the spec last synced.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+	// Revision is the commit of the source last rendered.
+	// +optional
+	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// +optional
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty" protobuf:"bytes,4,opt,name=lastSyncTime"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// Clusters is the sync status of every target cluster.
+	// +optional
+	Clusters []ClusterSyncClusterStatus `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters"`
+}
+
+// ClusterSyncClusterStatus is the sync status of a target cluster.
+type ClusterSyncClusterStatus struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	// +optional
+	Phase ClusterSyncPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=ClusterSyncPhase"`
+	// Revision is the commit of the source last applied to the cluster.
+	// +optional
+	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// Drift lists the objects which differed from the source at the last
+	// sync and were corrected, at most 50 of them.
+	// +optional
+	Drift []ClusterSyncObject `json:"drift,omitempty" protobuf:"bytes,4,rep,name=drift"`
+	// +optional
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty" protobuf:"bytes,5,opt,name=lastSyncTime"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
+// ClusterSyncObject is an object which differed from the source.
+type ClusterSyncObject struct {
+	// +optional
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// Action is the action which brought the object back in sync.
+	Action ClusterApplyAction `json:"action" protobuf:"bytes,5,opt,name=action,casttype=ClusterApplyAction"`
+}
+
+// ClusterSyncPhase defines the phase of a cluster sync.
+type ClusterSyncPhase string
+
+const (
+	// ClusterSyncPending means the source has not been synced yet.
+	ClusterSyncPending ClusterSyncPhase = "Pending"
+	// ClusterSyncSynced means the last sync succeeded.
+	ClusterSyncSynced ClusterSyncPhase = "Synced"
+	// ClusterSyncFailed means the last sync failed.
+	ClusterSyncFailed ClusterSyncPhase = "Failed"
+	// ClusterSyncSuspended means the sync is suspended.
+	ClusterSyncSuspended ClusterSyncPhase = "Suspended"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiClusterDeployment propagates a deployment to several clusters and
+// splits its replicas among them.
+type MultiClusterDeployment struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec defines the deployment and its placement.
+	// +optional
+	Spec MultiClusterDeploymentSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status MultiClusterDeploymentStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiClusterDeploymentList is the whole list of all multi cluster deployments.
+type MultiClusterDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of multi cluster deployments
+	Items []MultiClusterDeployment `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MultiClusterDeploymentSpec is a description of a multi cluster deployment.
+// A deployment with the same name is created in the namespace of every target
+// cluster.
+type MultiClusterDeploymentSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// Namespace of the deployments in the target clusters, it must exist.
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// Replicas is the total number of pods of all target clusters, defaults
+	// to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,3,opt,name=replicas"`
+	// Selector is the label query over pods of the deployments, it must match
+	// the labels of the template.
+	Selector *metav1.LabelSelector `json:"selector" protobuf:"bytes,4,opt,name=selector"`
+	// Template describes the pods of the deployments.
+	Template corev1.PodTemplateSpec `json:"template" protobuf:"bytes,5,opt,name=template"`
+	// Placement selects the target clusters.
+	Placement MultiClusterPlacement `json:"placement" protobuf:"bytes,6,opt,name=placement"`
+	// Overrides customize the deployment of individual target clusters.
+	// +optional
+	Overrides []MultiClusterOverride `json:"overrides,omitempty" protobuf:"bytes,7,rep,name=overrides"`
+}
+
+// MultiClusterPlacement selects the target clusters of a multi cluster
+// deployment and weighs them for splitting the replicas.
+type MultiClusterPlacement struct {
+	// ClusterNames are the names of the target clusters.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty" protobuf:"bytes,1,rep,name=clusterNames"`
+	// ClusterSelector selects the target clusters of the tenant by labels, in
+	// addition to ClusterNames.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty" protobuf:"bytes,2,opt,name=clusterSelector"`
+	// Weights of the target clusters when splitting the replicas, clusters
+	// not listed weigh 1.
+	// +optional
+	Weights []MultiClusterWeight `json:"weights,omitempty" protobuf:"bytes,3,rep,name=weights"`
+}
+
+// MultiClusterWeight is the weight of a target cluster.
+type MultiClusterWeight struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	// Weight is relative to the weights of the other target clusters, a
+	// cluster weighing 0 gets no replicas.
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
+}
+
+// MultiClusterOverride customizes the deployment of a target cluster.
+type MultiClusterOverride struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	// Replicas of the cluster instead of its share of the weighted split, the
+	// rest of the total replicas is split among the other clusters.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,2,opt,name=replicas"`
+	// Containers override the containers of the template by name.
+	// +optional
+	Containers []MultiClusterContainerOverride `json:"containers,omitempty" protobuf:"bytes,3,rep,name=containers"`
+}
+
+// MultiClusterContainerOverride overrides a container of the template.
+type MultiClusterContainerOverride struct {
+	// Name of the container in the template.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Image replaces the image of the container.
+	// +optional
+	Image string `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
+	// Env is merged into the environment variables of the container by name.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty" protobuf:"bytes,3,rep,name=env"`
+}
+
+// MultiClusterDeploymentStatus represents information about the status of a
+// multi cluster deployment, aggregated from the deployments of the target
+// clusters.
+type MultiClusterDeploymentStatus struct {
+	// +optional
+	Phase MultiClusterDeploymentPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=MultiClusterDeploymentPhase"`
+	// ObservedGeneration is the generation of the spec last propagated.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+	// Replicas is the total number of desired pods of all target clusters.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty" protobuf:"varint,3,opt,name=replicas"`
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" protobuf:"varint,4,opt,name=updatedReplicas"`
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty" protobuf:"varint,5,opt,name=readyReplicas"`
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty" protobuf:"varint,6,opt,name=availableReplicas"`
+	// +optional
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty" protobuf:"bytes,7,opt,name=lastSyncTime"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+	// Clusters is the status of the deployment of every target cluster.
+	// +optional
+	Clusters []MultiClusterDeploymentClusterStatus `json:"clusters,omitempty" protobuf:"bytes,9,rep,name=clusters"`
+}
+
+// MultiClusterDeploymentClusterStatus is the status of the deployment of a
+// target cluster.
+type MultiClusterDeploymentClusterStatus struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	// +optional
+	Phase MultiClusterDeploymentPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=MultiClusterDeploymentPhase"`
+	// Replicas is the number of desired pods of the cluster.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty" protobuf:"varint,3,opt,name=replicas"`
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" protobuf:"varint,4,opt,name=updatedReplicas"`
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty" protobuf:"varint,5,opt,name=readyReplicas"`
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty" protobuf:"varint,6,opt,name=availableReplicas"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
+}
+
+// MultiClusterDeploymentPhase defines the phase of a multi cluster deployment.
+type MultiClusterDeploymentPhase string
+
+const (
+	// MultiClusterDeploymentPending means the deployment has not been
+	// propagated yet.
+	MultiClusterDeploymentPending MultiClusterDeploymentPhase = "Pending"
+	// MultiClusterDeploymentProgressing means the deployments are rolling out.
+	MultiClusterDeploymentProgressing MultiClusterDeploymentPhase = "Progressing"
+	// MultiClusterDeploymentAvailable means the deployments have rolled out
+	// and all replicas are available.
+	MultiClusterDeploymentAvailable MultiClusterDeploymentPhase = "Available"
+	// MultiClusterDeploymentFailed means the deployment failed to be
+	// propagated to some clusters.
+	MultiClusterDeploymentFailed MultiClusterDeploymentPhase = "Failed"
+)
+
+const (
+	// MultiClusterDeploymentLabel is the label of the deployments propagated
+	// to the target clusters, whose value is the name of the multi cluster
+	// deployment.
+	MultiClusterDeploymentLabel = "platform.tkestack.io/multiclusterdeployment"
+	// MultiClusterDeploymentFinalizer is the finalizer of multi cluster
+	// deployments, removed once the deployments are deleted from the target
+	// clusters.
+	MultiClusterDeploymentFinalizer = "platform.tkestack.io/multiclusterdeployment"
+)
@@ -37,6 +37,88 @@ func (AddonSpec) SwaggerDoc() map[string]string {
 	return map_AddonSpec
 }
 
+var map_AuditGroupResources = map[string]string{
+	"": "AuditGroupResources represents resource kinds in an API group.",
+}
+
+func (AuditGroupResources) SwaggerDoc() map[string]string {
+	return map_AuditGroupResources
+}
+
+var map_AuditPolicy = map[string]string{
+	"":     "AuditPolicy is the kubernetes audit policy applied to the masters of a cluster.",
+	"spec": "Spec defines the desired audit policy of the cluster.",
+}
+
+func (AuditPolicy) SwaggerDoc() map[string]string {
+	return map_AuditPolicy
+}
+
+var map_AuditPolicyList = map[string]string{
+	"":      "AuditPolicyList is the whole list of all audit policies.",
+	"items": "List of audit policies",
+}
+
+func (AuditPolicyList) SwaggerDoc() map[string]string {
+	return map_AuditPolicyList
+}
+
+var map_AuditPolicyRule = map[string]string{
+	"": "AuditPolicyRule maps requests based off metadata to an audit Level.",
+}
+
+func (AuditPolicyRule) SwaggerDoc() map[string]string {
+	return map_AuditPolicyRule
+}
+
+var map_AuditPolicySpec = map[string]string{
+	"":           "AuditPolicySpec is a description of an audit policy, it mirrors the audit.k8s.io/v1 Policy. An empty rule list means the default policy shipped with the provider is used.",
+	"omitStages": "OmitStages is a list of stages for which no events are created.",
+	"rules":      "Rules specify the audit Level a request should be recorded at, the first matching rule wins.",
+}
+
+func (AuditPolicySpec) SwaggerDoc() map[string]string {
+	return map_AuditPolicySpec
+}
+
+var map_AuditPolicyStatus = map[string]string{
+	"":                   "AuditPolicyStatus represents information about the status of an audit policy.",
+	"observedGeneration": "ObservedGeneration is the generation of the spec last rolled to the masters.",
+}
+
+func (AuditPolicyStatus) SwaggerDoc() map[string]string {
+	return map_AuditPolicyStatus
+}
+
+var map_AutoscalingNodeGroup = map[string]string{
+	"":            "AutoscalingNodeGroup is a machine pool scaled by the autoscaler within bounds.",
+	"machinePool": "MachinePool is the name of machine pool.",
+	"minReplicas": "MinReplicas is the lower bound of replicas of machine pool.",
+	"maxReplicas": "MaxReplicas is the upper bound of replicas of machine pool.",
+}
+
+func (AutoscalingNodeGroup) SwaggerDoc() map[string]string {
+	return map_AutoscalingNodeGroup
+}
+
+var map_BGPConfig = map[string]string{
+	"":      "BGPConfig is the BGP speaker configuration of nodes.",
+	"asn":   "ASN is the AS number of nodes.",
+	"peers": "Peers are the routers the nodes peer with.",
+}
+
+func (BGPConfig) SwaggerDoc() map[string]string {
+	return map_BGPConfig
+}
+
+var map_BGPPeer = map[string]string{
+	"": "BGPPeer is a router nodes peer with.",
+}
+
+func (BGPPeer) SwaggerDoc() map[string]string {
+	return map_BGPPeer
+}
+
 var map_CSIOperator = map[string]string{
 	"":     "CSIOperator is a operator to manages CSI external components.",
 	"spec": "Spec defines the desired identities of storage operator.",
@@ -163,14 +245,60 @@ func (ClusterAddress) SwaggerDoc() map[string]string {
 	return map_ClusterAddress
 }
 
+var map_ClusterApplyObjectResult = map[string]string{
+	"":        "ClusterApplyObjectResult is the result of applying or pruning one object.",
+	"message": "Message is the error message when the action is Failed.",
+}
+
+func (ClusterApplyObjectResult) SwaggerDoc() map[string]string {
+	return map_ClusterApplyObjectResult
+}
+
 var map_ClusterApplyOptions = map[string]string{
-	"": "ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.",
+	"":             "ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.",
+	"serverSide":   "ServerSide applies the objects by server-side apply instead of create-or-update, and the response is a ClusterApplyResult.",
+	"fieldManager": "FieldManager is the name of the manager making the server-side apply, defaults to tke-apply.",
+	"force":        "Force takes the ownership of conflicting fields from other managers.",
+	"applySet":     "ApplySet labels the applied objects as members of the named set, so that they can be pruned by a later apply.",
+	"prune":        "Prune deletes the members of the apply set which are no longer in the payload. It requires ApplySet.",
+	"dryRun":       "DryRun submits the apply and prune without persisting them.",
 }
 
 func (ClusterApplyOptions) SwaggerDoc() map[string]string {
 	return map_ClusterApplyOptions
 }
 
+var map_ClusterApplyResult = map[string]string{
+	"":       "ClusterApplyResult is the result of a server-side apply to a cluster.",
+	"dryRun": "DryRun indicates that nothing was persisted.",
+	"items":  "Items is the list of the applied and pruned objects.",
+}
+
+func (ClusterApplyResult) SwaggerDoc() map[string]string {
+	return map_ClusterApplyResult
+}
+
+var map_ClusterAutoscaling = map[string]string{
+	"":                              "ClusterAutoscaling configures the autoscaler of machine pools of a cluster.",
+	"nodeGroups":                    "NodeGroups are the machine pools scaled by the autoscaler.",
+	"scaleDownUnneededTime":         "ScaleDownUnneededTime is how long a node should be unneeded before it is scaled down, defaults to 10m.",
+	"scaleDownUtilizationThreshold": "ScaleDownUtilizationThreshold is the percent of requested cpu and memory to allocatable of a node, under which the node is considered unneeded. Defaults to 50.",
+}
+
+func (ClusterAutoscaling) SwaggerDoc() map[string]string {
+	return map_ClusterAutoscaling
+}
+
+var map_ClusterCertificate = map[string]string{
+	"":     "ClusterCertificate records the expiration of a certificate on a master.",
+	"name": "Name of the certificate, such as apiserver or etcd-server.",
+	"node": "IP of the master which the certificate is on.",
+}
+
+func (ClusterCertificate) SwaggerDoc() map[string]string {
+	return map_ClusterCertificate
+}
+
 var map_ClusterComponent = map[string]string{
 	"": "ClusterComponent records the number of copies of each component of the cluster master.",
 }
@@ -202,18 +330,19 @@ func (ClusterCondition) SwaggerDoc() map[string]string {
 }
 
 var map_ClusterCredential = map[string]string{
-	"":               "ClusterCredential records the credential information needed to access the cluster.",
-	"etcdCACert":     "For TKE in global reuse",
-	"caCert":         "For connect the cluster",
-	"clientCert":     "For kube-apiserver X509 auth",
-	"clientKey":      "For kube-apiserver X509 auth",
-	"token":          "For kube-apiserver token auth",
-	"bootstrapToken": "For kubeadm init or join",
-	"certificateKey": "For kubeadm init or join",
-	"username":       "Username is the username for basic authentication to the kubernetes cluster.",
-	"as":             "Impersonate is the username to act-as.",
-	"as-groups":      "ImpersonateGroups is the groups to imperonate.",
-	"as-user-extra":  "ImpersonateUserExtra contains additional information for impersonated user.",
+	"":                   "ClusterCredential records the credential information needed to access the cluster.",
+	"etcdCACert":         "For TKE in global reuse",
+	"caCert":             "For connect the cluster",
+	"clientCert":         "For kube-apiserver X509 auth",
+	"clientKey":          "For kube-apiserver X509 auth",
+	"token":              "For kube-apiserver token auth",
+	"bootstrapToken":     "For kubeadm init or join",
+	"certificateKey":     "For kubeadm init or join",
+	"username":           "Username is the username for basic authentication to the kubernetes cluster.",
+	"as":                 "Impersonate is the username to act-as.",
+	"as-groups":          "ImpersonateGroups is the groups to imperonate.",
+	"as-user-extra":      "ImpersonateUserExtra contains additional information for impersonated user.",
+	"s3SecretAccessKeys": "S3SecretAccessKeys are the secret access keys of the S3 etcd snapshot targets of the cluster, keyed by access key ID.",
 }
 
 func (ClusterCredential) SwaggerDoc() map[string]string {
@@ -233,6 +362,9 @@ var map_ClusterFeature = map[string]string{
 	"":                 "ClusterFeature records the features that are enabled by the cluster.",
 	"authzWebhookAddr": "For kube-apiserver authorization webhook",
 	"upgrade":          "Upgrade control upgrade process.",
+	"etcdBackup":       "EtcdBackup controls scheduled snapshots of the local etcd.",
+	"autoscaling":      "Autoscaling scales machine pools of the cluster by unschedulable pods.",
+	"metalLB":          "MetalLB deploys metallb to serve Services of type LoadBalancer.",
 }
 
 func (ClusterFeature) SwaggerDoc() map[string]string {
@@ -294,8 +426,10 @@ func (ClusterList) SwaggerDoc() map[string]string {
 }
 
 var map_ClusterMachine = map[string]string{
-	"":       "ClusterMachine is the master machine definition of cluster.",
-	"taints": "If specified, the node's taints.",
+	"":                       "ClusterMachine is the master machine definition of cluster.",
+	"taints":                 "If specified, the node's taints.",
+	"credentialName":         "CredentialName is the name of the SSHCredential used to login the machine, it takes precedence over the inline username, password and private key.",
+	"containerRuntimeConfig": "ContainerRuntimeConfig is merged over the container runtime config of the cluster.",
 }
 
 func (ClusterMachine) SwaggerDoc() map[string]string {
@@ -310,6 +444,51 @@ func (ClusterMachineProxy) SwaggerDoc() map[string]string {
 	return map_ClusterMachineProxy
 }
 
+var map_ClusterPlan = map[string]string{
+	"":        "ClusterPlan is the plan of the operation which would be done on a cluster, returned by the plan subresource of cluster without mutating any host.",
+	"phase":   "Phase is the phase the cluster would enter for the operation.",
+	"steps":   "Steps are the handlers which would be executed in order.",
+	"checks":  "Checks are the results of preflight checks on machines.",
+	"configs": "Configs are the rendered config files which would be written to machines.",
+}
+
+func (ClusterPlan) SwaggerDoc() map[string]string {
+	return map_ClusterPlan
+}
+
+var map_ClusterPlanCheck = map[string]string{
+	"":        "ClusterPlanCheck is the result of a check on a machine.",
+	"name":    "Name of the check.",
+	"ip":      "IP of the machine checked.",
+	"passed":  "Passed is true if the check passed.",
+	"message": "A human readable message of warnings or errors found by the check.",
+}
+
+func (ClusterPlanCheck) SwaggerDoc() map[string]string {
+	return map_ClusterPlanCheck
+}
+
+var map_ClusterPlanConfig = map[string]string{
+	"":        "ClusterPlanConfig is a rendered config file which would be written to machines.",
+	"path":    "Path of the config file on machines.",
+	"ips":     "IPs of the machines the config file would be written to.",
+	"content": "Content of the config file.",
+}
+
+func (ClusterPlanConfig) SwaggerDoc() map[string]string {
+	return map_ClusterPlanConfig
+}
+
+var map_ClusterPlanStep = map[string]string{
+	"":        "ClusterPlanStep is a handler which would be executed by a cluster operation.",
+	"name":    "Name of the handler.",
+	"skipped": "Skipped is true if the handler is skipped by skipConditions of cluster.",
+}
+
+func (ClusterPlanStep) SwaggerDoc() map[string]string {
+	return map_ClusterPlanStep
+}
+
 var map_ClusterProperty = map[string]string{
 	"": "ClusterProperty records the attribute information of the cluster.",
 }
@@ -329,15 +508,17 @@ func (ClusterResource) SwaggerDoc() map[string]string {
 }
 
 var map_ClusterSpec = map[string]string{
-	"":                     "ClusterSpec is a description of a cluster.",
-	"finalizers":           "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-	"serviceCIDR":          "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
-	"dnsDomain":            "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
-	"clusterCredentialRef": "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
-	"etcd":                 "Etcd holds configuration for etcd.",
-	"hostnameAsNodename":   "If true will use hostname as nodename, if false will use machine IP as nodename.",
-	"bootstrapApps":        "BootstrapApps will install apps during creating cluster",
-	"appVersion":           "AppVersion is the overall version of system components",
+	"":                       "ClusterSpec is a description of a cluster.",
+	"finalizers":             "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+	"serviceCIDR":            "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
+	"dnsDomain":              "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
+	"clusterCredentialRef":   "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
+	"etcd":                   "Etcd holds configuration for etcd.",
+	"hostnameAsNodename":     "If true will use hostname as nodename, if false will use machine IP as nodename.",
+	"bootstrapApps":          "BootstrapApps will install apps during creating cluster",
+	"appVersion":             "AppVersion is the overall version of system components",
+	"templateRef":            "TemplateRef is the cluster template whose defaults were merged into the spec when the cluster was created.",
+	"containerRuntimeConfig": "ContainerRuntimeConfig configures containerd on the nodes of the cluster.",
 }
 
 func (ClusterSpec) SwaggerDoc() map[string]string {
@@ -351,12 +532,141 @@ var map_ClusterStatus = map[string]string{
 	"addresses":      "List of addresses reachable to the cluster.",
 	"appVersion":     "AppVersion is the overall version of system components",
 	"componentPhase": "ComponentPhase is the status of components, contains \"deployed\", \"pending-upgrade\", \"failed\" status",
+	"handlerHistory": "The bounded execution history of provider handlers.",
+	"certificates":   "The expiration of the certificates on the masters.",
 }
 
 func (ClusterStatus) SwaggerDoc() map[string]string {
 	return map_ClusterStatus
 }
 
+var map_ClusterSync = map[string]string{
+	"":     "ClusterSync keeps the objects of clusters in sync with the manifests in a git repository.",
+	"spec": "Spec defines the source and the target clusters of the sync.",
+}
+
+func (ClusterSync) SwaggerDoc() map[string]string {
+	return map_ClusterSync
+}
+
+var map_ClusterSyncClusterStatus = map[string]string{
+	"":         "ClusterSyncClusterStatus is the sync status of a target cluster.",
+	"revision": "Revision is the commit of the source last applied to the cluster.",
+	"drift":    "Drift lists the objects which differed from the source at the last sync and were corrected, at most 50 of them.",
+}
+
+func (ClusterSyncClusterStatus) SwaggerDoc() map[string]string {
+	return map_ClusterSyncClusterStatus
+}
+
+var map_ClusterSyncList = map[string]string{
+	"":      "ClusterSyncList is the whole list of all cluster syncs.",
+	"items": "List of cluster syncs",
+}
+
+func (ClusterSyncList) SwaggerDoc() map[string]string {
+	return map_ClusterSyncList
+}
+
+var map_ClusterSyncObject = map[string]string{
+	"":       "ClusterSyncObject is an object which differed from the source.",
+	"action": "Action is the action which brought the object back in sync.",
+}
+
+func (ClusterSyncObject) SwaggerDoc() map[string]string {
+	return map_ClusterSyncObject
+}
+
+var map_ClusterSyncSource = map[string]string{
+	"":       "ClusterSyncSource is a directory in a branch of a git repository. The directory is rendered by kustomize if it contains a kustomization file, otherwise the yaml and json files under it are applied as plain manifests.",
+	"url":    "URL of the repository, any url accepted by git fetch.",
+	"branch": "Branch defaults to master.",
+	"path":   "Path is the directory relative to the root of the repository.",
+}
+
+func (ClusterSyncSource) SwaggerDoc() map[string]string {
+	return map_ClusterSyncSource
+}
+
+var map_ClusterSyncSpec = map[string]string{
+	"":                "ClusterSyncSpec is a description of a cluster sync.",
+	"source":          "Source is the git repository the manifests are rendered from.",
+	"clusterNames":    "ClusterNames are the names of the target clusters.",
+	"clusterSelector": "ClusterSelector selects the target clusters of the tenant by labels, in addition to ClusterNames.",
+	"interval":        "Interval is the period of fetching the source and correcting the drift of the target clusters, defaults to 5 minutes.",
+	"prune":           "Prune deletes the objects which have been removed from the source.",
+	"suspend":         "Suspend stops syncing until it is unset.",
+}
+
+func (ClusterSyncSpec) SwaggerDoc() map[string]string {
+	return map_ClusterSyncSpec
+}
+
+var map_ClusterSyncStatus = map[string]string{
+	"":                   "ClusterSyncStatus represents information about the status of a cluster sync.",
+	"observedGeneration": "ObservedGeneration is the generation of the spec last synced.",
+	"revision":           "Revision is the commit of the source last rendered.",
+	"clusters":           "Clusters is the sync status of every target cluster.",
+}
+
+func (ClusterSyncStatus) SwaggerDoc() map[string]string {
+	return map_ClusterSyncStatus
+}
+
+var map_ClusterTemplate = map[string]string{
+	"":     "ClusterTemplate holds defaults shared by clusters created from it.",
+	"spec": "Spec defines the defaults of the ClusterTemplate.",
+}
+
+func (ClusterTemplate) SwaggerDoc() map[string]string {
+	return map_ClusterTemplate
+}
+
+var map_ClusterTemplateList = map[string]string{
+	"":      "ClusterTemplateList is the whole list of all cluster templates.",
+	"items": "List of cluster templates",
+}
+
+func (ClusterTemplateList) SwaggerDoc() map[string]string {
+	return map_ClusterTemplateList
+}
+
+var map_ClusterTemplateRef = map[string]string{
+	"":         "ClusterTemplateRef references a revision of a cluster template.",
+	"revision": "Revision is the revision of the template merged into the cluster. It is set by the server to the current revision on creation if it is empty, an earlier revision kept in the status of the template pins the cluster to it.",
+}
+
+func (ClusterTemplateRef) SwaggerDoc() map[string]string {
+	return map_ClusterTemplateRef
+}
+
+var map_ClusterTemplateRevision = map[string]string{
+	"":                  "ClusterTemplateRevision is a recorded revision of the spec of a cluster template.",
+	"creationTimestamp": "CreationTimestamp is the time when the revision was recorded.",
+}
+
+func (ClusterTemplateRevision) SwaggerDoc() map[string]string {
+	return map_ClusterTemplateRevision
+}
+
+var map_ClusterTemplateSpec = map[string]string{
+	"": "ClusterTemplateSpec is a description of a cluster template, every field is only used as a default for the same field of the cluster spec. The cluster CIDR is not a part of templates, every cluster sets its own.",
+}
+
+func (ClusterTemplateSpec) SwaggerDoc() map[string]string {
+	return map_ClusterTemplateSpec
+}
+
+var map_ClusterTemplateStatus = map[string]string{
+	"":          "ClusterTemplateStatus represents information about the status of a cluster template.",
+	"revision":  "Revision is increased by the server every time the spec changes.",
+	"revisions": "Revisions are the latest revisions of the spec kept by the server, including the current one. They are never changed once recorded.",
+}
+
+func (ClusterTemplateStatus) SwaggerDoc() map[string]string {
+	return map_ClusterTemplateStatus
+}
+
 var map_ConfigMap = map[string]string{
 	"":           "ConfigMap holds configuration data for tke to consume.",
 	"data":       "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
@@ -376,6 +686,19 @@ func (ConfigMapList) SwaggerDoc() map[string]string {
 	return map_ConfigMapList
 }
 
+var map_ContainerRuntimeConfig = map[string]string{
+	"":                   "ContainerRuntimeConfig is the declarative configuration of containerd.",
+	"registryMirrors":    "RegistryMirrors are the mirror endpoints of registries.",
+	"insecureRegistries": "InsecureRegistries are the registries accessed without verifying TLS.",
+	"snapshotter":        "Snapshotter is the snapshotter of containerd, overlayfs if empty.",
+	"cgroupDriver":       "CgroupDriver is the cgroup driver of containerd and kubelet, systemd if empty. It can only be set on the cluster.",
+	"runtimeClasses":     "RuntimeClasses are the runtime handlers added to containerd, a RuntimeClass object is created in the cluster for each of them. They can only be set on the cluster.",
+}
+
+func (ContainerRuntimeConfig) SwaggerDoc() map[string]string {
+	return map_ContainerRuntimeConfig
+}
+
 var map_CronHPA = map[string]string{
 	"":     "CronHPA is a new kubernetes workload.",
 	"spec": "Spec defines the desired identities of CronHPA.",
@@ -432,6 +755,80 @@ func (Etcd) SwaggerDoc() map[string]string {
 	return map_Etcd
 }
 
+var map_EtcdBackup = map[string]string{
+	"":                 "EtcdBackup describes the scheduled snapshot policy of the local etcd.",
+	"intervalInSecond": "IntervalInSecond is the interval between two scheduled snapshots. Zero means no scheduled snapshot will be taken.",
+	"maxBackups":       "MaxBackups is the maximum number of scheduled snapshots to retain.",
+	"target":           "Target is where the snapshots are stored.",
+}
+
+func (EtcdBackup) SwaggerDoc() map[string]string {
+	return map_EtcdBackup
+}
+
+var map_EtcdSnapshot = map[string]string{
+	"":     "EtcdSnapshot is a snapshot of the local etcd of a cluster.",
+	"spec": "Spec defines the desired identities of the EtcdSnapshot.",
+}
+
+func (EtcdSnapshot) SwaggerDoc() map[string]string {
+	return map_EtcdSnapshot
+}
+
+var map_EtcdSnapshotList = map[string]string{
+	"":      "EtcdSnapshotList is the whole list of all etcd snapshots.",
+	"items": "List of etcd snapshots",
+}
+
+func (EtcdSnapshotList) SwaggerDoc() map[string]string {
+	return map_EtcdSnapshotList
+}
+
+var map_EtcdSnapshotRestoreOptions = map[string]string{
+	"":        "EtcdSnapshotRestoreOptions is the query options to restore a cluster from an etcd snapshot.",
+	"confirm": "Confirm must be the name of the cluster to restore.",
+}
+
+func (EtcdSnapshotRestoreOptions) SwaggerDoc() map[string]string {
+	return map_EtcdSnapshotRestoreOptions
+}
+
+var map_EtcdSnapshotSpec = map[string]string{
+	"":           "EtcdSnapshotSpec is a description of an etcd snapshot.",
+	"finalizers": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+	"type":       "Type is the way the snapshot was created, one of Scheduled or Manual.",
+	"target":     "Target is where the snapshot is stored, defaults to the target of the cluster etcd backup feature.",
+}
+
+func (EtcdSnapshotSpec) SwaggerDoc() map[string]string {
+	return map_EtcdSnapshotSpec
+}
+
+var map_EtcdSnapshotStatus = map[string]string{
+	"":                "EtcdSnapshotStatus represents information about the status of an etcd snapshot.",
+	"location":        "Location is the path of the snapshot in the target.",
+	"sizeInBytes":     "SizeInBytes is the size of the snapshot.",
+	"revision":        "Revision of the etcd when the snapshot was taken.",
+	"lastRestoreTime": "LastRestoreTime is the last time the cluster was restored from the snapshot.",
+	"message":         "A human readable message indicating details about why the snapshot is in this condition.",
+	"reason":          "A brief CamelCase message indicating details about why the snapshot is in this state.",
+}
+
+func (EtcdSnapshotStatus) SwaggerDoc() map[string]string {
+	return map_EtcdSnapshotStatus
+}
+
+var map_EtcdSnapshotTarget = map[string]string{
+	"":         "EtcdSnapshotTarget describes where an etcd snapshot is stored. Exactly one of its members must be specified.",
+	"local":    "Local stores the snapshots on a master machine.",
+	"s3":       "S3 stores the snapshots in an S3 compatible object storage.",
+	"registry": "Registry stores the snapshots in the filesystem of tke-registry.",
+}
+
+func (EtcdSnapshotTarget) SwaggerDoc() map[string]string {
+	return map_EtcdSnapshotTarget
+}
+
 var map_ExternalEtcd = map[string]string{
 	"":          "ExternalEtcd describes an external etcd cluster. Kubeadm has no knowledge of where certificate files live and they must be supplied.",
 	"endpoints": "Endpoints of etcd members. Required for ExternalEtcd.",
@@ -444,6 +841,70 @@ func (ExternalEtcd) SwaggerDoc() map[string]string {
 	return map_ExternalEtcd
 }
 
+var map_HandlerRecord = map[string]string{
+	"":          "HandlerRecord records the execution of a provider handler.",
+	"name":      "Name of the handler.",
+	"startTime": "The time when the first attempt started.",
+	"endTime":   "The time when the last attempt ended.",
+	"attempts":  "The number of attempts.",
+	"lastError": "The error of the last attempt, empty if the handler succeeded.",
+	"output":    "The ssh commands of the last failed attempt with their exit codes and redacted excerpts of stderr and stdout, the content of files read is never recorded.",
+}
+
+func (HandlerRecord) SwaggerDoc() map[string]string {
+	return map_HandlerRecord
+}
+
+var map_Host = map[string]string{
+	"":     "Host is a server of the inventory that can be used as a machine of clusters.",
+	"spec": "Spec defines the desired identities of the Host.",
+}
+
+func (Host) SwaggerDoc() map[string]string {
+	return map_Host
+}
+
+var map_HostList = map[string]string{
+	"":      "HostList is the whole list of all hosts.",
+	"items": "List of hosts",
+}
+
+func (HostList) SwaggerDoc() map[string]string {
+	return map_HostList
+}
+
+var map_HostSpec = map[string]string{
+	"":               "HostSpec is a description of a host.",
+	"credentialName": "CredentialName is the name of the SSHCredential used to login the host.",
+}
+
+func (HostSpec) SwaggerDoc() map[string]string {
+	return map_HostSpec
+}
+
+var map_HostStatus = map[string]string{
+	"":              "HostStatus represents information about the status of a host.",
+	"lastProbeTime": "The last time the host was probed.",
+	"capacity":      "Capacity represents the total resources of the host, such as cpu and memory.",
+	"message":       "A human readable message indicating details about why the host is in this condition.",
+	"reason":        "A brief CamelCase message indicating details about why the host is in this state.",
+}
+
+func (HostStatus) SwaggerDoc() map[string]string {
+	return map_HostStatus
+}
+
+var map_KubeVIPHA = map[string]string{
+	"":          "KubeVIPHA announces the control-plane VIP by kube-vip static pods on masters.",
+	"interface": "Interface is the network interface the VIP is bound to, defaults to the network device of cluster.",
+	"mode":      "Mode is how the VIP is announced, one of ARP and BGP, defaults to ARP.",
+	"bgp":       "BGP configures the peering of masters in BGP mode.",
+}
+
+func (KubeVIPHA) SwaggerDoc() map[string]string {
+	return map_KubeVIPHA
+}
+
 var map_LocalEtcd = map[string]string{
 	"":               "LocalEtcd describes that kubeadm should run an etcd cluster locally",
 	"dataDir":        "DataDir is the directory etcd will place its data. Defaults to \"/var/lib/etcd\".",
@@ -456,6 +917,16 @@ func (LocalEtcd) SwaggerDoc() map[string]string {
 	return map_LocalEtcd
 }
 
+var map_LocalSnapshotTarget = map[string]string{
+	"":    "LocalSnapshotTarget stores the snapshots in a directory of a master machine.",
+	"ip":  "IP of the master machine, defaults to the first master of the cluster.",
+	"dir": "Dir is the directory which stores the snapshots.",
+}
+
+func (LocalSnapshotTarget) SwaggerDoc() map[string]string {
+	return map_LocalSnapshotTarget
+}
+
 var map_Machine = map[string]string{
 	"":     "Machine instance in Kubernetes cluster",
 	"spec": "Spec defines the desired identities of the Machine.",
@@ -498,10 +969,58 @@ func (MachineList) SwaggerDoc() map[string]string {
 	return map_MachineList
 }
 
+var map_MachinePool = map[string]string{
+	"":     "MachinePool is a group of machines of a cluster that share the same settings.",
+	"spec": "Spec defines the desired identities of the MachinePool.",
+}
+
+func (MachinePool) SwaggerDoc() map[string]string {
+	return map_MachinePool
+}
+
+var map_MachinePoolList = map[string]string{
+	"":      "MachinePoolList is the whole list of all machine pools.",
+	"items": "List of machine pools",
+}
+
+func (MachinePoolList) SwaggerDoc() map[string]string {
+	return map_MachinePoolList
+}
+
+var map_MachinePoolSpec = map[string]string{
+	"":             "MachinePoolSpec is a description of a machine pool.",
+	"finalizers":   "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+	"type":         "Type is the machine provider type of the machines, defaults to Baremetal.",
+	"replicas":     "Replicas is the desired number of machines in the pool.",
+	"hostSelector": "HostSelector selects the hosts of the inventory the machines of the pool are drawn from, the machines login the hosts by their ssh credentials.",
+	"template":     "Template describes the machines that will be created, changes to it only apply to machines created afterwards.",
+}
+
+func (MachinePoolSpec) SwaggerDoc() map[string]string {
+	return map_MachinePoolSpec
+}
+
+var map_MachinePoolStatus = map[string]string{
+	"":               "MachinePoolStatus represents information about the status of a machine pool.",
+	"replicas":       "Replicas is the number of machines in the pool.",
+	"readyReplicas":  "ReadyReplicas is the number of running machines in the pool.",
+	"availableHosts": "AvailableHosts is the number of hosts of the inventory not used by any machine.",
+	"message":        "A human readable message indicating details about why the pool is in this condition.",
+	"reason":         "A brief CamelCase message indicating details about why the pool is in this state.",
+}
+
+func (MachinePoolStatus) SwaggerDoc() map[string]string {
+	return map_MachinePoolStatus
+}
+
 var map_MachineSpec = map[string]string{
-	"":           "MachineSpec is a description of machine.",
-	"finalizers": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-	"taints":     "If specified, the node's taints.",
+	"":                       "MachineSpec is a description of machine.",
+	"finalizers":             "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+	"taints":                 "If specified, the node's taints.",
+	"kubeletExtraArgs":       "KubeletExtraArgs is merged over the cluster kubelet extra args when the machine joins.",
+	"dockerExtraArgs":        "DockerExtraArgs is merged over the cluster docker extra args when the machine joins.",
+	"credentialName":         "CredentialName is the name of the SSHCredential used to login the machine, it takes precedence over the inline username, password and private key.",
+	"containerRuntimeConfig": "ContainerRuntimeConfig is merged over the container runtime config of the cluster.",
 }
 
 func (MachineSpec) SwaggerDoc() map[string]string {
@@ -509,11 +1028,13 @@ func (MachineSpec) SwaggerDoc() map[string]string {
 }
 
 var map_MachineStatus = map[string]string{
-	"":            "MachineStatus represents information about the status of an machine.",
-	"message":     "A human readable message indicating details about why the machine is in this condition.",
-	"reason":      "A brief CamelCase message indicating details about why the machine is in this state.",
-	"addresses":   "List of addresses reachable to the machine.",
-	"machineInfo": "Set of ids/uuids to uniquely identify the node.",
+	"":               "MachineStatus represents information about the status of an machine.",
+	"message":        "A human readable message indicating details about why the machine is in this condition.",
+	"reason":         "A brief CamelCase message indicating details about why the machine is in this state.",
+	"addresses":      "List of addresses reachable to the machine.",
+	"machineInfo":    "Set of ids/uuids to uniquely identify the node.",
+	"upgrade":        "The latest kubernetes upgrade of the machine.",
+	"handlerHistory": "The bounded execution history of provider handlers.",
 }
 
 func (MachineStatus) SwaggerDoc() map[string]string {
@@ -538,6 +1059,142 @@ func (MachineSystemInfo) SwaggerDoc() map[string]string {
 	return map_MachineSystemInfo
 }
 
+var map_MachineTemplateSpec = map[string]string{
+	"":                       "MachineTemplateSpec describes the settings shared by the machines of a pool.",
+	"taints":                 "If specified, the node's taints.",
+	"capacity":               "Capacity is the allocatable resources of a machine, which the autoscaler compares with the requests of unschedulable pods. Defaults to the allocatable of a running node of the pool.",
+	"containerRuntimeConfig": "ContainerRuntimeConfig is merged over the container runtime config of the cluster on the machines of the pool.",
+}
+
+func (MachineTemplateSpec) SwaggerDoc() map[string]string {
+	return map_MachineTemplateSpec
+}
+
+var map_MachineUpgradeStatus = map[string]string{
+	"":            "MachineUpgradeStatus represents the kubernetes upgrade progress of a worker machine.",
+	"fromVersion": "The kubelet version before upgrade.",
+	"toVersion":   "The target kubernetes version.",
+	"canary":      "Whether the machine belongs to the canary batch.",
+	"message":     "A human readable message indicating details about the upgrade.",
+}
+
+func (MachineUpgradeStatus) SwaggerDoc() map[string]string {
+	return map_MachineUpgradeStatus
+}
+
+var map_MetalLB = map[string]string{
+	"":             "MetalLB is the load balancer of Services of type LoadBalancer.",
+	"addressPools": "AddressPools are the addresses assigned to LoadBalancer Services.",
+	"bgp":          "BGP configures the peering of nodes for pools in BGP protocol.",
+}
+
+func (MetalLB) SwaggerDoc() map[string]string {
+	return map_MetalLB
+}
+
+var map_MetalLBAddressPool = map[string]string{
+	"":          "MetalLBAddressPool is a range of addresses announced by the same protocol.",
+	"protocol":  "Protocol is one of layer2 and bgp, defaults to layer2.",
+	"addresses": "Addresses are CIDRs or ranges in the form of \"first-last\".",
+}
+
+func (MetalLBAddressPool) SwaggerDoc() map[string]string {
+	return map_MetalLBAddressPool
+}
+
+var map_MultiClusterContainerOverride = map[string]string{
+	"":      "MultiClusterContainerOverride overrides a container of the template.",
+	"name":  "Name of the container in the template.",
+	"image": "Image replaces the image of the container.",
+	"env":   "Env is merged into the environment variables of the container by name.",
+}
+
+func (MultiClusterContainerOverride) SwaggerDoc() map[string]string {
+	return map_MultiClusterContainerOverride
+}
+
+var map_MultiClusterDeployment = map[string]string{
+	"":     "MultiClusterDeployment propagates a deployment to several clusters and splits its replicas among them.",
+	"spec": "Spec defines the deployment and its placement.",
+}
+
+func (MultiClusterDeployment) SwaggerDoc() map[string]string {
+	return map_MultiClusterDeployment
+}
+
+var map_MultiClusterDeploymentClusterStatus = map[string]string{
+	"":         "MultiClusterDeploymentClusterStatus is the status of the deployment of a target cluster.",
+	"replicas": "Replicas is the number of desired pods of the cluster.",
+}
+
+func (MultiClusterDeploymentClusterStatus) SwaggerDoc() map[string]string {
+	return map_MultiClusterDeploymentClusterStatus
+}
+
+var map_MultiClusterDeploymentList = map[string]string{
+	"":      "MultiClusterDeploymentList is the whole list of all multi cluster deployments.",
+	"items": "List of multi cluster deployments",
+}
+
+func (MultiClusterDeploymentList) SwaggerDoc() map[string]string {
+	return map_MultiClusterDeploymentList
+}
+
+var map_MultiClusterDeploymentSpec = map[string]string{
+	"":          "MultiClusterDeploymentSpec is a description of a multi cluster deployment. A deployment with the same name is created in the namespace of every target cluster.",
+	"namespace": "Namespace of the deployments in the target clusters, it must exist.",
+	"replicas":  "Replicas is the total number of pods of all target clusters, defaults to 1.",
+	"selector":  "Selector is the label query over pods of the deployments, it must match the labels of the template.",
+	"template":  "Template describes the pods of the deployments.",
+	"placement": "Placement selects the target clusters.",
+	"overrides": "Overrides customize the deployment of individual target clusters.",
+}
+
+func (MultiClusterDeploymentSpec) SwaggerDoc() map[string]string {
+	return map_MultiClusterDeploymentSpec
+}
+
+var map_MultiClusterDeploymentStatus = map[string]string{
+	"":                   "MultiClusterDeploymentStatus represents information about the status of a multi cluster deployment, aggregated from the deployments of the target clusters.",
+	"observedGeneration": "ObservedGeneration is the generation of the spec last propagated.",
+	"replicas":           "Replicas is the total number of desired pods of all target clusters.",
+	"clusters":           "Clusters is the status of the deployment of every target cluster.",
+}
+
+func (MultiClusterDeploymentStatus) SwaggerDoc() map[string]string {
+	return map_MultiClusterDeploymentStatus
+}
+
+var map_MultiClusterOverride = map[string]string{
+	"":           "MultiClusterOverride customizes the deployment of a target cluster.",
+	"replicas":   "Replicas of the cluster instead of its share of the weighted split, the rest of the total replicas is split among the other clusters.",
+	"containers": "Containers override the containers of the template by name.",
+}
+
+func (MultiClusterOverride) SwaggerDoc() map[string]string {
+	return map_MultiClusterOverride
+}
+
+var map_MultiClusterPlacement = map[string]string{
+	"":                "MultiClusterPlacement selects the target clusters of a multi cluster deployment and weighs them for splitting the replicas.",
+	"clusterNames":    "ClusterNames are the names of the target clusters.",
+	"clusterSelector": "ClusterSelector selects the target clusters of the tenant by labels, in addition to ClusterNames.",
+	"weights":         "Weights of the target clusters when splitting the replicas, clusters not listed weigh 1.",
+}
+
+func (MultiClusterPlacement) SwaggerDoc() map[string]string {
+	return map_MultiClusterPlacement
+}
+
+var map_MultiClusterWeight = map[string]string{
+	"":       "MultiClusterWeight is the weight of a target cluster.",
+	"weight": "Weight is relative to the weights of the other target clusters, a cluster weighing 0 gets no replicas.",
+}
+
+func (MultiClusterWeight) SwaggerDoc() map[string]string {
+	return map_MultiClusterWeight
+}
+
 var map_PersistentBackEnd = map[string]string{
 	"": "PersistentBackEnd indicates the backend type and attributes of the persistent log store.",
 }
@@ -609,6 +1266,24 @@ func (RegistryList) SwaggerDoc() map[string]string {
 	return map_RegistryList
 }
 
+var map_RegistryMirror = map[string]string{
+	"":     "RegistryMirror is the mirror endpoints of a registry.",
+	"host": "Host of the registry, e.g. docker.io.",
+}
+
+func (RegistryMirror) SwaggerDoc() map[string]string {
+	return map_RegistryMirror
+}
+
+var map_RegistrySnapshotTarget = map[string]string{
+	"":       "RegistrySnapshotTarget stores the snapshots in the filesystem of tke-registry.",
+	"prefix": "Prefix is the path prefix relative to the root directory of the registry filesystem.",
+}
+
+func (RegistrySnapshotTarget) SwaggerDoc() map[string]string {
+	return map_RegistrySnapshotTarget
+}
+
 var map_RegistrySpec = map[string]string{
 	"": "RegistrySpec indicates the specifications of the third-party image repository.",
 }
@@ -625,6 +1300,50 @@ func (ResourceRequirements) SwaggerDoc() map[string]string {
 	return map_ResourceRequirements
 }
 
+var map_RuntimeClass = map[string]string{
+	"":     "RuntimeClass is a runtime handler of containerd.",
+	"name": "Name of the runtime handler and the RuntimeClass object.",
+}
+
+func (RuntimeClass) SwaggerDoc() map[string]string {
+	return map_RuntimeClass
+}
+
+var map_S3SnapshotTarget = map[string]string{
+	"":            "S3SnapshotTarget stores the snapshots in an S3 compatible object storage.",
+	"accessKeyID": "AccessKeyID of the target, its secret access key is kept in the S3SecretAccessKeys of the cluster credential.",
+}
+
+func (S3SnapshotTarget) SwaggerDoc() map[string]string {
+	return map_S3SnapshotTarget
+}
+
+var map_SSHCredential = map[string]string{
+	"":     "SSHCredential is a ssh login secret that can be referenced by name from hosts, cluster machines and machines.",
+	"spec": "Spec defines the desired identities of the SSHCredential.",
+}
+
+func (SSHCredential) SwaggerDoc() map[string]string {
+	return map_SSHCredential
+}
+
+var map_SSHCredentialList = map[string]string{
+	"":      "SSHCredentialList is the whole list of all ssh credentials.",
+	"items": "List of ssh credentials",
+}
+
+func (SSHCredentialList) SwaggerDoc() map[string]string {
+	return map_SSHCredentialList
+}
+
+var map_SSHCredentialSpec = map[string]string{
+	"": "SSHCredentialSpec is a description of a ssh credential.",
+}
+
+func (SSHCredentialSpec) SwaggerDoc() map[string]string {
+	return map_SSHCredentialSpec
+}
+
 var map_StorageBackEndCLS = map[string]string{
 	"": "StorageBackEndCLS records the attributes required when the backend storage type is CLS.",
 }
@@ -700,6 +1419,9 @@ var map_UpgradeStrategy = map[string]string{
 	"":                       "UpgradeStrategy used to control the upgrade process.",
 	"maxUnready":             "The maximum number of pods that can be unready during the upgrade. 0% means all pods need to be ready after evition. 100% means ignore any pods unready which may be used in one worker node, use this carefully! default value is 0%.",
 	"drainNodeBeforeUpgrade": "Whether drain node before upgrade. Draining node before upgrade is recommended. But not all pod running as cows, a few running as pets. If your pod can not accept be expelled from current node, this value should be false.",
+	"canaryMachines":         "The number of worker machines upgraded first as a canary batch. 0 means no canary batch.",
+	"pauseAfterCanary":       "Whether pause the worker upgrade after the canary batch is upgraded. Remove the worker upgrade annotation of cluster to resume.",
+	"rollbackOnFailure":      "Whether roll back kubelet and kubeadm binaries of a worker node when its upgrade failed. default value is true.",
 }
 
 func (UpgradeStrategy) SwaggerDoc() map[string]string {
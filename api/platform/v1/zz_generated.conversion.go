@@ -60,6 +60,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditGroupResources)(nil), (*platform.AuditGroupResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditGroupResources_To_platform_AuditGroupResources(a.(*AuditGroupResources), b.(*platform.AuditGroupResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditGroupResources)(nil), (*AuditGroupResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditGroupResources_To_v1_AuditGroupResources(a.(*platform.AuditGroupResources), b.(*AuditGroupResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditPolicy)(nil), (*platform.AuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditPolicy_To_platform_AuditPolicy(a.(*AuditPolicy), b.(*platform.AuditPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditPolicy)(nil), (*AuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditPolicy_To_v1_AuditPolicy(a.(*platform.AuditPolicy), b.(*AuditPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditPolicyList)(nil), (*platform.AuditPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditPolicyList_To_platform_AuditPolicyList(a.(*AuditPolicyList), b.(*platform.AuditPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditPolicyList)(nil), (*AuditPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditPolicyList_To_v1_AuditPolicyList(a.(*platform.AuditPolicyList), b.(*AuditPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditPolicyRule)(nil), (*platform.AuditPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditPolicyRule_To_platform_AuditPolicyRule(a.(*AuditPolicyRule), b.(*platform.AuditPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditPolicyRule)(nil), (*AuditPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditPolicyRule_To_v1_AuditPolicyRule(a.(*platform.AuditPolicyRule), b.(*AuditPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditPolicySpec)(nil), (*platform.AuditPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditPolicySpec_To_platform_AuditPolicySpec(a.(*AuditPolicySpec), b.(*platform.AuditPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditPolicySpec)(nil), (*AuditPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditPolicySpec_To_v1_AuditPolicySpec(a.(*platform.AuditPolicySpec), b.(*AuditPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditPolicyStatus)(nil), (*platform.AuditPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditPolicyStatus_To_platform_AuditPolicyStatus(a.(*AuditPolicyStatus), b.(*platform.AuditPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditPolicyStatus)(nil), (*AuditPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditPolicyStatus_To_v1_AuditPolicyStatus(a.(*platform.AuditPolicyStatus), b.(*AuditPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuthzWebhookAddr)(nil), (*platform.AuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(a.(*AuthzWebhookAddr), b.(*platform.AuthzWebhookAddr), scope)
 	}); err != nil {
@@ -70,6 +130,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AutoscalingNodeGroup)(nil), (*platform.AutoscalingNodeGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AutoscalingNodeGroup_To_platform_AutoscalingNodeGroup(a.(*AutoscalingNodeGroup), b.(*platform.AutoscalingNodeGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AutoscalingNodeGroup)(nil), (*AutoscalingNodeGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AutoscalingNodeGroup_To_v1_AutoscalingNodeGroup(a.(*platform.AutoscalingNodeGroup), b.(*AutoscalingNodeGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPConfig)(nil), (*platform.BGPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BGPConfig_To_platform_BGPConfig(a.(*BGPConfig), b.(*platform.BGPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.BGPConfig)(nil), (*BGPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_BGPConfig_To_v1_BGPConfig(a.(*platform.BGPConfig), b.(*BGPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPPeer)(nil), (*platform.BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BGPPeer_To_platform_BGPPeer(a.(*BGPPeer), b.(*platform.BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.BGPPeer)(nil), (*BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_BGPPeer_To_v1_BGPPeer(a.(*platform.BGPPeer), b.(*BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BuiltinAuthzWebhookAddr)(nil), (*platform.BuiltinAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(a.(*BuiltinAuthzWebhookAddr), b.(*platform.BuiltinAuthzWebhookAddr), scope)
 	}); err != nil {
@@ -220,6 +310,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterApplyObjectResult)(nil), (*platform.ClusterApplyObjectResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterApplyObjectResult_To_platform_ClusterApplyObjectResult(a.(*ClusterApplyObjectResult), b.(*platform.ClusterApplyObjectResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterApplyObjectResult)(nil), (*ClusterApplyObjectResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterApplyObjectResult_To_v1_ClusterApplyObjectResult(a.(*platform.ClusterApplyObjectResult), b.(*ClusterApplyObjectResult), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterApplyOptions)(nil), (*platform.ClusterApplyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(a.(*ClusterApplyOptions), b.(*platform.ClusterApplyOptions), scope)
 	}); err != nil {
@@ -230,6 +330,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterApplyResult)(nil), (*platform.ClusterApplyResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterApplyResult_To_platform_ClusterApplyResult(a.(*ClusterApplyResult), b.(*platform.ClusterApplyResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterApplyResult)(nil), (*ClusterApplyResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterApplyResult_To_v1_ClusterApplyResult(a.(*platform.ClusterApplyResult), b.(*ClusterApplyResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAutoscaling)(nil), (*platform.ClusterAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterAutoscaling_To_platform_ClusterAutoscaling(a.(*ClusterAutoscaling), b.(*platform.ClusterAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterAutoscaling)(nil), (*ClusterAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterAutoscaling_To_v1_ClusterAutoscaling(a.(*platform.ClusterAutoscaling), b.(*ClusterAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterCertificate)(nil), (*platform.ClusterCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterCertificate_To_platform_ClusterCertificate(a.(*ClusterCertificate), b.(*platform.ClusterCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterCertificate)(nil), (*ClusterCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterCertificate_To_v1_ClusterCertificate(a.(*platform.ClusterCertificate), b.(*ClusterCertificate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterComponent)(nil), (*platform.ClusterComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterComponent_To_platform_ClusterComponent(a.(*ClusterComponent), b.(*platform.ClusterComponent), scope)
 	}); err != nil {
@@ -360,6 +490,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterPlan)(nil), (*platform.ClusterPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterPlan_To_platform_ClusterPlan(a.(*ClusterPlan), b.(*platform.ClusterPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterPlan)(nil), (*ClusterPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterPlan_To_v1_ClusterPlan(a.(*platform.ClusterPlan), b.(*ClusterPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterPlanCheck)(nil), (*platform.ClusterPlanCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterPlanCheck_To_platform_ClusterPlanCheck(a.(*ClusterPlanCheck), b.(*platform.ClusterPlanCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterPlanCheck)(nil), (*ClusterPlanCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterPlanCheck_To_v1_ClusterPlanCheck(a.(*platform.ClusterPlanCheck), b.(*ClusterPlanCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterPlanConfig)(nil), (*platform.ClusterPlanConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterPlanConfig_To_platform_ClusterPlanConfig(a.(*ClusterPlanConfig), b.(*platform.ClusterPlanConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterPlanConfig)(nil), (*ClusterPlanConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterPlanConfig_To_v1_ClusterPlanConfig(a.(*platform.ClusterPlanConfig), b.(*ClusterPlanConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterPlanStep)(nil), (*platform.ClusterPlanStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterPlanStep_To_platform_ClusterPlanStep(a.(*ClusterPlanStep), b.(*platform.ClusterPlanStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterPlanStep)(nil), (*ClusterPlanStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterPlanStep_To_v1_ClusterPlanStep(a.(*platform.ClusterPlanStep), b.(*ClusterPlanStep), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterProperty)(nil), (*platform.ClusterProperty)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterProperty_To_platform_ClusterProperty(a.(*ClusterProperty), b.(*platform.ClusterProperty), scope)
 	}); err != nil {
@@ -400,2148 +570,4535 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ConfigMap)(nil), (*platform.ConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ConfigMap_To_platform_ConfigMap(a.(*ConfigMap), b.(*platform.ConfigMap), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterSync)(nil), (*platform.ClusterSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterSync_To_platform_ClusterSync(a.(*ClusterSync), b.(*platform.ClusterSync), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ConfigMap)(nil), (*ConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ConfigMap_To_v1_ConfigMap(a.(*platform.ConfigMap), b.(*ConfigMap), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterSync)(nil), (*ClusterSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterSync_To_v1_ClusterSync(a.(*platform.ClusterSync), b.(*ClusterSync), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ConfigMapList)(nil), (*platform.ConfigMapList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ConfigMapList_To_platform_ConfigMapList(a.(*ConfigMapList), b.(*platform.ConfigMapList), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterSyncClusterStatus)(nil), (*platform.ClusterSyncClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterSyncClusterStatus_To_platform_ClusterSyncClusterStatus(a.(*ClusterSyncClusterStatus), b.(*platform.ClusterSyncClusterStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ConfigMapList)(nil), (*ConfigMapList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ConfigMapList_To_v1_ConfigMapList(a.(*platform.ConfigMapList), b.(*ConfigMapList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterSyncClusterStatus)(nil), (*ClusterSyncClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterSyncClusterStatus_To_v1_ClusterSyncClusterStatus(a.(*platform.ClusterSyncClusterStatus), b.(*ClusterSyncClusterStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*CronHPA)(nil), (*platform.CronHPA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_CronHPA_To_platform_CronHPA(a.(*CronHPA), b.(*platform.CronHPA), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterSyncList)(nil), (*platform.ClusterSyncList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterSyncList_To_platform_ClusterSyncList(a.(*ClusterSyncList), b.(*platform.ClusterSyncList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.CronHPA)(nil), (*CronHPA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_CronHPA_To_v1_CronHPA(a.(*platform.CronHPA), b.(*CronHPA), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterSyncList)(nil), (*ClusterSyncList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterSyncList_To_v1_ClusterSyncList(a.(*platform.ClusterSyncList), b.(*ClusterSyncList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*CronHPAList)(nil), (*platform.CronHPAList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_CronHPAList_To_platform_CronHPAList(a.(*CronHPAList), b.(*platform.CronHPAList), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterSyncObject)(nil), (*platform.ClusterSyncObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterSyncObject_To_platform_ClusterSyncObject(a.(*ClusterSyncObject), b.(*platform.ClusterSyncObject), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.CronHPAList)(nil), (*CronHPAList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_CronHPAList_To_v1_CronHPAList(a.(*platform.CronHPAList), b.(*CronHPAList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterSyncObject)(nil), (*ClusterSyncObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterSyncObject_To_v1_ClusterSyncObject(a.(*platform.ClusterSyncObject), b.(*ClusterSyncObject), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*CronHPAProxyOptions)(nil), (*platform.CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(a.(*CronHPAProxyOptions), b.(*platform.CronHPAProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterSyncSource)(nil), (*platform.ClusterSyncSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterSyncSource_To_platform_ClusterSyncSource(a.(*ClusterSyncSource), b.(*platform.ClusterSyncSource), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.CronHPAProxyOptions)(nil), (*CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(a.(*platform.CronHPAProxyOptions), b.(*CronHPAProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterSyncSource)(nil), (*ClusterSyncSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterSyncSource_To_v1_ClusterSyncSource(a.(*platform.ClusterSyncSource), b.(*ClusterSyncSource), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*CronHPASpec)(nil), (*platform.CronHPASpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_CronHPASpec_To_platform_CronHPASpec(a.(*CronHPASpec), b.(*platform.CronHPASpec), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterSyncSpec)(nil), (*platform.ClusterSyncSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterSyncSpec_To_platform_ClusterSyncSpec(a.(*ClusterSyncSpec), b.(*platform.ClusterSyncSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.CronHPASpec)(nil), (*CronHPASpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_CronHPASpec_To_v1_CronHPASpec(a.(*platform.CronHPASpec), b.(*CronHPASpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterSyncSpec)(nil), (*ClusterSyncSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterSyncSpec_To_v1_ClusterSyncSpec(a.(*platform.ClusterSyncSpec), b.(*ClusterSyncSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*CronHPAStatus)(nil), (*platform.CronHPAStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(a.(*CronHPAStatus), b.(*platform.CronHPAStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterSyncStatus)(nil), (*platform.ClusterSyncStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterSyncStatus_To_platform_ClusterSyncStatus(a.(*ClusterSyncStatus), b.(*platform.ClusterSyncStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.CronHPAStatus)(nil), (*CronHPAStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(a.(*platform.CronHPAStatus), b.(*CronHPAStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterSyncStatus)(nil), (*ClusterSyncStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterSyncStatus_To_v1_ClusterSyncStatus(a.(*platform.ClusterSyncStatus), b.(*ClusterSyncStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Etcd)(nil), (*platform.Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Etcd_To_platform_Etcd(a.(*Etcd), b.(*platform.Etcd), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterTemplate)(nil), (*platform.ClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterTemplate_To_platform_ClusterTemplate(a.(*ClusterTemplate), b.(*platform.ClusterTemplate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Etcd)(nil), (*Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Etcd_To_v1_Etcd(a.(*platform.Etcd), b.(*Etcd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterTemplate)(nil), (*ClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterTemplate_To_v1_ClusterTemplate(a.(*platform.ClusterTemplate), b.(*ClusterTemplate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ExternalAuthzWebhookAddr)(nil), (*platform.ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(a.(*ExternalAuthzWebhookAddr), b.(*platform.ExternalAuthzWebhookAddr), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterTemplateList)(nil), (*platform.ClusterTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterTemplateList_To_platform_ClusterTemplateList(a.(*ClusterTemplateList), b.(*platform.ClusterTemplateList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ExternalAuthzWebhookAddr)(nil), (*ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(a.(*platform.ExternalAuthzWebhookAddr), b.(*ExternalAuthzWebhookAddr), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterTemplateList)(nil), (*ClusterTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterTemplateList_To_v1_ClusterTemplateList(a.(*platform.ClusterTemplateList), b.(*ClusterTemplateList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ExternalEtcd)(nil), (*platform.ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(a.(*ExternalEtcd), b.(*platform.ExternalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterTemplateRef)(nil), (*platform.ClusterTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterTemplateRef_To_platform_ClusterTemplateRef(a.(*ClusterTemplateRef), b.(*platform.ClusterTemplateRef), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ExternalEtcd)(nil), (*ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(a.(*platform.ExternalEtcd), b.(*ExternalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterTemplateRef)(nil), (*ClusterTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterTemplateRef_To_v1_ClusterTemplateRef(a.(*platform.ClusterTemplateRef), b.(*ClusterTemplateRef), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*File)(nil), (*platform.File)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_File_To_platform_File(a.(*File), b.(*platform.File), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterTemplateRevision)(nil), (*platform.ClusterTemplateRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterTemplateRevision_To_platform_ClusterTemplateRevision(a.(*ClusterTemplateRevision), b.(*platform.ClusterTemplateRevision), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.File)(nil), (*File)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_File_To_v1_File(a.(*platform.File), b.(*File), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterTemplateRevision)(nil), (*ClusterTemplateRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterTemplateRevision_To_v1_ClusterTemplateRevision(a.(*platform.ClusterTemplateRevision), b.(*ClusterTemplateRevision), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*HA)(nil), (*platform.HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_HA_To_platform_HA(a.(*HA), b.(*platform.HA), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterTemplateSpec)(nil), (*platform.ClusterTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterTemplateSpec_To_platform_ClusterTemplateSpec(a.(*ClusterTemplateSpec), b.(*platform.ClusterTemplateSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.HA)(nil), (*HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_HA_To_v1_HA(a.(*platform.HA), b.(*HA), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterTemplateSpec)(nil), (*ClusterTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterTemplateSpec_To_v1_ClusterTemplateSpec(a.(*platform.ClusterTemplateSpec), b.(*ClusterTemplateSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LocalEtcd)(nil), (*platform.LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LocalEtcd_To_platform_LocalEtcd(a.(*LocalEtcd), b.(*platform.LocalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*ClusterTemplateStatus)(nil), (*platform.ClusterTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterTemplateStatus_To_platform_ClusterTemplateStatus(a.(*ClusterTemplateStatus), b.(*platform.ClusterTemplateStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LocalEtcd)(nil), (*LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LocalEtcd_To_v1_LocalEtcd(a.(*platform.LocalEtcd), b.(*LocalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterTemplateStatus)(nil), (*ClusterTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterTemplateStatus_To_v1_ClusterTemplateStatus(a.(*platform.ClusterTemplateStatus), b.(*ClusterTemplateStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*platform.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Machine_To_platform_Machine(a.(*Machine), b.(*platform.Machine), scope)
+	if err := s.AddGeneratedConversionFunc((*ConfigMap)(nil), (*platform.ConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ConfigMap_To_platform_ConfigMap(a.(*ConfigMap), b.(*platform.ConfigMap), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Machine)(nil), (*Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Machine_To_v1_Machine(a.(*platform.Machine), b.(*Machine), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ConfigMap)(nil), (*ConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ConfigMap_To_v1_ConfigMap(a.(*platform.ConfigMap), b.(*ConfigMap), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineAddress)(nil), (*platform.MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineAddress_To_platform_MachineAddress(a.(*MachineAddress), b.(*platform.MachineAddress), scope)
+	if err := s.AddGeneratedConversionFunc((*ConfigMapList)(nil), (*platform.ConfigMapList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ConfigMapList_To_platform_ConfigMapList(a.(*ConfigMapList), b.(*platform.ConfigMapList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineAddress)(nil), (*MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineAddress_To_v1_MachineAddress(a.(*platform.MachineAddress), b.(*MachineAddress), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ConfigMapList)(nil), (*ConfigMapList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ConfigMapList_To_v1_ConfigMapList(a.(*platform.ConfigMapList), b.(*ConfigMapList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineCondition)(nil), (*platform.MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineCondition_To_platform_MachineCondition(a.(*MachineCondition), b.(*platform.MachineCondition), scope)
+	if err := s.AddGeneratedConversionFunc((*ContainerRuntimeConfig)(nil), (*platform.ContainerRuntimeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ContainerRuntimeConfig_To_platform_ContainerRuntimeConfig(a.(*ContainerRuntimeConfig), b.(*platform.ContainerRuntimeConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineCondition)(nil), (*MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineCondition_To_v1_MachineCondition(a.(*platform.MachineCondition), b.(*MachineCondition), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ContainerRuntimeConfig)(nil), (*ContainerRuntimeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ContainerRuntimeConfig_To_v1_ContainerRuntimeConfig(a.(*platform.ContainerRuntimeConfig), b.(*ContainerRuntimeConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineList)(nil), (*platform.MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineList_To_platform_MachineList(a.(*MachineList), b.(*platform.MachineList), scope)
+	if err := s.AddGeneratedConversionFunc((*CronHPA)(nil), (*platform.CronHPA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CronHPA_To_platform_CronHPA(a.(*CronHPA), b.(*platform.CronHPA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineList)(nil), (*MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineList_To_v1_MachineList(a.(*platform.MachineList), b.(*MachineList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.CronHPA)(nil), (*CronHPA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CronHPA_To_v1_CronHPA(a.(*platform.CronHPA), b.(*CronHPA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineSpec)(nil), (*platform.MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineSpec_To_platform_MachineSpec(a.(*MachineSpec), b.(*platform.MachineSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*CronHPAList)(nil), (*platform.CronHPAList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CronHPAList_To_platform_CronHPAList(a.(*CronHPAList), b.(*platform.CronHPAList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineSpec)(nil), (*MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineSpec_To_v1_MachineSpec(a.(*platform.MachineSpec), b.(*MachineSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.CronHPAList)(nil), (*CronHPAList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CronHPAList_To_v1_CronHPAList(a.(*platform.CronHPAList), b.(*CronHPAList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineStatus)(nil), (*platform.MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineStatus_To_platform_MachineStatus(a.(*MachineStatus), b.(*platform.MachineStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*CronHPAProxyOptions)(nil), (*platform.CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(a.(*CronHPAProxyOptions), b.(*platform.CronHPAProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineStatus)(nil), (*MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineStatus_To_v1_MachineStatus(a.(*platform.MachineStatus), b.(*MachineStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.CronHPAProxyOptions)(nil), (*CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(a.(*platform.CronHPAProxyOptions), b.(*CronHPAProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineSystemInfo)(nil), (*platform.MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(a.(*MachineSystemInfo), b.(*platform.MachineSystemInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*CronHPASpec)(nil), (*platform.CronHPASpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CronHPASpec_To_platform_CronHPASpec(a.(*CronHPASpec), b.(*platform.CronHPASpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineSystemInfo)(nil), (*MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(a.(*platform.MachineSystemInfo), b.(*MachineSystemInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.CronHPASpec)(nil), (*CronHPASpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CronHPASpec_To_v1_CronHPASpec(a.(*platform.CronHPASpec), b.(*CronHPASpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentBackEnd)(nil), (*platform.PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentBackEnd_To_platform_PersistentBackEnd(a.(*PersistentBackEnd), b.(*platform.PersistentBackEnd), scope)
+	if err := s.AddGeneratedConversionFunc((*CronHPAStatus)(nil), (*platform.CronHPAStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(a.(*CronHPAStatus), b.(*platform.CronHPAStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentBackEnd)(nil), (*PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentBackEnd_To_v1_PersistentBackEnd(a.(*platform.PersistentBackEnd), b.(*PersistentBackEnd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.CronHPAStatus)(nil), (*CronHPAStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(a.(*platform.CronHPAStatus), b.(*CronHPAStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEvent)(nil), (*platform.PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEvent_To_platform_PersistentEvent(a.(*PersistentEvent), b.(*platform.PersistentEvent), scope)
+	if err := s.AddGeneratedConversionFunc((*Etcd)(nil), (*platform.Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Etcd_To_platform_Etcd(a.(*Etcd), b.(*platform.Etcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEvent)(nil), (*PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEvent_To_v1_PersistentEvent(a.(*platform.PersistentEvent), b.(*PersistentEvent), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.Etcd)(nil), (*Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Etcd_To_v1_Etcd(a.(*platform.Etcd), b.(*Etcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEventList)(nil), (*platform.PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEventList_To_platform_PersistentEventList(a.(*PersistentEventList), b.(*platform.PersistentEventList), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdBackup)(nil), (*platform.EtcdBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdBackup_To_platform_EtcdBackup(a.(*EtcdBackup), b.(*platform.EtcdBackup), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventList)(nil), (*PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEventList_To_v1_PersistentEventList(a.(*platform.PersistentEventList), b.(*PersistentEventList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdBackup)(nil), (*EtcdBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdBackup_To_v1_EtcdBackup(a.(*platform.EtcdBackup), b.(*EtcdBackup), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEventSpec)(nil), (*platform.PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEventSpec_To_platform_PersistentEventSpec(a.(*PersistentEventSpec), b.(*platform.PersistentEventSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshot)(nil), (*platform.EtcdSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(a.(*EtcdSnapshot), b.(*platform.EtcdSnapshot), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventSpec)(nil), (*PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEventSpec_To_v1_PersistentEventSpec(a.(*platform.PersistentEventSpec), b.(*PersistentEventSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdSnapshot)(nil), (*EtcdSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(a.(*platform.EtcdSnapshot), b.(*EtcdSnapshot), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEventStatus)(nil), (*platform.PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(a.(*PersistentEventStatus), b.(*platform.PersistentEventStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshotList)(nil), (*platform.EtcdSnapshotList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdSnapshotList_To_platform_EtcdSnapshotList(a.(*EtcdSnapshotList), b.(*platform.EtcdSnapshotList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventStatus)(nil), (*PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(a.(*platform.PersistentEventStatus), b.(*PersistentEventStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdSnapshotList)(nil), (*EtcdSnapshotList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdSnapshotList_To_v1_EtcdSnapshotList(a.(*platform.EtcdSnapshotList), b.(*EtcdSnapshotList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ProxyOptions)(nil), (*platform.ProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ProxyOptions_To_platform_ProxyOptions(a.(*ProxyOptions), b.(*platform.ProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshotRestoreOptions)(nil), (*platform.EtcdSnapshotRestoreOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdSnapshotRestoreOptions_To_platform_EtcdSnapshotRestoreOptions(a.(*EtcdSnapshotRestoreOptions), b.(*platform.EtcdSnapshotRestoreOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ProxyOptions)(nil), (*ProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ProxyOptions_To_v1_ProxyOptions(a.(*platform.ProxyOptions), b.(*ProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdSnapshotRestoreOptions)(nil), (*EtcdSnapshotRestoreOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdSnapshotRestoreOptions_To_v1_EtcdSnapshotRestoreOptions(a.(*platform.EtcdSnapshotRestoreOptions), b.(*EtcdSnapshotRestoreOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Registry)(nil), (*platform.Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Registry_To_platform_Registry(a.(*Registry), b.(*platform.Registry), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshotSpec)(nil), (*platform.EtcdSnapshotSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdSnapshotSpec_To_platform_EtcdSnapshotSpec(a.(*EtcdSnapshotSpec), b.(*platform.EtcdSnapshotSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Registry)(nil), (*Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Registry_To_v1_Registry(a.(*platform.Registry), b.(*Registry), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdSnapshotSpec)(nil), (*EtcdSnapshotSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdSnapshotSpec_To_v1_EtcdSnapshotSpec(a.(*platform.EtcdSnapshotSpec), b.(*EtcdSnapshotSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*RegistryList)(nil), (*platform.RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_RegistryList_To_platform_RegistryList(a.(*RegistryList), b.(*platform.RegistryList), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshotStatus)(nil), (*platform.EtcdSnapshotStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdSnapshotStatus_To_platform_EtcdSnapshotStatus(a.(*EtcdSnapshotStatus), b.(*platform.EtcdSnapshotStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.RegistryList)(nil), (*RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_RegistryList_To_v1_RegistryList(a.(*platform.RegistryList), b.(*RegistryList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdSnapshotStatus)(nil), (*EtcdSnapshotStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdSnapshotStatus_To_v1_EtcdSnapshotStatus(a.(*platform.EtcdSnapshotStatus), b.(*EtcdSnapshotStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*RegistrySpec)(nil), (*platform.RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_RegistrySpec_To_platform_RegistrySpec(a.(*RegistrySpec), b.(*platform.RegistrySpec), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshotTarget)(nil), (*platform.EtcdSnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdSnapshotTarget_To_platform_EtcdSnapshotTarget(a.(*EtcdSnapshotTarget), b.(*platform.EtcdSnapshotTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.RegistrySpec)(nil), (*RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_RegistrySpec_To_v1_RegistrySpec(a.(*platform.RegistrySpec), b.(*RegistrySpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdSnapshotTarget)(nil), (*EtcdSnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdSnapshotTarget_To_v1_EtcdSnapshotTarget(a.(*platform.EtcdSnapshotTarget), b.(*EtcdSnapshotTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ResourceRequirements)(nil), (*platform.ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ResourceRequirements_To_platform_ResourceRequirements(a.(*ResourceRequirements), b.(*platform.ResourceRequirements), scope)
+	if err := s.AddGeneratedConversionFunc((*ExternalAuthzWebhookAddr)(nil), (*platform.ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(a.(*ExternalAuthzWebhookAddr), b.(*platform.ExternalAuthzWebhookAddr), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ResourceRequirements)(nil), (*ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ResourceRequirements_To_v1_ResourceRequirements(a.(*platform.ResourceRequirements), b.(*ResourceRequirements), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ExternalAuthzWebhookAddr)(nil), (*ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(a.(*platform.ExternalAuthzWebhookAddr), b.(*ExternalAuthzWebhookAddr), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageBackEndCLS)(nil), (*platform.StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_StorageBackEndCLS_To_platform_StorageBackEndCLS(a.(*StorageBackEndCLS), b.(*platform.StorageBackEndCLS), scope)
+	if err := s.AddGeneratedConversionFunc((*ExternalEtcd)(nil), (*platform.ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(a.(*ExternalEtcd), b.(*platform.ExternalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndCLS)(nil), (*StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_StorageBackEndCLS_To_v1_StorageBackEndCLS(a.(*platform.StorageBackEndCLS), b.(*StorageBackEndCLS), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ExternalEtcd)(nil), (*ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(a.(*platform.ExternalEtcd), b.(*ExternalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageBackEndES)(nil), (*platform.StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_StorageBackEndES_To_platform_StorageBackEndES(a.(*StorageBackEndES), b.(*platform.StorageBackEndES), scope)
+	if err := s.AddGeneratedConversionFunc((*File)(nil), (*platform.File)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_File_To_platform_File(a.(*File), b.(*platform.File), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndES)(nil), (*StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_StorageBackEndES_To_v1_StorageBackEndES(a.(*platform.StorageBackEndES), b.(*StorageBackEndES), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.File)(nil), (*File)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_File_To_v1_File(a.(*platform.File), b.(*File), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TKEHA)(nil), (*platform.TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TKEHA_To_platform_TKEHA(a.(*TKEHA), b.(*platform.TKEHA), scope)
+	if err := s.AddGeneratedConversionFunc((*HA)(nil), (*platform.HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HA_To_platform_HA(a.(*HA), b.(*platform.HA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TKEHA)(nil), (*TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TKEHA_To_v1_TKEHA(a.(*platform.TKEHA), b.(*TKEHA), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HA)(nil), (*HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HA_To_v1_HA(a.(*platform.HA), b.(*HA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappController)(nil), (*platform.TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappController_To_platform_TappController(a.(*TappController), b.(*platform.TappController), scope)
+	if err := s.AddGeneratedConversionFunc((*HandlerRecord)(nil), (*platform.HandlerRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HandlerRecord_To_platform_HandlerRecord(a.(*HandlerRecord), b.(*platform.HandlerRecord), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappController)(nil), (*TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappController_To_v1_TappController(a.(*platform.TappController), b.(*TappController), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HandlerRecord)(nil), (*HandlerRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HandlerRecord_To_v1_HandlerRecord(a.(*platform.HandlerRecord), b.(*HandlerRecord), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerList)(nil), (*platform.TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerList_To_platform_TappControllerList(a.(*TappControllerList), b.(*platform.TappControllerList), scope)
+	if err := s.AddGeneratedConversionFunc((*Host)(nil), (*platform.Host)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Host_To_platform_Host(a.(*Host), b.(*platform.Host), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerList)(nil), (*TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerList_To_v1_TappControllerList(a.(*platform.TappControllerList), b.(*TappControllerList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.Host)(nil), (*Host)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Host_To_v1_Host(a.(*platform.Host), b.(*Host), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerProxyOptions)(nil), (*platform.TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerProxyOptions_To_platform_TappControllerProxyOptions(a.(*TappControllerProxyOptions), b.(*platform.TappControllerProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*HostList)(nil), (*platform.HostList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HostList_To_platform_HostList(a.(*HostList), b.(*platform.HostList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerProxyOptions)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerProxyOptions_To_v1_TappControllerProxyOptions(a.(*platform.TappControllerProxyOptions), b.(*TappControllerProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HostList)(nil), (*HostList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HostList_To_v1_HostList(a.(*platform.HostList), b.(*HostList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerSpec)(nil), (*platform.TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerSpec_To_platform_TappControllerSpec(a.(*TappControllerSpec), b.(*platform.TappControllerSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*HostSpec)(nil), (*platform.HostSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HostSpec_To_platform_HostSpec(a.(*HostSpec), b.(*platform.HostSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerSpec)(nil), (*TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerSpec_To_v1_TappControllerSpec(a.(*platform.TappControllerSpec), b.(*TappControllerSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HostSpec)(nil), (*HostSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HostSpec_To_v1_HostSpec(a.(*platform.HostSpec), b.(*HostSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerStatus)(nil), (*platform.TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerStatus_To_platform_TappControllerStatus(a.(*TappControllerStatus), b.(*platform.TappControllerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*HostStatus)(nil), (*platform.HostStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HostStatus_To_platform_HostStatus(a.(*HostStatus), b.(*platform.HostStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerStatus)(nil), (*TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerStatus_To_v1_TappControllerStatus(a.(*platform.TappControllerStatus), b.(*TappControllerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HostStatus)(nil), (*HostStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HostStatus_To_v1_HostStatus(a.(*platform.HostStatus), b.(*HostStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ThirdPartyHA)(nil), (*platform.ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ThirdPartyHA_To_platform_ThirdPartyHA(a.(*ThirdPartyHA), b.(*platform.ThirdPartyHA), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeVIPHA)(nil), (*platform.KubeVIPHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KubeVIPHA_To_platform_KubeVIPHA(a.(*KubeVIPHA), b.(*platform.KubeVIPHA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ThirdPartyHA)(nil), (*ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ThirdPartyHA_To_v1_ThirdPartyHA(a.(*platform.ThirdPartyHA), b.(*ThirdPartyHA), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.KubeVIPHA)(nil), (*KubeVIPHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_KubeVIPHA_To_v1_KubeVIPHA(a.(*platform.KubeVIPHA), b.(*KubeVIPHA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Upgrade)(nil), (*platform.Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Upgrade_To_platform_Upgrade(a.(*Upgrade), b.(*platform.Upgrade), scope)
+	if err := s.AddGeneratedConversionFunc((*LocalEtcd)(nil), (*platform.LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LocalEtcd_To_platform_LocalEtcd(a.(*LocalEtcd), b.(*platform.LocalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Upgrade)(nil), (*Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Upgrade_To_v1_Upgrade(a.(*platform.Upgrade), b.(*Upgrade), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LocalEtcd)(nil), (*LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LocalEtcd_To_v1_LocalEtcd(a.(*platform.LocalEtcd), b.(*LocalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*UpgradeStrategy)(nil), (*platform.UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(a.(*UpgradeStrategy), b.(*platform.UpgradeStrategy), scope)
+	if err := s.AddGeneratedConversionFunc((*LocalSnapshotTarget)(nil), (*platform.LocalSnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LocalSnapshotTarget_To_platform_LocalSnapshotTarget(a.(*LocalSnapshotTarget), b.(*platform.LocalSnapshotTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.UpgradeStrategy)(nil), (*UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_UpgradeStrategy_To_v1_UpgradeStrategy(a.(*platform.UpgradeStrategy), b.(*UpgradeStrategy), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LocalSnapshotTarget)(nil), (*LocalSnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LocalSnapshotTarget_To_v1_LocalSnapshotTarget(a.(*platform.LocalSnapshotTarget), b.(*LocalSnapshotTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*CSIProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_CSIProxyOptions(a.(*url.Values), b.(*CSIProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*platform.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Machine_To_platform_Machine(a.(*Machine), b.(*platform.Machine), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ClusterApplyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_ClusterApplyOptions(a.(*url.Values), b.(*ClusterApplyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.Machine)(nil), (*Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Machine_To_v1_Machine(a.(*platform.Machine), b.(*Machine), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ClusterGroupAPIResourceOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_ClusterGroupAPIResourceOptions(a.(*url.Values), b.(*ClusterGroupAPIResourceOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*MachineAddress)(nil), (*platform.MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineAddress_To_platform_MachineAddress(a.(*MachineAddress), b.(*platform.MachineAddress), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_CronHPAProxyOptions(a.(*url.Values), b.(*CronHPAProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.MachineAddress)(nil), (*MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineAddress_To_v1_MachineAddress(a.(*platform.MachineAddress), b.(*MachineAddress), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_ProxyOptions(a.(*url.Values), b.(*ProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*MachineCondition)(nil), (*platform.MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineCondition_To_platform_MachineCondition(a.(*MachineCondition), b.(*platform.MachineCondition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_TappControllerProxyOptions(a.(*url.Values), b.(*TappControllerProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.MachineCondition)(nil), (*MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineCondition_To_v1_MachineCondition(a.(*platform.MachineCondition), b.(*MachineCondition), scope)
 	}); err != nil {
 		return err
 	}
-	return nil
-}
-
-func autoConvert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
-	return nil
-}
-
-// Convert_v1_AddonSpec_To_platform_AddonSpec is an autogenerated conversion function.
-func Convert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
-	return autoConvert_v1_AddonSpec_To_platform_AddonSpec(in, out, s)
-}
-
-func autoConvert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
-	return nil
-}
-
-// Convert_platform_AddonSpec_To_v1_AddonSpec is an autogenerated conversion function.
-func Convert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	return autoConvert_platform_AddonSpec_To_v1_AddonSpec(in, out, s)
-}
-
-func autoConvert_v1_App_To_platform_App(in *App, out *platform.App, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Spec = in.Spec
-	return nil
-}
-
-// Convert_v1_App_To_platform_App is an autogenerated conversion function.
-func Convert_v1_App_To_platform_App(in *App, out *platform.App, s conversion.Scope) error {
-	return autoConvert_v1_App_To_platform_App(in, out, s)
-}
-
-func autoConvert_platform_App_To_v1_App(in *platform.App, out *App, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Spec = in.Spec
-	return nil
-}
-
-// Convert_platform_App_To_v1_App is an autogenerated conversion function.
-func Convert_platform_App_To_v1_App(in *platform.App, out *App, s conversion.Scope) error {
-	return autoConvert_platform_App_To_v1_App(in, out, s)
-}
-
-func autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
-	out.Builtin = (*platform.BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
-	out.External = (*platform.ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
-	return nil
-}
-
-// Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr is an autogenerated conversion function.
-func Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in, out, s)
-}
-
-func autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
-	out.Builtin = (*BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
-	out.External = (*ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
-	return nil
-}
-
-// Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr is an autogenerated conversion function.
-func Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in, out, s)
-}
-
-func autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
-	return nil
-}
-
-// Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in, out, s)
-}
-
-func autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
-	return nil
-}
-
-// Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in, out, s)
-}
-
-func autoConvert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := s.AddGeneratedConversionFunc((*MachineList)(nil), (*platform.MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineList_To_platform_MachineList(a.(*MachineList), b.(*platform.MachineList), scope)
+	}); err != nil {
 		return err
 	}
-	if err := Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+	if err := s.AddGeneratedConversionFunc((*platform.MachineList)(nil), (*MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineList_To_v1_MachineList(a.(*platform.MachineList), b.(*MachineList), scope)
+	}); err != nil {
 		return err
 	}
-	return nil
-}
-
-// Convert_v1_CSIOperator_To_platform_CSIOperator is an autogenerated conversion function.
-func Convert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperator_To_platform_CSIOperator(in, out, s)
-}
-
-func autoConvert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := s.AddGeneratedConversionFunc((*MachinePool)(nil), (*platform.MachinePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachinePool_To_platform_MachinePool(a.(*MachinePool), b.(*platform.MachinePool), scope)
+	}); err != nil {
 		return err
 	}
-	if err := Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+	if err := s.AddGeneratedConversionFunc((*platform.MachinePool)(nil), (*MachinePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachinePool_To_v1_MachinePool(a.(*platform.MachinePool), b.(*MachinePool), scope)
+	}); err != nil {
 		return err
 	}
-	return nil
-}
-
-// Convert_platform_CSIOperator_To_v1_CSIOperator is an autogenerated conversion function.
-func Convert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperator_To_v1_CSIOperator(in, out, s)
-}
-
-func autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
-	out.Version = in.Version
-	return nil
-}
-
-// Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature is an autogenerated conversion function.
-func Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in, out, s)
-}
-
-func autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
-	out.Version = in.Version
-	return nil
-}
-
-// Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature is an autogenerated conversion function.
-func Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in, out, s)
-}
-
-func autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.CSIOperator)(unsafe.Pointer(&in.Items))
-	return nil
-}
-
-// Convert_v1_CSIOperatorList_To_platform_CSIOperatorList is an autogenerated conversion function.
-func Convert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in, out, s)
-}
-
-func autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]CSIOperator)(unsafe.Pointer(&in.Items))
-	return nil
-}
-
-// Convert_platform_CSIOperatorList_To_v1_CSIOperatorList is an autogenerated conversion function.
-func Convert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in, out, s)
-}
-
-func autoConvert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in *CSIOperatorSpec, out *platform.CSIOperatorSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
-	return nil
-}
-
+	if err := s.AddGeneratedConversionFunc((*MachinePoolList)(nil), (*platform.MachinePoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachinePoolList_To_platform_MachinePoolList(a.(*MachinePoolList), b.(*platform.MachinePoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachinePoolList)(nil), (*MachinePoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachinePoolList_To_v1_MachinePoolList(a.(*platform.MachinePoolList), b.(*MachinePoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachinePoolSpec)(nil), (*platform.MachinePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachinePoolSpec_To_platform_MachinePoolSpec(a.(*MachinePoolSpec), b.(*platform.MachinePoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachinePoolSpec)(nil), (*MachinePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachinePoolSpec_To_v1_MachinePoolSpec(a.(*platform.MachinePoolSpec), b.(*MachinePoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachinePoolStatus)(nil), (*platform.MachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachinePoolStatus_To_platform_MachinePoolStatus(a.(*MachinePoolStatus), b.(*platform.MachinePoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachinePoolStatus)(nil), (*MachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachinePoolStatus_To_v1_MachinePoolStatus(a.(*platform.MachinePoolStatus), b.(*MachinePoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSpec)(nil), (*platform.MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineSpec_To_platform_MachineSpec(a.(*MachineSpec), b.(*platform.MachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineSpec)(nil), (*MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineSpec_To_v1_MachineSpec(a.(*platform.MachineSpec), b.(*MachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineStatus)(nil), (*platform.MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineStatus_To_platform_MachineStatus(a.(*MachineStatus), b.(*platform.MachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineStatus)(nil), (*MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineStatus_To_v1_MachineStatus(a.(*platform.MachineStatus), b.(*MachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSystemInfo)(nil), (*platform.MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(a.(*MachineSystemInfo), b.(*platform.MachineSystemInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineSystemInfo)(nil), (*MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(a.(*platform.MachineSystemInfo), b.(*MachineSystemInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineTemplateSpec)(nil), (*platform.MachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineTemplateSpec_To_platform_MachineTemplateSpec(a.(*MachineTemplateSpec), b.(*platform.MachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineTemplateSpec)(nil), (*MachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineTemplateSpec_To_v1_MachineTemplateSpec(a.(*platform.MachineTemplateSpec), b.(*MachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineUpgradeStatus)(nil), (*platform.MachineUpgradeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineUpgradeStatus_To_platform_MachineUpgradeStatus(a.(*MachineUpgradeStatus), b.(*platform.MachineUpgradeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineUpgradeStatus)(nil), (*MachineUpgradeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineUpgradeStatus_To_v1_MachineUpgradeStatus(a.(*platform.MachineUpgradeStatus), b.(*MachineUpgradeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLB)(nil), (*platform.MetalLB)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLB_To_platform_MetalLB(a.(*MetalLB), b.(*platform.MetalLB), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLB)(nil), (*MetalLB)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLB_To_v1_MetalLB(a.(*platform.MetalLB), b.(*MetalLB), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBAddressPool)(nil), (*platform.MetalLBAddressPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(a.(*MetalLBAddressPool), b.(*platform.MetalLBAddressPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLBAddressPool)(nil), (*MetalLBAddressPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(a.(*platform.MetalLBAddressPool), b.(*MetalLBAddressPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterContainerOverride)(nil), (*platform.MultiClusterContainerOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterContainerOverride_To_platform_MultiClusterContainerOverride(a.(*MultiClusterContainerOverride), b.(*platform.MultiClusterContainerOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterContainerOverride)(nil), (*MultiClusterContainerOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterContainerOverride_To_v1_MultiClusterContainerOverride(a.(*platform.MultiClusterContainerOverride), b.(*MultiClusterContainerOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterDeployment)(nil), (*platform.MultiClusterDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterDeployment_To_platform_MultiClusterDeployment(a.(*MultiClusterDeployment), b.(*platform.MultiClusterDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterDeployment)(nil), (*MultiClusterDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterDeployment_To_v1_MultiClusterDeployment(a.(*platform.MultiClusterDeployment), b.(*MultiClusterDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterDeploymentClusterStatus)(nil), (*platform.MultiClusterDeploymentClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterDeploymentClusterStatus_To_platform_MultiClusterDeploymentClusterStatus(a.(*MultiClusterDeploymentClusterStatus), b.(*platform.MultiClusterDeploymentClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterDeploymentClusterStatus)(nil), (*MultiClusterDeploymentClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterDeploymentClusterStatus_To_v1_MultiClusterDeploymentClusterStatus(a.(*platform.MultiClusterDeploymentClusterStatus), b.(*MultiClusterDeploymentClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterDeploymentList)(nil), (*platform.MultiClusterDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterDeploymentList_To_platform_MultiClusterDeploymentList(a.(*MultiClusterDeploymentList), b.(*platform.MultiClusterDeploymentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterDeploymentList)(nil), (*MultiClusterDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterDeploymentList_To_v1_MultiClusterDeploymentList(a.(*platform.MultiClusterDeploymentList), b.(*MultiClusterDeploymentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterDeploymentSpec)(nil), (*platform.MultiClusterDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterDeploymentSpec_To_platform_MultiClusterDeploymentSpec(a.(*MultiClusterDeploymentSpec), b.(*platform.MultiClusterDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterDeploymentSpec)(nil), (*MultiClusterDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterDeploymentSpec_To_v1_MultiClusterDeploymentSpec(a.(*platform.MultiClusterDeploymentSpec), b.(*MultiClusterDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterDeploymentStatus)(nil), (*platform.MultiClusterDeploymentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterDeploymentStatus_To_platform_MultiClusterDeploymentStatus(a.(*MultiClusterDeploymentStatus), b.(*platform.MultiClusterDeploymentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterDeploymentStatus)(nil), (*MultiClusterDeploymentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterDeploymentStatus_To_v1_MultiClusterDeploymentStatus(a.(*platform.MultiClusterDeploymentStatus), b.(*MultiClusterDeploymentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterOverride)(nil), (*platform.MultiClusterOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterOverride_To_platform_MultiClusterOverride(a.(*MultiClusterOverride), b.(*platform.MultiClusterOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterOverride)(nil), (*MultiClusterOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterOverride_To_v1_MultiClusterOverride(a.(*platform.MultiClusterOverride), b.(*MultiClusterOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterPlacement)(nil), (*platform.MultiClusterPlacement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterPlacement_To_platform_MultiClusterPlacement(a.(*MultiClusterPlacement), b.(*platform.MultiClusterPlacement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterPlacement)(nil), (*MultiClusterPlacement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterPlacement_To_v1_MultiClusterPlacement(a.(*platform.MultiClusterPlacement), b.(*MultiClusterPlacement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiClusterWeight)(nil), (*platform.MultiClusterWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiClusterWeight_To_platform_MultiClusterWeight(a.(*MultiClusterWeight), b.(*platform.MultiClusterWeight), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultiClusterWeight)(nil), (*MultiClusterWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultiClusterWeight_To_v1_MultiClusterWeight(a.(*platform.MultiClusterWeight), b.(*MultiClusterWeight), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentBackEnd)(nil), (*platform.PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentBackEnd_To_platform_PersistentBackEnd(a.(*PersistentBackEnd), b.(*platform.PersistentBackEnd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentBackEnd)(nil), (*PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentBackEnd_To_v1_PersistentBackEnd(a.(*platform.PersistentBackEnd), b.(*PersistentBackEnd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEvent)(nil), (*platform.PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEvent_To_platform_PersistentEvent(a.(*PersistentEvent), b.(*platform.PersistentEvent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEvent)(nil), (*PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEvent_To_v1_PersistentEvent(a.(*platform.PersistentEvent), b.(*PersistentEvent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEventList)(nil), (*platform.PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEventList_To_platform_PersistentEventList(a.(*PersistentEventList), b.(*platform.PersistentEventList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventList)(nil), (*PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEventList_To_v1_PersistentEventList(a.(*platform.PersistentEventList), b.(*PersistentEventList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEventSpec)(nil), (*platform.PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEventSpec_To_platform_PersistentEventSpec(a.(*PersistentEventSpec), b.(*platform.PersistentEventSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventSpec)(nil), (*PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEventSpec_To_v1_PersistentEventSpec(a.(*platform.PersistentEventSpec), b.(*PersistentEventSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEventStatus)(nil), (*platform.PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(a.(*PersistentEventStatus), b.(*platform.PersistentEventStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventStatus)(nil), (*PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(a.(*platform.PersistentEventStatus), b.(*PersistentEventStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProxyOptions)(nil), (*platform.ProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProxyOptions_To_platform_ProxyOptions(a.(*ProxyOptions), b.(*platform.ProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ProxyOptions)(nil), (*ProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ProxyOptions_To_v1_ProxyOptions(a.(*platform.ProxyOptions), b.(*ProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Registry)(nil), (*platform.Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Registry_To_platform_Registry(a.(*Registry), b.(*platform.Registry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Registry)(nil), (*Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Registry_To_v1_Registry(a.(*platform.Registry), b.(*Registry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryList)(nil), (*platform.RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RegistryList_To_platform_RegistryList(a.(*RegistryList), b.(*platform.RegistryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RegistryList)(nil), (*RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RegistryList_To_v1_RegistryList(a.(*platform.RegistryList), b.(*RegistryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryMirror)(nil), (*platform.RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RegistryMirror_To_platform_RegistryMirror(a.(*RegistryMirror), b.(*platform.RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RegistryMirror)(nil), (*RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RegistryMirror_To_v1_RegistryMirror(a.(*platform.RegistryMirror), b.(*RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistrySnapshotTarget)(nil), (*platform.RegistrySnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RegistrySnapshotTarget_To_platform_RegistrySnapshotTarget(a.(*RegistrySnapshotTarget), b.(*platform.RegistrySnapshotTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RegistrySnapshotTarget)(nil), (*RegistrySnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RegistrySnapshotTarget_To_v1_RegistrySnapshotTarget(a.(*platform.RegistrySnapshotTarget), b.(*RegistrySnapshotTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistrySpec)(nil), (*platform.RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RegistrySpec_To_platform_RegistrySpec(a.(*RegistrySpec), b.(*platform.RegistrySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RegistrySpec)(nil), (*RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RegistrySpec_To_v1_RegistrySpec(a.(*platform.RegistrySpec), b.(*RegistrySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceRequirements)(nil), (*platform.ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ResourceRequirements_To_platform_ResourceRequirements(a.(*ResourceRequirements), b.(*platform.ResourceRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ResourceRequirements)(nil), (*ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ResourceRequirements_To_v1_ResourceRequirements(a.(*platform.ResourceRequirements), b.(*ResourceRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RuntimeClass)(nil), (*platform.RuntimeClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RuntimeClass_To_platform_RuntimeClass(a.(*RuntimeClass), b.(*platform.RuntimeClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RuntimeClass)(nil), (*RuntimeClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RuntimeClass_To_v1_RuntimeClass(a.(*platform.RuntimeClass), b.(*RuntimeClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*S3SnapshotTarget)(nil), (*platform.S3SnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_S3SnapshotTarget_To_platform_S3SnapshotTarget(a.(*S3SnapshotTarget), b.(*platform.S3SnapshotTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.S3SnapshotTarget)(nil), (*S3SnapshotTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_S3SnapshotTarget_To_v1_S3SnapshotTarget(a.(*platform.S3SnapshotTarget), b.(*S3SnapshotTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHCredential)(nil), (*platform.SSHCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCredential_To_platform_SSHCredential(a.(*SSHCredential), b.(*platform.SSHCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.SSHCredential)(nil), (*SSHCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_SSHCredential_To_v1_SSHCredential(a.(*platform.SSHCredential), b.(*SSHCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHCredentialList)(nil), (*platform.SSHCredentialList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCredentialList_To_platform_SSHCredentialList(a.(*SSHCredentialList), b.(*platform.SSHCredentialList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.SSHCredentialList)(nil), (*SSHCredentialList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_SSHCredentialList_To_v1_SSHCredentialList(a.(*platform.SSHCredentialList), b.(*SSHCredentialList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHCredentialSpec)(nil), (*platform.SSHCredentialSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCredentialSpec_To_platform_SSHCredentialSpec(a.(*SSHCredentialSpec), b.(*platform.SSHCredentialSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.SSHCredentialSpec)(nil), (*SSHCredentialSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_SSHCredentialSpec_To_v1_SSHCredentialSpec(a.(*platform.SSHCredentialSpec), b.(*SSHCredentialSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndCLS)(nil), (*platform.StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndCLS_To_platform_StorageBackEndCLS(a.(*StorageBackEndCLS), b.(*platform.StorageBackEndCLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndCLS)(nil), (*StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndCLS_To_v1_StorageBackEndCLS(a.(*platform.StorageBackEndCLS), b.(*StorageBackEndCLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndES)(nil), (*platform.StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndES_To_platform_StorageBackEndES(a.(*StorageBackEndES), b.(*platform.StorageBackEndES), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndES)(nil), (*StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndES_To_v1_StorageBackEndES(a.(*platform.StorageBackEndES), b.(*StorageBackEndES), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TKEHA)(nil), (*platform.TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TKEHA_To_platform_TKEHA(a.(*TKEHA), b.(*platform.TKEHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TKEHA)(nil), (*TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TKEHA_To_v1_TKEHA(a.(*platform.TKEHA), b.(*TKEHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappController)(nil), (*platform.TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappController_To_platform_TappController(a.(*TappController), b.(*platform.TappController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappController)(nil), (*TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappController_To_v1_TappController(a.(*platform.TappController), b.(*TappController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerList)(nil), (*platform.TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerList_To_platform_TappControllerList(a.(*TappControllerList), b.(*platform.TappControllerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerList)(nil), (*TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerList_To_v1_TappControllerList(a.(*platform.TappControllerList), b.(*TappControllerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerProxyOptions)(nil), (*platform.TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerProxyOptions_To_platform_TappControllerProxyOptions(a.(*TappControllerProxyOptions), b.(*platform.TappControllerProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerProxyOptions)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerProxyOptions_To_v1_TappControllerProxyOptions(a.(*platform.TappControllerProxyOptions), b.(*TappControllerProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerSpec)(nil), (*platform.TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerSpec_To_platform_TappControllerSpec(a.(*TappControllerSpec), b.(*platform.TappControllerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerSpec)(nil), (*TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerSpec_To_v1_TappControllerSpec(a.(*platform.TappControllerSpec), b.(*TappControllerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerStatus)(nil), (*platform.TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerStatus_To_platform_TappControllerStatus(a.(*TappControllerStatus), b.(*platform.TappControllerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerStatus)(nil), (*TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerStatus_To_v1_TappControllerStatus(a.(*platform.TappControllerStatus), b.(*TappControllerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ThirdPartyHA)(nil), (*platform.ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ThirdPartyHA_To_platform_ThirdPartyHA(a.(*ThirdPartyHA), b.(*platform.ThirdPartyHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ThirdPartyHA)(nil), (*ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ThirdPartyHA_To_v1_ThirdPartyHA(a.(*platform.ThirdPartyHA), b.(*ThirdPartyHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Upgrade)(nil), (*platform.Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Upgrade_To_platform_Upgrade(a.(*Upgrade), b.(*platform.Upgrade), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Upgrade)(nil), (*Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Upgrade_To_v1_Upgrade(a.(*platform.Upgrade), b.(*Upgrade), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UpgradeStrategy)(nil), (*platform.UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(a.(*UpgradeStrategy), b.(*platform.UpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.UpgradeStrategy)(nil), (*UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_UpgradeStrategy_To_v1_UpgradeStrategy(a.(*platform.UpgradeStrategy), b.(*UpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*CSIProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_CSIProxyOptions(a.(*url.Values), b.(*CSIProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ClusterApplyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_ClusterApplyOptions(a.(*url.Values), b.(*ClusterApplyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ClusterGroupAPIResourceOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_ClusterGroupAPIResourceOptions(a.(*url.Values), b.(*ClusterGroupAPIResourceOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_CronHPAProxyOptions(a.(*url.Values), b.(*CronHPAProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*EtcdSnapshotRestoreOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_EtcdSnapshotRestoreOptions(a.(*url.Values), b.(*EtcdSnapshotRestoreOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_ProxyOptions(a.(*url.Values), b.(*ProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_TappControllerProxyOptions(a.(*url.Values), b.(*TappControllerProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1_AddonSpec_To_platform_AddonSpec is an autogenerated conversion function.
+func Convert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
+	return autoConvert_v1_AddonSpec_To_platform_AddonSpec(in, out, s)
+}
+
+func autoConvert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_platform_AddonSpec_To_v1_AddonSpec is an autogenerated conversion function.
+func Convert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	return autoConvert_platform_AddonSpec_To_v1_AddonSpec(in, out, s)
+}
+
+func autoConvert_v1_App_To_platform_App(in *App, out *platform.App, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1_App_To_platform_App is an autogenerated conversion function.
+func Convert_v1_App_To_platform_App(in *App, out *platform.App, s conversion.Scope) error {
+	return autoConvert_v1_App_To_platform_App(in, out, s)
+}
+
+func autoConvert_platform_App_To_v1_App(in *platform.App, out *App, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_platform_App_To_v1_App is an autogenerated conversion function.
+func Convert_platform_App_To_v1_App(in *platform.App, out *App, s conversion.Scope) error {
+	return autoConvert_platform_App_To_v1_App(in, out, s)
+}
+
+func autoConvert_v1_AuditGroupResources_To_platform_AuditGroupResources(in *AuditGroupResources, out *platform.AuditGroupResources, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.ResourceNames = *(*[]string)(unsafe.Pointer(&in.ResourceNames))
+	return nil
+}
+
+// Convert_v1_AuditGroupResources_To_platform_AuditGroupResources is an autogenerated conversion function.
+func Convert_v1_AuditGroupResources_To_platform_AuditGroupResources(in *AuditGroupResources, out *platform.AuditGroupResources, s conversion.Scope) error {
+	return autoConvert_v1_AuditGroupResources_To_platform_AuditGroupResources(in, out, s)
+}
+
+func autoConvert_platform_AuditGroupResources_To_v1_AuditGroupResources(in *platform.AuditGroupResources, out *AuditGroupResources, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.ResourceNames = *(*[]string)(unsafe.Pointer(&in.ResourceNames))
+	return nil
+}
+
+// Convert_platform_AuditGroupResources_To_v1_AuditGroupResources is an autogenerated conversion function.
+func Convert_platform_AuditGroupResources_To_v1_AuditGroupResources(in *platform.AuditGroupResources, out *AuditGroupResources, s conversion.Scope) error {
+	return autoConvert_platform_AuditGroupResources_To_v1_AuditGroupResources(in, out, s)
+}
+
+func autoConvert_v1_AuditPolicy_To_platform_AuditPolicy(in *AuditPolicy, out *platform.AuditPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_AuditPolicySpec_To_platform_AuditPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_AuditPolicyStatus_To_platform_AuditPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_AuditPolicy_To_platform_AuditPolicy is an autogenerated conversion function.
+func Convert_v1_AuditPolicy_To_platform_AuditPolicy(in *AuditPolicy, out *platform.AuditPolicy, s conversion.Scope) error {
+	return autoConvert_v1_AuditPolicy_To_platform_AuditPolicy(in, out, s)
+}
+
+func autoConvert_platform_AuditPolicy_To_v1_AuditPolicy(in *platform.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_AuditPolicySpec_To_v1_AuditPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_AuditPolicyStatus_To_v1_AuditPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_AuditPolicy_To_v1_AuditPolicy is an autogenerated conversion function.
+func Convert_platform_AuditPolicy_To_v1_AuditPolicy(in *platform.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
+	return autoConvert_platform_AuditPolicy_To_v1_AuditPolicy(in, out, s)
+}
+
+func autoConvert_v1_AuditPolicyList_To_platform_AuditPolicyList(in *AuditPolicyList, out *platform.AuditPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.AuditPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_AuditPolicyList_To_platform_AuditPolicyList is an autogenerated conversion function.
+func Convert_v1_AuditPolicyList_To_platform_AuditPolicyList(in *AuditPolicyList, out *platform.AuditPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_AuditPolicyList_To_platform_AuditPolicyList(in, out, s)
+}
+
+func autoConvert_platform_AuditPolicyList_To_v1_AuditPolicyList(in *platform.AuditPolicyList, out *AuditPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AuditPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_AuditPolicyList_To_v1_AuditPolicyList is an autogenerated conversion function.
+func Convert_platform_AuditPolicyList_To_v1_AuditPolicyList(in *platform.AuditPolicyList, out *AuditPolicyList, s conversion.Scope) error {
+	return autoConvert_platform_AuditPolicyList_To_v1_AuditPolicyList(in, out, s)
+}
+
+func autoConvert_v1_AuditPolicyRule_To_platform_AuditPolicyRule(in *AuditPolicyRule, out *platform.AuditPolicyRule, s conversion.Scope) error {
+	out.Level = platform.AuditLevel(in.Level)
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.UserGroups = *(*[]string)(unsafe.Pointer(&in.UserGroups))
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.Resources = *(*[]platform.AuditGroupResources)(unsafe.Pointer(&in.Resources))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NonResourceURLs = *(*[]string)(unsafe.Pointer(&in.NonResourceURLs))
+	out.OmitStages = *(*[]platform.AuditStage)(unsafe.Pointer(&in.OmitStages))
+	return nil
+}
+
+// Convert_v1_AuditPolicyRule_To_platform_AuditPolicyRule is an autogenerated conversion function.
+func Convert_v1_AuditPolicyRule_To_platform_AuditPolicyRule(in *AuditPolicyRule, out *platform.AuditPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1_AuditPolicyRule_To_platform_AuditPolicyRule(in, out, s)
+}
+
+func autoConvert_platform_AuditPolicyRule_To_v1_AuditPolicyRule(in *platform.AuditPolicyRule, out *AuditPolicyRule, s conversion.Scope) error {
+	out.Level = AuditLevel(in.Level)
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.UserGroups = *(*[]string)(unsafe.Pointer(&in.UserGroups))
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.Resources = *(*[]AuditGroupResources)(unsafe.Pointer(&in.Resources))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NonResourceURLs = *(*[]string)(unsafe.Pointer(&in.NonResourceURLs))
+	out.OmitStages = *(*[]AuditStage)(unsafe.Pointer(&in.OmitStages))
+	return nil
+}
+
+// Convert_platform_AuditPolicyRule_To_v1_AuditPolicyRule is an autogenerated conversion function.
+func Convert_platform_AuditPolicyRule_To_v1_AuditPolicyRule(in *platform.AuditPolicyRule, out *AuditPolicyRule, s conversion.Scope) error {
+	return autoConvert_platform_AuditPolicyRule_To_v1_AuditPolicyRule(in, out, s)
+}
+
+func autoConvert_v1_AuditPolicySpec_To_platform_AuditPolicySpec(in *AuditPolicySpec, out *platform.AuditPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.OmitStages = *(*[]platform.AuditStage)(unsafe.Pointer(&in.OmitStages))
+	out.Rules = *(*[]platform.AuditPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_AuditPolicySpec_To_platform_AuditPolicySpec is an autogenerated conversion function.
+func Convert_v1_AuditPolicySpec_To_platform_AuditPolicySpec(in *AuditPolicySpec, out *platform.AuditPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_AuditPolicySpec_To_platform_AuditPolicySpec(in, out, s)
+}
+
+func autoConvert_platform_AuditPolicySpec_To_v1_AuditPolicySpec(in *platform.AuditPolicySpec, out *AuditPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.OmitStages = *(*[]AuditStage)(unsafe.Pointer(&in.OmitStages))
+	out.Rules = *(*[]AuditPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_platform_AuditPolicySpec_To_v1_AuditPolicySpec is an autogenerated conversion function.
+func Convert_platform_AuditPolicySpec_To_v1_AuditPolicySpec(in *platform.AuditPolicySpec, out *AuditPolicySpec, s conversion.Scope) error {
+	return autoConvert_platform_AuditPolicySpec_To_v1_AuditPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_AuditPolicyStatus_To_platform_AuditPolicyStatus(in *AuditPolicyStatus, out *platform.AuditPolicyStatus, s conversion.Scope) error {
+	out.Phase = platform.AuditPolicyPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1_AuditPolicyStatus_To_platform_AuditPolicyStatus is an autogenerated conversion function.
+func Convert_v1_AuditPolicyStatus_To_platform_AuditPolicyStatus(in *AuditPolicyStatus, out *platform.AuditPolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1_AuditPolicyStatus_To_platform_AuditPolicyStatus(in, out, s)
+}
+
+func autoConvert_platform_AuditPolicyStatus_To_v1_AuditPolicyStatus(in *platform.AuditPolicyStatus, out *AuditPolicyStatus, s conversion.Scope) error {
+	out.Phase = AuditPolicyPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_platform_AuditPolicyStatus_To_v1_AuditPolicyStatus is an autogenerated conversion function.
+func Convert_platform_AuditPolicyStatus_To_v1_AuditPolicyStatus(in *platform.AuditPolicyStatus, out *AuditPolicyStatus, s conversion.Scope) error {
+	return autoConvert_platform_AuditPolicyStatus_To_v1_AuditPolicyStatus(in, out, s)
+}
+
+func autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
+	out.Builtin = (*platform.BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
+	out.External = (*platform.ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr is an autogenerated conversion function.
+func Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
+	out.Builtin = (*BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
+	out.External = (*ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr is an autogenerated conversion function.
+func Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_v1_AutoscalingNodeGroup_To_platform_AutoscalingNodeGroup(in *AutoscalingNodeGroup, out *platform.AutoscalingNodeGroup, s conversion.Scope) error {
+	out.MachinePool = in.MachinePool
+	out.MinReplicas = in.MinReplicas
+	out.MaxReplicas = in.MaxReplicas
+	return nil
+}
+
+// Convert_v1_AutoscalingNodeGroup_To_platform_AutoscalingNodeGroup is an autogenerated conversion function.
+func Convert_v1_AutoscalingNodeGroup_To_platform_AutoscalingNodeGroup(in *AutoscalingNodeGroup, out *platform.AutoscalingNodeGroup, s conversion.Scope) error {
+	return autoConvert_v1_AutoscalingNodeGroup_To_platform_AutoscalingNodeGroup(in, out, s)
+}
+
+func autoConvert_platform_AutoscalingNodeGroup_To_v1_AutoscalingNodeGroup(in *platform.AutoscalingNodeGroup, out *AutoscalingNodeGroup, s conversion.Scope) error {
+	out.MachinePool = in.MachinePool
+	out.MinReplicas = in.MinReplicas
+	out.MaxReplicas = in.MaxReplicas
+	return nil
+}
+
+// Convert_platform_AutoscalingNodeGroup_To_v1_AutoscalingNodeGroup is an autogenerated conversion function.
+func Convert_platform_AutoscalingNodeGroup_To_v1_AutoscalingNodeGroup(in *platform.AutoscalingNodeGroup, out *AutoscalingNodeGroup, s conversion.Scope) error {
+	return autoConvert_platform_AutoscalingNodeGroup_To_v1_AutoscalingNodeGroup(in, out, s)
+}
+
+func autoConvert_v1_BGPConfig_To_platform_BGPConfig(in *BGPConfig, out *platform.BGPConfig, s conversion.Scope) error {
+	out.ASN = in.ASN
+	out.Peers = *(*[]platform.BGPPeer)(unsafe.Pointer(&in.Peers))
+	return nil
+}
+
+// Convert_v1_BGPConfig_To_platform_BGPConfig is an autogenerated conversion function.
+func Convert_v1_BGPConfig_To_platform_BGPConfig(in *BGPConfig, out *platform.BGPConfig, s conversion.Scope) error {
+	return autoConvert_v1_BGPConfig_To_platform_BGPConfig(in, out, s)
+}
+
+func autoConvert_platform_BGPConfig_To_v1_BGPConfig(in *platform.BGPConfig, out *BGPConfig, s conversion.Scope) error {
+	out.ASN = in.ASN
+	out.Peers = *(*[]BGPPeer)(unsafe.Pointer(&in.Peers))
+	return nil
+}
+
+// Convert_platform_BGPConfig_To_v1_BGPConfig is an autogenerated conversion function.
+func Convert_platform_BGPConfig_To_v1_BGPConfig(in *platform.BGPConfig, out *BGPConfig, s conversion.Scope) error {
+	return autoConvert_platform_BGPConfig_To_v1_BGPConfig(in, out, s)
+}
+
+func autoConvert_v1_BGPPeer_To_platform_BGPPeer(in *BGPPeer, out *platform.BGPPeer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.ASN = in.ASN
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_v1_BGPPeer_To_platform_BGPPeer is an autogenerated conversion function.
+func Convert_v1_BGPPeer_To_platform_BGPPeer(in *BGPPeer, out *platform.BGPPeer, s conversion.Scope) error {
+	return autoConvert_v1_BGPPeer_To_platform_BGPPeer(in, out, s)
+}
+
+func autoConvert_platform_BGPPeer_To_v1_BGPPeer(in *platform.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.ASN = in.ASN
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_platform_BGPPeer_To_v1_BGPPeer is an autogenerated conversion function.
+func Convert_platform_BGPPeer_To_v1_BGPPeer(in *platform.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	return autoConvert_platform_BGPPeer_To_v1_BGPPeer(in, out, s)
+}
+
+func autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CSIOperator_To_platform_CSIOperator is an autogenerated conversion function.
+func Convert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperator_To_platform_CSIOperator(in, out, s)
+}
+
+func autoConvert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_CSIOperator_To_v1_CSIOperator is an autogenerated conversion function.
+func Convert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperator_To_v1_CSIOperator(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature is an autogenerated conversion function.
+func Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in, out, s)
+}
+
+func autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature is an autogenerated conversion function.
+func Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.CSIOperator)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CSIOperatorList_To_platform_CSIOperatorList is an autogenerated conversion function.
+func Convert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in, out, s)
+}
+
+func autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]CSIOperator)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_CSIOperatorList_To_v1_CSIOperatorList is an autogenerated conversion function.
+func Convert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in *CSIOperatorSpec, out *platform.CSIOperatorSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	return nil
+}
+
 // Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec is an autogenerated conversion function.
 func Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in *CSIOperatorSpec, out *platform.CSIOperatorSpec, s conversion.Scope) error {
 	return autoConvert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in, out, s)
 }
 
-func autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
+func autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec is an autogenerated conversion function.
+func Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.StorageVendorVersion = in.StorageVendorVersion
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	return nil
+}
+
+// Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus is an autogenerated conversion function.
+func Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in, out, s)
+}
+
+func autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.StorageVendorVersion = in.StorageVendorVersion
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	return nil
+}
+
+// Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus is an autogenerated conversion function.
+func Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in, out, s)
+}
+
+func autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions is an autogenerated conversion function.
+func Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in, out, s)
+}
+
+func autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions is an autogenerated conversion function.
+func Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_CSIProxyOptions(in *url.Values, out *CSIProxyOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["namespace"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Namespace, s); err != nil {
+			return err
+		}
+	} else {
+		out.Namespace = ""
+	}
+	if values, ok := map[string][]string(*in)["name"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Name, s); err != nil {
+			return err
+		}
+	} else {
+		out.Name = ""
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_CSIProxyOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_CSIProxyOptions(in *url.Values, out *CSIProxyOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_CSIProxyOptions(in, out, s)
+}
+
+func autoConvert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterSpec_To_platform_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterStatus_To_platform_ClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Cluster_To_platform_Cluster is an autogenerated conversion function.
+func Convert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
+	return autoConvert_v1_Cluster_To_platform_Cluster(in, out, s)
+}
+
+func autoConvert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_ClusterSpec_To_v1_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterStatus_To_v1_ClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_Cluster_To_v1_Cluster is an autogenerated conversion function.
+func Convert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
+	return autoConvert_platform_Cluster_To_v1_Cluster(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterAddon_To_platform_ClusterAddon is an autogenerated conversion function.
+func Convert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_ClusterAddon_To_v1_ClusterAddon is an autogenerated conversion function.
+func Convert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterAddon)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterAddonList_To_platform_ClusterAddonList is an autogenerated conversion function.
+func Convert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterAddon)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterAddonList_To_v1_ClusterAddonList is an autogenerated conversion function.
+func Convert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Level = platform.AddonLevel(in.Level)
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec is an autogenerated conversion function.
+func Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Level = AddonLevel(in.Level)
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec is an autogenerated conversion function.
+func Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = in.Phase
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus is an autogenerated conversion function.
+func Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = in.Phase
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus is an autogenerated conversion function.
+func Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Type = in.Type
+	out.Level = platform.AddonLevel(in.Level)
+	out.LatestVersion = in.LatestVersion
+	out.Description = in.Description
+	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+	return nil
+}
+
+// Convert_v1_ClusterAddonType_To_platform_ClusterAddonType is an autogenerated conversion function.
+func Convert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Type = in.Type
+	out.Level = AddonLevel(in.Level)
+	out.LatestVersion = in.LatestVersion
+	out.Description = in.Description
+	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+	return nil
+}
+
+// Convert_platform_ClusterAddonType_To_v1_ClusterAddonType is an autogenerated conversion function.
+func Convert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterAddonType)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList is an autogenerated conversion function.
+func Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterAddonType)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList is an autogenerated conversion function.
+func Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
+	out.Type = platform.AddressType(in.Type)
+	out.Host = in.Host
+	out.Port = in.Port
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1_ClusterAddress_To_platform_ClusterAddress is an autogenerated conversion function.
+func Convert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
+	out.Type = AddressType(in.Type)
+	out.Host = in.Host
+	out.Port = in.Port
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_platform_ClusterAddress_To_v1_ClusterAddress is an autogenerated conversion function.
+func Convert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in, out, s)
+}
+
+func autoConvert_v1_ClusterApplyObjectResult_To_platform_ClusterApplyObjectResult(in *ClusterApplyObjectResult, out *platform.ClusterApplyObjectResult, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Action = platform.ClusterApplyAction(in.Action)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ClusterApplyObjectResult_To_platform_ClusterApplyObjectResult is an autogenerated conversion function.
+func Convert_v1_ClusterApplyObjectResult_To_platform_ClusterApplyObjectResult(in *ClusterApplyObjectResult, out *platform.ClusterApplyObjectResult, s conversion.Scope) error {
+	return autoConvert_v1_ClusterApplyObjectResult_To_platform_ClusterApplyObjectResult(in, out, s)
+}
+
+func autoConvert_platform_ClusterApplyObjectResult_To_v1_ClusterApplyObjectResult(in *platform.ClusterApplyObjectResult, out *ClusterApplyObjectResult, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Action = ClusterApplyAction(in.Action)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_platform_ClusterApplyObjectResult_To_v1_ClusterApplyObjectResult is an autogenerated conversion function.
+func Convert_platform_ClusterApplyObjectResult_To_v1_ClusterApplyObjectResult(in *platform.ClusterApplyObjectResult, out *ClusterApplyObjectResult, s conversion.Scope) error {
+	return autoConvert_platform_ClusterApplyObjectResult_To_v1_ClusterApplyObjectResult(in, out, s)
+}
+
+func autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
+	out.NotUpdate = in.NotUpdate
+	out.ServerSide = in.ServerSide
+	out.FieldManager = in.FieldManager
+	out.Force = in.Force
+	out.ApplySet = in.ApplySet
+	out.Prune = in.Prune
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions is an autogenerated conversion function.
+func Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
+	return autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in, out, s)
+}
+
+func autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
+	out.NotUpdate = in.NotUpdate
+	out.ServerSide = in.ServerSide
+	out.FieldManager = in.FieldManager
+	out.Force = in.Force
+	out.ApplySet = in.ApplySet
+	out.Prune = in.Prune
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions is an autogenerated conversion function.
+func Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
+	return autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_ClusterApplyOptions(in *url.Values, out *ClusterApplyOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["notUpdate"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.NotUpdate, s); err != nil {
+			return err
+		}
+	} else {
+		out.NotUpdate = false
+	}
+	if values, ok := map[string][]string(*in)["serverSide"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.ServerSide, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServerSide = false
+	}
+	if values, ok := map[string][]string(*in)["fieldManager"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.FieldManager, s); err != nil {
+			return err
+		}
+	} else {
+		out.FieldManager = ""
+	}
+	if values, ok := map[string][]string(*in)["force"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Force, s); err != nil {
+			return err
+		}
+	} else {
+		out.Force = false
+	}
+	if values, ok := map[string][]string(*in)["applySet"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.ApplySet, s); err != nil {
+			return err
+		}
+	} else {
+		out.ApplySet = ""
+	}
+	if values, ok := map[string][]string(*in)["prune"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Prune, s); err != nil {
+			return err
+		}
+	} else {
+		out.Prune = false
+	}
+	if values, ok := map[string][]string(*in)["dryRun"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.DryRun, s); err != nil {
+			return err
+		}
+	} else {
+		out.DryRun = false
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_ClusterApplyOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_ClusterApplyOptions(in *url.Values, out *ClusterApplyOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_ClusterApplyOptions(in, out, s)
+}
+
+func autoConvert_v1_ClusterApplyResult_To_platform_ClusterApplyResult(in *ClusterApplyResult, out *platform.ClusterApplyResult, s conversion.Scope) error {
+	out.DryRun = in.DryRun
+	out.Items = *(*[]platform.ClusterApplyObjectResult)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterApplyResult_To_platform_ClusterApplyResult is an autogenerated conversion function.
+func Convert_v1_ClusterApplyResult_To_platform_ClusterApplyResult(in *ClusterApplyResult, out *platform.ClusterApplyResult, s conversion.Scope) error {
+	return autoConvert_v1_ClusterApplyResult_To_platform_ClusterApplyResult(in, out, s)
+}
+
+func autoConvert_platform_ClusterApplyResult_To_v1_ClusterApplyResult(in *platform.ClusterApplyResult, out *ClusterApplyResult, s conversion.Scope) error {
+	out.DryRun = in.DryRun
+	out.Items = *(*[]ClusterApplyObjectResult)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterApplyResult_To_v1_ClusterApplyResult is an autogenerated conversion function.
+func Convert_platform_ClusterApplyResult_To_v1_ClusterApplyResult(in *platform.ClusterApplyResult, out *ClusterApplyResult, s conversion.Scope) error {
+	return autoConvert_platform_ClusterApplyResult_To_v1_ClusterApplyResult(in, out, s)
+}
+
+func autoConvert_v1_ClusterAutoscaling_To_platform_ClusterAutoscaling(in *ClusterAutoscaling, out *platform.ClusterAutoscaling, s conversion.Scope) error {
+	out.NodeGroups = *(*[]platform.AutoscalingNodeGroup)(unsafe.Pointer(&in.NodeGroups))
+	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	return nil
+}
+
+// Convert_v1_ClusterAutoscaling_To_platform_ClusterAutoscaling is an autogenerated conversion function.
+func Convert_v1_ClusterAutoscaling_To_platform_ClusterAutoscaling(in *ClusterAutoscaling, out *platform.ClusterAutoscaling, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAutoscaling_To_platform_ClusterAutoscaling(in, out, s)
+}
+
+func autoConvert_platform_ClusterAutoscaling_To_v1_ClusterAutoscaling(in *platform.ClusterAutoscaling, out *ClusterAutoscaling, s conversion.Scope) error {
+	out.NodeGroups = *(*[]AutoscalingNodeGroup)(unsafe.Pointer(&in.NodeGroups))
+	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	return nil
+}
+
+// Convert_platform_ClusterAutoscaling_To_v1_ClusterAutoscaling is an autogenerated conversion function.
+func Convert_platform_ClusterAutoscaling_To_v1_ClusterAutoscaling(in *platform.ClusterAutoscaling, out *ClusterAutoscaling, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAutoscaling_To_v1_ClusterAutoscaling(in, out, s)
+}
+
+func autoConvert_v1_ClusterCertificate_To_platform_ClusterCertificate(in *ClusterCertificate, out *platform.ClusterCertificate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Node = in.Node
+	out.NotAfter = in.NotAfter
+	return nil
+}
+
+// Convert_v1_ClusterCertificate_To_platform_ClusterCertificate is an autogenerated conversion function.
+func Convert_v1_ClusterCertificate_To_platform_ClusterCertificate(in *ClusterCertificate, out *platform.ClusterCertificate, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCertificate_To_platform_ClusterCertificate(in, out, s)
+}
+
+func autoConvert_platform_ClusterCertificate_To_v1_ClusterCertificate(in *platform.ClusterCertificate, out *ClusterCertificate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Node = in.Node
+	out.NotAfter = in.NotAfter
+	return nil
+}
+
+// Convert_platform_ClusterCertificate_To_v1_ClusterCertificate is an autogenerated conversion function.
+func Convert_platform_ClusterCertificate_To_v1_ClusterCertificate(in *platform.ClusterCertificate, out *ClusterCertificate, s conversion.Scope) error {
+	return autoConvert_platform_ClusterCertificate_To_v1_ClusterCertificate(in, out, s)
+}
+
+func autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
+	out.Type = in.Type
+	if err := Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterComponent_To_platform_ClusterComponent is an autogenerated conversion function.
+func Convert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
+	return autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in, out, s)
+}
+
+func autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
+	out.Type = in.Type
+	if err := Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_ClusterComponent_To_v1_ClusterComponent is an autogenerated conversion function.
+func Convert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
+	return autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in, out, s)
+}
+
+func autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
+	out.Desired = in.Desired
+	out.Current = in.Current
+	out.Available = in.Available
+	out.Updated = in.Updated
+	return nil
+}
+
+// Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas is an autogenerated conversion function.
+func Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
+	return autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in, out, s)
+}
+
+func autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
+	out.Desired = in.Desired
+	out.Current = in.Current
+	out.Available = in.Available
+	out.Updated = in.Updated
+	return nil
+}
+
+// Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas is an autogenerated conversion function.
+func Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
+	return autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in, out, s)
+}
+
+func autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = platform.ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ClusterCondition_To_platform_ClusterCondition is an autogenerated conversion function.
+func Convert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in, out, s)
+}
+
+func autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_platform_ClusterCondition_To_v1_ClusterCondition is an autogenerated conversion function.
+func Convert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
+	return autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in, out, s)
+}
+
+func autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
+	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
+	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
+	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
+	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
+	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
+	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
+	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
+	out.Token = (*string)(unsafe.Pointer(in.Token))
+	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
+	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
+	out.Username = in.Username
+	out.Impersonate = in.Impersonate
+	out.ImpersonateGroups = *(*[]string)(unsafe.Pointer(&in.ImpersonateGroups))
+	out.ImpersonateUserExtra = *(*platform.ImpersonateUserExtra)(unsafe.Pointer(&in.ImpersonateUserExtra))
+	out.S3SecretAccessKeys = *(*map[string][]byte)(unsafe.Pointer(&in.S3SecretAccessKeys))
+	return nil
+}
+
+// Convert_v1_ClusterCredential_To_platform_ClusterCredential is an autogenerated conversion function.
+func Convert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in, out, s)
+}
+
+func autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
+	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
+	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
+	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
+	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
+	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
+	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
+	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
+	out.Token = (*string)(unsafe.Pointer(in.Token))
+	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
+	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
+	out.Username = in.Username
+	out.Impersonate = in.Impersonate
+	out.ImpersonateGroups = *(*[]string)(unsafe.Pointer(&in.ImpersonateGroups))
+	out.ImpersonateUserExtra = *(*ImpersonateUserExtra)(unsafe.Pointer(&in.ImpersonateUserExtra))
+	out.S3SecretAccessKeys = *(*map[string][]byte)(unsafe.Pointer(&in.S3SecretAccessKeys))
+	return nil
+}
+
+// Convert_platform_ClusterCredential_To_v1_ClusterCredential is an autogenerated conversion function.
+func Convert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
+	return autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in, out, s)
+}
+
+func autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterCredential)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList is an autogenerated conversion function.
+func Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in, out, s)
+}
+
+func autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterCredential)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList is an autogenerated conversion function.
+func Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in, out, s)
+}
+
+func autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
+	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
+	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
+	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
+	out.GPUType = (*platform.GPUType)(unsafe.Pointer(in.GPUType))
+	out.EnableMasterSchedule = in.EnableMasterSchedule
+	out.HA = (*platform.HA)(unsafe.Pointer(in.HA))
+	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
+	out.Files = *(*[]platform.File)(unsafe.Pointer(&in.Files))
+	out.Hooks = *(*map[platform.HookType]string)(unsafe.Pointer(&in.Hooks))
+	out.CSIOperator = (*platform.CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
+	out.AuthzWebhookAddr = (*platform.AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
+	out.EnableMetricsServer = in.EnableMetricsServer
+	out.IPv6DualStack = in.IPv6DualStack
+	out.EnableCilium = in.EnableCilium
+	out.ContainerRuntime = in.ContainerRuntime
+	if err := Convert_v1_Upgrade_To_platform_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
+		return err
+	}
+	out.EtcdBackup = (*platform.EtcdBackup)(unsafe.Pointer(in.EtcdBackup))
+	out.Autoscaling = (*platform.ClusterAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	out.MetalLB = (*platform.MetalLB)(unsafe.Pointer(in.MetalLB))
+	return nil
+}
+
+// Convert_v1_ClusterFeature_To_platform_ClusterFeature is an autogenerated conversion function.
+func Convert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
+	return autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in, out, s)
+}
+
+func autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
+	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
+	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
+	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
+	out.GPUType = (*GPUType)(unsafe.Pointer(in.GPUType))
+	out.EnableMasterSchedule = in.EnableMasterSchedule
+	out.HA = (*HA)(unsafe.Pointer(in.HA))
+	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
+	out.Files = *(*[]File)(unsafe.Pointer(&in.Files))
+	out.Hooks = *(*map[HookType]string)(unsafe.Pointer(&in.Hooks))
+	out.CSIOperator = (*CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
+	out.AuthzWebhookAddr = (*AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
+	out.EnableMetricsServer = in.EnableMetricsServer
+	out.EnableCilium = in.EnableCilium
+	out.ContainerRuntime = in.ContainerRuntime
+	out.IPv6DualStack = in.IPv6DualStack
+	if err := Convert_platform_Upgrade_To_v1_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
+		return err
+	}
+	out.EtcdBackup = (*EtcdBackup)(unsafe.Pointer(in.EtcdBackup))
+	out.Autoscaling = (*ClusterAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	out.MetalLB = (*MetalLB)(unsafe.Pointer(in.MetalLB))
+	return nil
+}
+
+// Convert_platform_ClusterFeature_To_v1_ClusterFeature is an autogenerated conversion function.
+func Convert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
+	return autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in, out, s)
+}
+
+func autoConvert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem(in *ClusterGroupAPIResourceItem, out *platform.ClusterGroupAPIResourceItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SingularName = in.SingularName
+	out.Namespaced = in.Namespaced
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.ShortNames = *(*[]string)(unsafe.Pointer(&in.ShortNames))
+	out.Categories = *(*[]string)(unsafe.Pointer(&in.Categories))
+	return nil
+}
+
+// Convert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem is an autogenerated conversion function.
+func Convert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem(in *ClusterGroupAPIResourceItem, out *platform.ClusterGroupAPIResourceItem, s conversion.Scope) error {
+	return autoConvert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem(in, out, s)
+}
+
+func autoConvert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem(in *platform.ClusterGroupAPIResourceItem, out *ClusterGroupAPIResourceItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SingularName = in.SingularName
+	out.Namespaced = in.Namespaced
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.ShortNames = *(*[]string)(unsafe.Pointer(&in.ShortNames))
+	out.Categories = *(*[]string)(unsafe.Pointer(&in.Categories))
+	return nil
+}
+
+// Convert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem is an autogenerated conversion function.
+func Convert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem(in *platform.ClusterGroupAPIResourceItem, out *ClusterGroupAPIResourceItem, s conversion.Scope) error {
+	return autoConvert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem(in, out, s)
+}
+
+func autoConvert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems(in *ClusterGroupAPIResourceItems, out *platform.ClusterGroupAPIResourceItems, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.GroupVersion = in.GroupVersion
+	out.APIResources = *(*[]platform.ClusterGroupAPIResourceItem)(unsafe.Pointer(&in.APIResources))
+	return nil
+}
+
+// Convert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems is an autogenerated conversion function.
+func Convert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems(in *ClusterGroupAPIResourceItems, out *platform.ClusterGroupAPIResourceItems, s conversion.Scope) error {
+	return autoConvert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems(in, out, s)
+}
+
+func autoConvert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems(in *platform.ClusterGroupAPIResourceItems, out *ClusterGroupAPIResourceItems, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.GroupVersion = in.GroupVersion
+	out.APIResources = *(*[]ClusterGroupAPIResourceItem)(unsafe.Pointer(&in.APIResources))
+	return nil
+}
+
+// Convert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems is an autogenerated conversion function.
+func Convert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems(in *platform.ClusterGroupAPIResourceItems, out *ClusterGroupAPIResourceItems, s conversion.Scope) error {
+	return autoConvert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems(in, out, s)
+}
+
+func autoConvert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList(in *ClusterGroupAPIResourceItemsList, out *platform.ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterGroupAPIResourceItems)(unsafe.Pointer(&in.Items))
+	out.FailedGroupError = in.FailedGroupError
+	return nil
+}
+
+// Convert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList is an autogenerated conversion function.
+func Convert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList(in *ClusterGroupAPIResourceItemsList, out *platform.ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList(in, out, s)
+}
+
+func autoConvert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList(in *platform.ClusterGroupAPIResourceItemsList, out *ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterGroupAPIResourceItems)(unsafe.Pointer(&in.Items))
+	out.FailedGroupError = in.FailedGroupError
+	return nil
+}
+
+// Convert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList is an autogenerated conversion function.
+func Convert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList(in *platform.ClusterGroupAPIResourceItemsList, out *ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList(in, out, s)
+}
+
+func autoConvert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions(in *ClusterGroupAPIResourceOptions, out *platform.ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions is an autogenerated conversion function.
+func Convert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions(in *ClusterGroupAPIResourceOptions, out *platform.ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+	return autoConvert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions(in, out, s)
+}
+
+func autoConvert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions(in *platform.ClusterGroupAPIResourceOptions, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions is an autogenerated conversion function.
+func Convert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions(in *platform.ClusterGroupAPIResourceOptions, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+	return autoConvert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_ClusterGroupAPIResourceOptions(in *url.Values, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	return nil
+}
+
+// Convert_url_Values_To_v1_ClusterGroupAPIResourceOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_ClusterGroupAPIResourceOptions(in *url.Values, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_ClusterGroupAPIResourceOptions(in, out, s)
+}
+
+func autoConvert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]platform.Cluster, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Cluster_To_platform_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_ClusterList_To_platform_ClusterList is an autogenerated conversion function.
+func Convert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterList_To_platform_ClusterList(in, out, s)
+}
+
+func autoConvert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			if err := Convert_platform_Cluster_To_v1_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_platform_ClusterList_To_v1_ClusterList is an autogenerated conversion function.
+func Convert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterList_To_v1_ClusterList(in, out, s)
+}
+
+func autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	if err := Convert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(&in.Proxy, &out.Proxy, s); err != nil {
+		return err
+	}
+	out.CredentialName = in.CredentialName
+	out.ContainerRuntimeConfig = (*platform.ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
+	return nil
+}
+
+// Convert_v1_ClusterMachine_To_platform_ClusterMachine is an autogenerated conversion function.
+func Convert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
+	return autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in, out, s)
+}
+
+func autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	if err := Convert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(&in.Proxy, &out.Proxy, s); err != nil {
+		return err
+	}
+	out.CredentialName = in.CredentialName
+	out.ContainerRuntimeConfig = (*ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
+	return nil
+}
+
+// Convert_platform_ClusterMachine_To_v1_ClusterMachine is an autogenerated conversion function.
+func Convert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
+	return autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in, out, s)
+}
+
+func autoConvert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(in *ClusterMachineProxy, out *platform.ClusterMachineProxy, s conversion.Scope) error {
+	out.Type = platform.ProxyType(in.Type)
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	return nil
+}
+
+// Convert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy is an autogenerated conversion function.
+func Convert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(in *ClusterMachineProxy, out *platform.ClusterMachineProxy, s conversion.Scope) error {
+	return autoConvert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(in, out, s)
+}
+
+func autoConvert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(in *platform.ClusterMachineProxy, out *ClusterMachineProxy, s conversion.Scope) error {
+	out.Type = ProxyType(in.Type)
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	return nil
+}
+
+// Convert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy is an autogenerated conversion function.
+func Convert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(in *platform.ClusterMachineProxy, out *ClusterMachineProxy, s conversion.Scope) error {
+	return autoConvert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(in, out, s)
+}
+
+func autoConvert_v1_ClusterPlan_To_platform_ClusterPlan(in *ClusterPlan, out *platform.ClusterPlan, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Phase = platform.ClusterPhase(in.Phase)
+	out.Steps = *(*[]platform.ClusterPlanStep)(unsafe.Pointer(&in.Steps))
+	out.Checks = *(*[]platform.ClusterPlanCheck)(unsafe.Pointer(&in.Checks))
+	out.Configs = *(*[]platform.ClusterPlanConfig)(unsafe.Pointer(&in.Configs))
+	return nil
+}
+
+// Convert_v1_ClusterPlan_To_platform_ClusterPlan is an autogenerated conversion function.
+func Convert_v1_ClusterPlan_To_platform_ClusterPlan(in *ClusterPlan, out *platform.ClusterPlan, s conversion.Scope) error {
+	return autoConvert_v1_ClusterPlan_To_platform_ClusterPlan(in, out, s)
+}
+
+func autoConvert_platform_ClusterPlan_To_v1_ClusterPlan(in *platform.ClusterPlan, out *ClusterPlan, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Phase = ClusterPhase(in.Phase)
+	out.Steps = *(*[]ClusterPlanStep)(unsafe.Pointer(&in.Steps))
+	out.Checks = *(*[]ClusterPlanCheck)(unsafe.Pointer(&in.Checks))
+	out.Configs = *(*[]ClusterPlanConfig)(unsafe.Pointer(&in.Configs))
+	return nil
+}
+
+// Convert_platform_ClusterPlan_To_v1_ClusterPlan is an autogenerated conversion function.
+func Convert_platform_ClusterPlan_To_v1_ClusterPlan(in *platform.ClusterPlan, out *ClusterPlan, s conversion.Scope) error {
+	return autoConvert_platform_ClusterPlan_To_v1_ClusterPlan(in, out, s)
+}
+
+func autoConvert_v1_ClusterPlanCheck_To_platform_ClusterPlanCheck(in *ClusterPlanCheck, out *platform.ClusterPlanCheck, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IP = in.IP
+	out.Passed = in.Passed
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ClusterPlanCheck_To_platform_ClusterPlanCheck is an autogenerated conversion function.
+func Convert_v1_ClusterPlanCheck_To_platform_ClusterPlanCheck(in *ClusterPlanCheck, out *platform.ClusterPlanCheck, s conversion.Scope) error {
+	return autoConvert_v1_ClusterPlanCheck_To_platform_ClusterPlanCheck(in, out, s)
+}
+
+func autoConvert_platform_ClusterPlanCheck_To_v1_ClusterPlanCheck(in *platform.ClusterPlanCheck, out *ClusterPlanCheck, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IP = in.IP
+	out.Passed = in.Passed
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_platform_ClusterPlanCheck_To_v1_ClusterPlanCheck is an autogenerated conversion function.
+func Convert_platform_ClusterPlanCheck_To_v1_ClusterPlanCheck(in *platform.ClusterPlanCheck, out *ClusterPlanCheck, s conversion.Scope) error {
+	return autoConvert_platform_ClusterPlanCheck_To_v1_ClusterPlanCheck(in, out, s)
+}
+
+func autoConvert_v1_ClusterPlanConfig_To_platform_ClusterPlanConfig(in *ClusterPlanConfig, out *platform.ClusterPlanConfig, s conversion.Scope) error {
+	out.Path = in.Path
+	out.IPs = *(*[]string)(unsafe.Pointer(&in.IPs))
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1_ClusterPlanConfig_To_platform_ClusterPlanConfig is an autogenerated conversion function.
+func Convert_v1_ClusterPlanConfig_To_platform_ClusterPlanConfig(in *ClusterPlanConfig, out *platform.ClusterPlanConfig, s conversion.Scope) error {
+	return autoConvert_v1_ClusterPlanConfig_To_platform_ClusterPlanConfig(in, out, s)
+}
+
+func autoConvert_platform_ClusterPlanConfig_To_v1_ClusterPlanConfig(in *platform.ClusterPlanConfig, out *ClusterPlanConfig, s conversion.Scope) error {
+	out.Path = in.Path
+	out.IPs = *(*[]string)(unsafe.Pointer(&in.IPs))
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_platform_ClusterPlanConfig_To_v1_ClusterPlanConfig is an autogenerated conversion function.
+func Convert_platform_ClusterPlanConfig_To_v1_ClusterPlanConfig(in *platform.ClusterPlanConfig, out *ClusterPlanConfig, s conversion.Scope) error {
+	return autoConvert_platform_ClusterPlanConfig_To_v1_ClusterPlanConfig(in, out, s)
+}
+
+func autoConvert_v1_ClusterPlanStep_To_platform_ClusterPlanStep(in *ClusterPlanStep, out *platform.ClusterPlanStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Skipped = in.Skipped
+	return nil
+}
+
+// Convert_v1_ClusterPlanStep_To_platform_ClusterPlanStep is an autogenerated conversion function.
+func Convert_v1_ClusterPlanStep_To_platform_ClusterPlanStep(in *ClusterPlanStep, out *platform.ClusterPlanStep, s conversion.Scope) error {
+	return autoConvert_v1_ClusterPlanStep_To_platform_ClusterPlanStep(in, out, s)
+}
+
+func autoConvert_platform_ClusterPlanStep_To_v1_ClusterPlanStep(in *platform.ClusterPlanStep, out *ClusterPlanStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Skipped = in.Skipped
+	return nil
+}
+
+// Convert_platform_ClusterPlanStep_To_v1_ClusterPlanStep is an autogenerated conversion function.
+func Convert_platform_ClusterPlanStep_To_v1_ClusterPlanStep(in *platform.ClusterPlanStep, out *ClusterPlanStep, s conversion.Scope) error {
+	return autoConvert_platform_ClusterPlanStep_To_v1_ClusterPlanStep(in, out, s)
+}
+
+func autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
+	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
+	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
+	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+	return nil
+}
+
+// Convert_v1_ClusterProperty_To_platform_ClusterProperty is an autogenerated conversion function.
+func Convert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
+	return autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in, out, s)
+}
+
+func autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
+	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
+	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
+	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+	return nil
+}
+
+// Convert_platform_ClusterProperty_To_v1_ClusterProperty is an autogenerated conversion function.
+func Convert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
+	return autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in, out, s)
+}
+
+func autoConvert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
+	out.Capacity = *(*platform.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Allocated = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocated))
+	return nil
+}
+
+// Convert_v1_ClusterResource_To_platform_ClusterResource is an autogenerated conversion function.
+func Convert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
+	return autoConvert_v1_ClusterResource_To_platform_ClusterResource(in, out, s)
+}
+
+func autoConvert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
+	out.Capacity = *(*ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Allocated = *(*ResourceList)(unsafe.Pointer(&in.Allocated))
+	return nil
+}
+
+// Convert_platform_ClusterResource_To_v1_ClusterResource is an autogenerated conversion function.
+func Convert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
+	return autoConvert_platform_ClusterResource_To_v1_ClusterResource(in, out, s)
+}
+
+func autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Type = in.Type
+	out.Version = in.Version
+	out.NetworkType = platform.NetworkType(in.NetworkType)
+	out.NetworkDevice = in.NetworkDevice
+	out.ClusterCIDR = in.ClusterCIDR
+	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
+	out.DNSDomain = in.DNSDomain
+	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
+	if err := Convert_v1_ClusterFeature_To_platform_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterProperty_To_platform_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	out.Machines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.Machines))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
+	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
+	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
+	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
+	out.Etcd = (*platform.Etcd)(unsafe.Pointer(in.Etcd))
+	out.HostnameAsNodename = in.HostnameAsNodename
+	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
+	out.ScalingMachines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
+	out.BootstrapApps = *(*platform.BootstrapApps)(unsafe.Pointer(&in.BootstrapApps))
+	out.AppVersion = in.AppVersion
+	out.TemplateRef = (*platform.ClusterTemplateRef)(unsafe.Pointer(in.TemplateRef))
+	out.ContainerRuntimeConfig = (*platform.ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
+	return nil
+}
+
+// Convert_v1_ClusterSpec_To_platform_ClusterSpec is an autogenerated conversion function.
+func Convert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in, out, s)
+}
+
+func autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Type = in.Type
+	out.Version = in.Version
+	out.NetworkType = NetworkType(in.NetworkType)
+	out.NetworkDevice = in.NetworkDevice
+	out.ClusterCIDR = in.ClusterCIDR
+	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
+	out.DNSDomain = in.DNSDomain
+	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
+	if err := Convert_platform_ClusterFeature_To_v1_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterProperty_To_v1_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	out.Machines = *(*[]ClusterMachine)(unsafe.Pointer(&in.Machines))
+	out.ScalingMachines = *(*[]ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
+	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
+	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
+	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
+	out.Etcd = (*Etcd)(unsafe.Pointer(in.Etcd))
+	out.HostnameAsNodename = in.HostnameAsNodename
+	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
+	out.BootstrapApps = *(*BootstrapApps)(unsafe.Pointer(&in.BootstrapApps))
+	out.AppVersion = in.AppVersion
+	out.TemplateRef = (*ClusterTemplateRef)(unsafe.Pointer(in.TemplateRef))
+	out.ContainerRuntimeConfig = (*ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
+	return nil
+}
+
+// Convert_platform_ClusterSpec_To_v1_ClusterSpec is an autogenerated conversion function.
+func Convert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in, out, s)
+}
+
+func autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Version = in.Version
+	out.Phase = platform.ClusterPhase(in.Phase)
+	out.Conditions = *(*[]platform.ClusterCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
+	out.Reason = in.Reason
+	out.Addresses = *(*[]platform.ClusterAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_v1_ClusterResource_To_platform_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
+		return err
+	}
+	out.Components = *(*[]platform.ClusterComponent)(unsafe.Pointer(&in.Components))
+	out.ServiceCIDR = in.ServiceCIDR
+	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
+	out.DNSIP = in.DNSIP
+	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
+	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
+	out.ClusterCIDR = in.ClusterCIDR
+	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
+	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
+	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
+	out.KubeVendor = platform.KubeVendorType(in.KubeVendor)
+	out.AppVersion = in.AppVersion
+	out.ComponentPhase = platform.ComponentPhase(in.ComponentPhase)
+	out.HandlerHistory = *(*[]platform.HandlerRecord)(unsafe.Pointer(&in.HandlerHistory))
+	out.Certificates = *(*[]platform.ClusterCertificate)(unsafe.Pointer(&in.Certificates))
+	return nil
+}
+
+// Convert_v1_ClusterStatus_To_platform_ClusterStatus is an autogenerated conversion function.
+func Convert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Version = in.Version
+	out.Phase = ClusterPhase(in.Phase)
+	out.Conditions = *(*[]ClusterCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
+	out.Reason = in.Reason
+	out.Addresses = *(*[]ClusterAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_platform_ClusterResource_To_v1_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
+		return err
+	}
+	out.Components = *(*[]ClusterComponent)(unsafe.Pointer(&in.Components))
+	out.ServiceCIDR = in.ServiceCIDR
+	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
+	out.DNSIP = in.DNSIP
+	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
+	out.ClusterCIDR = in.ClusterCIDR
+	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
+	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
+	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
+	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
+	out.KubeVendor = KubeVendorType(in.KubeVendor)
+	out.AppVersion = in.AppVersion
+	out.ComponentPhase = ComponentPhase(in.ComponentPhase)
+	out.HandlerHistory = *(*[]HandlerRecord)(unsafe.Pointer(&in.HandlerHistory))
+	out.Certificates = *(*[]ClusterCertificate)(unsafe.Pointer(&in.Certificates))
+	return nil
+}
+
+// Convert_platform_ClusterStatus_To_v1_ClusterStatus is an autogenerated conversion function.
+func Convert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterSync_To_platform_ClusterSync(in *ClusterSync, out *platform.ClusterSync, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterSyncSpec_To_platform_ClusterSyncSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterSyncStatus_To_platform_ClusterSyncStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterSync_To_platform_ClusterSync is an autogenerated conversion function.
+func Convert_v1_ClusterSync_To_platform_ClusterSync(in *ClusterSync, out *platform.ClusterSync, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSync_To_platform_ClusterSync(in, out, s)
+}
+
+func autoConvert_platform_ClusterSync_To_v1_ClusterSync(in *platform.ClusterSync, out *ClusterSync, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_ClusterSyncSpec_To_v1_ClusterSyncSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterSyncStatus_To_v1_ClusterSyncStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_ClusterSync_To_v1_ClusterSync is an autogenerated conversion function.
+func Convert_platform_ClusterSync_To_v1_ClusterSync(in *platform.ClusterSync, out *ClusterSync, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSync_To_v1_ClusterSync(in, out, s)
+}
+
+func autoConvert_v1_ClusterSyncClusterStatus_To_platform_ClusterSyncClusterStatus(in *ClusterSyncClusterStatus, out *platform.ClusterSyncClusterStatus, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Phase = platform.ClusterSyncPhase(in.Phase)
+	out.Revision = in.Revision
+	out.Drift = *(*[]platform.ClusterSyncObject)(unsafe.Pointer(&in.Drift))
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ClusterSyncClusterStatus_To_platform_ClusterSyncClusterStatus is an autogenerated conversion function.
+func Convert_v1_ClusterSyncClusterStatus_To_platform_ClusterSyncClusterStatus(in *ClusterSyncClusterStatus, out *platform.ClusterSyncClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSyncClusterStatus_To_platform_ClusterSyncClusterStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterSyncClusterStatus_To_v1_ClusterSyncClusterStatus(in *platform.ClusterSyncClusterStatus, out *ClusterSyncClusterStatus, s conversion.Scope) error {
 	out.ClusterName = in.ClusterName
+	out.Phase = ClusterSyncPhase(in.Phase)
+	out.Revision = in.Revision
+	out.Drift = *(*[]ClusterSyncObject)(unsafe.Pointer(&in.Drift))
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_platform_ClusterSyncClusterStatus_To_v1_ClusterSyncClusterStatus is an autogenerated conversion function.
+func Convert_platform_ClusterSyncClusterStatus_To_v1_ClusterSyncClusterStatus(in *platform.ClusterSyncClusterStatus, out *ClusterSyncClusterStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSyncClusterStatus_To_v1_ClusterSyncClusterStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterSyncList_To_platform_ClusterSyncList(in *ClusterSyncList, out *platform.ClusterSyncList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterSync)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterSyncList_To_platform_ClusterSyncList is an autogenerated conversion function.
+func Convert_v1_ClusterSyncList_To_platform_ClusterSyncList(in *ClusterSyncList, out *platform.ClusterSyncList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSyncList_To_platform_ClusterSyncList(in, out, s)
+}
+
+func autoConvert_platform_ClusterSyncList_To_v1_ClusterSyncList(in *platform.ClusterSyncList, out *ClusterSyncList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterSync)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterSyncList_To_v1_ClusterSyncList is an autogenerated conversion function.
+func Convert_platform_ClusterSyncList_To_v1_ClusterSyncList(in *platform.ClusterSyncList, out *ClusterSyncList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSyncList_To_v1_ClusterSyncList(in, out, s)
+}
+
+func autoConvert_v1_ClusterSyncObject_To_platform_ClusterSyncObject(in *ClusterSyncObject, out *platform.ClusterSyncObject, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Action = platform.ClusterApplyAction(in.Action)
+	return nil
+}
+
+// Convert_v1_ClusterSyncObject_To_platform_ClusterSyncObject is an autogenerated conversion function.
+func Convert_v1_ClusterSyncObject_To_platform_ClusterSyncObject(in *ClusterSyncObject, out *platform.ClusterSyncObject, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSyncObject_To_platform_ClusterSyncObject(in, out, s)
+}
+
+func autoConvert_platform_ClusterSyncObject_To_v1_ClusterSyncObject(in *platform.ClusterSyncObject, out *ClusterSyncObject, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Action = ClusterApplyAction(in.Action)
+	return nil
+}
+
+// Convert_platform_ClusterSyncObject_To_v1_ClusterSyncObject is an autogenerated conversion function.
+func Convert_platform_ClusterSyncObject_To_v1_ClusterSyncObject(in *platform.ClusterSyncObject, out *ClusterSyncObject, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSyncObject_To_v1_ClusterSyncObject(in, out, s)
+}
+
+func autoConvert_v1_ClusterSyncSource_To_platform_ClusterSyncSource(in *ClusterSyncSource, out *platform.ClusterSyncSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Branch = in.Branch
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1_ClusterSyncSource_To_platform_ClusterSyncSource is an autogenerated conversion function.
+func Convert_v1_ClusterSyncSource_To_platform_ClusterSyncSource(in *ClusterSyncSource, out *platform.ClusterSyncSource, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSyncSource_To_platform_ClusterSyncSource(in, out, s)
+}
+
+func autoConvert_platform_ClusterSyncSource_To_v1_ClusterSyncSource(in *platform.ClusterSyncSource, out *ClusterSyncSource, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Branch = in.Branch
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_platform_ClusterSyncSource_To_v1_ClusterSyncSource is an autogenerated conversion function.
+func Convert_platform_ClusterSyncSource_To_v1_ClusterSyncSource(in *platform.ClusterSyncSource, out *ClusterSyncSource, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSyncSource_To_v1_ClusterSyncSource(in, out, s)
+}
+
+func autoConvert_v1_ClusterSyncSpec_To_platform_ClusterSyncSpec(in *ClusterSyncSpec, out *platform.ClusterSyncSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	if err := Convert_v1_ClusterSyncSource_To_platform_ClusterSyncSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.ClusterSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ClusterSelector))
+	out.Interval = in.Interval
+	out.Prune = in.Prune
+	out.Suspend = in.Suspend
+	return nil
+}
+
+// Convert_v1_ClusterSyncSpec_To_platform_ClusterSyncSpec is an autogenerated conversion function.
+func Convert_v1_ClusterSyncSpec_To_platform_ClusterSyncSpec(in *ClusterSyncSpec, out *platform.ClusterSyncSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSyncSpec_To_platform_ClusterSyncSpec(in, out, s)
+}
+
+func autoConvert_platform_ClusterSyncSpec_To_v1_ClusterSyncSpec(in *platform.ClusterSyncSpec, out *ClusterSyncSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	if err := Convert_platform_ClusterSyncSource_To_v1_ClusterSyncSource(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.ClusterSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ClusterSelector))
+	out.Interval = in.Interval
+	out.Prune = in.Prune
+	out.Suspend = in.Suspend
+	return nil
+}
+
+// Convert_platform_ClusterSyncSpec_To_v1_ClusterSyncSpec is an autogenerated conversion function.
+func Convert_platform_ClusterSyncSpec_To_v1_ClusterSyncSpec(in *platform.ClusterSyncSpec, out *ClusterSyncSpec, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSyncSpec_To_v1_ClusterSyncSpec(in, out, s)
+}
+
+func autoConvert_v1_ClusterSyncStatus_To_platform_ClusterSyncStatus(in *ClusterSyncStatus, out *platform.ClusterSyncStatus, s conversion.Scope) error {
+	out.Phase = platform.ClusterSyncPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Revision = in.Revision
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	out.Clusters = *(*[]platform.ClusterSyncClusterStatus)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_v1_ClusterSyncStatus_To_platform_ClusterSyncStatus is an autogenerated conversion function.
+func Convert_v1_ClusterSyncStatus_To_platform_ClusterSyncStatus(in *ClusterSyncStatus, out *platform.ClusterSyncStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSyncStatus_To_platform_ClusterSyncStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterSyncStatus_To_v1_ClusterSyncStatus(in *platform.ClusterSyncStatus, out *ClusterSyncStatus, s conversion.Scope) error {
+	out.Phase = ClusterSyncPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Revision = in.Revision
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	out.Clusters = *(*[]ClusterSyncClusterStatus)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_platform_ClusterSyncStatus_To_v1_ClusterSyncStatus is an autogenerated conversion function.
+func Convert_platform_ClusterSyncStatus_To_v1_ClusterSyncStatus(in *platform.ClusterSyncStatus, out *ClusterSyncStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSyncStatus_To_v1_ClusterSyncStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterTemplate_To_platform_ClusterTemplate(in *ClusterTemplate, out *platform.ClusterTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterTemplateSpec_To_platform_ClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterTemplateStatus_To_platform_ClusterTemplateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterTemplate_To_platform_ClusterTemplate is an autogenerated conversion function.
+func Convert_v1_ClusterTemplate_To_platform_ClusterTemplate(in *ClusterTemplate, out *platform.ClusterTemplate, s conversion.Scope) error {
+	return autoConvert_v1_ClusterTemplate_To_platform_ClusterTemplate(in, out, s)
+}
+
+func autoConvert_platform_ClusterTemplate_To_v1_ClusterTemplate(in *platform.ClusterTemplate, out *ClusterTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_ClusterTemplateSpec_To_v1_ClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterTemplateStatus_To_v1_ClusterTemplateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_ClusterTemplate_To_v1_ClusterTemplate is an autogenerated conversion function.
+func Convert_platform_ClusterTemplate_To_v1_ClusterTemplate(in *platform.ClusterTemplate, out *ClusterTemplate, s conversion.Scope) error {
+	return autoConvert_platform_ClusterTemplate_To_v1_ClusterTemplate(in, out, s)
+}
+
+func autoConvert_v1_ClusterTemplateList_To_platform_ClusterTemplateList(in *ClusterTemplateList, out *platform.ClusterTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]platform.ClusterTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ClusterTemplate_To_platform_ClusterTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_ClusterTemplateList_To_platform_ClusterTemplateList is an autogenerated conversion function.
+func Convert_v1_ClusterTemplateList_To_platform_ClusterTemplateList(in *ClusterTemplateList, out *platform.ClusterTemplateList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterTemplateList_To_platform_ClusterTemplateList(in, out, s)
+}
+
+func autoConvert_platform_ClusterTemplateList_To_v1_ClusterTemplateList(in *platform.ClusterTemplateList, out *ClusterTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_platform_ClusterTemplate_To_v1_ClusterTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_platform_ClusterTemplateList_To_v1_ClusterTemplateList is an autogenerated conversion function.
+func Convert_platform_ClusterTemplateList_To_v1_ClusterTemplateList(in *platform.ClusterTemplateList, out *ClusterTemplateList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterTemplateList_To_v1_ClusterTemplateList(in, out, s)
+}
+
+func autoConvert_v1_ClusterTemplateRef_To_platform_ClusterTemplateRef(in *ClusterTemplateRef, out *platform.ClusterTemplateRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_v1_ClusterTemplateRef_To_platform_ClusterTemplateRef is an autogenerated conversion function.
+func Convert_v1_ClusterTemplateRef_To_platform_ClusterTemplateRef(in *ClusterTemplateRef, out *platform.ClusterTemplateRef, s conversion.Scope) error {
+	return autoConvert_v1_ClusterTemplateRef_To_platform_ClusterTemplateRef(in, out, s)
+}
+
+func autoConvert_platform_ClusterTemplateRef_To_v1_ClusterTemplateRef(in *platform.ClusterTemplateRef, out *ClusterTemplateRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_platform_ClusterTemplateRef_To_v1_ClusterTemplateRef is an autogenerated conversion function.
+func Convert_platform_ClusterTemplateRef_To_v1_ClusterTemplateRef(in *platform.ClusterTemplateRef, out *ClusterTemplateRef, s conversion.Scope) error {
+	return autoConvert_platform_ClusterTemplateRef_To_v1_ClusterTemplateRef(in, out, s)
+}
+
+func autoConvert_v1_ClusterTemplateRevision_To_platform_ClusterTemplateRevision(in *ClusterTemplateRevision, out *platform.ClusterTemplateRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	if err := Convert_v1_ClusterTemplateSpec_To_platform_ClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.CreationTimestamp = in.CreationTimestamp
+	return nil
+}
+
+// Convert_v1_ClusterTemplateRevision_To_platform_ClusterTemplateRevision is an autogenerated conversion function.
+func Convert_v1_ClusterTemplateRevision_To_platform_ClusterTemplateRevision(in *ClusterTemplateRevision, out *platform.ClusterTemplateRevision, s conversion.Scope) error {
+	return autoConvert_v1_ClusterTemplateRevision_To_platform_ClusterTemplateRevision(in, out, s)
+}
+
+func autoConvert_platform_ClusterTemplateRevision_To_v1_ClusterTemplateRevision(in *platform.ClusterTemplateRevision, out *ClusterTemplateRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	if err := Convert_platform_ClusterTemplateSpec_To_v1_ClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.CreationTimestamp = in.CreationTimestamp
+	return nil
+}
+
+// Convert_platform_ClusterTemplateRevision_To_v1_ClusterTemplateRevision is an autogenerated conversion function.
+func Convert_platform_ClusterTemplateRevision_To_v1_ClusterTemplateRevision(in *platform.ClusterTemplateRevision, out *ClusterTemplateRevision, s conversion.Scope) error {
+	return autoConvert_platform_ClusterTemplateRevision_To_v1_ClusterTemplateRevision(in, out, s)
+}
+
+func autoConvert_v1_ClusterTemplateSpec_To_platform_ClusterTemplateSpec(in *ClusterTemplateSpec, out *platform.ClusterTemplateSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Type = in.Type
 	out.Version = in.Version
+	out.NetworkType = platform.NetworkType(in.NetworkType)
+	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
+	if err := Convert_v1_ClusterFeature_To_platform_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterProperty_To_platform_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
+	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
+	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
+	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
+	out.BootstrapApps = *(*platform.BootstrapApps)(unsafe.Pointer(&in.BootstrapApps))
 	return nil
 }
 
-// Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec is an autogenerated conversion function.
-func Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in, out, s)
+// Convert_v1_ClusterTemplateSpec_To_platform_ClusterTemplateSpec is an autogenerated conversion function.
+func Convert_v1_ClusterTemplateSpec_To_platform_ClusterTemplateSpec(in *ClusterTemplateSpec, out *platform.ClusterTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterTemplateSpec_To_platform_ClusterTemplateSpec(in, out, s)
 }
 
-func autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
+func autoConvert_platform_ClusterTemplateSpec_To_v1_ClusterTemplateSpec(in *platform.ClusterTemplateSpec, out *ClusterTemplateSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Type = in.Type
 	out.Version = in.Version
-	out.StorageVendorVersion = in.StorageVendorVersion
-	out.Phase = platform.AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.NetworkType = NetworkType(in.NetworkType)
+	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
+	if err := Convert_platform_ClusterFeature_To_v1_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterProperty_To_v1_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
+	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
+	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
+	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
+	out.BootstrapApps = *(*BootstrapApps)(unsafe.Pointer(&in.BootstrapApps))
+	return nil
+}
+
+// Convert_platform_ClusterTemplateSpec_To_v1_ClusterTemplateSpec is an autogenerated conversion function.
+func Convert_platform_ClusterTemplateSpec_To_v1_ClusterTemplateSpec(in *platform.ClusterTemplateSpec, out *ClusterTemplateSpec, s conversion.Scope) error {
+	return autoConvert_platform_ClusterTemplateSpec_To_v1_ClusterTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1_ClusterTemplateStatus_To_platform_ClusterTemplateStatus(in *ClusterTemplateStatus, out *platform.ClusterTemplateStatus, s conversion.Scope) error {
+	out.Revision = in.Revision
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]platform.ClusterTemplateRevision, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ClusterTemplateRevision_To_platform_ClusterTemplateRevision(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revisions = nil
+	}
+	return nil
+}
+
+// Convert_v1_ClusterTemplateStatus_To_platform_ClusterTemplateStatus is an autogenerated conversion function.
+func Convert_v1_ClusterTemplateStatus_To_platform_ClusterTemplateStatus(in *ClusterTemplateStatus, out *platform.ClusterTemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterTemplateStatus_To_platform_ClusterTemplateStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterTemplateStatus_To_v1_ClusterTemplateStatus(in *platform.ClusterTemplateStatus, out *ClusterTemplateStatus, s conversion.Scope) error {
+	out.Revision = in.Revision
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]ClusterTemplateRevision, len(*in))
+		for i := range *in {
+			if err := Convert_platform_ClusterTemplateRevision_To_v1_ClusterTemplateRevision(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revisions = nil
+	}
 	return nil
 }
 
-// Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus is an autogenerated conversion function.
-func Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in, out, s)
+// Convert_platform_ClusterTemplateStatus_To_v1_ClusterTemplateStatus is an autogenerated conversion function.
+func Convert_platform_ClusterTemplateStatus_To_v1_ClusterTemplateStatus(in *platform.ClusterTemplateStatus, out *ClusterTemplateStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterTemplateStatus_To_v1_ClusterTemplateStatus(in, out, s)
 }
 
-func autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.StorageVendorVersion = in.StorageVendorVersion
-	out.Phase = AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
+	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
 	return nil
 }
 
-// Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus is an autogenerated conversion function.
-func Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in, out, s)
+// Convert_v1_ConfigMap_To_platform_ConfigMap is an autogenerated conversion function.
+func Convert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
+	return autoConvert_v1_ConfigMap_To_platform_ConfigMap(in, out, s)
 }
 
-func autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
+	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
 	return nil
 }
 
-// Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions is an autogenerated conversion function.
-func Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in, out, s)
+// Convert_platform_ConfigMap_To_v1_ConfigMap is an autogenerated conversion function.
+func Convert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	return autoConvert_platform_ConfigMap_To_v1_ConfigMap(in, out, s)
 }
 
-func autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ConfigMap)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions is an autogenerated conversion function.
-func Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in, out, s)
+// Convert_v1_ConfigMapList_To_platform_ConfigMapList is an autogenerated conversion function.
+func Convert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
+	return autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in, out, s)
 }
 
-func autoConvert_url_Values_To_v1_CSIProxyOptions(in *url.Values, out *CSIProxyOptions, s conversion.Scope) error {
-	// WARNING: Field TypeMeta does not have json tag, skipping.
-
-	if values, ok := map[string][]string(*in)["namespace"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_string(&values, &out.Namespace, s); err != nil {
-			return err
-		}
-	} else {
-		out.Namespace = ""
-	}
-	if values, ok := map[string][]string(*in)["name"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_string(&values, &out.Name, s); err != nil {
-			return err
-		}
-	} else {
-		out.Name = ""
-	}
+func autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ConfigMap)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_url_Values_To_v1_CSIProxyOptions is an autogenerated conversion function.
-func Convert_url_Values_To_v1_CSIProxyOptions(in *url.Values, out *CSIProxyOptions, s conversion.Scope) error {
-	return autoConvert_url_Values_To_v1_CSIProxyOptions(in, out, s)
+// Convert_platform_ConfigMapList_To_v1_ConfigMapList is an autogenerated conversion function.
+func Convert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	return autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in, out, s)
 }
 
-func autoConvert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_ClusterSpec_To_platform_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_v1_ClusterStatus_To_platform_ClusterStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_v1_ContainerRuntimeConfig_To_platform_ContainerRuntimeConfig(in *ContainerRuntimeConfig, out *platform.ContainerRuntimeConfig, s conversion.Scope) error {
+	out.RegistryMirrors = *(*[]platform.RegistryMirror)(unsafe.Pointer(&in.RegistryMirrors))
+	out.InsecureRegistries = *(*[]string)(unsafe.Pointer(&in.InsecureRegistries))
+	out.Snapshotter = in.Snapshotter
+	out.CgroupDriver = platform.CgroupDriver(in.CgroupDriver)
+	out.RuntimeClasses = *(*[]platform.RuntimeClass)(unsafe.Pointer(&in.RuntimeClasses))
 	return nil
 }
 
-// Convert_v1_Cluster_To_platform_Cluster is an autogenerated conversion function.
-func Convert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
-	return autoConvert_v1_Cluster_To_platform_Cluster(in, out, s)
+// Convert_v1_ContainerRuntimeConfig_To_platform_ContainerRuntimeConfig is an autogenerated conversion function.
+func Convert_v1_ContainerRuntimeConfig_To_platform_ContainerRuntimeConfig(in *ContainerRuntimeConfig, out *platform.ContainerRuntimeConfig, s conversion.Scope) error {
+	return autoConvert_v1_ContainerRuntimeConfig_To_platform_ContainerRuntimeConfig(in, out, s)
 }
 
-func autoConvert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_ClusterSpec_To_v1_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_platform_ClusterStatus_To_v1_ClusterStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_platform_ContainerRuntimeConfig_To_v1_ContainerRuntimeConfig(in *platform.ContainerRuntimeConfig, out *ContainerRuntimeConfig, s conversion.Scope) error {
+	out.RegistryMirrors = *(*[]RegistryMirror)(unsafe.Pointer(&in.RegistryMirrors))
+	out.InsecureRegistries = *(*[]string)(unsafe.Pointer(&in.InsecureRegistries))
+	out.Snapshotter = in.Snapshotter
+	out.CgroupDriver = CgroupDriver(in.CgroupDriver)
+	out.RuntimeClasses = *(*[]RuntimeClass)(unsafe.Pointer(&in.RuntimeClasses))
 	return nil
 }
 
-// Convert_platform_Cluster_To_v1_Cluster is an autogenerated conversion function.
-func Convert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
-	return autoConvert_platform_Cluster_To_v1_Cluster(in, out, s)
+// Convert_platform_ContainerRuntimeConfig_To_v1_ContainerRuntimeConfig is an autogenerated conversion function.
+func Convert_platform_ContainerRuntimeConfig_To_v1_ContainerRuntimeConfig(in *platform.ContainerRuntimeConfig, out *ContainerRuntimeConfig, s conversion.Scope) error {
+	return autoConvert_platform_ContainerRuntimeConfig_To_v1_ContainerRuntimeConfig(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
+func autoConvert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_CronHPASpec_To_platform_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_ClusterAddon_To_platform_ClusterAddon is an autogenerated conversion function.
-func Convert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in, out, s)
+// Convert_v1_CronHPA_To_platform_CronHPA is an autogenerated conversion function.
+func Convert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
+	return autoConvert_v1_CronHPA_To_platform_CronHPA(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
+func autoConvert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_CronHPASpec_To_v1_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_ClusterAddon_To_v1_ClusterAddon is an autogenerated conversion function.
-func Convert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in, out, s)
+// Convert_platform_CronHPA_To_v1_CronHPA is an autogenerated conversion function.
+func Convert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
+	return autoConvert_platform_CronHPA_To_v1_CronHPA(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
+func autoConvert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ClusterAddon)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.CronHPA)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ClusterAddonList_To_platform_ClusterAddonList is an autogenerated conversion function.
-func Convert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in, out, s)
+// Convert_v1_CronHPAList_To_platform_CronHPAList is an autogenerated conversion function.
+func Convert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
+	return autoConvert_v1_CronHPAList_To_platform_CronHPAList(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
+func autoConvert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterAddon)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]CronHPA)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ClusterAddonList_To_v1_ClusterAddonList is an autogenerated conversion function.
-func Convert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in, out, s)
+// Convert_platform_CronHPAList_To_v1_CronHPAList is an autogenerated conversion function.
+func Convert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
+	return autoConvert_platform_CronHPAList_To_v1_CronHPAList(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Level = platform.AddonLevel(in.Level)
-	out.Version = in.Version
+func autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec is an autogenerated conversion function.
-func Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in, out, s)
+// Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions is an autogenerated conversion function.
+func Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Level = AddonLevel(in.Level)
-	out.Version = in.Version
+func autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec is an autogenerated conversion function.
-func Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in, out, s)
-}
-
-func autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = in.Phase
-	out.Reason = in.Reason
-	return nil
+// Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
+func Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in, out, s)
 }
 
-// Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus is an autogenerated conversion function.
-func Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in, out, s)
-}
+func autoConvert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
 
-func autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = in.Phase
-	out.Reason = in.Reason
+	if values, ok := map[string][]string(*in)["namespace"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Namespace, s); err != nil {
+			return err
+		}
+	} else {
+		out.Namespace = ""
+	}
+	if values, ok := map[string][]string(*in)["name"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Name, s); err != nil {
+			return err
+		}
+	} else {
+		out.Name = ""
+	}
 	return nil
 }
 
-// Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus is an autogenerated conversion function.
-func Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in, out, s)
+// Convert_url_Values_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_CronHPAProxyOptions(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Type = in.Type
-	out.Level = platform.AddonLevel(in.Level)
-	out.LatestVersion = in.LatestVersion
-	out.Description = in.Description
-	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+func autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
 	return nil
 }
 
-// Convert_v1_ClusterAddonType_To_platform_ClusterAddonType is an autogenerated conversion function.
-func Convert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in, out, s)
+// Convert_v1_CronHPASpec_To_platform_CronHPASpec is an autogenerated conversion function.
+func Convert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
+	return autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Type = in.Type
-	out.Level = AddonLevel(in.Level)
-	out.LatestVersion = in.LatestVersion
-	out.Description = in.Description
-	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+func autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
 	return nil
 }
 
-// Convert_platform_ClusterAddonType_To_v1_ClusterAddonType is an autogenerated conversion function.
-func Convert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in, out, s)
+// Convert_platform_CronHPASpec_To_v1_CronHPASpec is an autogenerated conversion function.
+func Convert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
+	return autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ClusterAddonType)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
 	return nil
 }
 
-// Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList is an autogenerated conversion function.
-func Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in, out, s)
+// Convert_v1_CronHPAStatus_To_platform_CronHPAStatus is an autogenerated conversion function.
+func Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
+	return autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterAddonType)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
 	return nil
 }
 
-// Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList is an autogenerated conversion function.
-func Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in, out, s)
+// Convert_platform_CronHPAStatus_To_v1_CronHPAStatus is an autogenerated conversion function.
+func Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
+	return autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
-	out.Type = platform.AddressType(in.Type)
-	out.Host = in.Host
-	out.Port = in.Port
-	out.Path = in.Path
+func autoConvert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
+	out.Local = (*platform.LocalEtcd)(unsafe.Pointer(in.Local))
+	out.External = (*platform.ExternalEtcd)(unsafe.Pointer(in.External))
 	return nil
 }
 
-// Convert_v1_ClusterAddress_To_platform_ClusterAddress is an autogenerated conversion function.
-func Convert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in, out, s)
+// Convert_v1_Etcd_To_platform_Etcd is an autogenerated conversion function.
+func Convert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
+	return autoConvert_v1_Etcd_To_platform_Etcd(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
-	out.Type = AddressType(in.Type)
-	out.Host = in.Host
-	out.Port = in.Port
-	out.Path = in.Path
+func autoConvert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
+	out.Local = (*LocalEtcd)(unsafe.Pointer(in.Local))
+	out.External = (*ExternalEtcd)(unsafe.Pointer(in.External))
 	return nil
 }
 
-// Convert_platform_ClusterAddress_To_v1_ClusterAddress is an autogenerated conversion function.
-func Convert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in, out, s)
+// Convert_platform_Etcd_To_v1_Etcd is an autogenerated conversion function.
+func Convert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
+	return autoConvert_platform_Etcd_To_v1_Etcd(in, out, s)
 }
 
-func autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
-	out.NotUpdate = in.NotUpdate
+func autoConvert_v1_EtcdBackup_To_platform_EtcdBackup(in *EtcdBackup, out *platform.EtcdBackup, s conversion.Scope) error {
+	out.IntervalInSecond = in.IntervalInSecond
+	out.MaxBackups = in.MaxBackups
+	if err := Convert_v1_EtcdSnapshotTarget_To_platform_EtcdSnapshotTarget(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions is an autogenerated conversion function.
-func Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
-	return autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in, out, s)
+// Convert_v1_EtcdBackup_To_platform_EtcdBackup is an autogenerated conversion function.
+func Convert_v1_EtcdBackup_To_platform_EtcdBackup(in *EtcdBackup, out *platform.EtcdBackup, s conversion.Scope) error {
+	return autoConvert_v1_EtcdBackup_To_platform_EtcdBackup(in, out, s)
 }
 
-func autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
-	out.NotUpdate = in.NotUpdate
+func autoConvert_platform_EtcdBackup_To_v1_EtcdBackup(in *platform.EtcdBackup, out *EtcdBackup, s conversion.Scope) error {
+	out.IntervalInSecond = in.IntervalInSecond
+	out.MaxBackups = in.MaxBackups
+	if err := Convert_platform_EtcdSnapshotTarget_To_v1_EtcdSnapshotTarget(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions is an autogenerated conversion function.
-func Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
-	return autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in, out, s)
+// Convert_platform_EtcdBackup_To_v1_EtcdBackup is an autogenerated conversion function.
+func Convert_platform_EtcdBackup_To_v1_EtcdBackup(in *platform.EtcdBackup, out *EtcdBackup, s conversion.Scope) error {
+	return autoConvert_platform_EtcdBackup_To_v1_EtcdBackup(in, out, s)
 }
 
-func autoConvert_url_Values_To_v1_ClusterApplyOptions(in *url.Values, out *ClusterApplyOptions, s conversion.Scope) error {
-	// WARNING: Field TypeMeta does not have json tag, skipping.
-
-	if values, ok := map[string][]string(*in)["notUpdate"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_bool(&values, &out.NotUpdate, s); err != nil {
-			return err
-		}
-	} else {
-		out.NotUpdate = false
+func autoConvert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(in *EtcdSnapshot, out *platform.EtcdSnapshot, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_EtcdSnapshotSpec_To_platform_EtcdSnapshotSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_EtcdSnapshotStatus_To_platform_EtcdSnapshotStatus(&in.Status, &out.Status, s); err != nil {
+		return err
 	}
 	return nil
 }
 
-// Convert_url_Values_To_v1_ClusterApplyOptions is an autogenerated conversion function.
-func Convert_url_Values_To_v1_ClusterApplyOptions(in *url.Values, out *ClusterApplyOptions, s conversion.Scope) error {
-	return autoConvert_url_Values_To_v1_ClusterApplyOptions(in, out, s)
+// Convert_v1_EtcdSnapshot_To_platform_EtcdSnapshot is an autogenerated conversion function.
+func Convert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(in *EtcdSnapshot, out *platform.EtcdSnapshot, s conversion.Scope) error {
+	return autoConvert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(in, out, s)
 }
 
-func autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
-	out.Type = in.Type
-	if err := Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
+func autoConvert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(in *platform.EtcdSnapshot, out *EtcdSnapshot, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_EtcdSnapshotSpec_To_v1_EtcdSnapshotSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_EtcdSnapshotStatus_To_v1_EtcdSnapshotStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_ClusterComponent_To_platform_ClusterComponent is an autogenerated conversion function.
-func Convert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
-	return autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in, out, s)
+// Convert_platform_EtcdSnapshot_To_v1_EtcdSnapshot is an autogenerated conversion function.
+func Convert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(in *platform.EtcdSnapshot, out *EtcdSnapshot, s conversion.Scope) error {
+	return autoConvert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(in, out, s)
 }
 
-func autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
-	out.Type = in.Type
-	if err := Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
-		return err
-	}
+func autoConvert_v1_EtcdSnapshotList_To_platform_EtcdSnapshotList(in *EtcdSnapshotList, out *platform.EtcdSnapshotList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.EtcdSnapshot)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ClusterComponent_To_v1_ClusterComponent is an autogenerated conversion function.
-func Convert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
-	return autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in, out, s)
+// Convert_v1_EtcdSnapshotList_To_platform_EtcdSnapshotList is an autogenerated conversion function.
+func Convert_v1_EtcdSnapshotList_To_platform_EtcdSnapshotList(in *EtcdSnapshotList, out *platform.EtcdSnapshotList, s conversion.Scope) error {
+	return autoConvert_v1_EtcdSnapshotList_To_platform_EtcdSnapshotList(in, out, s)
 }
 
-func autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
-	out.Desired = in.Desired
-	out.Current = in.Current
-	out.Available = in.Available
-	out.Updated = in.Updated
+func autoConvert_platform_EtcdSnapshotList_To_v1_EtcdSnapshotList(in *platform.EtcdSnapshotList, out *EtcdSnapshotList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]EtcdSnapshot)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas is an autogenerated conversion function.
-func Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
-	return autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in, out, s)
+// Convert_platform_EtcdSnapshotList_To_v1_EtcdSnapshotList is an autogenerated conversion function.
+func Convert_platform_EtcdSnapshotList_To_v1_EtcdSnapshotList(in *platform.EtcdSnapshotList, out *EtcdSnapshotList, s conversion.Scope) error {
+	return autoConvert_platform_EtcdSnapshotList_To_v1_EtcdSnapshotList(in, out, s)
 }
 
-func autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
-	out.Desired = in.Desired
-	out.Current = in.Current
-	out.Available = in.Available
-	out.Updated = in.Updated
+func autoConvert_v1_EtcdSnapshotRestoreOptions_To_platform_EtcdSnapshotRestoreOptions(in *EtcdSnapshotRestoreOptions, out *platform.EtcdSnapshotRestoreOptions, s conversion.Scope) error {
+	out.Confirm = in.Confirm
 	return nil
 }
 
-// Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas is an autogenerated conversion function.
-func Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
-	return autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in, out, s)
+// Convert_v1_EtcdSnapshotRestoreOptions_To_platform_EtcdSnapshotRestoreOptions is an autogenerated conversion function.
+func Convert_v1_EtcdSnapshotRestoreOptions_To_platform_EtcdSnapshotRestoreOptions(in *EtcdSnapshotRestoreOptions, out *platform.EtcdSnapshotRestoreOptions, s conversion.Scope) error {
+	return autoConvert_v1_EtcdSnapshotRestoreOptions_To_platform_EtcdSnapshotRestoreOptions(in, out, s)
 }
 
-func autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = platform.ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
-	out.Reason = in.Reason
-	out.Message = in.Message
+func autoConvert_platform_EtcdSnapshotRestoreOptions_To_v1_EtcdSnapshotRestoreOptions(in *platform.EtcdSnapshotRestoreOptions, out *EtcdSnapshotRestoreOptions, s conversion.Scope) error {
+	out.Confirm = in.Confirm
 	return nil
 }
 
-// Convert_v1_ClusterCondition_To_platform_ClusterCondition is an autogenerated conversion function.
-func Convert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
-	return autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in, out, s)
+// Convert_platform_EtcdSnapshotRestoreOptions_To_v1_EtcdSnapshotRestoreOptions is an autogenerated conversion function.
+func Convert_platform_EtcdSnapshotRestoreOptions_To_v1_EtcdSnapshotRestoreOptions(in *platform.EtcdSnapshotRestoreOptions, out *EtcdSnapshotRestoreOptions, s conversion.Scope) error {
+	return autoConvert_platform_EtcdSnapshotRestoreOptions_To_v1_EtcdSnapshotRestoreOptions(in, out, s)
 }
 
-func autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
-	out.Reason = in.Reason
-	out.Message = in.Message
+func autoConvert_url_Values_To_v1_EtcdSnapshotRestoreOptions(in *url.Values, out *EtcdSnapshotRestoreOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["confirm"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Confirm, s); err != nil {
+			return err
+		}
+	} else {
+		out.Confirm = ""
+	}
 	return nil
 }
 
-// Convert_platform_ClusterCondition_To_v1_ClusterCondition is an autogenerated conversion function.
-func Convert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
-	return autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in, out, s)
+// Convert_url_Values_To_v1_EtcdSnapshotRestoreOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_EtcdSnapshotRestoreOptions(in *url.Values, out *EtcdSnapshotRestoreOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_EtcdSnapshotRestoreOptions(in, out, s)
 }
 
-func autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
+func autoConvert_v1_EtcdSnapshotSpec_To_platform_EtcdSnapshotSpec(in *EtcdSnapshotSpec, out *platform.EtcdSnapshotSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
-	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
-	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
-	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
-	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
-	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
-	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
-	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
-	out.Token = (*string)(unsafe.Pointer(in.Token))
-	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
-	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
-	out.Username = in.Username
-	out.Impersonate = in.Impersonate
-	out.ImpersonateGroups = *(*[]string)(unsafe.Pointer(&in.ImpersonateGroups))
-	out.ImpersonateUserExtra = *(*platform.ImpersonateUserExtra)(unsafe.Pointer(&in.ImpersonateUserExtra))
+	out.Type = platform.EtcdSnapshotType(in.Type)
+	if err := Convert_v1_EtcdSnapshotTarget_To_platform_EtcdSnapshotTarget(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_ClusterCredential_To_platform_ClusterCredential is an autogenerated conversion function.
-func Convert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
-	return autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in, out, s)
+// Convert_v1_EtcdSnapshotSpec_To_platform_EtcdSnapshotSpec is an autogenerated conversion function.
+func Convert_v1_EtcdSnapshotSpec_To_platform_EtcdSnapshotSpec(in *EtcdSnapshotSpec, out *platform.EtcdSnapshotSpec, s conversion.Scope) error {
+	return autoConvert_v1_EtcdSnapshotSpec_To_platform_EtcdSnapshotSpec(in, out, s)
 }
 
-func autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
+func autoConvert_platform_EtcdSnapshotSpec_To_v1_EtcdSnapshotSpec(in *platform.EtcdSnapshotSpec, out *EtcdSnapshotSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
-	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
-	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
-	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
-	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
-	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
-	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
-	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
-	out.Token = (*string)(unsafe.Pointer(in.Token))
-	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
-	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
-	out.Username = in.Username
-	out.Impersonate = in.Impersonate
-	out.ImpersonateGroups = *(*[]string)(unsafe.Pointer(&in.ImpersonateGroups))
-	out.ImpersonateUserExtra = *(*ImpersonateUserExtra)(unsafe.Pointer(&in.ImpersonateUserExtra))
+	out.Type = EtcdSnapshotType(in.Type)
+	if err := Convert_platform_EtcdSnapshotTarget_To_v1_EtcdSnapshotTarget(&in.Target, &out.Target, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_platform_ClusterCredential_To_v1_ClusterCredential is an autogenerated conversion function.
-func Convert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
-	return autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in, out, s)
+// Convert_platform_EtcdSnapshotSpec_To_v1_EtcdSnapshotSpec is an autogenerated conversion function.
+func Convert_platform_EtcdSnapshotSpec_To_v1_EtcdSnapshotSpec(in *platform.EtcdSnapshotSpec, out *EtcdSnapshotSpec, s conversion.Scope) error {
+	return autoConvert_platform_EtcdSnapshotSpec_To_v1_EtcdSnapshotSpec(in, out, s)
 }
 
-func autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ClusterCredential)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_EtcdSnapshotStatus_To_platform_EtcdSnapshotStatus(in *EtcdSnapshotStatus, out *platform.EtcdSnapshotStatus, s conversion.Scope) error {
+	out.Phase = platform.EtcdSnapshotPhase(in.Phase)
+	out.Location = in.Location
+	out.SizeInBytes = in.SizeInBytes
+	out.Revision = in.Revision
+	out.StartTime = in.StartTime
+	out.CompletionTime = in.CompletionTime
+	out.LastRestoreTime = in.LastRestoreTime
+	out.Message = in.Message
+	out.Reason = in.Reason
 	return nil
 }
 
-// Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList is an autogenerated conversion function.
-func Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in, out, s)
+// Convert_v1_EtcdSnapshotStatus_To_platform_EtcdSnapshotStatus is an autogenerated conversion function.
+func Convert_v1_EtcdSnapshotStatus_To_platform_EtcdSnapshotStatus(in *EtcdSnapshotStatus, out *platform.EtcdSnapshotStatus, s conversion.Scope) error {
+	return autoConvert_v1_EtcdSnapshotStatus_To_platform_EtcdSnapshotStatus(in, out, s)
 }
 
-func autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterCredential)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_EtcdSnapshotStatus_To_v1_EtcdSnapshotStatus(in *platform.EtcdSnapshotStatus, out *EtcdSnapshotStatus, s conversion.Scope) error {
+	out.Phase = EtcdSnapshotPhase(in.Phase)
+	out.Location = in.Location
+	out.SizeInBytes = in.SizeInBytes
+	out.Revision = in.Revision
+	out.StartTime = in.StartTime
+	out.CompletionTime = in.CompletionTime
+	out.LastRestoreTime = in.LastRestoreTime
+	out.Message = in.Message
+	out.Reason = in.Reason
 	return nil
 }
 
-// Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList is an autogenerated conversion function.
-func Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in, out, s)
+// Convert_platform_EtcdSnapshotStatus_To_v1_EtcdSnapshotStatus is an autogenerated conversion function.
+func Convert_platform_EtcdSnapshotStatus_To_v1_EtcdSnapshotStatus(in *platform.EtcdSnapshotStatus, out *EtcdSnapshotStatus, s conversion.Scope) error {
+	return autoConvert_platform_EtcdSnapshotStatus_To_v1_EtcdSnapshotStatus(in, out, s)
 }
 
-func autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
-	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
-	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
-	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
-	out.GPUType = (*platform.GPUType)(unsafe.Pointer(in.GPUType))
-	out.EnableMasterSchedule = in.EnableMasterSchedule
-	out.HA = (*platform.HA)(unsafe.Pointer(in.HA))
-	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
-	out.Files = *(*[]platform.File)(unsafe.Pointer(&in.Files))
-	out.Hooks = *(*map[platform.HookType]string)(unsafe.Pointer(&in.Hooks))
-	out.CSIOperator = (*platform.CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
-	out.AuthzWebhookAddr = (*platform.AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
-	out.EnableMetricsServer = in.EnableMetricsServer
-	out.IPv6DualStack = in.IPv6DualStack
-	out.EnableCilium = in.EnableCilium
-	out.ContainerRuntime = in.ContainerRuntime
-	if err := Convert_v1_Upgrade_To_platform_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
-		return err
-	}
+func autoConvert_v1_EtcdSnapshotTarget_To_platform_EtcdSnapshotTarget(in *EtcdSnapshotTarget, out *platform.EtcdSnapshotTarget, s conversion.Scope) error {
+	out.Local = (*platform.LocalSnapshotTarget)(unsafe.Pointer(in.Local))
+	out.S3 = (*platform.S3SnapshotTarget)(unsafe.Pointer(in.S3))
+	out.Registry = (*platform.RegistrySnapshotTarget)(unsafe.Pointer(in.Registry))
 	return nil
 }
 
-// Convert_v1_ClusterFeature_To_platform_ClusterFeature is an autogenerated conversion function.
-func Convert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
-	return autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in, out, s)
+// Convert_v1_EtcdSnapshotTarget_To_platform_EtcdSnapshotTarget is an autogenerated conversion function.
+func Convert_v1_EtcdSnapshotTarget_To_platform_EtcdSnapshotTarget(in *EtcdSnapshotTarget, out *platform.EtcdSnapshotTarget, s conversion.Scope) error {
+	return autoConvert_v1_EtcdSnapshotTarget_To_platform_EtcdSnapshotTarget(in, out, s)
 }
 
-func autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
-	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
-	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
-	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
-	out.GPUType = (*GPUType)(unsafe.Pointer(in.GPUType))
-	out.EnableMasterSchedule = in.EnableMasterSchedule
-	out.HA = (*HA)(unsafe.Pointer(in.HA))
-	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
-	out.Files = *(*[]File)(unsafe.Pointer(&in.Files))
-	out.Hooks = *(*map[HookType]string)(unsafe.Pointer(&in.Hooks))
-	out.CSIOperator = (*CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
-	out.AuthzWebhookAddr = (*AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
-	out.EnableMetricsServer = in.EnableMetricsServer
-	out.EnableCilium = in.EnableCilium
-	out.ContainerRuntime = in.ContainerRuntime
-	out.IPv6DualStack = in.IPv6DualStack
-	if err := Convert_platform_Upgrade_To_v1_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
-		return err
-	}
+func autoConvert_platform_EtcdSnapshotTarget_To_v1_EtcdSnapshotTarget(in *platform.EtcdSnapshotTarget, out *EtcdSnapshotTarget, s conversion.Scope) error {
+	out.Local = (*LocalSnapshotTarget)(unsafe.Pointer(in.Local))
+	out.S3 = (*S3SnapshotTarget)(unsafe.Pointer(in.S3))
+	out.Registry = (*RegistrySnapshotTarget)(unsafe.Pointer(in.Registry))
+	return nil
+}
+
+// Convert_platform_EtcdSnapshotTarget_To_v1_EtcdSnapshotTarget is an autogenerated conversion function.
+func Convert_platform_EtcdSnapshotTarget_To_v1_EtcdSnapshotTarget(in *platform.EtcdSnapshotTarget, out *EtcdSnapshotTarget, s conversion.Scope) error {
+	return autoConvert_platform_EtcdSnapshotTarget_To_v1_EtcdSnapshotTarget(in, out, s)
+}
+
+func autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
 	return nil
 }
 
-// Convert_platform_ClusterFeature_To_v1_ClusterFeature is an autogenerated conversion function.
-func Convert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
-	return autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in, out, s)
+// Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in, out, s)
 }
 
-func autoConvert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem(in *ClusterGroupAPIResourceItem, out *platform.ClusterGroupAPIResourceItem, s conversion.Scope) error {
-	out.Name = in.Name
-	out.SingularName = in.SingularName
-	out.Namespaced = in.Namespaced
-	out.Group = in.Group
-	out.Version = in.Version
-	out.Kind = in.Kind
-	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
-	out.ShortNames = *(*[]string)(unsafe.Pointer(&in.ShortNames))
-	out.Categories = *(*[]string)(unsafe.Pointer(&in.Categories))
+func autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.CAFile = in.CAFile
+	out.CertFile = in.CertFile
+	out.KeyFile = in.KeyFile
 	return nil
 }
 
-// Convert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem is an autogenerated conversion function.
-func Convert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem(in *ClusterGroupAPIResourceItem, out *platform.ClusterGroupAPIResourceItem, s conversion.Scope) error {
-	return autoConvert_v1_ClusterGroupAPIResourceItem_To_platform_ClusterGroupAPIResourceItem(in, out, s)
+// Convert_v1_ExternalEtcd_To_platform_ExternalEtcd is an autogenerated conversion function.
+func Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
+	return autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in, out, s)
 }
 
-func autoConvert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem(in *platform.ClusterGroupAPIResourceItem, out *ClusterGroupAPIResourceItem, s conversion.Scope) error {
-	out.Name = in.Name
-	out.SingularName = in.SingularName
-	out.Namespaced = in.Namespaced
-	out.Group = in.Group
-	out.Version = in.Version
-	out.Kind = in.Kind
-	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
-	out.ShortNames = *(*[]string)(unsafe.Pointer(&in.ShortNames))
-	out.Categories = *(*[]string)(unsafe.Pointer(&in.Categories))
+func autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.CAFile = in.CAFile
+	out.CertFile = in.CertFile
+	out.KeyFile = in.KeyFile
 	return nil
 }
 
-// Convert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem is an autogenerated conversion function.
-func Convert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem(in *platform.ClusterGroupAPIResourceItem, out *ClusterGroupAPIResourceItem, s conversion.Scope) error {
-	return autoConvert_platform_ClusterGroupAPIResourceItem_To_v1_ClusterGroupAPIResourceItem(in, out, s)
+// Convert_platform_ExternalEtcd_To_v1_ExternalEtcd is an autogenerated conversion function.
+func Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
+	return autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in, out, s)
 }
 
-func autoConvert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems(in *ClusterGroupAPIResourceItems, out *platform.ClusterGroupAPIResourceItems, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.GroupVersion = in.GroupVersion
-	out.APIResources = *(*[]platform.ClusterGroupAPIResourceItem)(unsafe.Pointer(&in.APIResources))
+func autoConvert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
+	out.Src = in.Src
+	out.Dst = in.Dst
 	return nil
 }
 
-// Convert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems is an autogenerated conversion function.
-func Convert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems(in *ClusterGroupAPIResourceItems, out *platform.ClusterGroupAPIResourceItems, s conversion.Scope) error {
-	return autoConvert_v1_ClusterGroupAPIResourceItems_To_platform_ClusterGroupAPIResourceItems(in, out, s)
+// Convert_v1_File_To_platform_File is an autogenerated conversion function.
+func Convert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
+	return autoConvert_v1_File_To_platform_File(in, out, s)
 }
 
-func autoConvert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems(in *platform.ClusterGroupAPIResourceItems, out *ClusterGroupAPIResourceItems, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.GroupVersion = in.GroupVersion
-	out.APIResources = *(*[]ClusterGroupAPIResourceItem)(unsafe.Pointer(&in.APIResources))
+func autoConvert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
+	out.Src = in.Src
+	out.Dst = in.Dst
 	return nil
 }
 
-// Convert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems is an autogenerated conversion function.
-func Convert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems(in *platform.ClusterGroupAPIResourceItems, out *ClusterGroupAPIResourceItems, s conversion.Scope) error {
-	return autoConvert_platform_ClusterGroupAPIResourceItems_To_v1_ClusterGroupAPIResourceItems(in, out, s)
+// Convert_platform_File_To_v1_File is an autogenerated conversion function.
+func Convert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
+	return autoConvert_platform_File_To_v1_File(in, out, s)
 }
 
-func autoConvert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList(in *ClusterGroupAPIResourceItemsList, out *platform.ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ClusterGroupAPIResourceItems)(unsafe.Pointer(&in.Items))
-	out.FailedGroupError = in.FailedGroupError
+func autoConvert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
+	out.TKEHA = (*platform.TKEHA)(unsafe.Pointer(in.TKEHA))
+	out.ThirdPartyHA = (*platform.ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
+	out.KubeVIPHA = (*platform.KubeVIPHA)(unsafe.Pointer(in.KubeVIPHA))
 	return nil
 }
 
-// Convert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList is an autogenerated conversion function.
-func Convert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList(in *ClusterGroupAPIResourceItemsList, out *platform.ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterGroupAPIResourceItemsList_To_platform_ClusterGroupAPIResourceItemsList(in, out, s)
+// Convert_v1_HA_To_platform_HA is an autogenerated conversion function.
+func Convert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
+	return autoConvert_v1_HA_To_platform_HA(in, out, s)
 }
 
-func autoConvert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList(in *platform.ClusterGroupAPIResourceItemsList, out *ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterGroupAPIResourceItems)(unsafe.Pointer(&in.Items))
-	out.FailedGroupError = in.FailedGroupError
+func autoConvert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
+	out.TKEHA = (*TKEHA)(unsafe.Pointer(in.TKEHA))
+	out.ThirdPartyHA = (*ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
+	out.KubeVIPHA = (*KubeVIPHA)(unsafe.Pointer(in.KubeVIPHA))
 	return nil
 }
 
-// Convert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList is an autogenerated conversion function.
-func Convert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList(in *platform.ClusterGroupAPIResourceItemsList, out *ClusterGroupAPIResourceItemsList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterGroupAPIResourceItemsList_To_v1_ClusterGroupAPIResourceItemsList(in, out, s)
+// Convert_platform_HA_To_v1_HA is an autogenerated conversion function.
+func Convert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
+	return autoConvert_platform_HA_To_v1_HA(in, out, s)
 }
 
-func autoConvert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions(in *ClusterGroupAPIResourceOptions, out *platform.ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+func autoConvert_v1_HandlerRecord_To_platform_HandlerRecord(in *HandlerRecord, out *platform.HandlerRecord, s conversion.Scope) error {
+	out.Name = in.Name
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Attempts = in.Attempts
+	out.LastError = in.LastError
+	out.Output = in.Output
 	return nil
 }
 
-// Convert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions is an autogenerated conversion function.
-func Convert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions(in *ClusterGroupAPIResourceOptions, out *platform.ClusterGroupAPIResourceOptions, s conversion.Scope) error {
-	return autoConvert_v1_ClusterGroupAPIResourceOptions_To_platform_ClusterGroupAPIResourceOptions(in, out, s)
+// Convert_v1_HandlerRecord_To_platform_HandlerRecord is an autogenerated conversion function.
+func Convert_v1_HandlerRecord_To_platform_HandlerRecord(in *HandlerRecord, out *platform.HandlerRecord, s conversion.Scope) error {
+	return autoConvert_v1_HandlerRecord_To_platform_HandlerRecord(in, out, s)
 }
 
-func autoConvert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions(in *platform.ClusterGroupAPIResourceOptions, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
+func autoConvert_platform_HandlerRecord_To_v1_HandlerRecord(in *platform.HandlerRecord, out *HandlerRecord, s conversion.Scope) error {
+	out.Name = in.Name
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Attempts = in.Attempts
+	out.LastError = in.LastError
+	out.Output = in.Output
 	return nil
 }
 
-// Convert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions is an autogenerated conversion function.
-func Convert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions(in *platform.ClusterGroupAPIResourceOptions, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
-	return autoConvert_platform_ClusterGroupAPIResourceOptions_To_v1_ClusterGroupAPIResourceOptions(in, out, s)
+// Convert_platform_HandlerRecord_To_v1_HandlerRecord is an autogenerated conversion function.
+func Convert_platform_HandlerRecord_To_v1_HandlerRecord(in *platform.HandlerRecord, out *HandlerRecord, s conversion.Scope) error {
+	return autoConvert_platform_HandlerRecord_To_v1_HandlerRecord(in, out, s)
 }
 
-func autoConvert_url_Values_To_v1_ClusterGroupAPIResourceOptions(in *url.Values, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
-	// WARNING: Field TypeMeta does not have json tag, skipping.
+func autoConvert_v1_Host_To_platform_Host(in *Host, out *platform.Host, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_HostSpec_To_platform_HostSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_HostStatus_To_platform_HostStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Host_To_platform_Host is an autogenerated conversion function.
+func Convert_v1_Host_To_platform_Host(in *Host, out *platform.Host, s conversion.Scope) error {
+	return autoConvert_v1_Host_To_platform_Host(in, out, s)
+}
 
+func autoConvert_platform_Host_To_v1_Host(in *platform.Host, out *Host, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_HostSpec_To_v1_HostSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_HostStatus_To_v1_HostStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_url_Values_To_v1_ClusterGroupAPIResourceOptions is an autogenerated conversion function.
-func Convert_url_Values_To_v1_ClusterGroupAPIResourceOptions(in *url.Values, out *ClusterGroupAPIResourceOptions, s conversion.Scope) error {
-	return autoConvert_url_Values_To_v1_ClusterGroupAPIResourceOptions(in, out, s)
+// Convert_platform_Host_To_v1_Host is an autogenerated conversion function.
+func Convert_platform_Host_To_v1_Host(in *platform.Host, out *Host, s conversion.Scope) error {
+	return autoConvert_platform_Host_To_v1_Host(in, out, s)
 }
 
-func autoConvert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
+func autoConvert_v1_HostList_To_platform_HostList(in *HostList, out *platform.HostList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]platform.Cluster, len(*in))
-		for i := range *in {
-			if err := Convert_v1_Cluster_To_platform_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
-	}
+	out.Items = *(*[]platform.Host)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ClusterList_To_platform_ClusterList is an autogenerated conversion function.
-func Convert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterList_To_platform_ClusterList(in, out, s)
+// Convert_v1_HostList_To_platform_HostList is an autogenerated conversion function.
+func Convert_v1_HostList_To_platform_HostList(in *HostList, out *platform.HostList, s conversion.Scope) error {
+	return autoConvert_v1_HostList_To_platform_HostList(in, out, s)
 }
 
-func autoConvert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
+func autoConvert_platform_HostList_To_v1_HostList(in *platform.HostList, out *HostList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
-		for i := range *in {
-			if err := Convert_platform_Cluster_To_v1_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
-	}
+	out.Items = *(*[]Host)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ClusterList_To_v1_ClusterList is an autogenerated conversion function.
-func Convert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterList_To_v1_ClusterList(in, out, s)
+// Convert_platform_HostList_To_v1_HostList is an autogenerated conversion function.
+func Convert_platform_HostList_To_v1_HostList(in *platform.HostList, out *HostList, s conversion.Scope) error {
+	return autoConvert_platform_HostList_To_v1_HostList(in, out, s)
 }
 
-func autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
+func autoConvert_v1_HostSpec_To_platform_HostSpec(in *HostSpec, out *platform.HostSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
 	out.IP = in.IP
 	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.CredentialName = in.CredentialName
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
-	if err := Convert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(&in.Proxy, &out.Proxy, s); err != nil {
-		return err
-	}
 	return nil
 }
 
-// Convert_v1_ClusterMachine_To_platform_ClusterMachine is an autogenerated conversion function.
-func Convert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
-	return autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in, out, s)
+// Convert_v1_HostSpec_To_platform_HostSpec is an autogenerated conversion function.
+func Convert_v1_HostSpec_To_platform_HostSpec(in *HostSpec, out *platform.HostSpec, s conversion.Scope) error {
+	return autoConvert_v1_HostSpec_To_platform_HostSpec(in, out, s)
 }
 
-func autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
+func autoConvert_platform_HostSpec_To_v1_HostSpec(in *platform.HostSpec, out *HostSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
 	out.IP = in.IP
 	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.CredentialName = in.CredentialName
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
-	if err := Convert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(&in.Proxy, &out.Proxy, s); err != nil {
+	return nil
+}
+
+// Convert_platform_HostSpec_To_v1_HostSpec is an autogenerated conversion function.
+func Convert_platform_HostSpec_To_v1_HostSpec(in *platform.HostSpec, out *HostSpec, s conversion.Scope) error {
+	return autoConvert_platform_HostSpec_To_v1_HostSpec(in, out, s)
+}
+
+func autoConvert_v1_HostStatus_To_platform_HostStatus(in *HostStatus, out *platform.HostStatus, s conversion.Scope) error {
+	out.Phase = platform.HostPhase(in.Phase)
+	out.LastProbeTime = in.LastProbeTime
+	if err := Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
 		return err
 	}
+	out.Capacity = *(*platform.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Message = in.Message
+	out.Reason = in.Reason
 	return nil
 }
 
-// Convert_platform_ClusterMachine_To_v1_ClusterMachine is an autogenerated conversion function.
-func Convert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
-	return autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in, out, s)
+// Convert_v1_HostStatus_To_platform_HostStatus is an autogenerated conversion function.
+func Convert_v1_HostStatus_To_platform_HostStatus(in *HostStatus, out *platform.HostStatus, s conversion.Scope) error {
+	return autoConvert_v1_HostStatus_To_platform_HostStatus(in, out, s)
 }
 
-func autoConvert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(in *ClusterMachineProxy, out *platform.ClusterMachineProxy, s conversion.Scope) error {
-	out.Type = platform.ProxyType(in.Type)
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+func autoConvert_platform_HostStatus_To_v1_HostStatus(in *platform.HostStatus, out *HostStatus, s conversion.Scope) error {
+	out.Phase = HostPhase(in.Phase)
+	out.LastProbeTime = in.LastProbeTime
+	if err := Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
+		return err
+	}
+	out.Capacity = *(*ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Message = in.Message
+	out.Reason = in.Reason
 	return nil
 }
 
-// Convert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy is an autogenerated conversion function.
-func Convert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(in *ClusterMachineProxy, out *platform.ClusterMachineProxy, s conversion.Scope) error {
-	return autoConvert_v1_ClusterMachineProxy_To_platform_ClusterMachineProxy(in, out, s)
+// Convert_platform_HostStatus_To_v1_HostStatus is an autogenerated conversion function.
+func Convert_platform_HostStatus_To_v1_HostStatus(in *platform.HostStatus, out *HostStatus, s conversion.Scope) error {
+	return autoConvert_platform_HostStatus_To_v1_HostStatus(in, out, s)
 }
 
-func autoConvert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(in *platform.ClusterMachineProxy, out *ClusterMachineProxy, s conversion.Scope) error {
-	out.Type = ProxyType(in.Type)
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+func autoConvert_v1_KubeVIPHA_To_platform_KubeVIPHA(in *KubeVIPHA, out *platform.KubeVIPHA, s conversion.Scope) error {
+	out.VIP = in.VIP
+	out.Interface = in.Interface
+	out.Mode = platform.KubeVIPMode(in.Mode)
+	out.BGP = (*platform.BGPConfig)(unsafe.Pointer(in.BGP))
 	return nil
 }
 
-// Convert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy is an autogenerated conversion function.
-func Convert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(in *platform.ClusterMachineProxy, out *ClusterMachineProxy, s conversion.Scope) error {
-	return autoConvert_platform_ClusterMachineProxy_To_v1_ClusterMachineProxy(in, out, s)
+// Convert_v1_KubeVIPHA_To_platform_KubeVIPHA is an autogenerated conversion function.
+func Convert_v1_KubeVIPHA_To_platform_KubeVIPHA(in *KubeVIPHA, out *platform.KubeVIPHA, s conversion.Scope) error {
+	return autoConvert_v1_KubeVIPHA_To_platform_KubeVIPHA(in, out, s)
 }
 
-func autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
-	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
-	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
-	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+func autoConvert_platform_KubeVIPHA_To_v1_KubeVIPHA(in *platform.KubeVIPHA, out *KubeVIPHA, s conversion.Scope) error {
+	out.VIP = in.VIP
+	out.Interface = in.Interface
+	out.Mode = KubeVIPMode(in.Mode)
+	out.BGP = (*BGPConfig)(unsafe.Pointer(in.BGP))
 	return nil
 }
 
-// Convert_v1_ClusterProperty_To_platform_ClusterProperty is an autogenerated conversion function.
-func Convert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
-	return autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in, out, s)
+// Convert_platform_KubeVIPHA_To_v1_KubeVIPHA is an autogenerated conversion function.
+func Convert_platform_KubeVIPHA_To_v1_KubeVIPHA(in *platform.KubeVIPHA, out *KubeVIPHA, s conversion.Scope) error {
+	return autoConvert_platform_KubeVIPHA_To_v1_KubeVIPHA(in, out, s)
 }
 
-func autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
-	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
-	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
-	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+func autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
+	out.DataDir = in.DataDir
+	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
+	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
+	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
 	return nil
 }
 
-// Convert_platform_ClusterProperty_To_v1_ClusterProperty is an autogenerated conversion function.
-func Convert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
-	return autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in, out, s)
+// Convert_v1_LocalEtcd_To_platform_LocalEtcd is an autogenerated conversion function.
+func Convert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
+	return autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in, out, s)
+}
+
+func autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
+	out.DataDir = in.DataDir
+	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
+	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
+	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
+	return nil
+}
+
+// Convert_platform_LocalEtcd_To_v1_LocalEtcd is an autogenerated conversion function.
+func Convert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
+	return autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in, out, s)
 }
 
-func autoConvert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
-	out.Capacity = *(*platform.ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.Allocated = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocated))
+func autoConvert_v1_LocalSnapshotTarget_To_platform_LocalSnapshotTarget(in *LocalSnapshotTarget, out *platform.LocalSnapshotTarget, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Dir = in.Dir
 	return nil
 }
 
-// Convert_v1_ClusterResource_To_platform_ClusterResource is an autogenerated conversion function.
-func Convert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
-	return autoConvert_v1_ClusterResource_To_platform_ClusterResource(in, out, s)
+// Convert_v1_LocalSnapshotTarget_To_platform_LocalSnapshotTarget is an autogenerated conversion function.
+func Convert_v1_LocalSnapshotTarget_To_platform_LocalSnapshotTarget(in *LocalSnapshotTarget, out *platform.LocalSnapshotTarget, s conversion.Scope) error {
+	return autoConvert_v1_LocalSnapshotTarget_To_platform_LocalSnapshotTarget(in, out, s)
 }
 
-func autoConvert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
-	out.Capacity = *(*ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.Allocated = *(*ResourceList)(unsafe.Pointer(&in.Allocated))
+func autoConvert_platform_LocalSnapshotTarget_To_v1_LocalSnapshotTarget(in *platform.LocalSnapshotTarget, out *LocalSnapshotTarget, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Dir = in.Dir
 	return nil
 }
 
-// Convert_platform_ClusterResource_To_v1_ClusterResource is an autogenerated conversion function.
-func Convert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
-	return autoConvert_platform_ClusterResource_To_v1_ClusterResource(in, out, s)
+// Convert_platform_LocalSnapshotTarget_To_v1_LocalSnapshotTarget is an autogenerated conversion function.
+func Convert_platform_LocalSnapshotTarget_To_v1_LocalSnapshotTarget(in *platform.LocalSnapshotTarget, out *LocalSnapshotTarget, s conversion.Scope) error {
+	return autoConvert_platform_LocalSnapshotTarget_To_v1_LocalSnapshotTarget(in, out, s)
 }
 
-func autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
-	out.TenantID = in.TenantID
-	out.DisplayName = in.DisplayName
-	out.Type = in.Type
-	out.Version = in.Version
-	out.NetworkType = platform.NetworkType(in.NetworkType)
-	out.NetworkDevice = in.NetworkDevice
-	out.ClusterCIDR = in.ClusterCIDR
-	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
-	out.DNSDomain = in.DNSDomain
-	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
-	if err := Convert_v1_ClusterFeature_To_platform_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+func autoConvert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_MachineSpec_To_platform_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_ClusterProperty_To_platform_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+	if err := Convert_v1_MachineStatus_To_platform_MachineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
-	out.Machines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.Machines))
-	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
-	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
-	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
-	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
-	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
-	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
-	out.Etcd = (*platform.Etcd)(unsafe.Pointer(in.Etcd))
-	out.HostnameAsNodename = in.HostnameAsNodename
-	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
-	out.ScalingMachines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
-	out.BootstrapApps = *(*platform.BootstrapApps)(unsafe.Pointer(&in.BootstrapApps))
-	out.AppVersion = in.AppVersion
 	return nil
 }
 
-// Convert_v1_ClusterSpec_To_platform_ClusterSpec is an autogenerated conversion function.
-func Convert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
-	return autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in, out, s)
+// Convert_v1_Machine_To_platform_Machine is an autogenerated conversion function.
+func Convert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
+	return autoConvert_v1_Machine_To_platform_Machine(in, out, s)
 }
 
-func autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
-	out.TenantID = in.TenantID
-	out.DisplayName = in.DisplayName
-	out.Type = in.Type
-	out.Version = in.Version
-	out.NetworkType = NetworkType(in.NetworkType)
-	out.NetworkDevice = in.NetworkDevice
-	out.ClusterCIDR = in.ClusterCIDR
-	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
-	out.DNSDomain = in.DNSDomain
-	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
-	if err := Convert_platform_ClusterFeature_To_v1_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+func autoConvert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_MachineSpec_To_v1_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_ClusterProperty_To_v1_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+	if err := Convert_platform_MachineStatus_To_v1_MachineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
-	out.Machines = *(*[]ClusterMachine)(unsafe.Pointer(&in.Machines))
-	out.ScalingMachines = *(*[]ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
-	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
-	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
-	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
-	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
-	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
-	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
-	out.Etcd = (*Etcd)(unsafe.Pointer(in.Etcd))
-	out.HostnameAsNodename = in.HostnameAsNodename
-	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
-	out.BootstrapApps = *(*BootstrapApps)(unsafe.Pointer(&in.BootstrapApps))
-	out.AppVersion = in.AppVersion
 	return nil
 }
 
-// Convert_platform_ClusterSpec_To_v1_ClusterSpec is an autogenerated conversion function.
-func Convert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
-	return autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in, out, s)
+// Convert_platform_Machine_To_v1_Machine is an autogenerated conversion function.
+func Convert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
+	return autoConvert_platform_Machine_To_v1_Machine(in, out, s)
 }
 
-func autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Version = in.Version
-	out.Phase = platform.ClusterPhase(in.Phase)
-	out.Conditions = *(*[]platform.ClusterCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
-	out.Reason = in.Reason
-	out.Addresses = *(*[]platform.ClusterAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_v1_ClusterResource_To_platform_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
-		return err
-	}
-	out.Components = *(*[]platform.ClusterComponent)(unsafe.Pointer(&in.Components))
-	out.ServiceCIDR = in.ServiceCIDR
-	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
-	out.DNSIP = in.DNSIP
-	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
-	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
-	out.ClusterCIDR = in.ClusterCIDR
-	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
-	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
-	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
-	out.KubeVendor = platform.KubeVendorType(in.KubeVendor)
-	out.AppVersion = in.AppVersion
-	out.ComponentPhase = platform.ComponentPhase(in.ComponentPhase)
+func autoConvert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
+	out.Type = platform.MachineAddressType(in.Type)
+	out.Address = in.Address
 	return nil
 }
 
-// Convert_v1_ClusterStatus_To_platform_ClusterStatus is an autogenerated conversion function.
-func Convert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in, out, s)
+// Convert_v1_MachineAddress_To_platform_MachineAddress is an autogenerated conversion function.
+func Convert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
+	return autoConvert_v1_MachineAddress_To_platform_MachineAddress(in, out, s)
 }
 
-func autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Version = in.Version
-	out.Phase = ClusterPhase(in.Phase)
-	out.Conditions = *(*[]ClusterCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
-	out.Reason = in.Reason
-	out.Addresses = *(*[]ClusterAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_platform_ClusterResource_To_v1_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
-		return err
-	}
-	out.Components = *(*[]ClusterComponent)(unsafe.Pointer(&in.Components))
-	out.ServiceCIDR = in.ServiceCIDR
-	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
-	out.DNSIP = in.DNSIP
-	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
-	out.ClusterCIDR = in.ClusterCIDR
-	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
-	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
-	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
-	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
-	out.KubeVendor = KubeVendorType(in.KubeVendor)
-	out.AppVersion = in.AppVersion
-	out.ComponentPhase = ComponentPhase(in.ComponentPhase)
+func autoConvert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
+	out.Type = MachineAddressType(in.Type)
+	out.Address = in.Address
 	return nil
 }
 
-// Convert_platform_ClusterStatus_To_v1_ClusterStatus is an autogenerated conversion function.
-func Convert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
-	return autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in, out, s)
+// Convert_platform_MachineAddress_To_v1_MachineAddress is an autogenerated conversion function.
+func Convert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
+	return autoConvert_platform_MachineAddress_To_v1_MachineAddress(in, out, s)
 }
 
-func autoConvert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
-	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
+func autoConvert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = platform.ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_v1_ConfigMap_To_platform_ConfigMap is an autogenerated conversion function.
-func Convert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
-	return autoConvert_v1_ConfigMap_To_platform_ConfigMap(in, out, s)
+// Convert_v1_MachineCondition_To_platform_MachineCondition is an autogenerated conversion function.
+func Convert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
+	return autoConvert_v1_MachineCondition_To_platform_MachineCondition(in, out, s)
 }
 
-func autoConvert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
-	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
+func autoConvert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_platform_ConfigMap_To_v1_ConfigMap is an autogenerated conversion function.
-func Convert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
-	return autoConvert_platform_ConfigMap_To_v1_ConfigMap(in, out, s)
+// Convert_platform_MachineCondition_To_v1_MachineCondition is an autogenerated conversion function.
+func Convert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
+	return autoConvert_platform_MachineCondition_To_v1_MachineCondition(in, out, s)
 }
 
-func autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
+func autoConvert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ConfigMap)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.Machine)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ConfigMapList_To_platform_ConfigMapList is an autogenerated conversion function.
-func Convert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
-	return autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in, out, s)
+// Convert_v1_MachineList_To_platform_MachineList is an autogenerated conversion function.
+func Convert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
+	return autoConvert_v1_MachineList_To_platform_MachineList(in, out, s)
 }
 
-func autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+func autoConvert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ConfigMap)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]Machine)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ConfigMapList_To_v1_ConfigMapList is an autogenerated conversion function.
-func Convert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
-	return autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in, out, s)
+// Convert_platform_MachineList_To_v1_MachineList is an autogenerated conversion function.
+func Convert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
+	return autoConvert_platform_MachineList_To_v1_MachineList(in, out, s)
 }
 
-func autoConvert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
+func autoConvert_v1_MachinePool_To_platform_MachinePool(in *MachinePool, out *platform.MachinePool, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_CronHPASpec_To_platform_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_MachinePoolSpec_To_platform_MachinePoolSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_MachinePoolStatus_To_platform_MachinePoolStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_CronHPA_To_platform_CronHPA is an autogenerated conversion function.
-func Convert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
-	return autoConvert_v1_CronHPA_To_platform_CronHPA(in, out, s)
+// Convert_v1_MachinePool_To_platform_MachinePool is an autogenerated conversion function.
+func Convert_v1_MachinePool_To_platform_MachinePool(in *MachinePool, out *platform.MachinePool, s conversion.Scope) error {
+	return autoConvert_v1_MachinePool_To_platform_MachinePool(in, out, s)
 }
 
-func autoConvert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
+func autoConvert_platform_MachinePool_To_v1_MachinePool(in *platform.MachinePool, out *MachinePool, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_CronHPASpec_To_v1_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_MachinePoolSpec_To_v1_MachinePoolSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_MachinePoolStatus_To_v1_MachinePoolStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_CronHPA_To_v1_CronHPA is an autogenerated conversion function.
-func Convert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
-	return autoConvert_platform_CronHPA_To_v1_CronHPA(in, out, s)
+// Convert_platform_MachinePool_To_v1_MachinePool is an autogenerated conversion function.
+func Convert_platform_MachinePool_To_v1_MachinePool(in *platform.MachinePool, out *MachinePool, s conversion.Scope) error {
+	return autoConvert_platform_MachinePool_To_v1_MachinePool(in, out, s)
 }
 
-func autoConvert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
+func autoConvert_v1_MachinePoolList_To_platform_MachinePoolList(in *MachinePoolList, out *platform.MachinePoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.CronHPA)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.MachinePool)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_CronHPAList_To_platform_CronHPAList is an autogenerated conversion function.
-func Convert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
-	return autoConvert_v1_CronHPAList_To_platform_CronHPAList(in, out, s)
+// Convert_v1_MachinePoolList_To_platform_MachinePoolList is an autogenerated conversion function.
+func Convert_v1_MachinePoolList_To_platform_MachinePoolList(in *MachinePoolList, out *platform.MachinePoolList, s conversion.Scope) error {
+	return autoConvert_v1_MachinePoolList_To_platform_MachinePoolList(in, out, s)
 }
 
-func autoConvert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
+func autoConvert_platform_MachinePoolList_To_v1_MachinePoolList(in *platform.MachinePoolList, out *MachinePoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]CronHPA)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]MachinePool)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_CronHPAList_To_v1_CronHPAList is an autogenerated conversion function.
-func Convert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
-	return autoConvert_platform_CronHPAList_To_v1_CronHPAList(in, out, s)
+// Convert_platform_MachinePoolList_To_v1_MachinePoolList is an autogenerated conversion function.
+func Convert_platform_MachinePoolList_To_v1_MachinePoolList(in *platform.MachinePoolList, out *MachinePoolList, s conversion.Scope) error {
+	return autoConvert_platform_MachinePoolList_To_v1_MachinePoolList(in, out, s)
 }
 
-func autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_v1_MachinePoolSpec_To_platform_MachinePoolSpec(in *MachinePoolSpec, out *platform.MachinePoolSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Type = in.Type
+	out.Replicas = in.Replicas
+	out.HostSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.HostSelector))
+	if err := Convert_v1_MachineTemplateSpec_To_platform_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions is an autogenerated conversion function.
-func Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in, out, s)
+// Convert_v1_MachinePoolSpec_To_platform_MachinePoolSpec is an autogenerated conversion function.
+func Convert_v1_MachinePoolSpec_To_platform_MachinePoolSpec(in *MachinePoolSpec, out *platform.MachinePoolSpec, s conversion.Scope) error {
+	return autoConvert_v1_MachinePoolSpec_To_platform_MachinePoolSpec(in, out, s)
+}
+
+func autoConvert_platform_MachinePoolSpec_To_v1_MachinePoolSpec(in *platform.MachinePoolSpec, out *MachinePoolSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Type = in.Type
+	out.Replicas = in.Replicas
+	out.HostSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.HostSelector))
+	if err := Convert_platform_MachineTemplateSpec_To_v1_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
 }
 
-func autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
-	return nil
+// Convert_platform_MachinePoolSpec_To_v1_MachinePoolSpec is an autogenerated conversion function.
+func Convert_platform_MachinePoolSpec_To_v1_MachinePoolSpec(in *platform.MachinePoolSpec, out *MachinePoolSpec, s conversion.Scope) error {
+	return autoConvert_platform_MachinePoolSpec_To_v1_MachinePoolSpec(in, out, s)
 }
 
-// Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
-func Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in, out, s)
+func autoConvert_v1_MachinePoolStatus_To_platform_MachinePoolStatus(in *MachinePoolStatus, out *platform.MachinePoolStatus, s conversion.Scope) error {
+	out.Phase = platform.MachinePoolPhase(in.Phase)
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableHosts = in.AvailableHosts
+	out.Message = in.Message
+	out.Reason = in.Reason
+	return nil
 }
 
-func autoConvert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
-	// WARNING: Field TypeMeta does not have json tag, skipping.
+// Convert_v1_MachinePoolStatus_To_platform_MachinePoolStatus is an autogenerated conversion function.
+func Convert_v1_MachinePoolStatus_To_platform_MachinePoolStatus(in *MachinePoolStatus, out *platform.MachinePoolStatus, s conversion.Scope) error {
+	return autoConvert_v1_MachinePoolStatus_To_platform_MachinePoolStatus(in, out, s)
+}
 
-	if values, ok := map[string][]string(*in)["namespace"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_string(&values, &out.Namespace, s); err != nil {
-			return err
-		}
-	} else {
-		out.Namespace = ""
-	}
-	if values, ok := map[string][]string(*in)["name"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_string(&values, &out.Name, s); err != nil {
-			return err
-		}
-	} else {
-		out.Name = ""
-	}
+func autoConvert_platform_MachinePoolStatus_To_v1_MachinePoolStatus(in *platform.MachinePoolStatus, out *MachinePoolStatus, s conversion.Scope) error {
+	out.Phase = MachinePoolPhase(in.Phase)
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableHosts = in.AvailableHosts
+	out.Message = in.Message
+	out.Reason = in.Reason
 	return nil
 }
 
-// Convert_url_Values_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
-func Convert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
-	return autoConvert_url_Values_To_v1_CronHPAProxyOptions(in, out, s)
+// Convert_platform_MachinePoolStatus_To_v1_MachinePoolStatus is an autogenerated conversion function.
+func Convert_platform_MachinePoolStatus_To_v1_MachinePoolStatus(in *platform.MachinePoolStatus, out *MachinePoolStatus, s conversion.Scope) error {
+	return autoConvert_platform_MachinePoolStatus_To_v1_MachinePoolStatus(in, out, s)
 }
 
-func autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
+func autoConvert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+	out.Type = in.Type
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.CredentialName = in.CredentialName
+	out.ContainerRuntimeConfig = (*platform.ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
 	return nil
 }
 
-// Convert_v1_CronHPASpec_To_platform_CronHPASpec is an autogenerated conversion function.
-func Convert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
-	return autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in, out, s)
+// Convert_v1_MachineSpec_To_platform_MachineSpec is an autogenerated conversion function.
+func Convert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
+	return autoConvert_v1_MachineSpec_To_platform_MachineSpec(in, out, s)
 }
 
-func autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
+func autoConvert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+	out.Type = in.Type
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.CredentialName = in.CredentialName
+	out.ContainerRuntimeConfig = (*ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
 	return nil
 }
 
-// Convert_platform_CronHPASpec_To_v1_CronHPASpec is an autogenerated conversion function.
-func Convert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
-	return autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in, out, s)
+// Convert_platform_MachineSpec_To_v1_MachineSpec is an autogenerated conversion function.
+func Convert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
+	return autoConvert_platform_MachineSpec_To_v1_MachineSpec(in, out, s)
 }
 
-func autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = platform.AddonPhase(in.Phase)
+func autoConvert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Phase = platform.MachinePhase(in.Phase)
+	out.Conditions = *(*[]platform.MachineCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
 	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Addresses = *(*[]platform.MachineAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
+		return err
+	}
+	out.Upgrade = (*platform.MachineUpgradeStatus)(unsafe.Pointer(in.Upgrade))
+	out.HandlerHistory = *(*[]platform.HandlerRecord)(unsafe.Pointer(&in.HandlerHistory))
 	return nil
 }
 
-// Convert_v1_CronHPAStatus_To_platform_CronHPAStatus is an autogenerated conversion function.
-func Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
-	return autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in, out, s)
+// Convert_v1_MachineStatus_To_platform_MachineStatus is an autogenerated conversion function.
+func Convert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
+	return autoConvert_v1_MachineStatus_To_platform_MachineStatus(in, out, s)
 }
 
-func autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = AddonPhase(in.Phase)
+func autoConvert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Phase = MachinePhase(in.Phase)
+	out.Conditions = *(*[]MachineCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
 	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Addresses = *(*[]MachineAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
+		return err
+	}
+	out.Upgrade = (*MachineUpgradeStatus)(unsafe.Pointer(in.Upgrade))
+	out.HandlerHistory = *(*[]HandlerRecord)(unsafe.Pointer(&in.HandlerHistory))
 	return nil
 }
 
-// Convert_platform_CronHPAStatus_To_v1_CronHPAStatus is an autogenerated conversion function.
-func Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
-	return autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in, out, s)
+// Convert_platform_MachineStatus_To_v1_MachineStatus is an autogenerated conversion function.
+func Convert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
+	return autoConvert_platform_MachineStatus_To_v1_MachineStatus(in, out, s)
 }
 
-func autoConvert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
-	out.Local = (*platform.LocalEtcd)(unsafe.Pointer(in.Local))
-	out.External = (*platform.ExternalEtcd)(unsafe.Pointer(in.External))
+func autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
+	out.MachineID = in.MachineID
+	out.SystemUUID = in.SystemUUID
+	out.BootID = in.BootID
+	out.KernelVersion = in.KernelVersion
+	out.OSImage = in.OSImage
+	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
+	out.KubeletVersion = in.KubeletVersion
+	out.KubeProxyVersion = in.KubeProxyVersion
+	out.OperatingSystem = in.OperatingSystem
+	out.Architecture = in.Architecture
 	return nil
 }
 
-// Convert_v1_Etcd_To_platform_Etcd is an autogenerated conversion function.
-func Convert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
-	return autoConvert_v1_Etcd_To_platform_Etcd(in, out, s)
+// Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo is an autogenerated conversion function.
+func Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
+	return autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in, out, s)
 }
 
-func autoConvert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
-	out.Local = (*LocalEtcd)(unsafe.Pointer(in.Local))
-	out.External = (*ExternalEtcd)(unsafe.Pointer(in.External))
+func autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
+	out.MachineID = in.MachineID
+	out.SystemUUID = in.SystemUUID
+	out.BootID = in.BootID
+	out.KernelVersion = in.KernelVersion
+	out.OSImage = in.OSImage
+	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
+	out.KubeletVersion = in.KubeletVersion
+	out.KubeProxyVersion = in.KubeProxyVersion
+	out.OperatingSystem = in.OperatingSystem
+	out.Architecture = in.Architecture
 	return nil
 }
 
-// Convert_platform_Etcd_To_v1_Etcd is an autogenerated conversion function.
-func Convert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
-	return autoConvert_platform_Etcd_To_v1_Etcd(in, out, s)
+// Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo is an autogenerated conversion function.
+func Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
+	return autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in, out, s)
 }
 
-func autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	out.IP = in.IP
-	out.Port = in.Port
+func autoConvert_v1_MachineTemplateSpec_To_platform_MachineTemplateSpec(in *MachineTemplateSpec, out *platform.MachineTemplateSpec, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.ContainerRuntimeConfig = (*platform.ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
 	return nil
 }
 
-// Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in, out, s)
+// Convert_v1_MachineTemplateSpec_To_platform_MachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1_MachineTemplateSpec_To_platform_MachineTemplateSpec(in *MachineTemplateSpec, out *platform.MachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1_MachineTemplateSpec_To_platform_MachineTemplateSpec(in, out, s)
 }
 
-func autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	out.IP = in.IP
-	out.Port = in.Port
+func autoConvert_platform_MachineTemplateSpec_To_v1_MachineTemplateSpec(in *platform.MachineTemplateSpec, out *MachineTemplateSpec, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.ContainerRuntimeConfig = (*ContainerRuntimeConfig)(unsafe.Pointer(in.ContainerRuntimeConfig))
 	return nil
 }
 
-// Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in, out, s)
+// Convert_platform_MachineTemplateSpec_To_v1_MachineTemplateSpec is an autogenerated conversion function.
+func Convert_platform_MachineTemplateSpec_To_v1_MachineTemplateSpec(in *platform.MachineTemplateSpec, out *MachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_platform_MachineTemplateSpec_To_v1_MachineTemplateSpec(in, out, s)
 }
 
-func autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
-	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
-	out.CAFile = in.CAFile
-	out.CertFile = in.CertFile
-	out.KeyFile = in.KeyFile
+func autoConvert_v1_MachineUpgradeStatus_To_platform_MachineUpgradeStatus(in *MachineUpgradeStatus, out *platform.MachineUpgradeStatus, s conversion.Scope) error {
+	out.Phase = platform.MachineUpgradePhase(in.Phase)
+	out.FromVersion = in.FromVersion
+	out.ToVersion = in.ToVersion
+	out.Canary = in.Canary
+	out.StartTime = in.StartTime
+	out.FinishTime = in.FinishTime
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_v1_ExternalEtcd_To_platform_ExternalEtcd is an autogenerated conversion function.
-func Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
-	return autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in, out, s)
+// Convert_v1_MachineUpgradeStatus_To_platform_MachineUpgradeStatus is an autogenerated conversion function.
+func Convert_v1_MachineUpgradeStatus_To_platform_MachineUpgradeStatus(in *MachineUpgradeStatus, out *platform.MachineUpgradeStatus, s conversion.Scope) error {
+	return autoConvert_v1_MachineUpgradeStatus_To_platform_MachineUpgradeStatus(in, out, s)
 }
 
-func autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
-	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
-	out.CAFile = in.CAFile
-	out.CertFile = in.CertFile
-	out.KeyFile = in.KeyFile
+func autoConvert_platform_MachineUpgradeStatus_To_v1_MachineUpgradeStatus(in *platform.MachineUpgradeStatus, out *MachineUpgradeStatus, s conversion.Scope) error {
+	out.Phase = MachineUpgradePhase(in.Phase)
+	out.FromVersion = in.FromVersion
+	out.ToVersion = in.ToVersion
+	out.Canary = in.Canary
+	out.StartTime = in.StartTime
+	out.FinishTime = in.FinishTime
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_platform_ExternalEtcd_To_v1_ExternalEtcd is an autogenerated conversion function.
-func Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
-	return autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in, out, s)
+// Convert_platform_MachineUpgradeStatus_To_v1_MachineUpgradeStatus is an autogenerated conversion function.
+func Convert_platform_MachineUpgradeStatus_To_v1_MachineUpgradeStatus(in *platform.MachineUpgradeStatus, out *MachineUpgradeStatus, s conversion.Scope) error {
+	return autoConvert_platform_MachineUpgradeStatus_To_v1_MachineUpgradeStatus(in, out, s)
 }
 
-func autoConvert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
-	out.Src = in.Src
-	out.Dst = in.Dst
+func autoConvert_v1_MetalLB_To_platform_MetalLB(in *MetalLB, out *platform.MetalLB, s conversion.Scope) error {
+	out.AddressPools = *(*[]platform.MetalLBAddressPool)(unsafe.Pointer(&in.AddressPools))
+	out.BGP = (*platform.BGPConfig)(unsafe.Pointer(in.BGP))
 	return nil
 }
 
-// Convert_v1_File_To_platform_File is an autogenerated conversion function.
-func Convert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
-	return autoConvert_v1_File_To_platform_File(in, out, s)
+// Convert_v1_MetalLB_To_platform_MetalLB is an autogenerated conversion function.
+func Convert_v1_MetalLB_To_platform_MetalLB(in *MetalLB, out *platform.MetalLB, s conversion.Scope) error {
+	return autoConvert_v1_MetalLB_To_platform_MetalLB(in, out, s)
 }
 
-func autoConvert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
-	out.Src = in.Src
-	out.Dst = in.Dst
+func autoConvert_platform_MetalLB_To_v1_MetalLB(in *platform.MetalLB, out *MetalLB, s conversion.Scope) error {
+	out.AddressPools = *(*[]MetalLBAddressPool)(unsafe.Pointer(&in.AddressPools))
+	out.BGP = (*BGPConfig)(unsafe.Pointer(in.BGP))
 	return nil
 }
 
-// Convert_platform_File_To_v1_File is an autogenerated conversion function.
-func Convert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
-	return autoConvert_platform_File_To_v1_File(in, out, s)
+// Convert_platform_MetalLB_To_v1_MetalLB is an autogenerated conversion function.
+func Convert_platform_MetalLB_To_v1_MetalLB(in *platform.MetalLB, out *MetalLB, s conversion.Scope) error {
+	return autoConvert_platform_MetalLB_To_v1_MetalLB(in, out, s)
 }
 
-func autoConvert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
-	out.TKEHA = (*platform.TKEHA)(unsafe.Pointer(in.TKEHA))
-	out.ThirdPartyHA = (*platform.ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
+func autoConvert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(in *MetalLBAddressPool, out *platform.MetalLBAddressPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = platform.MetalLBProtocol(in.Protocol)
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
 	return nil
 }
 
-// Convert_v1_HA_To_platform_HA is an autogenerated conversion function.
-func Convert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
-	return autoConvert_v1_HA_To_platform_HA(in, out, s)
+// Convert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool is an autogenerated conversion function.
+func Convert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(in *MetalLBAddressPool, out *platform.MetalLBAddressPool, s conversion.Scope) error {
+	return autoConvert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(in, out, s)
 }
 
-func autoConvert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
-	out.TKEHA = (*TKEHA)(unsafe.Pointer(in.TKEHA))
-	out.ThirdPartyHA = (*ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
+func autoConvert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(in *platform.MetalLBAddressPool, out *MetalLBAddressPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = MetalLBProtocol(in.Protocol)
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
 	return nil
 }
 
-// Convert_platform_HA_To_v1_HA is an autogenerated conversion function.
-func Convert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
-	return autoConvert_platform_HA_To_v1_HA(in, out, s)
+// Convert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool is an autogenerated conversion function.
+func Convert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(in *platform.MetalLBAddressPool, out *MetalLBAddressPool, s conversion.Scope) error {
+	return autoConvert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(in, out, s)
 }
 
-func autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
-	out.DataDir = in.DataDir
-	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
-	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
-	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
+func autoConvert_v1_MultiClusterContainerOverride_To_platform_MultiClusterContainerOverride(in *MultiClusterContainerOverride, out *platform.MultiClusterContainerOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Image = in.Image
+	out.Env = *(*[]corev1.EnvVar)(unsafe.Pointer(&in.Env))
 	return nil
 }
 
-// Convert_v1_LocalEtcd_To_platform_LocalEtcd is an autogenerated conversion function.
-func Convert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
-	return autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in, out, s)
+// Convert_v1_MultiClusterContainerOverride_To_platform_MultiClusterContainerOverride is an autogenerated conversion function.
+func Convert_v1_MultiClusterContainerOverride_To_platform_MultiClusterContainerOverride(in *MultiClusterContainerOverride, out *platform.MultiClusterContainerOverride, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterContainerOverride_To_platform_MultiClusterContainerOverride(in, out, s)
 }
 
-func autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
-	out.DataDir = in.DataDir
-	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
-	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
-	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
+func autoConvert_platform_MultiClusterContainerOverride_To_v1_MultiClusterContainerOverride(in *platform.MultiClusterContainerOverride, out *MultiClusterContainerOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Image = in.Image
+	out.Env = *(*[]corev1.EnvVar)(unsafe.Pointer(&in.Env))
 	return nil
 }
 
-// Convert_platform_LocalEtcd_To_v1_LocalEtcd is an autogenerated conversion function.
-func Convert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
-	return autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in, out, s)
+// Convert_platform_MultiClusterContainerOverride_To_v1_MultiClusterContainerOverride is an autogenerated conversion function.
+func Convert_platform_MultiClusterContainerOverride_To_v1_MultiClusterContainerOverride(in *platform.MultiClusterContainerOverride, out *MultiClusterContainerOverride, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterContainerOverride_To_v1_MultiClusterContainerOverride(in, out, s)
 }
 
-func autoConvert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
+func autoConvert_v1_MultiClusterDeployment_To_platform_MultiClusterDeployment(in *MultiClusterDeployment, out *platform.MultiClusterDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_MachineSpec_To_platform_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_MultiClusterDeploymentSpec_To_platform_MultiClusterDeploymentSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_MachineStatus_To_platform_MachineStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_MultiClusterDeploymentStatus_To_platform_MultiClusterDeploymentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_MultiClusterDeployment_To_platform_MultiClusterDeployment is an autogenerated conversion function.
+func Convert_v1_MultiClusterDeployment_To_platform_MultiClusterDeployment(in *MultiClusterDeployment, out *platform.MultiClusterDeployment, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterDeployment_To_platform_MultiClusterDeployment(in, out, s)
+}
+
+func autoConvert_platform_MultiClusterDeployment_To_v1_MultiClusterDeployment(in *platform.MultiClusterDeployment, out *MultiClusterDeployment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_MultiClusterDeploymentSpec_To_v1_MultiClusterDeploymentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_MultiClusterDeploymentStatus_To_v1_MultiClusterDeploymentStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_Machine_To_platform_Machine is an autogenerated conversion function.
-func Convert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
-	return autoConvert_v1_Machine_To_platform_Machine(in, out, s)
+// Convert_platform_MultiClusterDeployment_To_v1_MultiClusterDeployment is an autogenerated conversion function.
+func Convert_platform_MultiClusterDeployment_To_v1_MultiClusterDeployment(in *platform.MultiClusterDeployment, out *MultiClusterDeployment, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterDeployment_To_v1_MultiClusterDeployment(in, out, s)
+}
+
+func autoConvert_v1_MultiClusterDeploymentClusterStatus_To_platform_MultiClusterDeploymentClusterStatus(in *MultiClusterDeploymentClusterStatus, out *platform.MultiClusterDeploymentClusterStatus, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Phase = platform.MultiClusterDeploymentPhase(in.Phase)
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_MultiClusterDeploymentClusterStatus_To_platform_MultiClusterDeploymentClusterStatus is an autogenerated conversion function.
+func Convert_v1_MultiClusterDeploymentClusterStatus_To_platform_MultiClusterDeploymentClusterStatus(in *MultiClusterDeploymentClusterStatus, out *platform.MultiClusterDeploymentClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterDeploymentClusterStatus_To_platform_MultiClusterDeploymentClusterStatus(in, out, s)
 }
 
-func autoConvert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_MachineSpec_To_v1_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_platform_MachineStatus_To_v1_MachineStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_platform_MultiClusterDeploymentClusterStatus_To_v1_MultiClusterDeploymentClusterStatus(in *platform.MultiClusterDeploymentClusterStatus, out *MultiClusterDeploymentClusterStatus, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Phase = MultiClusterDeploymentPhase(in.Phase)
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_platform_Machine_To_v1_Machine is an autogenerated conversion function.
-func Convert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
-	return autoConvert_platform_Machine_To_v1_Machine(in, out, s)
+// Convert_platform_MultiClusterDeploymentClusterStatus_To_v1_MultiClusterDeploymentClusterStatus is an autogenerated conversion function.
+func Convert_platform_MultiClusterDeploymentClusterStatus_To_v1_MultiClusterDeploymentClusterStatus(in *platform.MultiClusterDeploymentClusterStatus, out *MultiClusterDeploymentClusterStatus, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterDeploymentClusterStatus_To_v1_MultiClusterDeploymentClusterStatus(in, out, s)
 }
 
-func autoConvert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
-	out.Type = platform.MachineAddressType(in.Type)
-	out.Address = in.Address
+func autoConvert_v1_MultiClusterDeploymentList_To_platform_MultiClusterDeploymentList(in *MultiClusterDeploymentList, out *platform.MultiClusterDeploymentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.MultiClusterDeployment)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_MachineAddress_To_platform_MachineAddress is an autogenerated conversion function.
-func Convert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
-	return autoConvert_v1_MachineAddress_To_platform_MachineAddress(in, out, s)
+// Convert_v1_MultiClusterDeploymentList_To_platform_MultiClusterDeploymentList is an autogenerated conversion function.
+func Convert_v1_MultiClusterDeploymentList_To_platform_MultiClusterDeploymentList(in *MultiClusterDeploymentList, out *platform.MultiClusterDeploymentList, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterDeploymentList_To_platform_MultiClusterDeploymentList(in, out, s)
 }
 
-func autoConvert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
-	out.Type = MachineAddressType(in.Type)
-	out.Address = in.Address
+func autoConvert_platform_MultiClusterDeploymentList_To_v1_MultiClusterDeploymentList(in *platform.MultiClusterDeploymentList, out *MultiClusterDeploymentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MultiClusterDeployment)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_MachineAddress_To_v1_MachineAddress is an autogenerated conversion function.
-func Convert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
-	return autoConvert_platform_MachineAddress_To_v1_MachineAddress(in, out, s)
+// Convert_platform_MultiClusterDeploymentList_To_v1_MultiClusterDeploymentList is an autogenerated conversion function.
+func Convert_platform_MultiClusterDeploymentList_To_v1_MultiClusterDeploymentList(in *platform.MultiClusterDeploymentList, out *MultiClusterDeploymentList, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterDeploymentList_To_v1_MultiClusterDeploymentList(in, out, s)
 }
 
-func autoConvert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = platform.ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
-	out.Reason = in.Reason
-	out.Message = in.Message
+func autoConvert_v1_MultiClusterDeploymentSpec_To_platform_MultiClusterDeploymentSpec(in *MultiClusterDeploymentSpec, out *platform.MultiClusterDeploymentSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Namespace = in.Namespace
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Template = in.Template
+	if err := Convert_v1_MultiClusterPlacement_To_platform_MultiClusterPlacement(&in.Placement, &out.Placement, s); err != nil {
+		return err
+	}
+	out.Overrides = *(*[]platform.MultiClusterOverride)(unsafe.Pointer(&in.Overrides))
 	return nil
 }
 
-// Convert_v1_MachineCondition_To_platform_MachineCondition is an autogenerated conversion function.
-func Convert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
-	return autoConvert_v1_MachineCondition_To_platform_MachineCondition(in, out, s)
+// Convert_v1_MultiClusterDeploymentSpec_To_platform_MultiClusterDeploymentSpec is an autogenerated conversion function.
+func Convert_v1_MultiClusterDeploymentSpec_To_platform_MultiClusterDeploymentSpec(in *MultiClusterDeploymentSpec, out *platform.MultiClusterDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterDeploymentSpec_To_platform_MultiClusterDeploymentSpec(in, out, s)
 }
 
-func autoConvert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
-	out.Reason = in.Reason
-	out.Message = in.Message
+func autoConvert_platform_MultiClusterDeploymentSpec_To_v1_MultiClusterDeploymentSpec(in *platform.MultiClusterDeploymentSpec, out *MultiClusterDeploymentSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Namespace = in.Namespace
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Template = in.Template
+	if err := Convert_platform_MultiClusterPlacement_To_v1_MultiClusterPlacement(&in.Placement, &out.Placement, s); err != nil {
+		return err
+	}
+	out.Overrides = *(*[]MultiClusterOverride)(unsafe.Pointer(&in.Overrides))
 	return nil
 }
 
-// Convert_platform_MachineCondition_To_v1_MachineCondition is an autogenerated conversion function.
-func Convert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
-	return autoConvert_platform_MachineCondition_To_v1_MachineCondition(in, out, s)
+// Convert_platform_MultiClusterDeploymentSpec_To_v1_MultiClusterDeploymentSpec is an autogenerated conversion function.
+func Convert_platform_MultiClusterDeploymentSpec_To_v1_MultiClusterDeploymentSpec(in *platform.MultiClusterDeploymentSpec, out *MultiClusterDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterDeploymentSpec_To_v1_MultiClusterDeploymentSpec(in, out, s)
 }
 
-func autoConvert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.Machine)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_MultiClusterDeploymentStatus_To_platform_MultiClusterDeploymentStatus(in *MultiClusterDeploymentStatus, out *platform.MultiClusterDeploymentStatus, s conversion.Scope) error {
+	out.Phase = platform.MultiClusterDeploymentPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	out.Clusters = *(*[]platform.MultiClusterDeploymentClusterStatus)(unsafe.Pointer(&in.Clusters))
 	return nil
 }
 
-// Convert_v1_MachineList_To_platform_MachineList is an autogenerated conversion function.
-func Convert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
-	return autoConvert_v1_MachineList_To_platform_MachineList(in, out, s)
+// Convert_v1_MultiClusterDeploymentStatus_To_platform_MultiClusterDeploymentStatus is an autogenerated conversion function.
+func Convert_v1_MultiClusterDeploymentStatus_To_platform_MultiClusterDeploymentStatus(in *MultiClusterDeploymentStatus, out *platform.MultiClusterDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterDeploymentStatus_To_platform_MultiClusterDeploymentStatus(in, out, s)
 }
 
-func autoConvert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Machine)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_MultiClusterDeploymentStatus_To_v1_MultiClusterDeploymentStatus(in *platform.MultiClusterDeploymentStatus, out *MultiClusterDeploymentStatus, s conversion.Scope) error {
+	out.Phase = MultiClusterDeploymentPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	out.Clusters = *(*[]MultiClusterDeploymentClusterStatus)(unsafe.Pointer(&in.Clusters))
 	return nil
 }
 
-// Convert_platform_MachineList_To_v1_MachineList is an autogenerated conversion function.
-func Convert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
-	return autoConvert_platform_MachineList_To_v1_MachineList(in, out, s)
+// Convert_platform_MultiClusterDeploymentStatus_To_v1_MultiClusterDeploymentStatus is an autogenerated conversion function.
+func Convert_platform_MultiClusterDeploymentStatus_To_v1_MultiClusterDeploymentStatus(in *platform.MultiClusterDeploymentStatus, out *MultiClusterDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterDeploymentStatus_To_v1_MultiClusterDeploymentStatus(in, out, s)
 }
 
-func autoConvert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
-	out.TenantID = in.TenantID
+func autoConvert_v1_MultiClusterOverride_To_platform_MultiClusterOverride(in *MultiClusterOverride, out *platform.MultiClusterOverride, s conversion.Scope) error {
 	out.ClusterName = in.ClusterName
-	out.Type = in.Type
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Containers = *(*[]platform.MultiClusterContainerOverride)(unsafe.Pointer(&in.Containers))
 	return nil
 }
 
-// Convert_v1_MachineSpec_To_platform_MachineSpec is an autogenerated conversion function.
-func Convert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
-	return autoConvert_v1_MachineSpec_To_platform_MachineSpec(in, out, s)
+// Convert_v1_MultiClusterOverride_To_platform_MultiClusterOverride is an autogenerated conversion function.
+func Convert_v1_MultiClusterOverride_To_platform_MultiClusterOverride(in *MultiClusterOverride, out *platform.MultiClusterOverride, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterOverride_To_platform_MultiClusterOverride(in, out, s)
 }
 
-func autoConvert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
-	out.TenantID = in.TenantID
+func autoConvert_platform_MultiClusterOverride_To_v1_MultiClusterOverride(in *platform.MultiClusterOverride, out *MultiClusterOverride, s conversion.Scope) error {
 	out.ClusterName = in.ClusterName
-	out.Type = in.Type
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Containers = *(*[]MultiClusterContainerOverride)(unsafe.Pointer(&in.Containers))
 	return nil
 }
 
-// Convert_platform_MachineSpec_To_v1_MachineSpec is an autogenerated conversion function.
-func Convert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
-	return autoConvert_platform_MachineSpec_To_v1_MachineSpec(in, out, s)
+// Convert_platform_MultiClusterOverride_To_v1_MultiClusterOverride is an autogenerated conversion function.
+func Convert_platform_MultiClusterOverride_To_v1_MultiClusterOverride(in *platform.MultiClusterOverride, out *MultiClusterOverride, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterOverride_To_v1_MultiClusterOverride(in, out, s)
 }
 
-func autoConvert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Phase = platform.MachinePhase(in.Phase)
-	out.Conditions = *(*[]platform.MachineCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
-	out.Reason = in.Reason
-	out.Addresses = *(*[]platform.MachineAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
-		return err
-	}
+func autoConvert_v1_MultiClusterPlacement_To_platform_MultiClusterPlacement(in *MultiClusterPlacement, out *platform.MultiClusterPlacement, s conversion.Scope) error {
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.ClusterSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ClusterSelector))
+	out.Weights = *(*[]platform.MultiClusterWeight)(unsafe.Pointer(&in.Weights))
 	return nil
 }
 
-// Convert_v1_MachineStatus_To_platform_MachineStatus is an autogenerated conversion function.
-func Convert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
-	return autoConvert_v1_MachineStatus_To_platform_MachineStatus(in, out, s)
+// Convert_v1_MultiClusterPlacement_To_platform_MultiClusterPlacement is an autogenerated conversion function.
+func Convert_v1_MultiClusterPlacement_To_platform_MultiClusterPlacement(in *MultiClusterPlacement, out *platform.MultiClusterPlacement, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterPlacement_To_platform_MultiClusterPlacement(in, out, s)
 }
 
-func autoConvert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Phase = MachinePhase(in.Phase)
-	out.Conditions = *(*[]MachineCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
-	out.Reason = in.Reason
-	out.Addresses = *(*[]MachineAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
-		return err
-	}
+func autoConvert_platform_MultiClusterPlacement_To_v1_MultiClusterPlacement(in *platform.MultiClusterPlacement, out *MultiClusterPlacement, s conversion.Scope) error {
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.ClusterSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ClusterSelector))
+	out.Weights = *(*[]MultiClusterWeight)(unsafe.Pointer(&in.Weights))
 	return nil
 }
 
-// Convert_platform_MachineStatus_To_v1_MachineStatus is an autogenerated conversion function.
-func Convert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
-	return autoConvert_platform_MachineStatus_To_v1_MachineStatus(in, out, s)
+// Convert_platform_MultiClusterPlacement_To_v1_MultiClusterPlacement is an autogenerated conversion function.
+func Convert_platform_MultiClusterPlacement_To_v1_MultiClusterPlacement(in *platform.MultiClusterPlacement, out *MultiClusterPlacement, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterPlacement_To_v1_MultiClusterPlacement(in, out, s)
 }
 
-func autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
-	out.MachineID = in.MachineID
-	out.SystemUUID = in.SystemUUID
-	out.BootID = in.BootID
-	out.KernelVersion = in.KernelVersion
-	out.OSImage = in.OSImage
-	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
-	out.KubeletVersion = in.KubeletVersion
-	out.KubeProxyVersion = in.KubeProxyVersion
-	out.OperatingSystem = in.OperatingSystem
-	out.Architecture = in.Architecture
+func autoConvert_v1_MultiClusterWeight_To_platform_MultiClusterWeight(in *MultiClusterWeight, out *platform.MultiClusterWeight, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Weight = in.Weight
 	return nil
 }
 
-// Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo is an autogenerated conversion function.
-func Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
-	return autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in, out, s)
+// Convert_v1_MultiClusterWeight_To_platform_MultiClusterWeight is an autogenerated conversion function.
+func Convert_v1_MultiClusterWeight_To_platform_MultiClusterWeight(in *MultiClusterWeight, out *platform.MultiClusterWeight, s conversion.Scope) error {
+	return autoConvert_v1_MultiClusterWeight_To_platform_MultiClusterWeight(in, out, s)
 }
 
-func autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
-	out.MachineID = in.MachineID
-	out.SystemUUID = in.SystemUUID
-	out.BootID = in.BootID
-	out.KernelVersion = in.KernelVersion
-	out.OSImage = in.OSImage
-	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
-	out.KubeletVersion = in.KubeletVersion
-	out.KubeProxyVersion = in.KubeProxyVersion
-	out.OperatingSystem = in.OperatingSystem
-	out.Architecture = in.Architecture
+func autoConvert_platform_MultiClusterWeight_To_v1_MultiClusterWeight(in *platform.MultiClusterWeight, out *MultiClusterWeight, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Weight = in.Weight
 	return nil
 }
 
-// Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo is an autogenerated conversion function.
-func Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
-	return autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in, out, s)
+// Convert_platform_MultiClusterWeight_To_v1_MultiClusterWeight is an autogenerated conversion function.
+func Convert_platform_MultiClusterWeight_To_v1_MultiClusterWeight(in *platform.MultiClusterWeight, out *MultiClusterWeight, s conversion.Scope) error {
+	return autoConvert_platform_MultiClusterWeight_To_v1_MultiClusterWeight(in, out, s)
 }
 
 func autoConvert_v1_PersistentBackEnd_To_platform_PersistentBackEnd(in *PersistentBackEnd, out *platform.PersistentBackEnd, s conversion.Scope) error {
@@ -2764,6 +5321,48 @@ func Convert_platform_RegistryList_To_v1_RegistryList(in *platform.RegistryList,
 	return autoConvert_platform_RegistryList_To_v1_RegistryList(in, out, s)
 }
 
+func autoConvert_v1_RegistryMirror_To_platform_RegistryMirror(in *RegistryMirror, out *platform.RegistryMirror, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	return nil
+}
+
+// Convert_v1_RegistryMirror_To_platform_RegistryMirror is an autogenerated conversion function.
+func Convert_v1_RegistryMirror_To_platform_RegistryMirror(in *RegistryMirror, out *platform.RegistryMirror, s conversion.Scope) error {
+	return autoConvert_v1_RegistryMirror_To_platform_RegistryMirror(in, out, s)
+}
+
+func autoConvert_platform_RegistryMirror_To_v1_RegistryMirror(in *platform.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	return nil
+}
+
+// Convert_platform_RegistryMirror_To_v1_RegistryMirror is an autogenerated conversion function.
+func Convert_platform_RegistryMirror_To_v1_RegistryMirror(in *platform.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	return autoConvert_platform_RegistryMirror_To_v1_RegistryMirror(in, out, s)
+}
+
+func autoConvert_v1_RegistrySnapshotTarget_To_platform_RegistrySnapshotTarget(in *RegistrySnapshotTarget, out *platform.RegistrySnapshotTarget, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	return nil
+}
+
+// Convert_v1_RegistrySnapshotTarget_To_platform_RegistrySnapshotTarget is an autogenerated conversion function.
+func Convert_v1_RegistrySnapshotTarget_To_platform_RegistrySnapshotTarget(in *RegistrySnapshotTarget, out *platform.RegistrySnapshotTarget, s conversion.Scope) error {
+	return autoConvert_v1_RegistrySnapshotTarget_To_platform_RegistrySnapshotTarget(in, out, s)
+}
+
+func autoConvert_platform_RegistrySnapshotTarget_To_v1_RegistrySnapshotTarget(in *platform.RegistrySnapshotTarget, out *RegistrySnapshotTarget, s conversion.Scope) error {
+	out.Prefix = in.Prefix
+	return nil
+}
+
+// Convert_platform_RegistrySnapshotTarget_To_v1_RegistrySnapshotTarget is an autogenerated conversion function.
+func Convert_platform_RegistrySnapshotTarget_To_v1_RegistrySnapshotTarget(in *platform.RegistrySnapshotTarget, out *RegistrySnapshotTarget, s conversion.Scope) error {
+	return autoConvert_platform_RegistrySnapshotTarget_To_v1_RegistrySnapshotTarget(in, out, s)
+}
+
 func autoConvert_v1_RegistrySpec_To_platform_RegistrySpec(in *RegistrySpec, out *platform.RegistrySpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
@@ -2816,6 +5415,134 @@ func Convert_platform_ResourceRequirements_To_v1_ResourceRequirements(in *platfo
 	return autoConvert_platform_ResourceRequirements_To_v1_ResourceRequirements(in, out, s)
 }
 
+func autoConvert_v1_RuntimeClass_To_platform_RuntimeClass(in *RuntimeClass, out *platform.RuntimeClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = platform.RuntimeType(in.Type)
+	return nil
+}
+
+// Convert_v1_RuntimeClass_To_platform_RuntimeClass is an autogenerated conversion function.
+func Convert_v1_RuntimeClass_To_platform_RuntimeClass(in *RuntimeClass, out *platform.RuntimeClass, s conversion.Scope) error {
+	return autoConvert_v1_RuntimeClass_To_platform_RuntimeClass(in, out, s)
+}
+
+func autoConvert_platform_RuntimeClass_To_v1_RuntimeClass(in *platform.RuntimeClass, out *RuntimeClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = RuntimeType(in.Type)
+	return nil
+}
+
+// Convert_platform_RuntimeClass_To_v1_RuntimeClass is an autogenerated conversion function.
+func Convert_platform_RuntimeClass_To_v1_RuntimeClass(in *platform.RuntimeClass, out *RuntimeClass, s conversion.Scope) error {
+	return autoConvert_platform_RuntimeClass_To_v1_RuntimeClass(in, out, s)
+}
+
+func autoConvert_v1_S3SnapshotTarget_To_platform_S3SnapshotTarget(in *S3SnapshotTarget, out *platform.S3SnapshotTarget, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.AccessKeyID = in.AccessKeyID
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_v1_S3SnapshotTarget_To_platform_S3SnapshotTarget is an autogenerated conversion function.
+func Convert_v1_S3SnapshotTarget_To_platform_S3SnapshotTarget(in *S3SnapshotTarget, out *platform.S3SnapshotTarget, s conversion.Scope) error {
+	return autoConvert_v1_S3SnapshotTarget_To_platform_S3SnapshotTarget(in, out, s)
+}
+
+func autoConvert_platform_S3SnapshotTarget_To_v1_S3SnapshotTarget(in *platform.S3SnapshotTarget, out *S3SnapshotTarget, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.AccessKeyID = in.AccessKeyID
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_platform_S3SnapshotTarget_To_v1_S3SnapshotTarget is an autogenerated conversion function.
+func Convert_platform_S3SnapshotTarget_To_v1_S3SnapshotTarget(in *platform.S3SnapshotTarget, out *S3SnapshotTarget, s conversion.Scope) error {
+	return autoConvert_platform_S3SnapshotTarget_To_v1_S3SnapshotTarget(in, out, s)
+}
+
+func autoConvert_v1_SSHCredential_To_platform_SSHCredential(in *SSHCredential, out *platform.SSHCredential, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_SSHCredentialSpec_To_platform_SSHCredentialSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SSHCredential_To_platform_SSHCredential is an autogenerated conversion function.
+func Convert_v1_SSHCredential_To_platform_SSHCredential(in *SSHCredential, out *platform.SSHCredential, s conversion.Scope) error {
+	return autoConvert_v1_SSHCredential_To_platform_SSHCredential(in, out, s)
+}
+
+func autoConvert_platform_SSHCredential_To_v1_SSHCredential(in *platform.SSHCredential, out *SSHCredential, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_SSHCredentialSpec_To_v1_SSHCredentialSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_SSHCredential_To_v1_SSHCredential is an autogenerated conversion function.
+func Convert_platform_SSHCredential_To_v1_SSHCredential(in *platform.SSHCredential, out *SSHCredential, s conversion.Scope) error {
+	return autoConvert_platform_SSHCredential_To_v1_SSHCredential(in, out, s)
+}
+
+func autoConvert_v1_SSHCredentialList_To_platform_SSHCredentialList(in *SSHCredentialList, out *platform.SSHCredentialList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.SSHCredential)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_SSHCredentialList_To_platform_SSHCredentialList is an autogenerated conversion function.
+func Convert_v1_SSHCredentialList_To_platform_SSHCredentialList(in *SSHCredentialList, out *platform.SSHCredentialList, s conversion.Scope) error {
+	return autoConvert_v1_SSHCredentialList_To_platform_SSHCredentialList(in, out, s)
+}
+
+func autoConvert_platform_SSHCredentialList_To_v1_SSHCredentialList(in *platform.SSHCredentialList, out *SSHCredentialList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SSHCredential)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_SSHCredentialList_To_v1_SSHCredentialList is an autogenerated conversion function.
+func Convert_platform_SSHCredentialList_To_v1_SSHCredentialList(in *platform.SSHCredentialList, out *SSHCredentialList, s conversion.Scope) error {
+	return autoConvert_platform_SSHCredentialList_To_v1_SSHCredentialList(in, out, s)
+}
+
+func autoConvert_v1_SSHCredentialSpec_To_platform_SSHCredentialSpec(in *SSHCredentialSpec, out *platform.SSHCredentialSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	return nil
+}
+
+// Convert_v1_SSHCredentialSpec_To_platform_SSHCredentialSpec is an autogenerated conversion function.
+func Convert_v1_SSHCredentialSpec_To_platform_SSHCredentialSpec(in *SSHCredentialSpec, out *platform.SSHCredentialSpec, s conversion.Scope) error {
+	return autoConvert_v1_SSHCredentialSpec_To_platform_SSHCredentialSpec(in, out, s)
+}
+
+func autoConvert_platform_SSHCredentialSpec_To_v1_SSHCredentialSpec(in *platform.SSHCredentialSpec, out *SSHCredentialSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	return nil
+}
+
+// Convert_platform_SSHCredentialSpec_To_v1_SSHCredentialSpec is an autogenerated conversion function.
+func Convert_platform_SSHCredentialSpec_To_v1_SSHCredentialSpec(in *platform.SSHCredentialSpec, out *SSHCredentialSpec, s conversion.Scope) error {
+	return autoConvert_platform_SSHCredentialSpec_To_v1_SSHCredentialSpec(in, out, s)
+}
+
 func autoConvert_v1_StorageBackEndCLS_To_platform_StorageBackEndCLS(in *StorageBackEndCLS, out *platform.StorageBackEndCLS, s conversion.Scope) error {
 	out.LogSetID = in.LogSetID
 	out.TopicID = in.TopicID
@@ -3109,6 +5836,11 @@ func autoConvert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(in *UpgradeStrat
 	if err := metav1.Convert_Pointer_bool_To_bool(&in.DrainNodeBeforeUpgrade, &out.DrainNodeBeforeUpgrade, s); err != nil {
 		return err
 	}
+	out.CanaryMachines = in.CanaryMachines
+	out.PauseAfterCanary = in.PauseAfterCanary
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.RollbackOnFailure, &out.RollbackOnFailure, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3124,6 +5856,11 @@ func autoConvert_platform_UpgradeStrategy_To_v1_UpgradeStrategy(in *platform.Upg
 	if err := metav1.Convert_bool_To_Pointer_bool(&in.DrainNodeBeforeUpgrade, &out.DrainNodeBeforeUpgrade, s); err != nil {
 		return err
 	}
+	out.CanaryMachines = in.CanaryMachines
+	out.PauseAfterCanary = in.PauseAfterCanary
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.RollbackOnFailure, &out.RollbackOnFailure, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -35,12 +35,24 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&CSIOperatorList{}, func(obj interface{}) { SetObjectDefaults_CSIOperatorList(obj.(*CSIOperatorList)) })
 	scheme.AddTypeDefaultingFunc(&Cluster{}, func(obj interface{}) { SetObjectDefaults_Cluster(obj.(*Cluster)) })
 	scheme.AddTypeDefaultingFunc(&ClusterList{}, func(obj interface{}) { SetObjectDefaults_ClusterList(obj.(*ClusterList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterSync{}, func(obj interface{}) { SetObjectDefaults_ClusterSync(obj.(*ClusterSync)) })
+	scheme.AddTypeDefaultingFunc(&ClusterSyncList{}, func(obj interface{}) { SetObjectDefaults_ClusterSyncList(obj.(*ClusterSyncList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterTemplate{}, func(obj interface{}) { SetObjectDefaults_ClusterTemplate(obj.(*ClusterTemplate)) })
+	scheme.AddTypeDefaultingFunc(&ClusterTemplateList{}, func(obj interface{}) { SetObjectDefaults_ClusterTemplateList(obj.(*ClusterTemplateList)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMap{}, func(obj interface{}) { SetObjectDefaults_ConfigMap(obj.(*ConfigMap)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMapList{}, func(obj interface{}) { SetObjectDefaults_ConfigMapList(obj.(*ConfigMapList)) })
 	scheme.AddTypeDefaultingFunc(&CronHPA{}, func(obj interface{}) { SetObjectDefaults_CronHPA(obj.(*CronHPA)) })
 	scheme.AddTypeDefaultingFunc(&CronHPAList{}, func(obj interface{}) { SetObjectDefaults_CronHPAList(obj.(*CronHPAList)) })
+	scheme.AddTypeDefaultingFunc(&EtcdSnapshot{}, func(obj interface{}) { SetObjectDefaults_EtcdSnapshot(obj.(*EtcdSnapshot)) })
+	scheme.AddTypeDefaultingFunc(&EtcdSnapshotList{}, func(obj interface{}) { SetObjectDefaults_EtcdSnapshotList(obj.(*EtcdSnapshotList)) })
+	scheme.AddTypeDefaultingFunc(&Host{}, func(obj interface{}) { SetObjectDefaults_Host(obj.(*Host)) })
+	scheme.AddTypeDefaultingFunc(&HostList{}, func(obj interface{}) { SetObjectDefaults_HostList(obj.(*HostList)) })
 	scheme.AddTypeDefaultingFunc(&Machine{}, func(obj interface{}) { SetObjectDefaults_Machine(obj.(*Machine)) })
 	scheme.AddTypeDefaultingFunc(&MachineList{}, func(obj interface{}) { SetObjectDefaults_MachineList(obj.(*MachineList)) })
+	scheme.AddTypeDefaultingFunc(&MachinePool{}, func(obj interface{}) { SetObjectDefaults_MachinePool(obj.(*MachinePool)) })
+	scheme.AddTypeDefaultingFunc(&MachinePoolList{}, func(obj interface{}) { SetObjectDefaults_MachinePoolList(obj.(*MachinePoolList)) })
+	scheme.AddTypeDefaultingFunc(&MultiClusterDeployment{}, func(obj interface{}) { SetObjectDefaults_MultiClusterDeployment(obj.(*MultiClusterDeployment)) })
+	scheme.AddTypeDefaultingFunc(&MultiClusterDeploymentList{}, func(obj interface{}) { SetObjectDefaults_MultiClusterDeploymentList(obj.(*MultiClusterDeploymentList)) })
 	scheme.AddTypeDefaultingFunc(&PersistentEvent{}, func(obj interface{}) { SetObjectDefaults_PersistentEvent(obj.(*PersistentEvent)) })
 	scheme.AddTypeDefaultingFunc(&PersistentEventList{}, func(obj interface{}) { SetObjectDefaults_PersistentEventList(obj.(*PersistentEventList)) })
 	scheme.AddTypeDefaultingFunc(&TappController{}, func(obj interface{}) { SetObjectDefaults_TappController(obj.(*TappController)) })
@@ -62,6 +74,9 @@ func SetObjectDefaults_CSIOperatorList(in *CSIOperatorList) {
 func SetObjectDefaults_Cluster(in *Cluster) {
 	SetDefaults_ClusterSpec(&in.Spec)
 	SetDefaults_ClusterFeature(&in.Spec.Features)
+	if in.Spec.Features.EtcdBackup != nil {
+		SetDefaults_EtcdBackup(in.Spec.Features.EtcdBackup)
+	}
 	for i := range in.Spec.BootstrapApps {
 		a := &in.Spec.BootstrapApps[i]
 		applicationv1.SetDefaults_AppSpec(&a.App.Spec)
@@ -76,6 +91,36 @@ func SetObjectDefaults_ClusterList(in *ClusterList) {
 	}
 }
 
+func SetObjectDefaults_ClusterSync(in *ClusterSync) {
+	SetDefaults_ClusterSyncSpec(&in.Spec)
+	SetDefaults_ClusterSyncStatus(&in.Status)
+}
+
+func SetObjectDefaults_ClusterSyncList(in *ClusterSyncList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterSync(a)
+	}
+}
+
+func SetObjectDefaults_ClusterTemplate(in *ClusterTemplate) {
+	SetDefaults_ClusterFeature(&in.Spec.Features)
+	if in.Spec.Features.EtcdBackup != nil {
+		SetDefaults_EtcdBackup(in.Spec.Features.EtcdBackup)
+	}
+	for i := range in.Spec.BootstrapApps {
+		a := &in.Spec.BootstrapApps[i]
+		applicationv1.SetDefaults_AppSpec(&a.App.Spec)
+	}
+}
+
+func SetObjectDefaults_ClusterTemplateList(in *ClusterTemplateList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterTemplate(a)
+	}
+}
+
 func SetObjectDefaults_ConfigMap(in *ConfigMap) {
 	SetDefaults_ConfigMap(in)
 }
@@ -98,6 +143,30 @@ func SetObjectDefaults_CronHPAList(in *CronHPAList) {
 	}
 }
 
+func SetObjectDefaults_EtcdSnapshot(in *EtcdSnapshot) {
+	SetDefaults_EtcdSnapshotSpec(&in.Spec)
+	SetDefaults_EtcdSnapshotStatus(&in.Status)
+}
+
+func SetObjectDefaults_EtcdSnapshotList(in *EtcdSnapshotList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_EtcdSnapshot(a)
+	}
+}
+
+func SetObjectDefaults_Host(in *Host) {
+	SetDefaults_HostSpec(&in.Spec)
+	SetDefaults_HostStatus(&in.Status)
+}
+
+func SetObjectDefaults_HostList(in *HostList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_Host(a)
+	}
+}
+
 func SetObjectDefaults_Machine(in *Machine) {
 	SetDefaults_MachineStatus(&in.Status)
 }
@@ -109,6 +178,57 @@ func SetObjectDefaults_MachineList(in *MachineList) {
 	}
 }
 
+func SetObjectDefaults_MachinePool(in *MachinePool) {
+	SetDefaults_MachinePoolSpec(&in.Spec)
+	SetDefaults_MachinePoolStatus(&in.Status)
+}
+
+func SetObjectDefaults_MachinePoolList(in *MachinePoolList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_MachinePool(a)
+	}
+}
+
+func SetObjectDefaults_MultiClusterDeployment(in *MultiClusterDeployment) {
+	SetDefaults_MultiClusterDeploymentSpec(&in.Spec)
+	for i := range in.Spec.Template.Spec.InitContainers {
+		a := &in.Spec.Template.Spec.InitContainers[i]
+		for j := range a.Ports {
+			b := &a.Ports[j]
+			if b.Protocol == "" {
+				b.Protocol = "TCP"
+			}
+		}
+	}
+	for i := range in.Spec.Template.Spec.Containers {
+		a := &in.Spec.Template.Spec.Containers[i]
+		for j := range a.Ports {
+			b := &a.Ports[j]
+			if b.Protocol == "" {
+				b.Protocol = "TCP"
+			}
+		}
+	}
+	for i := range in.Spec.Template.Spec.EphemeralContainers {
+		a := &in.Spec.Template.Spec.EphemeralContainers[i]
+		for j := range a.EphemeralContainerCommon.Ports {
+			b := &a.EphemeralContainerCommon.Ports[j]
+			if b.Protocol == "" {
+				b.Protocol = "TCP"
+			}
+		}
+	}
+	SetDefaults_MultiClusterDeploymentStatus(&in.Status)
+}
+
+func SetObjectDefaults_MultiClusterDeploymentList(in *MultiClusterDeploymentList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_MultiClusterDeployment(a)
+	}
+}
+
 func SetObjectDefaults_PersistentEvent(in *PersistentEvent) {
 	SetDefaults_PersistentEventStatus(&in.Status)
 }
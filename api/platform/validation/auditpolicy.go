@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/apis/audit"
+	auditvalidation "k8s.io/apiserver/pkg/apis/audit/validation"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateAuditPolicy validates a given audit policy, a cluster can have at
+// most one audit policy.
+func ValidateAuditPolicy(ctx context.Context, policy *platform.AuditPolicy, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&policy.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateAuditPolicySpec(ctx, &policy.Spec, field.NewPath("spec"), platformClient)...)
+	if len(allErrs) != 0 {
+		return allErrs
+	}
+
+	policies, err := platformClient.AuditPolicies().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", policy.Spec.ClusterName).String(),
+	})
+	if err != nil {
+		return append(allErrs, field.InternalError(field.NewPath("spec", "clusterName"), err))
+	}
+	for _, p := range policies.Items {
+		if p.Name != policy.Name {
+			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec", "clusterName"), policy.Spec.ClusterName))
+			break
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAuditPolicyUpdate tests if an update to an audit policy is valid.
+func ValidateAuditPolicyUpdate(ctx context.Context, policy *platform.AuditPolicy, oldPolicy *platform.AuditPolicy, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&policy.ObjectMeta, &oldPolicy.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(policy.Spec.TenantID, oldPolicy.Spec.TenantID, fldPath.Child("tenantID"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(policy.Spec.ClusterName, oldPolicy.Spec.ClusterName, fldPath.Child("clusterName"))...)
+	allErrs = append(allErrs, ValidateAuditPolicySpec(ctx, &policy.Spec, fldPath, platformClient)...)
+
+	return allErrs
+}
+
+// ValidateAuditPolicySpec validates a given audit policy spec, the rules are
+// checked the same way kube-apiserver checks its --audit-policy-file.
+func ValidateAuditPolicySpec(ctx context.Context, spec *platform.AuditPolicySpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, err := range auditvalidation.ValidatePolicy(ConvertToAuditPolicy(spec)) {
+		err.Field = fldPath.String() + "." + err.Field
+		allErrs = append(allErrs, err)
+	}
+
+	if spec.ClusterName == "" {
+		return append(allErrs, field.Required(fldPath.Child("clusterName"), "must specify cluster name"))
+	}
+	cluster, err := platformClient.Clusters().Get(ctx, spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.NotFound(fldPath.Child("clusterName"), spec.ClusterName))
+		}
+		return append(allErrs, field.InternalError(fldPath.Child("clusterName"), err))
+	}
+	if cluster.Spec.TenantID != spec.TenantID {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("clusterName"), spec.ClusterName))
+	}
+
+	return allErrs
+}
+
+// ConvertToAuditPolicy converts the spec of an audit policy to the internal
+// policy of the audit.k8s.io group.
+func ConvertToAuditPolicy(spec *platform.AuditPolicySpec) *audit.Policy {
+	policy := &audit.Policy{
+		OmitStages: convertAuditStages(spec.OmitStages),
+	}
+	for _, rule := range spec.Rules {
+		r := audit.PolicyRule{
+			Level:           audit.Level(rule.Level),
+			Users:           rule.Users,
+			UserGroups:      rule.UserGroups,
+			Verbs:           rule.Verbs,
+			Namespaces:      rule.Namespaces,
+			NonResourceURLs: rule.NonResourceURLs,
+			OmitStages:      convertAuditStages(rule.OmitStages),
+		}
+		for _, resources := range rule.Resources {
+			r.Resources = append(r.Resources, audit.GroupResources{
+				Group:         resources.Group,
+				Resources:     resources.Resources,
+				ResourceNames: resources.ResourceNames,
+			})
+		}
+		policy.Rules = append(policy.Rules, r)
+	}
+	return policy
+}
+
+func convertAuditStages(stages []platform.AuditStage) []audit.Stage {
+	var result []audit.Stage
+	for _, stage := range stages {
+		result = append(result, audit.Stage(stage))
+	}
+	return result
+}
@@ -31,6 +31,7 @@ import (
 	"tkestack.io/tke/api/platform"
 	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
 	"tkestack.io/tke/pkg/platform/types"
+	"tkestack.io/tke/pkg/spec"
 	utilvalidation "tkestack.io/tke/pkg/util/validation"
 )
 
@@ -53,6 +54,7 @@ func ValidateClusterUpdate(cluster *types.Cluster, oldCluster *types.Cluster) fi
 	fldPath := field.NewPath("spec")
 	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&cluster.ObjectMeta, &oldCluster.ObjectMeta, field.NewPath("metadata"))
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.Type, oldCluster.Spec.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.TemplateRef, oldCluster.Spec.TemplateRef, fldPath.Child("templateRef"))...)
 	allErrs = append(allErrs, ValidatClusterSpec(&cluster.Spec, field.NewPath("spec"), false)...)
 	p, err := clusterprovider.GetProvider(cluster.Spec.Type)
 	if err != nil {
@@ -85,6 +87,9 @@ func ValidateClusterFeature(feature *platform.ClusterFeature, fldPath *field.Pat
 	allErrs = append(allErrs, ValidateHA(feature.HA, fldPath.Child("ha"))...)
 	allErrs = append(allErrs, ValidateFiles(feature.Files, fldPath.Child("files"))...)
 	allErrs = append(allErrs, ValidateHooks(feature.Hooks, fldPath.Child("hooks"), feature.Files, fldPath.Child("files"))...)
+	allErrs = append(allErrs, ValidateEtcdBackup(feature.EtcdBackup, fldPath.Child("etcdBackup"))...)
+	allErrs = append(allErrs, ValidateClusterAutoscaling(feature.Autoscaling, fldPath.Child("autoscaling"))...)
+	allErrs = append(allErrs, ValidateUpgrade(&feature.Upgrade, fldPath.Child("upgrade"))...)
 
 	return allErrs
 }
@@ -107,7 +112,24 @@ func ValidateFiles(files []platform.File, fldPath *field.Path) field.ErrorList {
 
 	for i, file := range files {
 		fldPath := fldPath.Index(i).Child("src")
-		s, err := os.Stat(file.Src)
+		src := file.Src
+		if strings.Contains(src, spec.ArchPlaceholder) {
+			// the file of each architecture is checked when it is copied to
+			// machines, here at least one of them must exist.
+			src = ""
+			for _, arch := range spec.Archs {
+				archSrc := strings.ReplaceAll(file.Src, spec.ArchPlaceholder, arch)
+				if _, err := os.Stat(archSrc); err == nil {
+					src = archSrc
+					break
+				}
+			}
+			if src == "" {
+				allErrs = append(allErrs, field.Invalid(fldPath, file.Src, fmt.Sprintf("no file exists for any of the architectures %v", spec.Archs)))
+				continue
+			}
+		}
+		s, err := os.Stat(src)
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(fldPath, file.Src, err.Error()))
 			continue
@@ -209,3 +231,17 @@ func ValidateHA(ha *platform.HA, fldPath *field.Path) field.ErrorList {
 
 	return allErrs
 }
+
+// ValidateUpgrade validates a given Upgrade.
+func ValidateUpgrade(upgrade *platform.Upgrade, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if upgrade.Strategy.CanaryMachines < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy", "canaryMachines"), upgrade.Strategy.CanaryMachines, "must be greater than or equal to 0"))
+	}
+	if upgrade.Strategy.PauseAfterCanary && upgrade.Strategy.CanaryMachines == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy", "pauseAfterCanary"), upgrade.Strategy.PauseAfterCanary, "requires canaryMachines to be greater than 0"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+)
+
+// minClusterSyncInterval is the minimum interval of syncing a cluster sync.
+const minClusterSyncInterval = 30 * time.Second
+
+// ValidateClusterSync validates a given cluster sync.
+func ValidateClusterSync(ctx context.Context, sync *platform.ClusterSync, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&sync.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateClusterSyncSpec(ctx, &sync.Spec, field.NewPath("spec"), platformClient)...)
+
+	return allErrs
+}
+
+// ValidateClusterSyncUpdate tests if an update to a cluster sync is valid.
+func ValidateClusterSyncUpdate(ctx context.Context, sync *platform.ClusterSync, oldSync *platform.ClusterSync, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&sync.ObjectMeta, &oldSync.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(sync.Spec.TenantID, oldSync.Spec.TenantID, fldPath.Child("tenantID"))...)
+	allErrs = append(allErrs, ValidateClusterSyncSpec(ctx, &sync.Spec, fldPath, platformClient)...)
+
+	return allErrs
+}
+
+// ValidateClusterSyncSpec validates a given cluster sync spec, the clusters
+// listed by name must exist and belong to the tenant of the cluster sync.
+func ValidateClusterSyncSpec(ctx context.Context, spec *platform.ClusterSyncSpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := ValidateClusterSyncSource(&spec.Source, fldPath.Child("source"))
+
+	if spec.Interval.Duration < minClusterSyncInterval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), spec.Interval.Duration.String(), "must be at least "+minClusterSyncInterval.String()))
+	}
+
+	if len(spec.ClusterNames) == 0 && spec.ClusterSelector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterNames"), "must specify cluster names or cluster selector"))
+	}
+	if spec.ClusterSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ClusterSelector, fldPath.Child("clusterSelector"))...)
+	}
+	allErrs = append(allErrs, validateClusterNames(ctx, spec.ClusterNames, spec.TenantID, fldPath.Child("clusterNames"), platformClient)...)
+
+	return allErrs
+}
+
+// validateClusterNames checks the clusters exist and belong to the tenant.
+func validateClusterNames(ctx context.Context, clusterNames []string, tenantID string, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, clusterName := range clusterNames {
+		cluster, err := platformClient.Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				allErrs = append(allErrs, field.NotFound(fldPath.Index(i), clusterName))
+				continue
+			}
+			allErrs = append(allErrs, field.InternalError(fldPath.Index(i), err))
+			continue
+		}
+		if cluster.Spec.TenantID != tenantID {
+			allErrs = append(allErrs, field.NotFound(fldPath.Index(i), clusterName))
+		}
+	}
+	return allErrs
+}
+
+// ValidateClusterSyncSource validates a given cluster sync source. Nothing
+// may start with a dash, since they are passed to git as arguments.
+func ValidateClusterSyncSource(source *platform.ClusterSyncSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if source.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must specify repository url"))
+	} else if strings.HasPrefix(source.URL, "-") || strings.ContainsAny(source.URL, " \t\n") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), source.URL, "must be a valid repository url"))
+	}
+
+	if source.Branch == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("branch"), "must specify branch"))
+	} else if strings.HasPrefix(source.Branch, "-") || strings.Contains(source.Branch, "..") || strings.ContainsAny(source.Branch, " \t\n~^:?*[\\") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("branch"), source.Branch, "must be a valid branch name"))
+	}
+
+	if source.Path != "" {
+		if path.IsAbs(source.Path) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), source.Path, "must be a relative path"))
+		}
+		for _, element := range strings.Split(source.Path, "/") {
+			if element == ".." {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), source.Path, "must not contain '..'"))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	clusterutil "tkestack.io/tke/pkg/platform/util"
+)
+
+// ValidateClusterTemplate validates a given cluster template.
+func ValidateClusterTemplate(template *platform.ClusterTemplate) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&template.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateClusterTemplateSpec(&template.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateClusterTemplateUpdate tests if an update to a cluster template is valid.
+func ValidateClusterTemplateUpdate(template *platform.ClusterTemplate, oldTemplate *platform.ClusterTemplate) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&template.ObjectMeta, &oldTemplate.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(template.Spec.TenantID, oldTemplate.Spec.TenantID, fldPath.Child("tenantID"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(template.Spec.Type, oldTemplate.Spec.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, ValidateClusterTemplateSpec(&template.Spec, fldPath)...)
+
+	return allErrs
+}
+
+// ValidateClusterTemplateSpec validates a given cluster template spec.
+func ValidateClusterTemplateSpec(spec *platform.ClusterTemplateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Type != "" {
+		allErrs = append(allErrs, ValidateClusterType(spec.Type, fldPath.Child("type"))...)
+	}
+	if spec.ServiceCIDR != nil {
+		if _, _, err := net.ParseCIDR(*spec.ServiceCIDR); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceCIDR"), *spec.ServiceCIDR, err.Error()))
+		}
+	}
+	allErrs = append(allErrs, ValidateClusterFeature(&spec.Features, fldPath.Child("features"))...)
+	for name, args := range map[string]map[string]string{
+		"dockerExtraArgs":            spec.DockerExtraArgs,
+		"kubeletExtraArgs":           spec.KubeletExtraArgs,
+		"apiServerExtraArgs":         spec.APIServerExtraArgs,
+		"controllerManagerExtraArgs": spec.ControllerManagerExtraArgs,
+		"schedulerExtraArgs":         spec.SchedulerExtraArgs,
+		"networkArgs":                spec.NetworkArgs,
+	} {
+		for key := range args {
+			if key == "" {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child(name), key, "must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateClusterTemplateRef validates the cluster template referenced by a
+// cluster, the template must exist, belong to the same tenant, still keep the
+// referenced revision and be of the same cluster type.
+func ValidateClusterTemplateRef(ctx context.Context, spec *platform.ClusterSpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.TemplateRef == nil {
+		return allErrs
+	}
+
+	if spec.TemplateRef.Name == "" {
+		return append(allErrs, field.Required(fldPath.Child("name"), "must specify name"))
+	}
+	template, err := platformClient.ClusterTemplates().Get(ctx, spec.TemplateRef.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.NotFound(fldPath.Child("name"), spec.TemplateRef.Name))
+		}
+		return append(allErrs, field.InternalError(fldPath.Child("name"), err))
+	}
+	if template.Spec.TenantID != spec.TenantID {
+		return append(allErrs, field.NotFound(fldPath.Child("name"), spec.TemplateRef.Name))
+	}
+	templateSpec, ok := clusterutil.GetClusterTemplateRevision(template, spec.TemplateRef.Revision)
+	if !ok {
+		return append(allErrs, field.NotFound(fldPath.Child("revision"), spec.TemplateRef.Revision))
+	}
+	if templateSpec.Type != "" && templateSpec.Type != spec.Type {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), spec.TemplateRef.Name, fmt.Sprintf("template is for %s clusters", templateSpec.Type)))
+	}
+
+	return allErrs
+}
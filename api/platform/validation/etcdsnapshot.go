@@ -0,0 +1,151 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	utilvalidation "tkestack.io/tke/pkg/util/validation"
+)
+
+// ValidateEtcdSnapshot validates a given etcd snapshot.
+func ValidateEtcdSnapshot(ctx context.Context, snapshot *platform.EtcdSnapshot, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&snapshot.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateEtcdSnapshotSpec(ctx, &snapshot.Spec, field.NewPath("spec"), platformClient)...)
+
+	return allErrs
+}
+
+// ValidateEtcdSnapshotUpdate tests if an update to an etcd snapshot is valid.
+func ValidateEtcdSnapshotUpdate(ctx context.Context, snapshot *platform.EtcdSnapshot, oldSnapshot *platform.EtcdSnapshot) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&snapshot.ObjectMeta, &oldSnapshot.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(snapshot.Spec.ClusterName, oldSnapshot.Spec.ClusterName, fldPath.Child("clusterName"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(snapshot.Spec.Type, oldSnapshot.Spec.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(snapshot.Spec.Target, oldSnapshot.Spec.Target, fldPath.Child("target"))...)
+
+	return allErrs
+}
+
+// ValidateEtcdSnapshotSpec validates a given etcd snapshot spec.
+func ValidateEtcdSnapshotSpec(ctx context.Context, spec *platform.EtcdSnapshotSpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, utilvalidation.ValidateEnum(spec.Type, fldPath.Child("type"),
+		[]interface{}{
+			platform.EtcdSnapshotScheduled,
+			platform.EtcdSnapshotManual,
+		})...)
+
+	if spec.ClusterName == "" {
+		return append(allErrs, field.Required(fldPath.Child("clusterName"), "must specify cluster name"))
+	}
+	cluster, err := platformClient.Clusters().Get(ctx, spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.NotFound(fldPath.Child("clusterName"), spec.ClusterName))
+		}
+		return append(allErrs, field.InternalError(fldPath.Child("clusterName"), err))
+	}
+	if cluster.Spec.Etcd == nil || cluster.Spec.Etcd.Local == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("clusterName"), spec.ClusterName, "only cluster with local etcd can be snapshotted"))
+	}
+
+	if isEmptyEtcdSnapshotTarget(&spec.Target) {
+		if cluster.Spec.Features.EtcdBackup == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("target"), "must specify target if etcd backup of cluster is not enabled"))
+		}
+		return allErrs
+	}
+
+	return append(allErrs, ValidateEtcdSnapshotTarget(&spec.Target, fldPath.Child("target"))...)
+}
+
+// ValidateEtcdBackup validates a given EtcdBackup.
+func ValidateEtcdBackup(backup *platform.EtcdBackup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if backup == nil {
+		return allErrs
+	}
+
+	if backup.IntervalInSecond < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("intervalInSecond"), backup.IntervalInSecond, "must be greater than or equal to 0"))
+	}
+	if backup.MaxBackups < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackups"), backup.MaxBackups, "must be greater than or equal to 0"))
+	}
+	allErrs = append(allErrs, ValidateEtcdSnapshotTarget(&backup.Target, fldPath.Child("target"))...)
+
+	return allErrs
+}
+
+// ValidateEtcdSnapshotTarget validates a given EtcdSnapshotTarget.
+func ValidateEtcdSnapshotTarget(target *platform.EtcdSnapshotTarget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	count := 0
+	if target.Local != nil {
+		count++
+		if target.Local.IP != "" {
+			for _, msg := range validation.IsValidIP(target.Local.IP) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("local", "ip"), target.Local.IP, msg))
+			}
+		}
+		if target.Local.Dir != "" && !path.IsAbs(target.Local.Dir) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("local", "dir"), target.Local.Dir, "must be an absolute path"))
+		}
+	}
+	if target.S3 != nil {
+		count++
+		if target.S3.Endpoint == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("s3", "endpoint"), "must specify endpoint"))
+		}
+		if target.S3.Bucket == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("s3", "bucket"), "must specify bucket"))
+		}
+		if target.S3.AccessKeyID == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("s3", "accessKeyID"), "must specify accessKeyID"))
+		}
+	}
+	if target.Registry != nil {
+		count++
+		if path.IsAbs(target.Registry.Prefix) || strings.HasPrefix(path.Clean(target.Registry.Prefix), "..") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("registry", "prefix"), target.Registry.Prefix, "must be a relative path inside the registry filesystem"))
+		}
+	}
+	if count != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "must specify exactly one of `local`, `s3` or `registry`"))
+	}
+
+	return allErrs
+}
+
+func isEmptyEtcdSnapshotTarget(target *platform.EtcdSnapshotTarget) bool {
+	return target.Local == nil && target.S3 == nil && target.Registry == nil
+}
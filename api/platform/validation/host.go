@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateHost validates a given host.
+func ValidateHost(ctx context.Context, host *platform.Host, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&host.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateHostSpec(ctx, &host.Spec, fldPath, platformClient)...)
+	if len(allErrs) != 0 {
+		return allErrs
+	}
+
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.tenantID", host.Spec.TenantID),
+		fields.OneTermEqualSelector("spec.ip", host.Spec.IP)).String()
+	hostList, err := platformClient.Hosts().List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath.Child("ip"), err))
+	}
+	for _, one := range hostList.Items {
+		if one.Name != host.Name {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("ip"), host.Spec.IP))
+			break
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateHostUpdate tests if an update to a host is valid.
+func ValidateHostUpdate(ctx context.Context, host *platform.Host, oldHost *platform.Host, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&host.ObjectMeta, &oldHost.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(host.Spec.TenantID, oldHost.Spec.TenantID, fldPath.Child("tenantID"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(host.Spec.IP, oldHost.Spec.IP, fldPath.Child("ip"))...)
+	allErrs = append(allErrs, ValidateHostSpec(ctx, &host.Spec, fldPath, platformClient)...)
+
+	return allErrs
+}
+
+// ValidateHostSpec validates a given host spec.
+func ValidateHostSpec(ctx context.Context, spec *platform.HostSpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, msg := range validation.IsValidIP(spec.IP) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ip"), spec.IP, msg))
+	}
+	for _, msg := range validation.IsValidPortNum(int(spec.Port)) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), spec.Port, msg))
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(spec.Labels, fldPath.Child("labels"))...)
+	if spec.CredentialName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("credentialName"), "must specify credential name"))
+	} else {
+		allErrs = append(allErrs, ValidateSSHCredentialName(ctx, spec.CredentialName, spec.TenantID, fldPath.Child("credentialName"), platformClient)...)
+	}
+
+	return allErrs
+}
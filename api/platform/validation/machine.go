@@ -64,6 +64,9 @@ func ValidateMachineSpec(ctx context.Context, spec *platform.MachineSpec, fldPat
 
 	allErrs = append(allErrs, ValidateMachineSpecType(spec.Type, fldPath.Child("type"))...)
 	allErrs = append(allErrs, ValidateClusterName(ctx, spec.ClusterName, fldPath.Child("clusterName"))...)
+	if spec.CredentialName != "" {
+		allErrs = append(allErrs, ValidateSSHCredentialName(ctx, spec.CredentialName, spec.TenantID, fldPath.Child("credentialName"), platformClient)...)
+	}
 
 	return allErrs
 }
@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	machineprovider "tkestack.io/tke/pkg/platform/provider/machine"
+)
+
+// ValidateMachinePool validates a given machine pool.
+func ValidateMachinePool(ctx context.Context, pool *platform.MachinePool, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&pool.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMachinePoolSpec(ctx, &pool.Spec, field.NewPath("spec"), platformClient)...)
+
+	return allErrs
+}
+
+// ValidateMachinePoolUpdate tests if an update to a machine pool is valid.
+func ValidateMachinePoolUpdate(ctx context.Context, pool *platform.MachinePool, oldPool *platform.MachinePool, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&pool.ObjectMeta, &oldPool.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(pool.Spec.Type, oldPool.Spec.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(pool.Spec.ClusterName, oldPool.Spec.ClusterName, fldPath.Child("clusterName"))...)
+	allErrs = append(allErrs, ValidateMachinePoolSpec(ctx, &pool.Spec, fldPath, platformClient)...)
+
+	return allErrs
+}
+
+// ValidateMachinePoolSpec validates a given machine pool spec.
+func ValidateMachinePoolSpec(ctx context.Context, spec *platform.MachinePoolSpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateMachineSpecType(spec.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(spec.Replicas), fldPath.Child("replicas"))...)
+	// Hosts of machines are provisioned on demand by some providers.
+	provider, err := machineprovider.GetProvider(spec.Type)
+	provisionsHosts := err == nil && machineprovider.ProvisionsHosts(provider)
+	if spec.HostSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.HostSelector, fldPath.Child("hostSelector"))...)
+	} else if !provisionsHosts {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hostSelector"), "must specify host selector"))
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(spec.Template.Labels, fldPath.Child("template", "labels"))...)
+
+	if spec.ClusterName == "" {
+		return append(allErrs, field.Required(fldPath.Child("clusterName"), "must specify cluster name"))
+	}
+	if _, err := platformClient.Clusters().Get(ctx, spec.ClusterName, metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.NotFound(fldPath.Child("clusterName"), spec.ClusterName))
+		}
+		return append(allErrs, field.InternalError(fldPath.Child("clusterName"), err))
+	}
+	if provider != nil {
+		allErrs = append(allErrs, machineprovider.ValidateMachinePoolSpec(provider, spec, fldPath)...)
+	}
+
+	return allErrs
+}
+
+// ValidateClusterAutoscaling validates a given ClusterAutoscaling, a machine
+// pool can be in at most one node group.
+func ValidateClusterAutoscaling(autoscaling *platform.ClusterAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoscaling == nil {
+		return allErrs
+	}
+
+	pools := sets.NewString()
+	for i, group := range autoscaling.NodeGroups {
+		idxPath := fldPath.Child("nodeGroups").Index(i)
+		if group.MachinePool == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("machinePool"), "must specify machine pool"))
+		} else if pools.Has(group.MachinePool) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("machinePool"), group.MachinePool))
+		}
+		pools.Insert(group.MachinePool)
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(group.MinReplicas), idxPath.Child("minReplicas"))...)
+		if group.MaxReplicas < group.MinReplicas {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxReplicas"), group.MaxReplicas, "must be greater than or equal to minReplicas"))
+		}
+	}
+	if autoscaling.ScaleDownUnneededTime != nil && autoscaling.ScaleDownUnneededTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUnneededTime"), autoscaling.ScaleDownUnneededTime.Duration.String(), "must be greater than or equal to 0"))
+	}
+	if autoscaling.ScaleDownUtilizationThreshold < 0 || autoscaling.ScaleDownUtilizationThreshold > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUtilizationThreshold"), autoscaling.ScaleDownUtilizationThreshold, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateMultiClusterDeployment validates a given multi cluster deployment.
+func ValidateMultiClusterDeployment(ctx context.Context, deployment *platform.MultiClusterDeployment, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&deployment.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMultiClusterDeploymentSpec(ctx, &deployment.Spec, field.NewPath("spec"), platformClient)...)
+
+	return allErrs
+}
+
+// ValidateMultiClusterDeploymentUpdate tests if an update to a multi cluster
+// deployment is valid.
+func ValidateMultiClusterDeploymentUpdate(ctx context.Context, deployment *platform.MultiClusterDeployment, oldDeployment *platform.MultiClusterDeployment, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&deployment.ObjectMeta, &oldDeployment.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(deployment.Spec.TenantID, oldDeployment.Spec.TenantID, fldPath.Child("tenantID"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(deployment.Spec.Namespace, oldDeployment.Spec.Namespace, fldPath.Child("namespace"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(deployment.Spec.Selector, oldDeployment.Spec.Selector, fldPath.Child("selector"))...)
+	allErrs = append(allErrs, ValidateMultiClusterDeploymentSpec(ctx, &deployment.Spec, fldPath, platformClient)...)
+
+	return allErrs
+}
+
+// ValidateMultiClusterDeploymentSpec validates a given multi cluster
+// deployment spec.
+func ValidateMultiClusterDeploymentSpec(ctx context.Context, spec *platform.MultiClusterDeploymentSpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Namespace == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must specify namespace"))
+	} else {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(spec.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), spec.Namespace, msg))
+		}
+	}
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *spec.Replicas, "must be greater than or equal to 0"))
+	}
+
+	containers := sets.NewString()
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), "must specify selector"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.Selector, fldPath.Child("selector"))...)
+		if len(spec.Selector.MatchLabels)+len(spec.Selector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), spec.Selector, "empty selector is invalid for deployment"))
+		} else if selector, err := metav1.LabelSelectorAsSelector(spec.Selector); err == nil && !selector.Matches(labels.Set(spec.Template.Labels)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("template", "metadata", "labels"), spec.Template.Labels, "`selector` does not match template `labels`"))
+		}
+	}
+	containersPath := fldPath.Child("template", "spec", "containers")
+	if len(spec.Template.Spec.Containers) == 0 {
+		allErrs = append(allErrs, field.Required(containersPath, "must specify at least one container"))
+	}
+	for i, container := range spec.Template.Spec.Containers {
+		idxPath := containersPath.Index(i)
+		if container.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify container name"))
+		} else if containers.Has(container.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), container.Name))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(container.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), container.Name, msg))
+			}
+		}
+		containers.Insert(container.Name)
+		if container.Image == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("image"), "must specify container image"))
+		}
+	}
+
+	allErrs = append(allErrs, ValidateMultiClusterPlacement(ctx, &spec.Placement, spec.TenantID, fldPath.Child("placement"), platformClient)...)
+
+	clusterNames := sets.NewString()
+	for i, override := range spec.Overrides {
+		idxPath := fldPath.Child("overrides").Index(i)
+		if override.ClusterName == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("clusterName"), "must specify cluster name"))
+		} else if clusterNames.Has(override.ClusterName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("clusterName"), override.ClusterName))
+		}
+		clusterNames.Insert(override.ClusterName)
+		if override.Replicas != nil && *override.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("replicas"), *override.Replicas, "must be greater than or equal to 0"))
+		}
+		overridden := sets.NewString()
+		for j, container := range override.Containers {
+			containerPath := idxPath.Child("containers").Index(j)
+			if !containers.Has(container.Name) {
+				allErrs = append(allErrs, field.NotFound(containerPath.Child("name"), container.Name))
+			} else if overridden.Has(container.Name) {
+				allErrs = append(allErrs, field.Duplicate(containerPath.Child("name"), container.Name))
+			}
+			overridden.Insert(container.Name)
+			for k, env := range container.Env {
+				if env.Name == "" {
+					allErrs = append(allErrs, field.Required(containerPath.Child("env").Index(k).Child("name"), "must specify env name"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMultiClusterPlacement validates a given placement, the clusters
+// listed by name must exist and belong to the tenant.
+func ValidateMultiClusterPlacement(ctx context.Context, placement *platform.MultiClusterPlacement, tenantID string, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(placement.ClusterNames) == 0 && placement.ClusterSelector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterNames"), "must specify cluster names or cluster selector"))
+	}
+	if placement.ClusterSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(placement.ClusterSelector, fldPath.Child("clusterSelector"))...)
+	}
+	allErrs = append(allErrs, validateClusterNames(ctx, placement.ClusterNames, tenantID, fldPath.Child("clusterNames"), platformClient)...)
+
+	clusterNames := sets.NewString()
+	for i, weight := range placement.Weights {
+		idxPath := fldPath.Child("weights").Index(i)
+		if weight.ClusterName == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("clusterName"), "must specify cluster name"))
+		} else if clusterNames.Has(weight.ClusterName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("clusterName"), weight.ClusterName))
+		}
+		clusterNames.Insert(weight.ClusterName)
+		if weight.Weight < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), weight.Weight, "must be greater than or equal to 0"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+// ValidateSSHCredential validates a given ssh credential.
+func ValidateSSHCredential(credential *platform.SSHCredential) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&credential.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateSSHCredentialSpec(&credential.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateSSHCredentialUpdate tests if an update to a ssh credential is valid.
+func ValidateSSHCredentialUpdate(credential *platform.SSHCredential, oldCredential *platform.SSHCredential) field.ErrorList {
+	fldPath := field.NewPath("spec")
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&credential.ObjectMeta, &oldCredential.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(credential.Spec.TenantID, oldCredential.Spec.TenantID, fldPath.Child("tenantID"))...)
+	allErrs = append(allErrs, ValidateSSHCredentialSpec(&credential.Spec, fldPath)...)
+
+	return allErrs
+}
+
+// ValidateSSHCredentialSpec validates a given ssh credential spec.
+func ValidateSSHCredentialSpec(spec *platform.SSHCredentialSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Username == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("username"), "must specify username"))
+	}
+	if len(spec.Password) == 0 && len(spec.PrivateKey) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("password"), "must specify password or privateKey"))
+	}
+	if len(spec.PrivateKey) != 0 {
+		if _, err := ssh.MakePrivateKeySigner(spec.PrivateKey, spec.PassPhrase); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("privateKey"), "", err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateSSHCredentialName validates a reference to a ssh credential, the
+// credential must exist and belong to the same tenant as the referrer.
+func ValidateSSHCredentialName(ctx context.Context, name string, tenantID string, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	credential, err := platformClient.SSHCredentials().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.NotFound(fldPath, name))
+		}
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	if credential.Spec.TenantID != tenantID {
+		allErrs = append(allErrs, field.NotFound(fldPath, name))
+	}
+
+	return allErrs
+}
+
+// ValidateClusterMachineCredentials validates the ssh credentials referenced
+// by the machines of a cluster.
+func ValidateClusterMachineCredentials(ctx context.Context, spec *platform.ClusterSpec, fldPath *field.Path, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, machine := range spec.Machines {
+		if machine.CredentialName != "" {
+			allErrs = append(allErrs, ValidateSSHCredentialName(ctx, machine.CredentialName, spec.TenantID, fldPath.Index(i).Child("credentialName"), platformClient)...)
+		}
+	}
+
+	return allErrs
+}
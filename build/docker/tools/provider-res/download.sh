@@ -31,6 +31,13 @@ declare -A archMap=(
   [arm64]=arm64
 )
 
+# OS_PACKAGES_IMAGES for building the offline package repository of each OS family
+declare -A osPackagesImages=(
+  [centos]=centos:7
+  [openeuler]=openeuler/openeuler:20.03-lts-sp2
+  [ubuntu]=ubuntu:20.04
+)
+
 cd "$DST_DIR" || exit
 
 function download::cni_plugins() {
@@ -140,6 +147,20 @@ function download::pkgs() {
   done
 }
 
+function download::os_packages() {
+  docker_arch=${archMap[${arch}]}
+  for family in "${!osPackagesImages[@]}"; do
+    packages_var="OS_PACKAGES_${family^^}"
+    image=${osPackagesImages[${family}]}
+    docker --config=${DOCKER_PULL_CONFIG} pull --platform=${docker_arch} "${image}"
+    for version in ${OS_PACKAGES_VERSIONS}; do
+      docker run --platform="${docker_arch}" -e OS="${os}" -e ARCH="${arch}" -e FAMILY="${family}" \
+        -e PACKAGES="${!packages_var}" -e VERSION="${version}" \
+        --rm -v"${SCRIPT_DIR}":/tmp/bin -v$(realpath $(pwd)):/output "${image}" /tmp/bin/os-packages.sh
+    done
+  done
+}
+
 echo "Starting to download resources..."
 
 for os in ${OSS}; do
@@ -156,6 +177,7 @@ for os in ${OSS}; do
     download::nvidia_driver
     download::nvidia_container_runtime
     download::pkgs
+    download::os_packages
 
     cd -
   done
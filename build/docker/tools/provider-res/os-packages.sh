@@ -0,0 +1,54 @@
+#!/usr/bin/env bash
+
+# Tencent is pleased to support the open source community by making TKEStack
+# available.
+#
+# Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+# this file except in compliance with the License. You may obtain a copy of the
+# License at
+#
+# https://opensource.org/licenses/Apache-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+# WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+# specific language governing permissions and limitations under the License.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+set -o xtrace
+
+# Build an offline package repository of ${PACKAGES} and their dependencies for
+# the OS ${FAMILY}, the repository metadata is at the root of the tarball.
+
+REPO_DIR=$(mktemp -d)
+
+case "${FAMILY}" in
+centos)
+  yum install -y yum-utils createrepo
+  yumdownloader --resolve --destdir="${REPO_DIR}" ${PACKAGES}
+  createrepo "${REPO_DIR}"
+  ;;
+openeuler)
+  dnf install -y dnf-plugins-core createrepo
+  dnf download --resolve --alldeps --destdir="${REPO_DIR}" ${PACKAGES}
+  createrepo "${REPO_DIR}"
+  ;;
+ubuntu)
+  apt-get update
+  DEBIAN_FRONTEND=noninteractive apt-get install -y dpkg-dev
+  cd "${REPO_DIR}"
+  apt-get download $(apt-cache depends --recurse --no-recommends --no-suggests \
+    --no-conflicts --no-breaks --no-replaces --no-enhances ${PACKAGES} | grep "^\w" | sort -u)
+  dpkg-scanpackages . /dev/null | gzip -9c >Packages.gz
+  ;;
+*)
+  echo "ERROR: unsupport OS family ${FAMILY}"
+  exit 1
+  ;;
+esac
+
+tar -C "${REPO_DIR}" -cvzf "/output/os-packages-${FAMILY}-${OS}-${ARCH}-${VERSION}.tar.gz" .
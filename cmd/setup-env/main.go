@@ -38,6 +38,10 @@ func main() {
 	env = append(env, fmt.Sprintf("CNI_PLUGINS_VERSIONS=%s", strings.Join(spec.CNIPluginsVersions, " ")))
 	env = append(env, fmt.Sprintf("NVIDIA_DRIVER_VERSIONS=%s", strings.Join(spec.NvidiaDriverVersions, " ")))
 	env = append(env, fmt.Sprintf("NVIDIA_CONTAINER_RUNTIME_VERSIONS=%s", strings.Join(spec.NvidiaContainerRuntimeVersions, " ")))
+	env = append(env, fmt.Sprintf("OS_PACKAGES_VERSIONS=%s", strings.Join(spec.OSPackagesVersions, " ")))
+	for family, packages := range spec.OSPackages {
+		env = append(env, fmt.Sprintf("OS_PACKAGES_%s=%s", strings.ToUpper(family), strings.Join(packages, " ")))
+	}
 
 	for _, one := range env {
 		fmt.Println(one)
@@ -26,8 +26,10 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/pkg/server/filters"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	"k8s.io/client-go/rest"
 
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	generatedopenapi "tkestack.io/tke/api/openapi"
 	"tkestack.io/tke/api/platform"
@@ -40,7 +42,9 @@ import (
 	"tkestack.io/tke/pkg/apiserver/storage"
 	"tkestack.io/tke/pkg/apiserver/util"
 	"tkestack.io/tke/pkg/auth/filter"
+	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	"tkestack.io/tke/pkg/platform/apiserver"
+	"tkestack.io/tke/pkg/platform/provider/util/plugins"
 )
 
 const (
@@ -56,6 +60,8 @@ type Config struct {
 	StorageFactory                 *serverstorage.DefaultStorageFactory
 	PrivilegedUsername             string
 	FeatureOptions                 *options.FeatureOptions
+	ProxyCacheOptions              *options.ProxyCacheOptions
+	BusinessClient                 businessversionedclient.BusinessV1Interface
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -80,6 +86,10 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
+	if err := plugins.Register(opts.Provider.PluginConfig); err != nil {
+		return nil, err
+	}
+
 	openapi.SetupOpenAPI(genericAPIServerConfig, generatedopenapi.GetOpenAPIDefinitions, title, license, opts.Generic.ExternalHost, opts.Generic.ExternalPort)
 
 	// storageFactory
@@ -119,6 +129,23 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
+	// client config for business apiserver
+	businessAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.BusinessAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if !ok && opts.BusinessAPIClient.Required {
+		return nil, fmt.Errorf("failed to initialize client config of business API server")
+	}
+	var businessClientV1 businessversionedclient.BusinessV1Interface
+	if ok {
+		businessClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(businessAPIServerClientConfig, serverName))
+		if err != nil {
+			return nil, err
+		}
+		businessClientV1 = businessClient.BusinessV1()
+	}
+
 	return &Config{
 		ServerName:                     serverName,
 		GenericAPIServerConfig:         genericAPIServerConfig,
@@ -126,5 +153,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		StorageFactory:                 storageFactory,
 		PrivilegedUsername:             opts.Authentication.PrivilegedUsername,
 		FeatureOptions:                 opts.FeatureOptions,
+		ProxyCacheOptions:              opts.ProxyCache,
+		BusinessClient:                 businessClientV1,
 	}, nil
 }
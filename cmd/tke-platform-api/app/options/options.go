@@ -25,6 +25,7 @@ import (
 	genericapiserveroptions "k8s.io/apiserver/pkg/server/options"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
 	storageoptions "tkestack.io/tke/pkg/apiserver/storage/options"
+	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/cachesize"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -40,20 +41,27 @@ type Options struct {
 	Authorization  *apiserveroptions.AuthorizationOptions
 	Audit          *genericapiserveroptions.AuditOptions
 	FeatureOptions *FeatureOptions
+	Provider       *ProviderOptions
+	ProxyCache     *ProxyCacheOptions
+	// BusinessAPIClient is used to get the clusters of projects.
+	BusinessAPIClient *controlleroptions.APIServerClientOptions
 }
 
 // NewOptions creates a new Options with a default config.
 func NewOptions(serverName string) *Options {
 	return &Options{
-		Log:            log.NewOptions(),
-		SecureServing:  apiserveroptions.NewSecureServingOptions(serverName, 9443),
-		Debug:          apiserveroptions.NewDebugOptions(),
-		ETCD:           storageoptions.NewETCDStorageOptions("/tke/platform"),
-		Generic:        apiserveroptions.NewGenericOptions(),
-		Authentication: apiserveroptions.NewAuthenticationWithAPIOptions(),
-		Authorization:  apiserveroptions.NewAuthorizationOptions(),
-		Audit:          genericapiserveroptions.NewAuditOptions(),
-		FeatureOptions: NewFeatureOptions(),
+		Log:               log.NewOptions(),
+		SecureServing:     apiserveroptions.NewSecureServingOptions(serverName, 9443),
+		Debug:             apiserveroptions.NewDebugOptions(),
+		ETCD:              storageoptions.NewETCDStorageOptions("/tke/platform"),
+		Generic:           apiserveroptions.NewGenericOptions(),
+		Authentication:    apiserveroptions.NewAuthenticationWithAPIOptions(),
+		Authorization:     apiserveroptions.NewAuthorizationOptions(),
+		Audit:             genericapiserveroptions.NewAuditOptions(),
+		FeatureOptions:    NewFeatureOptions(),
+		Provider:          NewProviderOptions(),
+		ProxyCache:        NewProxyCacheOptions(),
+		BusinessAPIClient: controlleroptions.NewAPIServerClientOptions("business", false),
 	}
 }
 
@@ -68,6 +76,9 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Authorization.AddFlags(fs)
 	o.Audit.AddFlags(fs)
 	o.FeatureOptions.AddFlags(fs)
+	o.Provider.AddFlags(fs)
+	o.ProxyCache.AddFlags(fs)
+	o.BusinessAPIClient.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -83,6 +94,9 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Authentication.ApplyFlags()...)
 	errs = append(errs, o.Authorization.ApplyFlags()...)
 	errs = append(errs, o.FeatureOptions.ApplyFlags()...)
+	errs = append(errs, o.Provider.ApplyFlags()...)
+	errs = append(errs, o.ProxyCache.ApplyFlags()...)
+	errs = append(errs, o.BusinessAPIClient.ApplyFlags()...)
 
 	return errs
 }
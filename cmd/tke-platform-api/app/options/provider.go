@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagProviderPluginConfig   = "provider-plugin-config"
+	configProviderPluginConfig = "provider.plugin_config"
+)
+
+// ProviderOptions contains the options of out-of-tree providers.
+type ProviderOptions struct {
+	// PluginConfig is the config file of providers served by plugins.
+	PluginConfig string
+}
+
+// NewProviderOptions creates a ProviderOptions object with default parameters.
+func NewProviderOptions() *ProviderOptions {
+	return &ProviderOptions{}
+}
+
+// AddFlags adds flags for provider to the specified FlagSet object.
+func (o *ProviderOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.String(flagProviderPluginConfig, o.PluginConfig,
+		"The config file of out-of-tree cluster and machine providers served by plugins.")
+	_ = viper.BindPFlag(configProviderPluginConfig, fs.Lookup(flagProviderPluginConfig))
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *ProviderOptions) ApplyFlags() []error {
+	var errs []error
+
+	o.PluginConfig = viper.GetString(configProviderPluginConfig)
+
+	return errs
+}
@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagProxyCacheResources   = "proxy-cache-resources"
+	flagProxyCacheMaxObjects  = "proxy-cache-max-objects"
+	flagProxyCacheIdleTimeout = "proxy-cache-idle-timeout"
+)
+
+const (
+	configProxyCacheResources   = "proxy_cache.resources"
+	configProxyCacheMaxObjects  = "proxy_cache.max_objects"
+	configProxyCacheIdleTimeout = "proxy_cache.idle_timeout"
+)
+
+// ProxyCacheOptions contains the options of the informer cache serving get
+// and list of the cluster resources.
+type ProxyCacheOptions struct {
+	// Resources served from the cache, the cache is disabled if it is empty.
+	Resources []string
+	// MaxObjects is the number of objects held by all informers.
+	MaxObjects int
+	// IdleTimeout is how long an informer is kept without being used.
+	IdleTimeout time.Duration
+}
+
+// NewProxyCacheOptions creates a ProxyCacheOptions object with default parameters.
+func NewProxyCacheOptions() *ProxyCacheOptions {
+	return &ProxyCacheOptions{
+		MaxObjects:  500000,
+		IdleTimeout: 10 * time.Minute,
+	}
+}
+
+// AddFlags adds flags for proxy cache to the specified FlagSet object.
+func (o *ProxyCacheOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSlice(flagProxyCacheResources, o.Resources,
+		"The cluster resources in the form of resource.group, e.g. pods,deployments.apps, whose get and list without an exact resourceVersion are served from per cluster informers. Empty disables the cache.")
+	_ = viper.BindPFlag(configProxyCacheResources, fs.Lookup(flagProxyCacheResources))
+	fs.Int(flagProxyCacheMaxObjects, o.MaxObjects,
+		"The number of objects held by all proxy cache informers, the least recently used informers are stopped when it is exceeded.")
+	_ = viper.BindPFlag(configProxyCacheMaxObjects, fs.Lookup(flagProxyCacheMaxObjects))
+	fs.Duration(flagProxyCacheIdleTimeout, o.IdleTimeout,
+		"How long a proxy cache informer is kept without being used.")
+	_ = viper.BindPFlag(configProxyCacheIdleTimeout, fs.Lookup(flagProxyCacheIdleTimeout))
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *ProxyCacheOptions) ApplyFlags() []error {
+	var errs []error
+
+	o.Resources = viper.GetStringSlice(configProxyCacheResources)
+	o.MaxObjects = viper.GetInt(configProxyCacheMaxObjects)
+	o.IdleTimeout = viper.GetDuration(configProxyCacheIdleTimeout)
+
+	return errs
+}
@@ -64,6 +64,8 @@ func createAPIServerConfig(cfg *config.Config) *apiserver.Config {
 			APIResourceConfigSource: cfg.StorageFactory.APIResourceConfigSource,
 			PrivilegedUsername:      cfg.PrivilegedUsername,
 			FeatureOptions:          cfg.FeatureOptions,
+			ProxyCacheOptions:       cfg.ProxyCacheOptions,
+			BusinessClient:          cfg.BusinessClient,
 		},
 	}
 }
@@ -30,8 +30,17 @@ import (
 	"tkestack.io/tke/cmd/tke-platform-controller/app/options"
 	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	controlleroptions "tkestack.io/tke/pkg/controller/options"
+	autoscalerconfig "tkestack.io/tke/pkg/platform/controller/autoscaler/config"
+	certificateconfig "tkestack.io/tke/pkg/platform/controller/certificate/config"
 	clusterconfig "tkestack.io/tke/pkg/platform/controller/cluster/config"
+	clustersyncconfig "tkestack.io/tke/pkg/platform/controller/clustersync/config"
+	driftconfig "tkestack.io/tke/pkg/platform/controller/drift/config"
+	etcdsnapshotconfig "tkestack.io/tke/pkg/platform/controller/etcdsnapshot/config"
+	hostconfig "tkestack.io/tke/pkg/platform/controller/host/config"
 	machineconfig "tkestack.io/tke/pkg/platform/controller/machine/config"
+	machinepoolconfig "tkestack.io/tke/pkg/platform/controller/machinepool/config"
+	multiclusterdeploymentconfig "tkestack.io/tke/pkg/platform/controller/multiclusterdeployment/config"
+	"tkestack.io/tke/pkg/platform/provider/util/plugins"
 )
 
 // Config is the running configuration structure of the TKE controller manager.
@@ -50,9 +59,19 @@ type Config struct {
 	ApplicationAPIServerClientConfig *restclient.Config
 	Component                        controlleroptions.ComponentConfiguration
 	Features                         *options.FeatureOptions
+	// the rest config for the notify apiserver
+	NotifyAPIServerClientConfig *restclient.Config
 
-	ClusterController clusterconfig.ClusterControllerConfiguration
-	MachineController machineconfig.MachineControllerConfiguration
+	ClusterController                clusterconfig.ClusterControllerConfiguration
+	MachineController                machineconfig.MachineControllerConfiguration
+	EtcdSnapshotController           etcdsnapshotconfig.EtcdSnapshotControllerConfiguration
+	MachinePoolController            machinepoolconfig.MachinePoolControllerConfiguration
+	HostController                   hostconfig.HostControllerConfiguration
+	AutoscalerController             autoscalerconfig.AutoscalerControllerConfiguration
+	CertificateController            certificateconfig.CertificateControllerConfiguration
+	DriftController                  driftconfig.DriftControllerConfiguration
+	ClusterSyncController            clustersyncconfig.ClusterSyncControllerConfiguration
+	MultiClusterDeploymentController multiclusterdeploymentconfig.MultiClusterDeploymentControllerConfiguration
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -62,6 +81,10 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, fmt.Errorf("error creating self-signed certificates: %v", err)
 	}
 
+	if err := plugins.Register(opts.Provider.PluginConfig); err != nil {
+		return nil, err
+	}
+
 	platformAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.PlatformAPIClient)
 	if err != nil {
 		return nil, err
@@ -80,6 +103,11 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
+	notifyAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.NotifyAPIClient)
+	if err != nil {
+		return nil, err
+	}
+
 	controllerManagerConfig := &Config{
 		ServerName:                    serverName,
 		LeaderElectionClient:          leaderElectionClient,
@@ -92,6 +120,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		},
 		Features:                         opts.FeatureOptions,
 		ApplicationAPIServerClientConfig: applicationAPIServerClientConfig,
+		NotifyAPIServerClientConfig:      notifyAPIServerClientConfig,
 	}
 
 	if err := opts.Component.ApplyTo(&controllerManagerConfig.Component); err != nil {
@@ -110,6 +139,30 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.MachineController.ApplyTo(&controllerManagerConfig.MachineController); err != nil {
 		return nil, err
 	}
+	if err := opts.EtcdSnapshotController.ApplyTo(&controllerManagerConfig.EtcdSnapshotController); err != nil {
+		return nil, err
+	}
+	if err := opts.MachinePoolController.ApplyTo(&controllerManagerConfig.MachinePoolController); err != nil {
+		return nil, err
+	}
+	if err := opts.HostController.ApplyTo(&controllerManagerConfig.HostController); err != nil {
+		return nil, err
+	}
+	if err := opts.AutoscalerController.ApplyTo(&controllerManagerConfig.AutoscalerController); err != nil {
+		return nil, err
+	}
+	if err := opts.CertificateController.ApplyTo(&controllerManagerConfig.CertificateController); err != nil {
+		return nil, err
+	}
+	if err := opts.DriftController.ApplyTo(&controllerManagerConfig.DriftController); err != nil {
+		return nil, err
+	}
+	if err := opts.ClusterSyncController.ApplyTo(&controllerManagerConfig.ClusterSyncController); err != nil {
+		return nil, err
+	}
+	if err := opts.MultiClusterDeploymentController.ApplyTo(&controllerManagerConfig.MultiClusterDeploymentController); err != nil {
+		return nil, err
+	}
 
 	return controllerManagerConfig, nil
 }
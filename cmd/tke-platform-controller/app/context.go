@@ -30,6 +30,7 @@ import (
 	"k8s.io/client-go/restmapper"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
 	applicationv1 "tkestack.io/tke/api/client/clientset/versioned/typed/application/v1"
+	notifyv1 "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/cmd/tke-platform-controller/app/config"
 	"tkestack.io/tke/pkg/controller"
@@ -77,6 +78,7 @@ type ControllerContext struct {
 	RemoteAddresses   []string
 	RemoteType        string
 	ApplicationClient applicationv1.ApplicationV1Interface
+	NotifyClient      notifyv1.NotifyV1Interface
 }
 
 // IsControllerEnabled returns whether the controller has been enabled
@@ -90,6 +92,7 @@ func (c ControllerContext) IsControllerEnabled(name string) bool {
 func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.ClientBuilder, stop <-chan struct{}) (ControllerContext, error) {
 	var applicationClientset *versionedclientset.Clientset
 	var applicationClient applicationv1.ApplicationV1Interface
+	var notifyClient notifyv1.NotifyV1Interface
 	var err error
 	if cfg.ApplicationAPIServerClientConfig != nil {
 		applicationClientset, err = versionedclientset.NewForConfig(rest.AddUserAgent(cfg.ApplicationAPIServerClientConfig, "tke-platform-controller"))
@@ -97,6 +100,13 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 			return ControllerContext{}, fmt.Errorf("failed to create the application client: %v", err)
 		}
 	}
+	if cfg.NotifyAPIServerClientConfig != nil {
+		notifyClientset, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.NotifyAPIServerClientConfig, "tke-platform-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the notify client: %v", err)
+		}
+		notifyClient = notifyClientset.NotifyV1()
+	}
 	versionedClient := rootClientBuilder.ClientOrDie("shared-informers")
 	sharedInformers := versionedinformers.NewSharedInformerFactory(versionedClient, controller.ResyncPeriod(&cfg.Component)())
 
@@ -137,6 +147,7 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 		RemoteAddresses:         cfg.Features.MonitorStorageAddresses,
 		RemoteType:              cfg.Features.MonitorStorageType,
 		ApplicationClient:       applicationClient,
+		NotifyClient:            notifyClient,
 	}
 	return ctx, nil
 }
@@ -55,6 +55,14 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["cronhpa"] = startCronHPAController
 	controllers["csioperator"] = startCSIOperatorController
 	controllers["bootstrapapps"] = startBootstrapAppsController
+	controllers["etcdsnapshot"] = startEtcdSnapshotController
+	controllers["machinepool"] = startMachinePoolController
+	controllers["host"] = startHostController
+	controllers["autoscaler"] = startAutoscalerController
+	controllers["certificate"] = startCertificateController
+	controllers["drift"] = startDriftController
+	controllers["clustersync"] = startClusterSyncController
+	controllers["multiclusterdeployment"] = startMultiClusterDeploymentController
 	return controllers
 }
 
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	autoscalerconfig "tkestack.io/tke/pkg/platform/controller/autoscaler/config"
+)
+
+const (
+	flagAutoscalerSyncPeriod      = "autoscaler-sync-period"
+	flagConcurrentAutoscalerSyncs = "concurrent-autoscaler-syncs"
+)
+
+const (
+	configAutoscalerSyncPeriod      = "controller.autoscaler_sync_period"
+	configConcurrentAutoscalerSyncs = "controller.concurrent_autoscaler_syncs"
+)
+
+const (
+	defaultAutoscalerSyncPeriod      = 30 * time.Second
+	defaultConcurrentAutoscalerSyncs = 5
+)
+
+// AutoscalerControllerOptions holds the AutoscalerController options.
+type AutoscalerControllerOptions struct {
+	*autoscalerconfig.AutoscalerControllerConfiguration
+}
+
+// NewAutoscalerControllerOptions creates a new Options with a default config.
+func NewAutoscalerControllerOptions() *AutoscalerControllerOptions {
+	return &AutoscalerControllerOptions{
+		&autoscalerconfig.AutoscalerControllerConfiguration{
+			AutoscalerSyncPeriod:      defaultAutoscalerSyncPeriod,
+			ConcurrentAutoscalerSyncs: defaultConcurrentAutoscalerSyncs,
+		},
+	}
+}
+
+// AddFlags adds flags related to AutoscalerController for controller manager to the specified FlagSet.
+func (o *AutoscalerControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.AutoscalerSyncPeriod, flagAutoscalerSyncPeriod, o.AutoscalerSyncPeriod, "The period for syncing unschedulable pods and unneeded nodes of clusters")
+	_ = viper.BindPFlag(configAutoscalerSyncPeriod, fs.Lookup(flagAutoscalerSyncPeriod))
+	fs.IntVar(&o.ConcurrentAutoscalerSyncs, flagConcurrentAutoscalerSyncs, o.ConcurrentAutoscalerSyncs, "The number of clusters that are allowed to be autoscaled concurrently")
+	_ = viper.BindPFlag(configConcurrentAutoscalerSyncs, fs.Lookup(flagConcurrentAutoscalerSyncs))
+}
+
+// ApplyTo fills up AutoscalerController config with options.
+func (o *AutoscalerControllerOptions) ApplyTo(cfg *autoscalerconfig.AutoscalerControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.AutoscalerSyncPeriod = o.AutoscalerSyncPeriod
+	cfg.ConcurrentAutoscalerSyncs = o.ConcurrentAutoscalerSyncs
+
+	return nil
+}
+
+// Validate checks validation of AutoscalerControllerOptions.
+func (o *AutoscalerControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *AutoscalerControllerOptions) ApplyFlags() []error {
+	o.AutoscalerSyncPeriod = viper.GetDuration(configAutoscalerSyncPeriod)
+	o.ConcurrentAutoscalerSyncs = viper.GetInt(configConcurrentAutoscalerSyncs)
+	return nil
+}
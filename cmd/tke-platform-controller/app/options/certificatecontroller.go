@@ -0,0 +1,139 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	certificateconfig "tkestack.io/tke/pkg/platform/controller/certificate/config"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+)
+
+const (
+	flagCertificateSyncPeriod           = "certificate-sync-period"
+	flagConcurrentCertificateSyncs      = "concurrent-certificate-syncs"
+	flagCertificateRenewBefore          = "certificate-renew-before"
+	flagCertificateAutoRenew            = "certificate-auto-renew"
+	flagCertificateNotifyChannel        = "certificate-notify-channel"
+	flagCertificateNotifyTemplate       = "certificate-notify-template"
+	flagCertificateNotifyReceivers      = "certificate-notify-receivers"
+	flagCertificateNotifyReceiverGroups = "certificate-notify-receiver-groups"
+)
+
+const (
+	configCertificateSyncPeriod           = "controller.certificate_sync_period"
+	configConcurrentCertificateSyncs      = "controller.concurrent_certificate_syncs"
+	configCertificateRenewBefore          = "controller.certificate_renew_before"
+	configCertificateAutoRenew            = "controller.certificate_auto_renew"
+	configCertificateNotifyChannel        = "controller.certificate_notify_channel"
+	configCertificateNotifyTemplate       = "controller.certificate_notify_template"
+	configCertificateNotifyReceivers      = "controller.certificate_notify_receivers"
+	configCertificateNotifyReceiverGroups = "controller.certificate_notify_receiver_groups"
+)
+
+const (
+	defaultCertificateSyncPeriod      = 1 * time.Hour
+	defaultConcurrentCertificateSyncs = 5
+)
+
+// CertificateControllerOptions holds the CertificateController options.
+type CertificateControllerOptions struct {
+	*certificateconfig.CertificateControllerConfiguration
+}
+
+// NewCertificateControllerOptions creates a new Options with a default config.
+func NewCertificateControllerOptions() *CertificateControllerOptions {
+	return &CertificateControllerOptions{
+		&certificateconfig.CertificateControllerConfiguration{
+			CertificateSyncPeriod:      defaultCertificateSyncPeriod,
+			ConcurrentCertificateSyncs: defaultConcurrentCertificateSyncs,
+			RenewBefore:                constants.RenewCertsTimeThreshold,
+			AutoRenew:                  true,
+		},
+	}
+}
+
+// AddFlags adds flags related to CertificateController for controller manager to the specified FlagSet.
+func (o *CertificateControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.CertificateSyncPeriod, flagCertificateSyncPeriod, o.CertificateSyncPeriod, "The period for reading the expiration of the certificates on masters of clusters")
+	_ = viper.BindPFlag(configCertificateSyncPeriod, fs.Lookup(flagCertificateSyncPeriod))
+	fs.IntVar(&o.ConcurrentCertificateSyncs, flagConcurrentCertificateSyncs, o.ConcurrentCertificateSyncs, "The number of clusters that are allowed to be checked concurrently")
+	_ = viper.BindPFlag(configConcurrentCertificateSyncs, fs.Lookup(flagConcurrentCertificateSyncs))
+	fs.DurationVar(&o.RenewBefore, flagCertificateRenewBefore, o.RenewBefore, "How long before the expiration the certificates are reported as expiring and renewed")
+	_ = viper.BindPFlag(configCertificateRenewBefore, fs.Lookup(flagCertificateRenewBefore))
+	fs.BoolVar(&o.AutoRenew, flagCertificateAutoRenew, o.AutoRenew, "Renew the expiring certificates master by master automatically")
+	_ = viper.BindPFlag(configCertificateAutoRenew, fs.Lookup(flagCertificateAutoRenew))
+	fs.StringVar(&o.NotifyChannel, flagCertificateNotifyChannel, o.NotifyChannel, "The notify channel of the message sent when certificates are expiring")
+	_ = viper.BindPFlag(configCertificateNotifyChannel, fs.Lookup(flagCertificateNotifyChannel))
+	fs.StringVar(&o.NotifyTemplate, flagCertificateNotifyTemplate, o.NotifyTemplate, "The notify template of the message sent when certificates are expiring")
+	_ = viper.BindPFlag(configCertificateNotifyTemplate, fs.Lookup(flagCertificateNotifyTemplate))
+	fs.StringSliceVar(&o.NotifyReceivers, flagCertificateNotifyReceivers, o.NotifyReceivers, "The receivers of the message sent when certificates are expiring")
+	_ = viper.BindPFlag(configCertificateNotifyReceivers, fs.Lookup(flagCertificateNotifyReceivers))
+	fs.StringSliceVar(&o.NotifyReceiverGroups, flagCertificateNotifyReceiverGroups, o.NotifyReceiverGroups, "The receiver groups of the message sent when certificates are expiring")
+	_ = viper.BindPFlag(configCertificateNotifyReceiverGroups, fs.Lookup(flagCertificateNotifyReceiverGroups))
+}
+
+// ApplyTo fills up CertificateController config with options.
+func (o *CertificateControllerOptions) ApplyTo(cfg *certificateconfig.CertificateControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.CertificateSyncPeriod = o.CertificateSyncPeriod
+	cfg.ConcurrentCertificateSyncs = o.ConcurrentCertificateSyncs
+	cfg.RenewBefore = o.RenewBefore
+	cfg.AutoRenew = o.AutoRenew
+	cfg.NotifyChannel = o.NotifyChannel
+	cfg.NotifyTemplate = o.NotifyTemplate
+	cfg.NotifyReceivers = o.NotifyReceivers
+	cfg.NotifyReceiverGroups = o.NotifyReceiverGroups
+
+	return nil
+}
+
+// Validate checks validation of CertificateControllerOptions.
+func (o *CertificateControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *CertificateControllerOptions) ApplyFlags() []error {
+	o.CertificateSyncPeriod = viper.GetDuration(configCertificateSyncPeriod)
+	o.ConcurrentCertificateSyncs = viper.GetInt(configConcurrentCertificateSyncs)
+	o.RenewBefore = viper.GetDuration(configCertificateRenewBefore)
+	o.AutoRenew = viper.GetBool(configCertificateAutoRenew)
+	o.NotifyChannel = viper.GetString(configCertificateNotifyChannel)
+	o.NotifyTemplate = viper.GetString(configCertificateNotifyTemplate)
+	o.NotifyReceivers = viper.GetStringSlice(configCertificateNotifyReceivers)
+	o.NotifyReceiverGroups = viper.GetStringSlice(configCertificateNotifyReceiverGroups)
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	clustersyncconfig "tkestack.io/tke/pkg/platform/controller/clustersync/config"
+)
+
+const (
+	flagClusterSyncWorkDir                = "cluster-sync-work-dir"
+	flagClusterSyncGitTimeout             = "cluster-sync-git-timeout"
+	flagClusterSyncAllowLocalRepositories = "cluster-sync-allow-local-repositories"
+	flagConcurrentClusterSyncSyncs        = "concurrent-cluster-sync-syncs"
+)
+
+const (
+	configClusterSyncWorkDir                = "controller.cluster_sync_work_dir"
+	configClusterSyncGitTimeout             = "controller.cluster_sync_git_timeout"
+	configClusterSyncAllowLocalRepositories = "controller.cluster_sync_allow_local_repositories"
+	configConcurrentClusterSyncSyncs        = "controller.concurrent_cluster_sync_syncs"
+)
+
+const (
+	defaultClusterSyncGitTimeout      = 2 * time.Minute
+	defaultConcurrentClusterSyncSyncs = 5
+)
+
+// ClusterSyncControllerOptions holds the ClusterSyncController options.
+type ClusterSyncControllerOptions struct {
+	*clustersyncconfig.ClusterSyncControllerConfiguration
+}
+
+// NewClusterSyncControllerOptions creates a new Options with a default config.
+func NewClusterSyncControllerOptions() *ClusterSyncControllerOptions {
+	return &ClusterSyncControllerOptions{
+		&clustersyncconfig.ClusterSyncControllerConfiguration{
+			WorkDir:                    filepath.Join(os.TempDir(), "tke-clustersync"),
+			GitTimeout:                 defaultClusterSyncGitTimeout,
+			ConcurrentClusterSyncSyncs: defaultConcurrentClusterSyncSyncs,
+		},
+	}
+}
+
+// AddFlags adds flags related to ClusterSyncController for controller manager to the specified FlagSet.
+func (o *ClusterSyncControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.StringVar(&o.WorkDir, flagClusterSyncWorkDir, o.WorkDir, "The directory the git repositories of cluster syncs are fetched into")
+	_ = viper.BindPFlag(configClusterSyncWorkDir, fs.Lookup(flagClusterSyncWorkDir))
+	fs.DurationVar(&o.GitTimeout, flagClusterSyncGitTimeout, o.GitTimeout, "The timeout of every git command of cluster syncs")
+	_ = viper.BindPFlag(configClusterSyncGitTimeout, fs.Lookup(flagClusterSyncGitTimeout))
+	fs.BoolVar(&o.AllowLocalRepositories, flagClusterSyncAllowLocalRepositories, o.AllowLocalRepositories, "Allow cluster syncs to fetch local repositories of the controller host, only intended for testing")
+	_ = viper.BindPFlag(configClusterSyncAllowLocalRepositories, fs.Lookup(flagClusterSyncAllowLocalRepositories))
+	fs.IntVar(&o.ConcurrentClusterSyncSyncs, flagConcurrentClusterSyncSyncs, o.ConcurrentClusterSyncSyncs, "The number of cluster sync objects that are allowed to sync concurrently")
+	_ = viper.BindPFlag(configConcurrentClusterSyncSyncs, fs.Lookup(flagConcurrentClusterSyncSyncs))
+}
+
+// ApplyTo fills up ClusterSyncController config with options.
+func (o *ClusterSyncControllerOptions) ApplyTo(cfg *clustersyncconfig.ClusterSyncControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.WorkDir = o.WorkDir
+	cfg.GitTimeout = o.GitTimeout
+	cfg.AllowLocalRepositories = o.AllowLocalRepositories
+	cfg.ConcurrentClusterSyncSyncs = o.ConcurrentClusterSyncSyncs
+
+	return nil
+}
+
+// Validate checks validation of ClusterSyncControllerOptions.
+func (o *ClusterSyncControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *ClusterSyncControllerOptions) ApplyFlags() []error {
+	o.WorkDir = viper.GetString(configClusterSyncWorkDir)
+	o.GitTimeout = viper.GetDuration(configClusterSyncGitTimeout)
+	o.AllowLocalRepositories = viper.GetBool(configClusterSyncAllowLocalRepositories)
+	o.ConcurrentClusterSyncSyncs = viper.GetInt(configConcurrentClusterSyncSyncs)
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	driftconfig "tkestack.io/tke/pkg/platform/controller/drift/config"
+)
+
+const (
+	flagDriftSyncPeriod      = "drift-sync-period"
+	flagConcurrentDriftSyncs = "concurrent-drift-syncs"
+	flagDriftAutoCorrect     = "drift-auto-correct"
+)
+
+const (
+	configDriftSyncPeriod      = "controller.drift_sync_period"
+	configConcurrentDriftSyncs = "controller.concurrent_drift_syncs"
+	configDriftAutoCorrect     = "controller.drift_auto_correct"
+)
+
+const (
+	defaultDriftSyncPeriod      = 30 * time.Minute
+	defaultConcurrentDriftSyncs = 5
+)
+
+// DriftControllerOptions holds the DriftController options.
+type DriftControllerOptions struct {
+	*driftconfig.DriftControllerConfiguration
+}
+
+// NewDriftControllerOptions creates a new Options with a default config.
+func NewDriftControllerOptions() *DriftControllerOptions {
+	return &DriftControllerOptions{
+		&driftconfig.DriftControllerConfiguration{
+			DriftSyncPeriod:      defaultDriftSyncPeriod,
+			ConcurrentDriftSyncs: defaultConcurrentDriftSyncs,
+		},
+	}
+}
+
+// AddFlags adds flags related to DriftController for controller manager to the specified FlagSet.
+func (o *DriftControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.DriftSyncPeriod, flagDriftSyncPeriod, o.DriftSyncPeriod, "The period for comparing the configuration on masters of clusters with the cluster spec")
+	_ = viper.BindPFlag(configDriftSyncPeriod, fs.Lookup(flagDriftSyncPeriod))
+	fs.IntVar(&o.ConcurrentDriftSyncs, flagConcurrentDriftSyncs, o.ConcurrentDriftSyncs, "The number of clusters that are allowed to be checked concurrently")
+	_ = viper.BindPFlag(configConcurrentDriftSyncs, fs.Lookup(flagConcurrentDriftSyncs))
+	fs.BoolVar(&o.AutoCorrect, flagDriftAutoCorrect, o.AutoCorrect, "Re-apply the drifted configuration on masters from the cluster spec automatically")
+	_ = viper.BindPFlag(configDriftAutoCorrect, fs.Lookup(flagDriftAutoCorrect))
+}
+
+// ApplyTo fills up DriftController config with options.
+func (o *DriftControllerOptions) ApplyTo(cfg *driftconfig.DriftControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.DriftSyncPeriod = o.DriftSyncPeriod
+	cfg.ConcurrentDriftSyncs = o.ConcurrentDriftSyncs
+	cfg.AutoCorrect = o.AutoCorrect
+
+	return nil
+}
+
+// Validate checks validation of DriftControllerOptions.
+func (o *DriftControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *DriftControllerOptions) ApplyFlags() []error {
+	o.DriftSyncPeriod = viper.GetDuration(configDriftSyncPeriod)
+	o.ConcurrentDriftSyncs = viper.GetInt(configConcurrentDriftSyncs)
+	o.AutoCorrect = viper.GetBool(configDriftAutoCorrect)
+	return nil
+}
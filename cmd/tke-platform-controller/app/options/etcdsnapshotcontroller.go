@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	etcdsnapshotconfig "tkestack.io/tke/pkg/platform/controller/etcdsnapshot/config"
+)
+
+const (
+	flagEtcdSnapshotSyncPeriod         = "etcdsnapshot-sync-period"
+	flagEtcdSnapshotScheduleInterval   = "etcdsnapshot-schedule-interval"
+	flagConcurrentEtcdSnapshotSyncs    = "concurrent-etcdsnapshot-syncs"
+	flagEtcdSnapshotRegistryStorageDir = "etcdsnapshot-registry-storage-dir"
+)
+
+const (
+	configEtcdSnapshotSyncPeriod         = "controller.etcdsnapshot_sync_period"
+	configEtcdSnapshotScheduleInterval   = "controller.etcdsnapshot_schedule_interval"
+	configConcurrentEtcdSnapshotSyncs    = "controller.concurrent_etcdsnapshot_syncs"
+	configEtcdSnapshotRegistryStorageDir = "controller.etcdsnapshot_registry_storage_dir"
+)
+
+const (
+	defaultEtcdSnapshotScheduleInterval = 1 * time.Minute
+	defaultConcurrentEtcdSnapshotSyncs  = 2
+)
+
+// EtcdSnapshotControllerOptions holds the EtcdSnapshotController options.
+type EtcdSnapshotControllerOptions struct {
+	*etcdsnapshotconfig.EtcdSnapshotControllerConfiguration
+}
+
+// NewEtcdSnapshotControllerOptions creates a new Options with a default config.
+func NewEtcdSnapshotControllerOptions() *EtcdSnapshotControllerOptions {
+	return &EtcdSnapshotControllerOptions{
+		&etcdsnapshotconfig.EtcdSnapshotControllerConfiguration{
+			EtcdSnapshotSyncPeriod:      defaultSyncPeriod,
+			ScheduleInterval:            defaultEtcdSnapshotScheduleInterval,
+			ConcurrentEtcdSnapshotSyncs: defaultConcurrentEtcdSnapshotSyncs,
+		},
+	}
+}
+
+// AddFlags adds flags related to EtcdSnapshotController for controller manager to the specified FlagSet.
+func (o *EtcdSnapshotControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.EtcdSnapshotSyncPeriod, flagEtcdSnapshotSyncPeriod, o.EtcdSnapshotSyncPeriod, "The period for syncing etcd snapshot life-cycle updates")
+	_ = viper.BindPFlag(configEtcdSnapshotSyncPeriod, fs.Lookup(flagEtcdSnapshotSyncPeriod))
+	fs.DurationVar(&o.ScheduleInterval, flagEtcdSnapshotScheduleInterval, o.ScheduleInterval, "The period for checking whether scheduled etcd snapshots of clusters are due")
+	_ = viper.BindPFlag(configEtcdSnapshotScheduleInterval, fs.Lookup(flagEtcdSnapshotScheduleInterval))
+	fs.IntVar(&o.ConcurrentEtcdSnapshotSyncs, flagConcurrentEtcdSnapshotSyncs, o.ConcurrentEtcdSnapshotSyncs, "The number of etcd snapshot objects that are allowed to sync concurrently")
+	_ = viper.BindPFlag(configConcurrentEtcdSnapshotSyncs, fs.Lookup(flagConcurrentEtcdSnapshotSyncs))
+	fs.StringVar(&o.RegistryStorageDir, flagEtcdSnapshotRegistryStorageDir, o.RegistryStorageDir, "The root directory of the tke-registry filesystem storage, required by the registry etcd snapshot target")
+	_ = viper.BindPFlag(configEtcdSnapshotRegistryStorageDir, fs.Lookup(flagEtcdSnapshotRegistryStorageDir))
+}
+
+// ApplyTo fills up EtcdSnapshotController config with options.
+func (o *EtcdSnapshotControllerOptions) ApplyTo(cfg *etcdsnapshotconfig.EtcdSnapshotControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.EtcdSnapshotSyncPeriod = o.EtcdSnapshotSyncPeriod
+	cfg.ScheduleInterval = o.ScheduleInterval
+	cfg.ConcurrentEtcdSnapshotSyncs = o.ConcurrentEtcdSnapshotSyncs
+	cfg.RegistryStorageDir = o.RegistryStorageDir
+
+	return nil
+}
+
+// Validate checks validation of EtcdSnapshotControllerOptions.
+func (o *EtcdSnapshotControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *EtcdSnapshotControllerOptions) ApplyFlags() []error {
+	o.EtcdSnapshotSyncPeriod = viper.GetDuration(configEtcdSnapshotSyncPeriod)
+	o.ScheduleInterval = viper.GetDuration(configEtcdSnapshotScheduleInterval)
+	o.ConcurrentEtcdSnapshotSyncs = viper.GetInt(configConcurrentEtcdSnapshotSyncs)
+	o.RegistryStorageDir = viper.GetString(configEtcdSnapshotRegistryStorageDir)
+	return nil
+}
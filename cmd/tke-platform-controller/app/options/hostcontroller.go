@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	hostconfig "tkestack.io/tke/pkg/platform/controller/host/config"
+)
+
+const (
+	flagHostProbePeriod     = "host-probe-period"
+	flagConcurrentHostSyncs = "concurrent-host-syncs"
+)
+
+const (
+	configHostProbePeriod     = "controller.host_probe_period"
+	configConcurrentHostSyncs = "controller.concurrent_host_syncs"
+)
+
+const (
+	defaultHostProbePeriod     = 5 * time.Minute
+	defaultConcurrentHostSyncs = 5
+)
+
+// HostControllerOptions holds the HostController options.
+type HostControllerOptions struct {
+	*hostconfig.HostControllerConfiguration
+}
+
+// NewHostControllerOptions creates a new Options with a default config.
+func NewHostControllerOptions() *HostControllerOptions {
+	return &HostControllerOptions{
+		&hostconfig.HostControllerConfiguration{
+			HostProbePeriod:     defaultHostProbePeriod,
+			ConcurrentHostSyncs: defaultConcurrentHostSyncs,
+		},
+	}
+}
+
+// AddFlags adds flags related to HostController for controller manager to the specified FlagSet.
+func (o *HostControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.HostProbePeriod, flagHostProbePeriod, o.HostProbePeriod, "The period for probing the reachability and system information of hosts")
+	_ = viper.BindPFlag(configHostProbePeriod, fs.Lookup(flagHostProbePeriod))
+	fs.IntVar(&o.ConcurrentHostSyncs, flagConcurrentHostSyncs, o.ConcurrentHostSyncs, "The number of host objects that are allowed to sync concurrently")
+	_ = viper.BindPFlag(configConcurrentHostSyncs, fs.Lookup(flagConcurrentHostSyncs))
+}
+
+// ApplyTo fills up HostController config with options.
+func (o *HostControllerOptions) ApplyTo(cfg *hostconfig.HostControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.HostProbePeriod = o.HostProbePeriod
+	cfg.ConcurrentHostSyncs = o.ConcurrentHostSyncs
+
+	return nil
+}
+
+// Validate checks validation of HostControllerOptions.
+func (o *HostControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *HostControllerOptions) ApplyFlags() []error {
+	o.HostProbePeriod = viper.GetDuration(configHostProbePeriod)
+	o.ConcurrentHostSyncs = viper.GetInt(configConcurrentHostSyncs)
+	return nil
+}
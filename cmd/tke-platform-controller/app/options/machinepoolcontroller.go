@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	machinepoolconfig "tkestack.io/tke/pkg/platform/controller/machinepool/config"
+)
+
+const (
+	flagMachinePoolSyncPeriod      = "machinepool-sync-period"
+	flagConcurrentMachinePoolSyncs = "concurrent-machinepool-syncs"
+)
+
+const (
+	configMachinePoolSyncPeriod      = "controller.machinepool_sync_period"
+	configConcurrentMachinePoolSyncs = "controller.concurrent_machinepool_syncs"
+)
+
+const (
+	defaultConcurrentMachinePoolSyncs = 5
+)
+
+// MachinePoolControllerOptions holds the MachinePoolController options.
+type MachinePoolControllerOptions struct {
+	*machinepoolconfig.MachinePoolControllerConfiguration
+}
+
+// NewMachinePoolControllerOptions creates a new Options with a default config.
+func NewMachinePoolControllerOptions() *MachinePoolControllerOptions {
+	return &MachinePoolControllerOptions{
+		&machinepoolconfig.MachinePoolControllerConfiguration{
+			MachinePoolSyncPeriod:      defaultSyncPeriod,
+			ConcurrentMachinePoolSyncs: defaultConcurrentMachinePoolSyncs,
+		},
+	}
+}
+
+// AddFlags adds flags related to MachinePoolController for controller manager to the specified FlagSet.
+func (o *MachinePoolControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.MachinePoolSyncPeriod, flagMachinePoolSyncPeriod, o.MachinePoolSyncPeriod, "The period for syncing machine pool life-cycle updates")
+	_ = viper.BindPFlag(configMachinePoolSyncPeriod, fs.Lookup(flagMachinePoolSyncPeriod))
+	fs.IntVar(&o.ConcurrentMachinePoolSyncs, flagConcurrentMachinePoolSyncs, o.ConcurrentMachinePoolSyncs, "The number of machine pool objects that are allowed to sync concurrently")
+	_ = viper.BindPFlag(configConcurrentMachinePoolSyncs, fs.Lookup(flagConcurrentMachinePoolSyncs))
+}
+
+// ApplyTo fills up MachinePoolController config with options.
+func (o *MachinePoolControllerOptions) ApplyTo(cfg *machinepoolconfig.MachinePoolControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.MachinePoolSyncPeriod = o.MachinePoolSyncPeriod
+	cfg.ConcurrentMachinePoolSyncs = o.ConcurrentMachinePoolSyncs
+
+	return nil
+}
+
+// Validate checks validation of MachinePoolControllerOptions.
+func (o *MachinePoolControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *MachinePoolControllerOptions) ApplyFlags() []error {
+	o.MachinePoolSyncPeriod = viper.GetDuration(configMachinePoolSyncPeriod)
+	o.ConcurrentMachinePoolSyncs = viper.GetInt(configConcurrentMachinePoolSyncs)
+	return nil
+}
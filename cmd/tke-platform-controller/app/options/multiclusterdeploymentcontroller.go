@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	multiclusterdeploymentconfig "tkestack.io/tke/pkg/platform/controller/multiclusterdeployment/config"
+)
+
+const (
+	flagMultiClusterDeploymentResyncPeriod    = "multi-cluster-deployment-resync-period"
+	flagConcurrentMultiClusterDeploymentSyncs = "concurrent-multi-cluster-deployment-syncs"
+)
+
+const (
+	configMultiClusterDeploymentResyncPeriod    = "controller.multi_cluster_deployment_resync_period"
+	configConcurrentMultiClusterDeploymentSyncs = "controller.concurrent_multi_cluster_deployment_syncs"
+)
+
+const (
+	defaultMultiClusterDeploymentResyncPeriod    = 5 * time.Minute
+	defaultConcurrentMultiClusterDeploymentSyncs = 5
+)
+
+// MultiClusterDeploymentControllerOptions holds the
+// MultiClusterDeploymentController options.
+type MultiClusterDeploymentControllerOptions struct {
+	*multiclusterdeploymentconfig.MultiClusterDeploymentControllerConfiguration
+}
+
+// NewMultiClusterDeploymentControllerOptions creates a new Options with a default config.
+func NewMultiClusterDeploymentControllerOptions() *MultiClusterDeploymentControllerOptions {
+	return &MultiClusterDeploymentControllerOptions{
+		&multiclusterdeploymentconfig.MultiClusterDeploymentControllerConfiguration{
+			ResyncPeriod:                          defaultMultiClusterDeploymentResyncPeriod,
+			ConcurrentMultiClusterDeploymentSyncs: defaultConcurrentMultiClusterDeploymentSyncs,
+		},
+	}
+}
+
+// AddFlags adds flags related to MultiClusterDeploymentController for controller manager to the specified FlagSet.
+func (o *MultiClusterDeploymentControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.ResyncPeriod, flagMultiClusterDeploymentResyncPeriod, o.ResyncPeriod, "The period of propagating multi cluster deployments again to correct the drift of clusters")
+	_ = viper.BindPFlag(configMultiClusterDeploymentResyncPeriod, fs.Lookup(flagMultiClusterDeploymentResyncPeriod))
+	fs.IntVar(&o.ConcurrentMultiClusterDeploymentSyncs, flagConcurrentMultiClusterDeploymentSyncs, o.ConcurrentMultiClusterDeploymentSyncs, "The number of multi cluster deployment objects that are allowed to sync concurrently")
+	_ = viper.BindPFlag(configConcurrentMultiClusterDeploymentSyncs, fs.Lookup(flagConcurrentMultiClusterDeploymentSyncs))
+}
+
+// ApplyTo fills up MultiClusterDeploymentController config with options.
+func (o *MultiClusterDeploymentControllerOptions) ApplyTo(cfg *multiclusterdeploymentconfig.MultiClusterDeploymentControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.ResyncPeriod = o.ResyncPeriod
+	cfg.ConcurrentMultiClusterDeploymentSyncs = o.ConcurrentMultiClusterDeploymentSyncs
+
+	return nil
+}
+
+// Validate checks validation of MultiClusterDeploymentControllerOptions.
+func (o *MultiClusterDeploymentControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *MultiClusterDeploymentControllerOptions) ApplyFlags() []error {
+	o.ResyncPeriod = viper.GetDuration(configMultiClusterDeploymentResyncPeriod)
+	o.ConcurrentMultiClusterDeploymentSyncs = viper.GetInt(configConcurrentMultiClusterDeploymentSyncs)
+	return nil
+}
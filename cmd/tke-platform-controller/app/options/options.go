@@ -44,12 +44,22 @@ type Options struct {
 	SecureServing        *apiserveroptions.SecureServingOptions
 	Component            *controlleroptions.ComponentOptions
 	ApplicationAPIClient *controlleroptions.APIServerClientOptions
+	NotifyAPIClient      *controlleroptions.APIServerClientOptions
 	PlatformAPIClient    *controlleroptions.APIServerClientOptions
 	Registry             *apiserveroptions.RegistryOptions
 	FeatureOptions       *FeatureOptions
+	Provider             *ProviderOptions
 
-	ClusterController *ClusterControllerOptions
-	MachineController *MachineControllerOptions
+	ClusterController                *ClusterControllerOptions
+	MachineController                *MachineControllerOptions
+	EtcdSnapshotController           *EtcdSnapshotControllerOptions
+	MachinePoolController            *MachinePoolControllerOptions
+	HostController                   *HostControllerOptions
+	AutoscalerController             *AutoscalerControllerOptions
+	CertificateController            *CertificateControllerOptions
+	DriftController                  *DriftControllerOptions
+	ClusterSyncController            *ClusterSyncControllerOptions
+	MultiClusterDeploymentController *MultiClusterDeploymentControllerOptions
 }
 
 // NewOptions creates a new Options with a default config.
@@ -61,11 +71,21 @@ func NewOptions(serverName string, allControllers []string, disabledByDefaultCon
 		Component:            controlleroptions.NewComponentOptions(allControllers, disabledByDefaultControllers),
 		PlatformAPIClient:    controlleroptions.NewAPIServerClientOptions("platform", true),
 		ApplicationAPIClient: controlleroptions.NewAPIServerClientOptions("application", false),
+		NotifyAPIClient:      controlleroptions.NewAPIServerClientOptions("notify", false),
 		Registry:             apiserveroptions.NewRegistryOptions(),
 		FeatureOptions:       NewFeatureOptions(),
+		Provider:             NewProviderOptions(),
 
-		ClusterController: NewClusterControllerOptions(),
-		MachineController: NewMachineControllerOptions(),
+		ClusterController:                NewClusterControllerOptions(),
+		MachineController:                NewMachineControllerOptions(),
+		EtcdSnapshotController:           NewEtcdSnapshotControllerOptions(),
+		MachinePoolController:            NewMachinePoolControllerOptions(),
+		HostController:                   NewHostControllerOptions(),
+		AutoscalerController:             NewAutoscalerControllerOptions(),
+		CertificateController:            NewCertificateControllerOptions(),
+		DriftController:                  NewDriftControllerOptions(),
+		ClusterSyncController:            NewClusterSyncControllerOptions(),
+		MultiClusterDeploymentController: NewMultiClusterDeploymentControllerOptions(),
 	}
 }
 
@@ -77,10 +97,20 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Component.AddFlags(fs)
 	o.PlatformAPIClient.AddFlags(fs)
 	o.ApplicationAPIClient.AddFlags(fs)
+	o.NotifyAPIClient.AddFlags(fs)
 	o.Registry.AddFlags(fs)
 	o.FeatureOptions.AddFlags(fs)
+	o.Provider.AddFlags(fs)
 	o.ClusterController.AddFlags(fs)
 	o.MachineController.AddFlags(fs)
+	o.EtcdSnapshotController.AddFlags(fs)
+	o.MachinePoolController.AddFlags(fs)
+	o.HostController.AddFlags(fs)
+	o.AutoscalerController.AddFlags(fs)
+	o.CertificateController.AddFlags(fs)
+	o.DriftController.AddFlags(fs)
+	o.ClusterSyncController.AddFlags(fs)
+	o.MultiClusterDeploymentController.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -94,10 +124,20 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Component.ApplyFlags()...)
 	errs = append(errs, o.PlatformAPIClient.ApplyFlags()...)
 	errs = append(errs, o.ApplicationAPIClient.ApplyFlags()...)
+	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
 	errs = append(errs, o.Registry.ApplyFlags()...)
 	errs = append(errs, o.FeatureOptions.ApplyFlags()...)
+	errs = append(errs, o.Provider.ApplyFlags()...)
 	errs = append(errs, o.ClusterController.ApplyFlags()...)
 	errs = append(errs, o.MachineController.ApplyFlags()...)
+	errs = append(errs, o.EtcdSnapshotController.ApplyFlags()...)
+	errs = append(errs, o.MachinePoolController.ApplyFlags()...)
+	errs = append(errs, o.HostController.ApplyFlags()...)
+	errs = append(errs, o.AutoscalerController.ApplyFlags()...)
+	errs = append(errs, o.CertificateController.ApplyFlags()...)
+	errs = append(errs, o.DriftController.ApplyFlags()...)
+	errs = append(errs, o.ClusterSyncController.ApplyFlags()...)
+	errs = append(errs, o.MultiClusterDeploymentController.ApplyFlags()...)
 
 	return errs
 }
@@ -30,9 +30,17 @@ import (
 	"tkestack.io/tke/pkg/platform/controller/addon/persistentevent"
 	"tkestack.io/tke/pkg/platform/controller/addon/storage/csioperator"
 	"tkestack.io/tke/pkg/platform/controller/addon/tappcontroller"
+	"tkestack.io/tke/pkg/platform/controller/autoscaler"
 	bootstrapps "tkestack.io/tke/pkg/platform/controller/bootstrapapps"
+	"tkestack.io/tke/pkg/platform/controller/certificate"
 	clustercontroller "tkestack.io/tke/pkg/platform/controller/cluster"
+	"tkestack.io/tke/pkg/platform/controller/clustersync"
+	"tkestack.io/tke/pkg/platform/controller/drift"
+	"tkestack.io/tke/pkg/platform/controller/etcdsnapshot"
+	"tkestack.io/tke/pkg/platform/controller/host"
 	"tkestack.io/tke/pkg/platform/controller/machine"
+	"tkestack.io/tke/pkg/platform/controller/machinepool"
+	"tkestack.io/tke/pkg/platform/controller/multiclusterdeployment"
 )
 
 const (
@@ -51,6 +59,7 @@ func startClusterController(ctx ControllerContext) (http.Handler, bool, error) {
 	ctrl := clustercontroller.NewController(
 		ctx.ClientBuilder.ClientOrDie("cluster-controller").PlatformV1(),
 		ctx.InformerFactory.Platform().V1().Clusters(),
+		ctx.InformerFactory.Platform().V1().AuditPolicies(),
 		ctx.Config.ClusterController,
 		platformv1.ClusterFinalize,
 	)
@@ -81,6 +90,64 @@ func startMachineController(ctx ControllerContext) (http.Handler, bool, error) {
 	return nil, true, nil
 }
 
+func startEtcdSnapshotController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "etcdsnapshots"}] {
+		return nil, false, nil
+	}
+
+	ctrl := etcdsnapshot.NewController(
+		ctx.ClientBuilder.ClientOrDie("etcdsnapshot-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().EtcdSnapshots(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		ctx.Config.EtcdSnapshotController,
+		platformv1.EtcdSnapshotFinalize,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.EtcdSnapshotController.ConcurrentEtcdSnapshotSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startMachinePoolController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "machinepools"}] {
+		return nil, false, nil
+	}
+
+	ctrl := machinepool.NewController(
+		ctx.ClientBuilder.ClientOrDie("machinepool-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().MachinePools(),
+		ctx.InformerFactory.Platform().V1().Machines(),
+		ctx.Config.MachinePoolController,
+		platformv1.MachinePoolFinalize,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.MachinePoolController.ConcurrentMachinePoolSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startHostController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "hosts"}] {
+		return nil, false, nil
+	}
+
+	ctrl := host.NewController(
+		ctx.ClientBuilder.ClientOrDie("host-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().Hosts(),
+		ctx.Config.HostController,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.HostController.ConcurrentHostSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
 func startPersistentEventController(ctx ControllerContext) (http.Handler, bool, error) {
 	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "persistentevents"}] {
 		return nil, false, nil
@@ -178,3 +245,96 @@ func startBootstrapAppsController(ctx ControllerContext) (http.Handler, bool, er
 
 	return nil, true, nil
 }
+
+func startAutoscalerController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "machinepools"}] {
+		return nil, false, nil
+	}
+
+	ctrl := autoscaler.NewController(
+		ctx.ClientBuilder.ClientOrDie("autoscaler-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		ctx.Config.AutoscalerController,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.AutoscalerController.ConcurrentAutoscalerSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startCertificateController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "clusters"}] {
+		return nil, false, nil
+	}
+
+	ctrl := certificate.NewController(
+		ctx.ClientBuilder.ClientOrDie("certificate-controller").PlatformV1(),
+		ctx.NotifyClient,
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		ctx.Config.CertificateController,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.CertificateController.ConcurrentCertificateSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startDriftController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "clusters"}] {
+		return nil, false, nil
+	}
+
+	ctrl := drift.NewController(
+		ctx.ClientBuilder.ClientOrDie("drift-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		ctx.Config.DriftController,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.DriftController.ConcurrentDriftSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startClusterSyncController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "clustersyncs"}] {
+		return nil, false, nil
+	}
+
+	ctrl := clustersync.NewController(
+		ctx.ClientBuilder.ClientOrDie("clustersync-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().ClusterSyncs(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		ctx.Config.ClusterSyncController,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.ClusterSyncController.ConcurrentClusterSyncSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startMultiClusterDeploymentController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "multiclusterdeployments"}] {
+		return nil, false, nil
+	}
+
+	ctrl := multiclusterdeployment.NewController(
+		ctx.ClientBuilder.ClientOrDie("multiclusterdeployment-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().MultiClusterDeployments(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		ctx.Config.MultiClusterDeploymentController,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Config.MultiClusterDeploymentController.ConcurrentMultiClusterDeploymentSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
@@ -31,9 +31,11 @@ import (
 	"tkestack.io/tke/pkg/controller"
 	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
 	machineprovider "tkestack.io/tke/pkg/platform/provider/machine"
+	"tkestack.io/tke/pkg/platform/util/credential"
 	"tkestack.io/tke/pkg/util/leaderelection"
 	"tkestack.io/tke/pkg/util/leaderelection/resourcelock"
 	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
 )
 
 // Run runs the specified platform controller manager. This should never exit.
@@ -73,6 +75,8 @@ func Run(cfg *config.Config, stopCh <-chan struct{}) error {
 			log.Fatalf("error building controller context: %v", err)
 		}
 
+		ssh.SetCredentialResolver(credential.NewSSHCredentialResolver(rootClientBuilder.ClientOrDie("ssh-credential-resolver").PlatformV1()))
+
 		if err := StartControllers(controllerContext, NewControllerInitializers(), serverMux); err != nil {
 			log.Fatalf("error starting controllers: %v", err)
 		}
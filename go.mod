@@ -109,6 +109,8 @@ require (
 	k8s.io/metrics v0.22.3
 	k8s.io/utils v0.0.0-20210819203725-bdf08cb9a70a
 	sigs.k8s.io/controller-runtime v0.9.3
+	sigs.k8s.io/kustomize/api v0.8.11
+	sigs.k8s.io/kustomize/kyaml v0.11.0
 	sigs.k8s.io/yaml v1.2.0
 	yunion.io/x/pkg v0.0.0-20200603123312-ad58e621aec0
 )
@@ -138,24 +138,7 @@ func WithTKEAuthorization(handler http.Handler, a authorizer.Authorizer, s runti
 			reason     string
 		)
 
-		tenantID := ""
-		extra := attributes.GetUser().GetExtra()
-		if len(extra) > 0 {
-			if tenantIDs, ok := extra[genericoidc.TenantIDKey]; ok {
-				if len(tenantIDs) > 0 {
-					tenantID = tenantIDs[0]
-				} else {
-					tenantID = "default"
-				}
-			}
-		}
-		find := false
-		if tenantID == "" {
-			find, tenantID = genericfilter.FindValueFromGroups(attributes.GetUser().GetGroups(), "tenant")
-			if find && tenantID == "" {
-				tenantID = "default"
-			}
-		}
+		tenantID := tenantOf(attributes)
 
 		// firstly check if resource is unprotected
 		authorized = UnprotectedAuthorized(attributes)
@@ -192,6 +175,47 @@ func WithTKEAuthorization(handler http.Handler, a authorizer.Authorizer, s runti
 	})
 }
 
+// Authorize decides the attributes the same way as WithTKEAuthorization
+// without serving a request, the kubernetes attributes are checked first and
+// then the ones converted for tke-auth if the user belongs to a tenant.
+func Authorize(ctx context.Context, a authorizer.Authorizer, attributes authorizer.Attributes) (authorizer.Decision, string, error) {
+	if UnprotectedAuthorized(attributes) == authorizer.DecisionAllow {
+		return authorizer.DecisionAllow, "", nil
+	}
+	authorized, reason, err := a.Authorize(ctx, attributes)
+	if authorized == authorizer.DecisionAllow {
+		return authorized, reason, err
+	}
+	if tenantOf(attributes) != "" {
+		attributes = ConvertTKEAttributes(ctx, attributes)
+	}
+	return a.Authorize(ctx, attributes)
+}
+
+// tenantOf returns the tenant of the user of the attributes, "default" is
+// returned for the users belonging to a tenant without name.
+func tenantOf(attributes authorizer.Attributes) string {
+	tenantID := ""
+	extra := attributes.GetUser().GetExtra()
+	if len(extra) > 0 {
+		if tenantIDs, ok := extra[genericoidc.TenantIDKey]; ok {
+			if len(tenantIDs) > 0 {
+				tenantID = tenantIDs[0]
+			} else {
+				tenantID = "default"
+			}
+		}
+	}
+	find := false
+	if tenantID == "" {
+		find, tenantID = genericfilter.FindValueFromGroups(attributes.GetUser().GetGroups(), "tenant")
+		if find && tenantID == "" {
+			tenantID = "default"
+		}
+	}
+	return tenantID
+}
+
 func WithInspectors(handler http.Handler, inspectors []Inspector, c *genericapiserver.Config) http.Handler {
 	if len(inspectors) > 0 {
 		for _, inspector := range inspectors {
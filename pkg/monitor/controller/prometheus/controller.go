@@ -924,6 +924,9 @@ func (c *Controller) getWebhookAddr(ctx context.Context, prometheus *v1.Promethe
 				if globalCluster.Spec.Features.HA.ThirdPartyHA != nil {
 					gatewayAddr = globalCluster.Spec.Features.HA.ThirdPartyHA.VIP
 				}
+				if globalCluster.Spec.Features.HA.KubeVIPHA != nil {
+					gatewayAddr = globalCluster.Spec.Features.HA.KubeVIPHA.VIP
+				}
 			}
 			webhookAddr = utilhttp.MakeEndpoint("https", gatewayAddr,
 				443, "/webhook")
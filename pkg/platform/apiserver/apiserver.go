@@ -51,10 +51,14 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/cmd/tke-platform-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	"tkestack.io/tke/pkg/platform/proxy"
 	admissionrest "tkestack.io/tke/pkg/platform/proxy/admissionregistration/rest"
 	appsrest "tkestack.io/tke/pkg/platform/proxy/apps/rest"
 	autoscalingrest "tkestack.io/tke/pkg/platform/proxy/autoscaling/rest"
@@ -72,7 +76,9 @@ import (
 	storagerest "tkestack.io/tke/pkg/platform/proxy/storage/rest"
 	proxyrest "tkestack.io/tke/pkg/platform/registry/cluster/storage"
 	platformrest "tkestack.io/tke/pkg/platform/registry/rest"
+	"tkestack.io/tke/pkg/platform/util/credential"
 	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
 )
 
 // ExtraConfig contains the additional configuration of apiserver.
@@ -83,6 +89,8 @@ type ExtraConfig struct {
 	VersionedInformers      versionedinformers.SharedInformerFactory
 	PrivilegedUsername      string
 	FeatureOptions          *options.FeatureOptions
+	ProxyCacheOptions       *options.ProxyCacheOptions
+	BusinessClient          businessversionedclient.BusinessV1Interface
 }
 
 // Config contains the core configuration instance of apiserver and
@@ -130,6 +138,9 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		GenericAPIServer: s,
 	}
 
+	// resolve the ssh credentials referenced by machines when validating them
+	ssh.SetCredentialResolver(credential.NewSSHCredentialResolver(platformv1client.NewForConfigOrDie(c.GenericConfig.LoopbackClientConfig)))
+
 	// install legacy rest storage
 	if c.ExtraConfig.APIResourceConfigSource.VersionEnabled(corev1.SchemeGroupVersion) {
 		legacyRESTStorageProvider := corerest.LegacyRESTStorageProvider{}
@@ -166,6 +177,22 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		m.GenericAPIServer.Handler.NonGoRestfulMux.NotFoundHandler(&notFoundProxy)
 	}
 
+	proxy.SetAuthorizer(c.GenericConfig.Authorization.Authorizer)
+	proxy.SetBusinessClient(c.ExtraConfig.BusinessClient)
+
+	if c.ExtraConfig.ProxyCacheOptions != nil && len(c.ExtraConfig.ProxyCacheOptions.Resources) != 0 {
+		proxyCache := proxy.NewCache(proxy.CacheConfig{
+			Resources:   c.ExtraConfig.ProxyCacheOptions.Resources,
+			MaxObjects:  c.ExtraConfig.ProxyCacheOptions.MaxObjects,
+			IdleTimeout: c.ExtraConfig.ProxyCacheOptions.IdleTimeout,
+		}, platforminternalclient.NewForConfigOrDie(c.GenericConfig.LoopbackClientConfig))
+		proxy.SetCache(proxyCache)
+		m.GenericAPIServer.AddPostStartHookOrDie("start-proxy-cache", func(hookContext genericapiserver.PostStartHookContext) error {
+			go proxyCache.Run(hookContext.StopCh)
+			return nil
+		})
+	}
+
 	m.InstallAPIs(c.ExtraConfig.APIResourceConfigSource, c.GenericConfig.RESTOptionsGetter, restStorageProviders...)
 
 	return m, nil
@@ -37,6 +37,10 @@ const namespaceParamKey = "namespace"
 // ClusterNameHeaderKey is the header name of cluster.
 const ClusterNameHeaderKey = "X-TKE-ClusterName"
 
+// AllClusters is the cluster name requesting the resources of every cluster
+// the caller can access, it is only supported by list and watch.
+const AllClusters = "*"
+
 // ProjectNameHeaderKey is the header name of project.
 const ProjectNameHeaderKey = "X-TKE-ProjectName"
 
@@ -58,6 +62,11 @@ func ClusterFrom(ctx context.Context) string {
 	return clusterName
 }
 
+// WithClusterName returns a copy of parent in which the cluster name is set.
+func WithClusterName(parent context.Context, clusterName string) context.Context {
+	return genericrequest.WithValue(parent, clusterContextKey, clusterName)
+}
+
 // FuzzyResourceFrom get the fuzzy resource name from request context.
 func FuzzyResourceFrom(ctx context.Context) string {
 	fuzzyResourceName, ok := ctx.Value(fuzzyResourceContextKey).(string)
@@ -93,7 +102,7 @@ func WithCluster(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		clusterName := req.Header.Get(ClusterNameHeaderKey)
 		if clusterName != "" {
-			req = req.WithContext(genericrequest.WithValue(req.Context(), clusterContextKey, clusterName))
+			req = req.WithContext(WithClusterName(req.Context(), clusterName))
 		}
 		handler.ServeHTTP(w, req)
 	})
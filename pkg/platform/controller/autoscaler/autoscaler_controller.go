@@ -0,0 +1,798 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	clusterapiserver "tkestack.io/tke/pkg/platform/apiserver/cluster"
+	"tkestack.io/tke/pkg/platform/apiserver/cluster/drain"
+	autoscalerconfig "tkestack.io/tke/pkg/platform/controller/autoscaler/config"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	defaultScaleDownUnneededTime         = 10 * time.Minute
+	defaultScaleDownUtilizationThreshold = 50
+)
+
+// Controller scales the machine pools of clusters configured by autoscaling.
+// A pool is scaled up by one machine when there are unschedulable pods which
+// fit its template, and scaled down by draining and deleting a node whose
+// utilization stays under threshold for a while, and whose pods fit the other
+// nodes and can be evicted within their disruption budgets.
+type Controller struct {
+	queue          workqueue.RateLimitingInterface
+	lister         platformv1lister.ClusterLister
+	listerSynced   cache.InformerSynced
+	log            log.Logger
+	platformClient platformversionedclient.PlatformV1Interface
+	config         autoscalerconfig.AutoscalerControllerConfiguration
+
+	// unneededSince records the time since when a machine is unneeded,
+	// keyed by cluster and machine name.
+	unneededMu    sync.Mutex
+	unneededSince map[string]time.Time
+	// scaledUpFor records the unschedulable pods which have triggered a
+	// scale up, keyed by cluster and pod uid, so that a pod still pending
+	// after the scale up does not trigger another one.
+	scaledUpMu  sync.Mutex
+	scaledUpFor map[string]bool
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	clusterInformer platformv1informer.ClusterInformer,
+	configuration autoscalerconfig.AutoscalerControllerConfiguration) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(5*time.Second, 5*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "autoscaler"),
+		log:            log.WithName("AutoscalerController"),
+		platformClient: platformclient,
+		config:         configuration,
+		unneededSince:  make(map[string]time.Time),
+		scaledUpFor:    make(map[string]bool),
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("autoscaler_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	clusterInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.enqueue,
+			UpdateFunc: func(old, obj interface{}) {
+				c.enqueue(obj)
+			},
+		},
+		configuration.AutoscalerSyncPeriod,
+	)
+	c.lister = clusterInformer.Lister()
+	c.listerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	cluster := obj.(*platformv1.Cluster)
+	if cluster.Spec.Features.Autoscaling == nil {
+		return
+	}
+	c.queue.Add(cluster.Name)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting autoscaler controller")
+	defer log.Info("Shutting down autoscaler controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for autoscaler caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of cluster objects.
+// Each cluster can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error autoscaling cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncCluster(key string) error {
+	ctx := c.log.WithValues("cluster", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished autoscaling cluster", "processTime", time.Since(startTime).String())
+	}()
+
+	cluster, err := c.lister.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			c.forgetCluster(key)
+			return nil
+		}
+		return err
+	}
+	if cluster.DeletionTimestamp != nil || cluster.Status.Phase != platformv1.ClusterRunning ||
+		cluster.Spec.Features.Autoscaling == nil {
+		c.forgetCluster(key)
+		return nil
+	}
+
+	return c.autoscale(ctx, cluster)
+}
+
+// nodeGroup is a machine pool scaled by the autoscaler.
+type nodeGroup struct {
+	platformv1.AutoscalingNodeGroup
+	pool *platformv1.MachinePool
+}
+
+func (c *Controller) autoscale(ctx context.Context, cluster *platformv1.Cluster) error {
+	autoscaling := cluster.Spec.Features.Autoscaling
+	var groups []nodeGroup
+	for _, one := range autoscaling.NodeGroups {
+		pool, err := c.platformClient.MachinePools().Get(ctx, one.MachinePool, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.FromContext(ctx).Info("Machine pool of node group not found", "machinepool", one.MachinePool)
+				continue
+			}
+			return err
+		}
+		if pool.Spec.ClusterName != cluster.Name || pool.DeletionTimestamp != nil {
+			continue
+		}
+		groups = append(groups, nodeGroup{AutoscalingNodeGroup: one, pool: pool})
+	}
+
+	// Keep replicas of pools within bounds before scaling by workloads.
+	for _, group := range groups {
+		replicas := BoundReplicas(group.pool.Spec.Replicas, group.AutoscalingNodeGroup)
+		if replicas != group.pool.Spec.Replicas {
+			if err := c.setReplicas(ctx, group.pool, replicas); err != nil {
+				return err
+			}
+		}
+	}
+
+	v1Cluster, err := clusterprovider.GetV1ClusterByName(ctx, c.platformClient, cluster.Name, clusterprovider.AdminUsername)
+	if err != nil {
+		return err
+	}
+	client, err := v1Cluster.Clientset()
+	if err != nil {
+		return err
+	}
+	podList, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	scaledUp, err := c.scaleUp(ctx, client, cluster.Name, groups, c.untriggeredPods(cluster.Name, UnschedulablePods(podList.Items)))
+	if err != nil || scaledUp {
+		return err
+	}
+
+	threshold := autoscaling.ScaleDownUtilizationThreshold
+	if threshold == 0 {
+		threshold = defaultScaleDownUtilizationThreshold
+	}
+	unneededTime := defaultScaleDownUnneededTime
+	if autoscaling.ScaleDownUnneededTime != nil {
+		unneededTime = autoscaling.ScaleDownUnneededTime.Duration
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+	nodeList, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pdbList, err := client.PolicyV1beta1().PodDisruptionBudgets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	workloads := clusterWorkloads{pods: podList.Items, nodes: nodeList.Items, pdbs: pdbList.Items}
+	for _, group := range groups {
+		scaledDown, err := c.scaleDown(ctx, client, cluster.Name, group, workloads, int64(threshold), unneededTime)
+		if err != nil {
+			return err
+		}
+		// The workloads don't tell the pods evicted from the drained node,
+		// the other pools are scaled down by the next sync from the new
+		// workloads, so that two nodes never count on each other.
+		if scaledDown {
+			c.queue.Add(cluster.Name)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// scaleUp adds a machine to each pool which unschedulable pods fit. A pool
+// which is still scaling is not scaled up again, so that the new machine is
+// taken into account by the scheduler before next scale up.
+func (c *Controller) scaleUp(ctx context.Context, client kubernetes.Interface, clusterName string, groups []nodeGroup, pods []corev1.Pod) (bool, error) {
+	scaledUp := false
+	for _, group := range groups {
+		if len(pods) == 0 {
+			break
+		}
+		if group.pool.Spec.Replicas >= group.MaxReplicas || !poolStable(group.pool) {
+			continue
+		}
+		capacity, err := c.poolCapacity(ctx, client, clusterName, group.pool)
+		if err != nil {
+			return scaledUp, err
+		}
+		for i := range pods {
+			if !PodFitsMachinePool(&pods[i], group.pool, capacity) {
+				continue
+			}
+			log.FromContext(ctx).Info("Scale up machine pool for unschedulable pod", "machinepool", group.pool.Name,
+				"pod", pods[i].Namespace+"/"+pods[i].Name)
+			if err := c.setReplicas(ctx, group.pool, group.pool.Spec.Replicas+1); err != nil {
+				return scaledUp, err
+			}
+			c.scaledUpMu.Lock()
+			c.scaledUpFor[clusterName+"/"+string(pods[i].UID)] = true
+			c.scaledUpMu.Unlock()
+			pods = append(pods[:i:i], pods[i+1:]...)
+			scaledUp = true
+			break
+		}
+	}
+	return scaledUp, nil
+}
+
+// untriggeredPods returns the unschedulable pods of cluster which have not
+// triggered a scale up yet, and forgets the pods which are no longer
+// unschedulable.
+func (c *Controller) untriggeredPods(clusterName string, pods []corev1.Pod) []corev1.Pod {
+	c.scaledUpMu.Lock()
+	defer c.scaledUpMu.Unlock()
+
+	pending := make(map[string]bool, len(pods))
+	var result []corev1.Pod
+	for _, pod := range pods {
+		key := clusterName + "/" + string(pod.UID)
+		pending[key] = true
+		if !c.scaledUpFor[key] {
+			result = append(result, pod)
+		}
+	}
+	for key := range c.scaledUpFor {
+		if strings.HasPrefix(key, clusterName+"/") && !pending[key] {
+			delete(c.scaledUpFor, key)
+		}
+	}
+	return result
+}
+
+// poolCapacity returns the capacity of a new machine of pool, which is the
+// capacity of template, or the allocatable of a running node of the pool.
+// It returns nil if neither is known.
+func (c *Controller) poolCapacity(ctx context.Context, client kubernetes.Interface, clusterName string, pool *platformv1.MachinePool) (corev1.ResourceList, error) {
+	if len(pool.Spec.Template.Capacity) != 0 {
+		return pool.Spec.Template.Capacity, nil
+	}
+	fieldSelector := fields.OneTermEqualSelector("spec.clusterName", clusterName).String()
+	machineList, err := c.platformClient.Machines().List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return nil, err
+	}
+	for _, machine := range machineList.Items {
+		if machine.Labels[platformv1.MachinePoolLabel] != pool.Name || machine.Status.Phase != platformv1.MachineRunning {
+			continue
+		}
+		node, err := apiclient.GetNodeByMachineIP(ctx, client, machine.Spec.IP)
+		if err != nil {
+			continue
+		}
+		return node.Status.Allocatable, nil
+	}
+	return nil, nil
+}
+
+// forgetCluster drops what is recorded for the machines and pods of cluster.
+func (c *Controller) forgetCluster(clusterName string) {
+	prefix := clusterName + "/"
+	c.unneededMu.Lock()
+	for key := range c.unneededSince {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.unneededSince, key)
+		}
+	}
+	c.unneededMu.Unlock()
+
+	c.scaledUpMu.Lock()
+	for key := range c.scaledUpFor {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.scaledUpFor, key)
+		}
+	}
+	c.scaledUpMu.Unlock()
+}
+
+// clusterWorkloads is what the scale down of a cluster is decided by.
+type clusterWorkloads struct {
+	pods  []corev1.Pod
+	nodes []corev1.Node
+	pdbs  []policyv1beta1.PodDisruptionBudget
+}
+
+// scaleDown drains and deletes at most one machine of pool, which has been
+// unneeded for unneededTime, and reports whether a machine is deleted. A
+// machine is unneeded if the utilization of its node is under threshold, and
+// the pods to evict from it can be moved to the other nodes without violating
+// their disruption budgets.
+func (c *Controller) scaleDown(ctx context.Context, client kubernetes.Interface, clusterName string, group nodeGroup,
+	workloads clusterWorkloads, threshold int64, unneededTime time.Duration) (bool, error) {
+	pool := group.pool
+	fieldSelector := fields.OneTermEqualSelector("spec.clusterName", clusterName).String()
+	machineList, err := c.platformClient.Machines().List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return false, err
+	}
+	podsByNode := make(map[string][]corev1.Pod)
+	for _, pod := range workloads.pods {
+		if pod.Spec.NodeName != "" {
+			podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+		}
+	}
+	c.pruneUnneeded(clusterName, machineList.Items)
+
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		if machine.Labels[platformv1.MachinePoolLabel] != pool.Name || machine.DeletionTimestamp != nil ||
+			machine.Status.Phase != platformv1.MachineRunning {
+			continue
+		}
+		key := clusterName + "/" + machine.Name
+		node, err := apiclient.GetNodeByMachineIP(ctx, client, machine.Spec.IP)
+		if err != nil {
+			c.setUnneeded(key, false)
+			continue
+		}
+		if NodeUtilization(node, podsByNode[node.Name]) >= threshold || !c.podsRemovable(ctx, client, node.Name, workloads, podsByNode) {
+			c.setUnneeded(key, false)
+			continue
+		}
+		since := c.setUnneeded(key, true)
+		if time.Since(since) < unneededTime || pool.Spec.Replicas <= group.MinReplicas || !poolStable(pool) {
+			continue
+		}
+
+		log.FromContext(ctx).Info("Scale down unneeded machine", "machinepool", pool.Name, "machine", machine.Name,
+			"node", node.Name, "unneededSince", since)
+		if err := clusterapiserver.DrainNode(ctx, client, node); err != nil {
+			return false, err
+		}
+		if machine.Annotations == nil {
+			machine.Annotations = make(map[string]string)
+		}
+		machine.Annotations[platformv1.MachineDeleteAnnotation] = ""
+		if _, err := c.platformClient.Machines().Update(ctx, machine, metav1.UpdateOptions{}); err != nil {
+			return false, err
+		}
+		c.setUnneeded(key, false)
+		// Only one machine of a pool is deleted at a time.
+		return true, c.setReplicas(ctx, pool, pool.Spec.Replicas-1)
+	}
+
+	return false, nil
+}
+
+// podsRemovable reports whether all pods on node can be moved to other nodes
+// by drain, which excludes pods not managed by controllers and pods with
+// local storage, and whether the pods to evict fit the other nodes and are
+// allowed to be disrupted by their budgets.
+func (c *Controller) podsRemovable(ctx context.Context, client kubernetes.Interface, nodeName string,
+	workloads clusterWorkloads, podsByNode map[string][]corev1.Pod) bool {
+	helper := &drain.Helper{
+		Client:              client,
+		IgnoreAllDaemonSets: true,
+	}
+	list, errs := helper.GetPodsForDeletion(ctx, nodeName)
+	if len(errs) != 0 {
+		return false
+	}
+	pods := list.Pods()
+	var others []corev1.Node
+	for _, node := range workloads.nodes {
+		if node.Name != nodeName {
+			others = append(others, node)
+		}
+	}
+	return PodsFitNodes(pods, others, podsByNode) && DisruptionsAllowed(pods, workloads.pdbs)
+}
+
+// setUnneeded records whether machine of key is unneeded, and returns the
+// time since when it is unneeded.
+func (c *Controller) setUnneeded(key string, unneeded bool) time.Time {
+	c.unneededMu.Lock()
+	defer c.unneededMu.Unlock()
+	if !unneeded {
+		delete(c.unneededSince, key)
+		return time.Time{}
+	}
+	since, ok := c.unneededSince[key]
+	if !ok {
+		since = time.Now()
+		c.unneededSince[key] = since
+	}
+	return since
+}
+
+// pruneUnneeded forgets the unneeded machines of cluster which no longer exist.
+func (c *Controller) pruneUnneeded(clusterName string, machines []platformv1.Machine) {
+	existing := make(map[string]bool, len(machines))
+	for _, machine := range machines {
+		existing[clusterName+"/"+machine.Name] = true
+	}
+	c.unneededMu.Lock()
+	defer c.unneededMu.Unlock()
+	for key := range c.unneededSince {
+		if strings.HasPrefix(key, clusterName+"/") && !existing[key] {
+			delete(c.unneededSince, key)
+		}
+	}
+}
+
+func (c *Controller) setReplicas(ctx context.Context, pool *platformv1.MachinePool, replicas int32) error {
+	log.FromContext(ctx).Info("Set replicas of machine pool", "machinepool", pool.Name,
+		"from", pool.Spec.Replicas, "to", replicas)
+	pool.Spec.Replicas = replicas
+	result, err := c.platformClient.MachinePools().Update(ctx, pool, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	*pool = *result
+	return nil
+}
+
+// poolStable reports whether pool has converged to the desired replicas.
+func poolStable(pool *platformv1.MachinePool) bool {
+	return pool.Status.Phase == platformv1.MachinePoolRunning && pool.Status.Replicas == pool.Spec.Replicas
+}
+
+// BoundReplicas returns replicas within the bounds of node group.
+func BoundReplicas(replicas int32, group platformv1.AutoscalingNodeGroup) int32 {
+	if replicas < group.MinReplicas {
+		return group.MinReplicas
+	}
+	if replicas > group.MaxReplicas {
+		return group.MaxReplicas
+	}
+	return replicas
+}
+
+// UnschedulablePods returns the pods which can't be scheduled for lack of nodes.
+func UnschedulablePods(pods []corev1.Pod) []corev1.Pod {
+	var result []corev1.Pod
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" || pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse &&
+				condition.Reason == corev1.PodReasonUnschedulable {
+				result = append(result, pod)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// PodFitsMachinePool reports whether pod can be scheduled to a new machine of
+// pool, by the node selector, required node affinity and resource requests of
+// pod, and the labels, taints and capacity of machine template. Requests are
+// not checked if capacity is nil.
+func PodFitsMachinePool(pod *corev1.Pod, pool *platformv1.MachinePool, capacity corev1.ResourceList) bool {
+	return podFits(pod, podRequests(pod), labels.Set(pool.Spec.Template.Labels), pool.Spec.Template.Taints, capacity)
+}
+
+// PodsFitNodes reports whether all pods can be scheduled to the ready and
+// schedulable nodes. Pods are placed one by one on the first node they fit,
+// the free resources of a node are its allocatable minus the requests of the
+// pods already on it and the pods placed.
+func PodsFitNodes(pods []corev1.Pod, nodes []corev1.Node, podsByNode map[string][]corev1.Pod) bool {
+	free := make([]corev1.ResourceList, len(nodes))
+	for i := range nodes {
+		free[i] = nodes[i].Status.Allocatable.DeepCopy()
+		for j := range podsByNode[nodes[i].Name] {
+			pod := &podsByNode[nodes[i].Name][j]
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			subtractResources(free[i], podSlotRequests(pod))
+		}
+	}
+	for i := range pods {
+		requests := podSlotRequests(&pods[i])
+		placed := false
+		for j := range nodes {
+			if !nodeSchedulable(&nodes[j]) || !podFits(&pods[i], requests, labels.Set(nodes[j].Labels), nodes[j].Spec.Taints, free[j]) {
+				continue
+			}
+			subtractResources(free[j], requests)
+			placed = true
+			break
+		}
+		if !placed {
+			return false
+		}
+	}
+	return true
+}
+
+// DisruptionsAllowed reports whether all pods can be evicted within the
+// disruptions allowed by the pod disruption budgets selecting them.
+func DisruptionsAllowed(pods []corev1.Pod, pdbs []policyv1beta1.PodDisruptionBudget) bool {
+	for i := range pdbs {
+		pdb := &pdbs[i]
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return false
+		}
+		var disruptions int32
+		for _, pod := range pods {
+			if pod.Namespace == pdb.Namespace && selector.Matches(labels.Set(pod.Labels)) {
+				disruptions++
+			}
+		}
+		if disruptions > pdb.Status.DisruptionsAllowed {
+			return false
+		}
+	}
+	return true
+}
+
+// podFits reports whether pod with requests can be scheduled to a node with
+// labels, taints and free resources, by the node selector, required node
+// affinity and tolerations of pod. Requests are not checked if free is nil.
+func podFits(pod *corev1.Pod, requests corev1.ResourceList, nodeLabels labels.Set, taints []corev1.Taint, free corev1.ResourceList) bool {
+	for k, v := range pod.Spec.NodeSelector {
+		if nodeLabels[k] != v {
+			return false
+		}
+	}
+	if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil &&
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil &&
+		!matchNodeSelectorTerms(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms, nodeLabels) {
+		return false
+	}
+	if free != nil {
+		for name, request := range requests {
+			if request.IsZero() {
+				continue
+			}
+			allocatable, ok := free[name]
+			if !ok || request.Cmp(allocatable) > 0 {
+				return false
+			}
+		}
+	}
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for j := range pod.Spec.Tolerations {
+			if pod.Spec.Tolerations[j].ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeSchedulable reports whether node is ready and not cordoned.
+func nodeSchedulable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// subtractResources subtracts requests from free.
+func subtractResources(free corev1.ResourceList, requests corev1.ResourceList) {
+	for name, quantity := range requests {
+		if current, ok := free[name]; ok {
+			current.Sub(quantity)
+			free[name] = current
+		}
+	}
+}
+
+// matchNodeSelectorTerms reports whether a node with labels matches any of
+// terms. The fields of a new node are unknown, so a term selecting by fields
+// never matches.
+func matchNodeSelectorTerms(terms []corev1.NodeSelectorTerm, nodeLabels labels.Set) bool {
+	for _, term := range terms {
+		if len(term.MatchFields) != 0 || len(term.MatchExpressions) == 0 {
+			continue
+		}
+		selector := labels.NewSelector()
+		valid := true
+		for _, expr := range term.MatchExpressions {
+			var op selection.Operator
+			switch expr.Operator {
+			case corev1.NodeSelectorOpIn:
+				op = selection.In
+			case corev1.NodeSelectorOpNotIn:
+				op = selection.NotIn
+			case corev1.NodeSelectorOpExists:
+				op = selection.Exists
+			case corev1.NodeSelectorOpDoesNotExist:
+				op = selection.DoesNotExist
+			case corev1.NodeSelectorOpGt:
+				op = selection.GreaterThan
+			case corev1.NodeSelectorOpLt:
+				op = selection.LessThan
+			default:
+				valid = false
+			}
+			if !valid {
+				break
+			}
+			requirement, err := labels.NewRequirement(expr.Key, op, expr.Values)
+			if err != nil {
+				valid = false
+				break
+			}
+			selector = selector.Add(*requirement)
+		}
+		if valid && selector.Matches(nodeLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// podRequests returns the resources requested by pod, which is the larger of
+// the sum of containers and any init container, plus the overhead.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, quantity := range container.Resources.Requests {
+			sum := requests[name]
+			sum.Add(quantity)
+			requests[name] = sum
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	for name, quantity := range pod.Spec.Overhead {
+		sum := requests[name]
+		sum.Add(quantity)
+		requests[name] = sum
+	}
+	return requests
+}
+
+// podSlotRequests returns the resources requested by pod with the pod slot
+// it takes on a node.
+func podSlotRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := podRequests(pod)
+	requests[corev1.ResourcePods] = *resource.NewQuantity(1, resource.DecimalSI)
+	return requests
+}
+
+// NodeUtilization returns the max percent of requested cpu and memory of pods
+// to allocatable of node, the requests of a pod are counted as by the
+// scheduler.
+func NodeUtilization(node *corev1.Node, pods []corev1.Pod) int64 {
+	var utilization int64
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		allocatable := node.Status.Allocatable[name]
+		if allocatable.IsZero() {
+			continue
+		}
+		var requested int64
+		for i := range pods {
+			if pods[i].Status.Phase == corev1.PodSucceeded || pods[i].Status.Phase == corev1.PodFailed {
+				continue
+			}
+			quantity := podRequests(&pods[i])[name]
+			requested += quantity.MilliValue()
+		}
+		if percent := requested * 100 / allocatable.MilliValue(); percent > utilization {
+			utilization = percent
+		}
+	}
+	return utilization
+}
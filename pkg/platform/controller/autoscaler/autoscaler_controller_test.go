@@ -0,0 +1,331 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package autoscaler
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestBoundReplicas(t *testing.T) {
+	group := platformv1.AutoscalingNodeGroup{MachinePool: "workers", MinReplicas: 1, MaxReplicas: 3}
+	for replicas, want := range map[int32]int32{0: 1, 1: 1, 2: 2, 3: 3, 5: 3} {
+		if got := BoundReplicas(replicas, group); got != want {
+			t.Errorf("BoundReplicas(%d) = %d, want %d", replicas, got, want)
+		}
+	}
+}
+
+func TestUnschedulablePods(t *testing.T) {
+	pod := func(name string, phase corev1.PodPhase, nodeName string, reason string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				Conditions: []corev1.PodCondition{{
+					Type:   corev1.PodScheduled,
+					Status: corev1.ConditionFalse,
+					Reason: reason,
+				}},
+			},
+		}
+	}
+	pods := []corev1.Pod{
+		pod("unschedulable", corev1.PodPending, "", corev1.PodReasonUnschedulable),
+		pod("scheduling", corev1.PodPending, "", ""),
+		pod("bound", corev1.PodPending, "node1", corev1.PodReasonUnschedulable),
+		pod("running", corev1.PodRunning, "", corev1.PodReasonUnschedulable),
+	}
+	got := UnschedulablePods(pods)
+	if len(got) != 1 || got[0].Name != "unschedulable" {
+		t.Errorf("UnschedulablePods() = %v, want [unschedulable]", got)
+	}
+}
+
+func TestPodFitsMachinePool(t *testing.T) {
+	pool := &platformv1.MachinePool{
+		Spec: platformv1.MachinePoolSpec{
+			Template: platformv1.MachineTemplateSpec{
+				Labels: map[string]string{"pool": "gpu"},
+				Taints: []corev1.Taint{
+					{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "spot", Effect: corev1.TaintEffectPreferNoSchedule},
+				},
+			},
+		},
+	}
+	toleration := corev1.Toleration{Key: "gpu", Operator: corev1.TolerationOpEqual, Value: "true", Effect: corev1.TaintEffectNoSchedule}
+	tests := []struct {
+		name string
+		spec corev1.PodSpec
+		want bool
+	}{
+		{"not tolerate taint", corev1.PodSpec{}, false},
+		{"tolerate taint", corev1.PodSpec{Tolerations: []corev1.Toleration{toleration}}, true},
+		{
+			"node selector matches",
+			corev1.PodSpec{NodeSelector: map[string]string{"pool": "gpu"}, Tolerations: []corev1.Toleration{toleration}},
+			true,
+		},
+		{
+			"node selector mismatches",
+			corev1.PodSpec{NodeSelector: map[string]string{"pool": "cpu"}, Tolerations: []corev1.Toleration{toleration}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PodFitsMachinePool(&corev1.Pod{Spec: tt.spec}, pool, nil); got != tt.want {
+				t.Errorf("PodFitsMachinePool() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodFitsMachinePoolByAffinityAndRequests(t *testing.T) {
+	pool := &platformv1.MachinePool{
+		Spec: platformv1.MachinePoolSpec{
+			Template: platformv1.MachineTemplateSpec{
+				Labels: map[string]string{"zone": "a", "cores": "8"},
+			},
+		},
+	}
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("8Gi"),
+	}
+	affinity := func(key string, op corev1.NodeSelectorOperator, values ...string) *corev1.Affinity {
+		return &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{Key: key, Operator: op, Values: values}},
+					}},
+				},
+			},
+		}
+	}
+	requests := func(cpu string) []corev1.Container {
+		return []corev1.Container{{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+			},
+		}}
+	}
+	tests := []struct {
+		name     string
+		spec     corev1.PodSpec
+		capacity corev1.ResourceList
+		want     bool
+	}{
+		{"affinity matches", corev1.PodSpec{Affinity: affinity("zone", corev1.NodeSelectorOpIn, "a", "b")}, capacity, true},
+		{"affinity mismatches", corev1.PodSpec{Affinity: affinity("zone", corev1.NodeSelectorOpIn, "b")}, capacity, false},
+		{"affinity greater than", corev1.PodSpec{Affinity: affinity("cores", corev1.NodeSelectorOpGt, "4")}, capacity, true},
+		{"affinity not exists", corev1.PodSpec{Affinity: affinity("gpu", corev1.NodeSelectorOpDoesNotExist)}, capacity, true},
+		{"requests fit", corev1.PodSpec{Containers: requests("3")}, capacity, true},
+		{"requests exceed", corev1.PodSpec{Containers: requests("5")}, capacity, false},
+		{"init container exceeds", corev1.PodSpec{Containers: requests("1"), InitContainers: requests("6")}, capacity, false},
+		{"unknown resource", corev1.PodSpec{Containers: []corev1.Container{{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			},
+		}}}, capacity, false},
+		{"unknown capacity", corev1.PodSpec{Containers: requests("5")}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PodFitsMachinePool(&corev1.Pod{Spec: tt.spec}, pool, tt.capacity); got != tt.want {
+				t.Errorf("PodFitsMachinePool() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUntriggeredPods(t *testing.T) {
+	c := &Controller{scaledUpFor: map[string]bool{"cls-a/p1": true, "cls-a/p2": true, "cls-b/p1": true}}
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "p1", UID: "p1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "p3", UID: "p3"}},
+	}
+	got := c.untriggeredPods("cls-a", pods)
+	if len(got) != 1 || got[0].Name != "p3" {
+		t.Errorf("untriggeredPods() = %v, want [p3]", got)
+	}
+	want := map[string]bool{"cls-a/p1": true, "cls-b/p1": true}
+	if !reflect.DeepEqual(c.scaledUpFor, want) {
+		t.Errorf("scaledUpFor = %v, want %v", c.scaledUpFor, want)
+	}
+}
+
+func TestPruneUnneeded(t *testing.T) {
+	now := time.Now()
+	c := &Controller{unneededSince: map[string]time.Time{"cls-a/m1": now, "cls-a/m2": now, "cls-b/m2": now}}
+	c.pruneUnneeded("cls-a", []platformv1.Machine{{ObjectMeta: metav1.ObjectMeta{Name: "m1"}}})
+	if len(c.unneededSince) != 2 {
+		t.Errorf("unneededSince = %v, want cls-a/m1 and cls-b/m2", c.unneededSince)
+	}
+	if _, ok := c.unneededSince["cls-a/m2"]; ok {
+		t.Errorf("pruneUnneeded() keeps the deleted machine")
+	}
+}
+
+func TestNodeUtilization(t *testing.T) {
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+	pod := func(cpu, memory string, phase corev1.PodPhase) corev1.Pod {
+		return corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpu),
+							corev1.ResourceMemory: resource.MustParse(memory),
+						},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	pods := []corev1.Pod{
+		pod("1", "2Gi", corev1.PodRunning),
+		pod("500m", "4Gi", corev1.PodRunning),
+		pod("2", "2Gi", corev1.PodSucceeded),
+	}
+	if got := NodeUtilization(node, pods); got != 75 {
+		t.Errorf("NodeUtilization() = %d, want 75", got)
+	}
+	if got := NodeUtilization(node, nil); got != 0 {
+		t.Errorf("NodeUtilization() without pods = %d, want 0", got)
+	}
+
+	initialized := pod("500m", "1Gi", corev1.PodRunning)
+	initialized.Spec.InitContainers = []corev1.Container{{
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")},
+		},
+	}}
+	if got := NodeUtilization(node, []corev1.Pod{initialized}); got != 75 {
+		t.Errorf("NodeUtilization() with init container = %d, want 75", got)
+	}
+}
+
+func TestPodsFitNodes(t *testing.T) {
+	node := func(name string, cpu string, ready bool, taints ...corev1.Taint) corev1.Node {
+		status := corev1.ConditionTrue
+		if !ready {
+			status = corev1.ConditionFalse
+		}
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"zone": "a"}},
+			Spec:       corev1.NodeSpec{Taints: taints},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse(cpu),
+					corev1.ResourcePods: resource.MustParse("110"),
+				},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+			},
+		}
+	}
+	pod := func(name string, cpu string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	podsByNode := map[string][]corev1.Pod{"node1": {pod("running", "3")}}
+	tests := []struct {
+		name  string
+		pods  []corev1.Pod
+		nodes []corev1.Node
+		want  bool
+	}{
+		{"fit free resources", []corev1.Pod{pod("a", "1"), pod("b", "2")}, []corev1.Node{node("node1", "4", true), node("node2", "2", true)}, true},
+		{"exceed free resources", []corev1.Pod{pod("a", "2"), pod("b", "2")}, []corev1.Node{node("node1", "4", true), node("node2", "2", true)}, false},
+		{"skip not ready node", []corev1.Pod{pod("a", "1")}, []corev1.Node{node("node2", "2", false)}, false},
+		{
+			"skip tainted node",
+			[]corev1.Pod{pod("a", "1")},
+			[]corev1.Node{node("node2", "2", true, corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule})},
+			false,
+		},
+		{"no nodes", []corev1.Pod{pod("a", "1")}, nil, false},
+		{"no pods", nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PodsFitNodes(tt.pods, tt.nodes, podsByNode); got != tt.want {
+				t.Errorf("PodsFitNodes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisruptionsAllowed(t *testing.T) {
+	pod := func(namespace string, app string) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Labels: map[string]string{"app": app}}}
+	}
+	pdb := func(app string, allowed int32) policyv1beta1.PodDisruptionBudget {
+		return policyv1beta1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec:       policyv1beta1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}}},
+			Status:     policyv1beta1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed},
+		}
+	}
+	pods := []corev1.Pod{pod("default", "web"), pod("default", "web"), pod("other", "db")}
+	tests := []struct {
+		name string
+		pdbs []policyv1beta1.PodDisruptionBudget
+		want bool
+	}{
+		{"no budgets", nil, true},
+		{"allowed", []policyv1beta1.PodDisruptionBudget{pdb("web", 2)}, true},
+		{"not enough disruptions", []policyv1beta1.PodDisruptionBudget{pdb("web", 1)}, false},
+		{"other namespace", []policyv1beta1.PodDisruptionBudget{pdb("db", 0)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisruptionsAllowed(pods, tt.pdbs); got != tt.want {
+				t.Errorf("DisruptionsAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
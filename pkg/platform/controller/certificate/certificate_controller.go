@@ -0,0 +1,468 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	certificateconfig "tkestack.io/tke/pkg/platform/controller/certificate/config"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	// ConditionTypeCertificatesExpiring is the condition raised on a cluster
+	// while certificates on its masters are expiring.
+	ConditionTypeCertificatesExpiring = "CertificatesExpiring"
+	reasonCertificatesExpiring        = "CertificatesExpiring"
+)
+
+// Controller reads the expiration of the certificates on the masters of
+// clusters, reports it in the cluster status and renews the expiring
+// certificates master by master.
+type Controller struct {
+	queue          workqueue.RateLimitingInterface
+	lister         platformv1lister.ClusterLister
+	listerSynced   cache.InformerSynced
+	log            log.Logger
+	platformClient platformversionedclient.PlatformV1Interface
+	notifyClient   notifyversionedclient.NotifyV1Interface
+	config         certificateconfig.CertificateControllerConfiguration
+}
+
+// NewController creates a new Controller object. The notify client is
+// optional, no message is sent about expiring certificates without it.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	notifyclient notifyversionedclient.NotifyV1Interface,
+	clusterInformer platformv1informer.ClusterInformer,
+	configuration certificateconfig.CertificateControllerConfiguration) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(30*time.Second, 30*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "certificate"),
+		log:            log.WithName("CertificateController"),
+		platformClient: platformclient,
+		notifyClient:   notifyclient,
+		config:         configuration,
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("certificate_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	clusterInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addCluster,
+			UpdateFunc: c.updateCluster,
+		},
+		configuration.CertificateSyncPeriod,
+	)
+	c.lister = clusterInformer.Lister()
+	c.listerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) addCluster(obj interface{}) {
+	c.enqueue(obj.(*platformv1.Cluster))
+}
+
+// updateCluster only enqueues resync events and changes of masters, reading
+// certificates over ssh on every status change is too expensive.
+func (c *Controller) updateCluster(old, obj interface{}) {
+	oldCluster := old.(*platformv1.Cluster)
+	cluster := obj.(*platformv1.Cluster)
+	if oldCluster.ResourceVersion != cluster.ResourceVersion &&
+		oldCluster.Status.Phase == cluster.Status.Phase &&
+		reflect.DeepEqual(oldCluster.Spec.Machines, cluster.Spec.Machines) {
+		return
+	}
+	c.enqueue(cluster)
+}
+
+func (c *Controller) enqueue(cluster *platformv1.Cluster) {
+	if len(cluster.Spec.Machines) == 0 {
+		return
+	}
+	c.queue.Add(cluster.Name)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting certificate controller")
+	defer log.Info("Shutting down certificate controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for certificate caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of cluster objects.
+// Each cluster can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error checking certificates of cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncCluster(key string) error {
+	ctx := c.log.WithValues("cluster", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished checking certificates of cluster", "processTime", time.Since(startTime).String())
+	}()
+
+	cluster, err := c.lister.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if cluster.DeletionTimestamp != nil || cluster.Status.Phase != platformv1.ClusterRunning ||
+		len(cluster.Spec.Machines) == 0 {
+		return nil
+	}
+
+	return c.reconcile(ctx, cluster.DeepCopy())
+}
+
+func (c *Controller) reconcile(ctx context.Context, cluster *platformv1.Cluster) error {
+	v1Cluster, err := clusterprovider.GetV1Cluster(ctx, c.platformClient, cluster, clusterprovider.AdminUsername)
+	if err != nil {
+		return err
+	}
+
+	certificates, err := readCertificates(ctx, v1Cluster)
+	if err != nil {
+		return err
+	}
+	expiring := ExpiringCertificates(certificates, time.Now().Add(c.config.RenewBefore))
+
+	var renewErr error
+	if len(expiring) > 0 && c.config.AutoRenew {
+		renewErr = c.renew(ctx, v1Cluster, expiring)
+		certificates, err = readCertificates(ctx, v1Cluster)
+		if err != nil {
+			return err
+		}
+		expiring = ExpiringCertificates(certificates, time.Now().Add(c.config.RenewBefore))
+	}
+
+	raised, err := c.updateStatus(ctx, cluster.Name, certificates, expiring)
+	if err != nil {
+		return err
+	}
+	if raised {
+		if err := c.notify(ctx, cluster, expiring); err != nil {
+			log.FromContext(ctx).Error(err, "Notify expiring certificates failed")
+		}
+	}
+
+	return renewErr
+}
+
+// readCertificates reads the expiration of the certificates on all masters.
+// Certificates which can not be read, such as the etcd certificates of a
+// cluster with external etcd, are skipped, but a master without any readable
+// certificate is an error.
+func readCertificates(ctx context.Context, cluster *typesv1.Cluster) ([]platformv1.ClusterCertificate, error) {
+	var certificates []platformv1.ClusterCertificate
+	for _, machine := range cluster.Spec.Machines {
+		s, err := machine.SSH()
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, file := range kubeadm.CertificateFiles {
+			notAfter, err := kubeadm.ReadCertificateExpiration(s, file.Path)
+			if err != nil {
+				log.FromContext(ctx).Info("Skip reading certificate", "node", machine.IP, "certificate", file.Name, "error", err.Error())
+				continue
+			}
+			found = true
+			certificates = append(certificates, platformv1.ClusterCertificate{
+				Name:     file.Name,
+				Node:     machine.IP,
+				NotAfter: metav1.NewTime(notAfter),
+			})
+		}
+		if !found {
+			return nil, fmt.Errorf("no certificate can be read on %s", machine.IP)
+		}
+	}
+
+	return certificates, nil
+}
+
+// renew renews the certificates master by master, waiting for the
+// kube-apiserver of each master to be healthy before moving to the next one,
+// and then refreshes the cluster credential.
+func (c *Controller) renew(ctx context.Context, cluster *typesv1.Cluster, expiring []platformv1.ClusterCertificate) error {
+	nodes := sets.NewString()
+	for _, one := range expiring {
+		if renewable(one.Name) {
+			nodes.Insert(one.Node)
+		}
+	}
+	if nodes.Len() == 0 {
+		return nil
+	}
+
+	for _, machine := range cluster.Spec.Machines {
+		if !nodes.Has(machine.IP) {
+			continue
+		}
+		s, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+		log.FromContext(ctx).Info("Renewing certificates", "node", machine.IP)
+		if err := kubeadm.RenewCerts(cluster, s); err != nil {
+			return fmt.Errorf("renew certificates of %s error: %w", machine.IP, err)
+		}
+		if err := kubeadm.WaitForAPIServer(s); err != nil {
+			return fmt.Errorf("wait for kube-apiserver of %s error: %w", machine.IP, err)
+		}
+		log.FromContext(ctx).Info("Certificates renewed", "node", machine.IP)
+	}
+
+	return c.refreshCredential(ctx, cluster)
+}
+
+// refreshCredential reads the certificates held by the cluster credential
+// from the first reachable master, and stores them if any has changed.
+// kubeadm renews the leaf certificates only, of which the credential holds
+// the etcd client pair; the CAs are read too so that the credential never
+// holds a pair which does not match its CA. The token authenticating the
+// platform is not a certificate and is not affected by the renewal.
+func (c *Controller) refreshCredential(ctx context.Context, cluster *typesv1.Cluster) error {
+	if cluster.ClusterCredential == nil {
+		return nil
+	}
+	credential := cluster.ClusterCredential.DeepCopy()
+	files := []struct {
+		path  string
+		value *[]byte
+	}{
+		{constants.CACertName, &credential.CACert},
+		{constants.CAKeyName, &credential.CAKey},
+		{constants.EtcdCACertName, &credential.ETCDCACert},
+		{constants.EtcdCAKeyName, &credential.ETCDCAKey},
+		{constants.APIServerEtcdClientCertName, &credential.ETCDAPIClientCert},
+		{constants.APIServerEtcdClientKeyName, &credential.ETCDAPIClientKey},
+	}
+
+	var errs []error
+	for _, machine := range cluster.Spec.Machines {
+		s, err := machine.SSH()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", machine.IP, err))
+			continue
+		}
+		contents := make([][]byte, len(files))
+		for i, file := range files {
+			if contents[i], err = s.ReadFile(file.path); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", machine.IP, err))
+			continue
+		}
+
+		changed := false
+		for i, file := range files {
+			if !reflect.DeepEqual(*file.value, contents[i]) {
+				*file.value = contents[i]
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+		_, err = c.platformClient.ClusterCredentials().Update(ctx, credential, metav1.UpdateOptions{})
+		return err
+	}
+
+	return fmt.Errorf("read credential from masters error: %v", utilerrors.NewAggregate(errs))
+}
+
+// updateStatus records the certificates in the cluster status and raises or
+// clears the expiring condition. It returns whether the condition is newly
+// raised.
+func (c *Controller) updateStatus(ctx context.Context, name string, certificates, expiring []platformv1.ClusterCertificate) (bool, error) {
+	raised := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := c.platformClient.Clusters().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		raised = SetExpiringCondition(cluster, expiring)
+		cluster.Status.Certificates = certificates
+		_, err = c.platformClient.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+
+	return raised, err
+}
+
+func (c *Controller) notify(ctx context.Context, cluster *platformv1.Cluster, expiring []platformv1.ClusterCertificate) error {
+	if c.notifyClient == nil || c.config.NotifyChannel == "" || c.config.NotifyTemplate == "" {
+		return nil
+	}
+	request := &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: c.config.NotifyChannel,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TemplateName:   c.config.NotifyTemplate,
+			Receivers:      c.config.NotifyReceivers,
+			ReceiverGroups: c.config.NotifyReceiverGroups,
+			Variables: map[string]string{
+				"clusterID": cluster.Name,
+				"summary":   ExpiringMessage(expiring),
+			},
+		},
+	}
+	_, err := c.notifyClient.MessageRequests(request.Namespace).Create(ctx, request, metav1.CreateOptions{})
+	return err
+}
+
+func renewable(name string) bool {
+	for _, file := range kubeadm.CertificateFiles {
+		if file.Name == name {
+			return file.Renewable
+		}
+	}
+	return false
+}
+
+// ExpiringCertificates returns the certificates which expire before the
+// deadline, the earliest first.
+func ExpiringCertificates(certificates []platformv1.ClusterCertificate, deadline time.Time) []platformv1.ClusterCertificate {
+	var expiring []platformv1.ClusterCertificate
+	for _, one := range certificates {
+		if one.NotAfter.Time.Before(deadline) {
+			expiring = append(expiring, one)
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].NotAfter.Before(&expiring[j].NotAfter)
+	})
+
+	return expiring
+}
+
+// ExpiringMessage describes the expiring certificates.
+func ExpiringMessage(expiring []platformv1.ClusterCertificate) string {
+	messages := make([]string, 0, len(expiring))
+	for _, one := range expiring {
+		messages = append(messages, fmt.Sprintf("%s on %s expires at %s", one.Name, one.Node, one.NotAfter.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// SetExpiringCondition raises the expiring condition on the cluster if there
+// are expiring certificates, and removes it otherwise. It returns whether the
+// condition is newly raised.
+func SetExpiringCondition(cluster *platformv1.Cluster, expiring []platformv1.ClusterCertificate) bool {
+	existing := cluster.GetCondition(ConditionTypeCertificatesExpiring)
+	if len(expiring) == 0 {
+		if existing == nil {
+			return false
+		}
+		conditions := make([]platformv1.ClusterCondition, 0, len(cluster.Status.Conditions))
+		for _, condition := range cluster.Status.Conditions {
+			if condition.Type != ConditionTypeCertificatesExpiring {
+				conditions = append(conditions, condition)
+			}
+		}
+		cluster.Status.Conditions = conditions
+		return false
+	}
+
+	// The condition is true while certificates are expiring, a false or
+	// unknown condition would block the provider handlers of the cluster.
+	cluster.SetCondition(platformv1.ClusterCondition{
+		Type:    ConditionTypeCertificatesExpiring,
+		Status:  platformv1.ConditionTrue,
+		Reason:  reasonCertificatesExpiring,
+		Message: ExpiringMessage(expiring),
+	}, false)
+
+	return existing == nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package certificate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func newCertificate(name, node string, notAfter time.Time) platformv1.ClusterCertificate {
+	return platformv1.ClusterCertificate{Name: name, Node: node, NotAfter: metav1.NewTime(notAfter)}
+}
+
+func TestExpiringCertificates(t *testing.T) {
+	now := time.Now()
+	certificates := []platformv1.ClusterCertificate{
+		newCertificate("apiserver", "10.0.0.1", now.Add(300*24*time.Hour)),
+		newCertificate("etcd-server", "10.0.0.1", now.Add(20*24*time.Hour)),
+		newCertificate("apiserver", "10.0.0.2", now.Add(10*24*time.Hour)),
+	}
+
+	expiring := ExpiringCertificates(certificates, now.Add(30*24*time.Hour))
+	if len(expiring) != 2 {
+		t.Fatalf("expected 2 expiring certificates, got %d", len(expiring))
+	}
+	if expiring[0].Node != "10.0.0.2" || expiring[1].Name != "etcd-server" {
+		t.Errorf("expected the earliest expiring certificate first, got %v", expiring)
+	}
+	if got := ExpiringCertificates(certificates, now); len(got) != 0 {
+		t.Errorf("expected no expiring certificates, got %v", got)
+	}
+}
+
+func TestExpiringMessage(t *testing.T) {
+	notAfter := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	got := ExpiringMessage([]platformv1.ClusterCertificate{
+		newCertificate("apiserver", "10.0.0.1", notAfter),
+		newCertificate("etcd-peer", "10.0.0.2", notAfter),
+	})
+	expected := "apiserver on 10.0.0.1 expires at 2022-01-02T03:04:05Z; etcd-peer on 10.0.0.2 expires at 2022-01-02T03:04:05Z"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSetExpiringCondition(t *testing.T) {
+	cluster := &platformv1.Cluster{}
+	cluster.SetCondition(platformv1.ClusterCondition{Type: "HealthCheck", Status: platformv1.ConditionTrue}, false)
+	expiring := []platformv1.ClusterCertificate{newCertificate("apiserver", "10.0.0.1", time.Now())}
+
+	if !SetExpiringCondition(cluster, expiring) {
+		t.Errorf("expected the condition to be newly raised")
+	}
+	condition := cluster.GetCondition(ConditionTypeCertificatesExpiring)
+	if condition == nil || condition.Status != platformv1.ConditionTrue {
+		t.Fatalf("expected a true expiring condition, got %v", condition)
+	}
+	if SetExpiringCondition(cluster, expiring) {
+		t.Errorf("expected the condition not to be raised again")
+	}
+
+	SetExpiringCondition(cluster, nil)
+	if cluster.GetCondition(ConditionTypeCertificatesExpiring) != nil {
+		t.Errorf("expected the expiring condition to be removed")
+	}
+	if cluster.GetCondition("HealthCheck") == nil {
+		t.Errorf("expected other conditions to be kept")
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	cluster := &platformv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cls-test"}}
+	c := &Controller{platformClient: fake.NewSimpleClientset(cluster).PlatformV1()}
+	ctx := context.Background()
+	certificates := []platformv1.ClusterCertificate{
+		newCertificate("apiserver", "10.0.0.1", time.Now().Add(24*time.Hour)),
+	}
+
+	raised, err := c.updateStatus(ctx, cluster.Name, certificates, certificates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !raised {
+		t.Errorf("expected the condition to be raised")
+	}
+	got, _ := c.platformClient.Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+	if len(got.Status.Certificates) != 1 || got.GetCondition(ConditionTypeCertificatesExpiring) == nil {
+		t.Errorf("expected certificates and condition in status, got %v", got.Status)
+	}
+
+	if _, err := c.updateStatus(ctx, cluster.Name, certificates, nil); err != nil {
+		t.Fatal(err)
+	}
+	got, _ = c.platformClient.Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+	if got.GetCondition(ConditionTypeCertificatesExpiring) != nil {
+		t.Errorf("expected the condition to be cleared, got %v", got.Status.Conditions)
+	}
+}
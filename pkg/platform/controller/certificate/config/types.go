@@ -0,0 +1,46 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package config
+
+import "time"
+
+// CertificateControllerConfiguration contains elements describing CertificateController.
+type CertificateControllerConfiguration struct {
+	// CertificateSyncPeriod is the period for reading the expiration of the
+	// certificates on the masters of clusters.
+	CertificateSyncPeriod time.Duration
+	// ConcurrentCertificateSyncs is the number of clusters that are allowed
+	// to be checked concurrently.
+	ConcurrentCertificateSyncs int
+	// RenewBefore is how long before the expiration the certificates are
+	// reported as expiring and renewed.
+	RenewBefore time.Duration
+	// AutoRenew is whether the expiring certificates are renewed master by
+	// master automatically.
+	AutoRenew bool
+	// NotifyChannel and NotifyTemplate are the notify channel and template of
+	// the message sent when certificates are expiring. No message is sent if
+	// they are empty.
+	NotifyChannel  string
+	NotifyTemplate string
+	// NotifyReceivers and NotifyReceiverGroups receive the message sent when
+	// certificates are expiring.
+	NotifyReceivers      []string
+	NotifyReceiverGroups []string
+}
@@ -61,9 +61,10 @@ const (
 
 // Controller is responsible for performing actions dependent upon a cluster phase.
 type Controller struct {
-	queue        workqueue.RateLimitingInterface
-	lister       platformv1lister.ClusterLister
-	listerSynced cache.InformerSynced
+	queue                   workqueue.RateLimitingInterface
+	lister                  platformv1lister.ClusterLister
+	listerSynced            cache.InformerSynced
+	auditPolicyListerSynced cache.InformerSynced
 
 	log                                        log.Logger
 	platformClient                             platformversionedclient.PlatformV1Interface
@@ -78,6 +79,7 @@ type Controller struct {
 func NewController(
 	platformClient platformversionedclient.PlatformV1Interface,
 	clusterInformer platformv1informer.ClusterInformer,
+	auditPolicyInformer platformv1informer.AuditPolicyInformer,
 	configuration clusterconfig.ClusterControllerConfiguration,
 	finalizerToken platformv1.FinalizerName) *Controller {
 	rand.Seed(time.Now().Unix())
@@ -126,6 +128,14 @@ func NewController(
 
 	c.lister = clusterInformer.Lister()
 	c.listerSynced = clusterInformer.Informer().HasSynced
+
+	// the audit policy of a cluster is rolled out by its provider handlers
+	auditPolicyInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueAuditPolicyCluster,
+		UpdateFunc: c.updateAuditPolicy,
+		DeleteFunc: c.enqueueAuditPolicyCluster,
+	})
+	c.auditPolicyListerSynced = auditPolicyInformer.Informer().HasSynced
 	c.healthCheckPeriod = configuration.HealthCheckPeriod
 	c.randomeRangeLowerLimitForHealthCheckPeriod = configuration.RandomeRangeLowerLimitForHealthCheckPeriod
 	c.randomeRangeUpperLimitForHealthCheckPeriod = configuration.RandomeRangeUpperLimitForHealthCheckPeriod
@@ -213,6 +223,35 @@ func (c *Controller) enqueue(obj *platformv1.Cluster) {
 	c.queue.Add(key)
 }
 
+// updateAuditPolicy only enqueues changes of spec, the status is updated by
+// the rollout itself.
+func (c *Controller) updateAuditPolicy(old, obj interface{}) {
+	if old.(*platformv1.AuditPolicy).Generation == obj.(*platformv1.AuditPolicy).Generation {
+		return
+	}
+	c.enqueueAuditPolicyCluster(obj)
+}
+
+func (c *Controller) enqueueAuditPolicyCluster(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	policy, ok := obj.(*platformv1.AuditPolicy)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("couldn't get audit policy from object %+v", obj))
+		return
+	}
+	cluster, err := c.lister.Get(policy.Spec.ClusterName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			utilruntime.HandleError(fmt.Errorf("couldn't get cluster of audit policy %s: %v", policy.Name, err))
+		}
+		return
+	}
+	c.log.Info("Updating cluster for audit policy", "clusterName", cluster.Name, "auditPolicy", policy.Name)
+	c.enqueue(cluster)
+}
+
 func (c *Controller) needsUpdate(old *platformv1.Cluster, new *platformv1.Cluster) bool {
 	healthCondition := new.GetCondition(conditionTypeHealthCheck)
 	if !reflect.DeepEqual(old.Spec, new.Spec) {
@@ -266,7 +305,7 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
 		return err
 	}
 
-	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.auditPolicyListerSynced); !ok {
 		return fmt.Errorf("failed to wait for cluster caches to sync")
 	}
 
@@ -0,0 +1,415 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clustersync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"tkestack.io/tke/api/client/clientset/versioned/scheme"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	clustersyncconfig "tkestack.io/tke/pkg/platform/controller/clustersync/config"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	// fieldManager is the manager of the fields applied by cluster syncs.
+	fieldManager = "tke-clustersync"
+	// maxDriftObjects is the maximum number of drifted objects recorded in
+	// the status of a target cluster.
+	maxDriftObjects = 50
+	// concurrentClusterApplies is the number of target clusters of a cluster
+	// sync applied concurrently.
+	concurrentClusterApplies = 8
+	defaultInterval          = 5 * time.Minute
+)
+
+// Controller is responsible for keeping the objects of the target clusters
+// of cluster syncs in sync with their git sources.
+type Controller struct {
+	queue               workqueue.RateLimitingInterface
+	lister              platformv1lister.ClusterSyncLister
+	listerSynced        cache.InformerSynced
+	clusterLister       platformv1lister.ClusterLister
+	clusterListerSynced cache.InformerSynced
+	log                 log.Logger
+	platformClient      platformversionedclient.PlatformV1Interface
+	source              source
+	applier             applier
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	clusterSyncInformer platformv1informer.ClusterSyncInformer,
+	clusterInformer platformv1informer.ClusterInformer,
+	configuration clustersyncconfig.ClusterSyncControllerConfiguration) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(5*time.Second, 5*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "clustersync"),
+		log:            log.WithName("ClusterSyncController"),
+		platformClient: platformclient,
+		source:         newGitSource(configuration.WorkDir, configuration.GitTimeout, configuration.AllowLocalRepositories),
+		applier:        &clusterApplier{client: platformclient.RESTClient()},
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("clustersync_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	clusterSyncInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addClusterSync,
+			UpdateFunc: c.updateClusterSync,
+			DeleteFunc: c.deleteClusterSync,
+		},
+	)
+	c.lister = clusterSyncInformer.Lister()
+	c.listerSynced = clusterSyncInformer.Informer().HasSynced
+	c.clusterLister = clusterInformer.Lister()
+	c.clusterListerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) addClusterSync(obj interface{}) {
+	sync := obj.(*platformv1.ClusterSync)
+	c.log.Info("Adding cluster sync", "clusterSync", sync.Name)
+	c.queue.Add(sync.Name)
+}
+
+// updateClusterSync only enqueues the spec changes, the status updates of the
+// controller itself are ignored and every cluster sync is requeued after its
+// interval by syncClusterSync.
+func (c *Controller) updateClusterSync(old, obj interface{}) {
+	oldSync := old.(*platformv1.ClusterSync)
+	sync := obj.(*platformv1.ClusterSync)
+	if oldSync.Generation == sync.Generation &&
+		apiequality.Semantic.DeepEqual(oldSync.Spec, sync.Spec) {
+		return
+	}
+	c.queue.Add(sync.Name)
+}
+
+func (c *Controller) deleteClusterSync(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	sync, ok := obj.(*platformv1.ClusterSync)
+	if !ok {
+		return
+	}
+	c.queue.Add(sync.Name)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting cluster sync controller")
+	defer log.Info("Shutting down cluster sync controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.clusterListerSynced); !ok {
+		return fmt.Errorf("failed to wait for cluster sync caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of cluster sync objects.
+// Each cluster sync can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncClusterSync(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing cluster sync %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncClusterSync(key string) error {
+	ctx := c.log.WithValues("clusterSync", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished syncing cluster sync", "processTime", time.Since(startTime).String())
+	}()
+
+	sync, err := c.lister.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Info("Cluster sync has been deleted")
+			return c.source.Remove(key)
+		}
+		return err
+	}
+
+	status := c.reconcile(ctx, sync)
+	if !apiequality.Semantic.DeepEqual(status, &sync.Status) {
+		sync = sync.DeepCopy()
+		sync.Status = *status
+		if _, err := c.platformClient.ClusterSyncs().UpdateStatus(ctx, sync, metav1.UpdateOptions{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	if !sync.Spec.Suspend {
+		interval := sync.Spec.Interval.Duration
+		if interval <= 0 {
+			interval = defaultInterval
+		}
+		c.queue.AddAfter(key, interval)
+	}
+	return nil
+}
+
+// reconcile renders the source of the cluster sync, applies it to every
+// target cluster, and returns the observed status.
+func (c *Controller) reconcile(ctx context.Context, sync *platformv1.ClusterSync) *platformv1.ClusterSyncStatus {
+	status := sync.Status.DeepCopy()
+	status.ObservedGeneration = sync.Generation
+	if sync.Spec.Suspend {
+		status.Phase = platformv1.ClusterSyncSuspended
+		status.Message = ""
+		return status
+	}
+
+	status.LastSyncTime = metav1.Now()
+	manifests, revision, err := c.source.Render(ctx, sync)
+	if revision != "" {
+		status.Revision = revision
+	}
+	if err == nil && len(strings.TrimSpace(string(manifests))) == 0 {
+		err = fmt.Errorf("no manifests found in %q", sync.Spec.Source.Path)
+	}
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to render the source")
+		status.Phase = platformv1.ClusterSyncFailed
+		status.Message = err.Error()
+		return status
+	}
+
+	clusterNames, err := c.targetClusters(sync)
+	if err != nil {
+		status.Phase = platformv1.ClusterSyncFailed
+		status.Message = err.Error()
+		return status
+	}
+
+	previous := make(map[string]platformv1.ClusterSyncClusterStatus)
+	for _, clusterStatus := range sync.Status.Clusters {
+		previous[clusterStatus.ClusterName] = clusterStatus
+	}
+	clusters := make([]platformv1.ClusterSyncClusterStatus, len(clusterNames))
+	workqueue.ParallelizeUntil(ctx, concurrentClusterApplies, len(clusterNames), func(i int) {
+		clusterStatus, ok := previous[clusterNames[i]]
+		if !ok {
+			clusterStatus = platformv1.ClusterSyncClusterStatus{ClusterName: clusterNames[i]}
+		}
+		clusters[i] = c.syncCluster(ctx, sync, clusterStatus, manifests, revision)
+	})
+	status.Clusters = clusters
+
+	var failed []string
+	for _, clusterStatus := range clusters {
+		if clusterStatus.Phase == platformv1.ClusterSyncFailed {
+			failed = append(failed, clusterStatus.ClusterName)
+		}
+	}
+	if len(failed) > 0 {
+		status.Phase = platformv1.ClusterSyncFailed
+		status.Message = fmt.Sprintf("failed to sync clusters: %s", strings.Join(failed, ", "))
+	} else {
+		status.Phase = platformv1.ClusterSyncSynced
+		status.Message = ""
+	}
+	return status
+}
+
+// targetClusters returns the sorted names of the clusters listed by name or
+// selected by labels.
+func (c *Controller) targetClusters(sync *platformv1.ClusterSync) ([]string, error) {
+	names := sets.NewString(sync.Spec.ClusterNames...)
+	if sync.Spec.ClusterSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(sync.Spec.ClusterSelector)
+		if err != nil {
+			return nil, err
+		}
+		clusters, err := c.clusterLister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			if cluster.Spec.TenantID == sync.Spec.TenantID {
+				names.Insert(cluster.Name)
+			}
+		}
+	}
+	return names.List(), nil
+}
+
+// syncCluster applies the manifests to the cluster by server-side apply, and
+// records the objects which were not in sync as drift.
+func (c *Controller) syncCluster(ctx context.Context, sync *platformv1.ClusterSync, status platformv1.ClusterSyncClusterStatus, manifests []byte, revision string) platformv1.ClusterSyncClusterStatus {
+	status.LastSyncTime = metav1.Now()
+	status.Phase = platformv1.ClusterSyncFailed
+
+	cluster, err := c.clusterLister.Get(status.ClusterName)
+	if err != nil || cluster.Spec.TenantID != sync.Spec.TenantID {
+		status.Message = fmt.Sprintf("cluster %s not found", status.ClusterName)
+		return status
+	}
+	if cluster.Status.Phase != platformv1.ClusterRunning {
+		status.Message = fmt.Sprintf("cluster is %s", cluster.Status.Phase)
+		return status
+	}
+
+	result, err := c.applier.Apply(ctx, status.ClusterName, manifests, &platformv1.ClusterApplyOptions{
+		ServerSide:   true,
+		FieldManager: fieldManager,
+		Force:        true,
+		ApplySet:     applySetName(sync),
+		Prune:        sync.Spec.Prune,
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to apply to the cluster", "clusterName", status.ClusterName)
+		status.Message = err.Error()
+		return status
+	}
+
+	status.Phase = platformv1.ClusterSyncSynced
+	status.Revision = revision
+	status.Message = ""
+	status.Drift = nil
+	for _, item := range result.Items {
+		if item.Action == platformv1.ClusterApplyActionUnchanged {
+			continue
+		}
+		if len(status.Drift) == maxDriftObjects {
+			break
+		}
+		status.Drift = append(status.Drift, platformv1.ClusterSyncObject{
+			Group:     item.Group,
+			Kind:      item.Kind,
+			Namespace: item.Namespace,
+			Name:      item.Name,
+			Action:    item.Action,
+		})
+	}
+	return status
+}
+
+// applySetName returns the name of the apply set labeling the objects of the
+// cluster sync, which must be a valid label value.
+func applySetName(sync *platformv1.ClusterSync) string {
+	name := "cs-" + sync.Name
+	if len(validation.IsDNS1123Label(name)) == 0 {
+		return name
+	}
+	sum := sha256.Sum256([]byte(sync.Name))
+	return "cs-" + hex.EncodeToString(sum[:])[:32]
+}
+
+// applier applies manifests to a cluster.
+type applier interface {
+	Apply(ctx context.Context, clusterName string, manifests []byte, opts *platformv1.ClusterApplyOptions) (*platformv1.ClusterApplyResult, error)
+}
+
+// clusterApplier applies manifests through the apply subresource of clusters.
+type clusterApplier struct {
+	client restclient.Interface
+}
+
+func (a *clusterApplier) Apply(ctx context.Context, clusterName string, manifests []byte, opts *platformv1.ClusterApplyOptions) (*platformv1.ClusterApplyResult, error) {
+	body, err := a.client.Post().
+		Resource("clusters").
+		Name(clusterName).
+		SubResource("apply").
+		VersionedParams(opts, scheme.ParameterCodec).
+		Body(manifests).
+		DoRaw(ctx)
+
+	result := &platformv1.ClusterApplyResult{}
+	if jsonErr := json.Unmarshal(body, result); jsonErr != nil || result.Kind != "ClusterApplyResult" {
+		if err == nil {
+			err = fmt.Errorf("unexpected response of apply: %s", string(body))
+		}
+		return nil, err
+	}
+	var failures []string
+	for _, item := range result.Items {
+		if item.Action == platformv1.ClusterApplyActionFailed {
+			failures = append(failures, fmt.Sprintf("%s %s/%s: %s", item.Kind, item.Namespace, item.Name, item.Message))
+		}
+	}
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("failed to apply %s", strings.Join(failures, "; "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
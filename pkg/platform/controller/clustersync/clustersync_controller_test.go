@@ -0,0 +1,299 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clustersync
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/controllertest"
+)
+
+type fakeSource struct {
+	manifests string
+	revision  string
+	err       error
+}
+
+func (f *fakeSource) Render(ctx context.Context, sync *platformv1.ClusterSync) ([]byte, string, error) {
+	return []byte(f.manifests), f.revision, f.err
+}
+
+func (f *fakeSource) Remove(name string) error { return nil }
+
+// fakeApplier reports every object of the manifests with the action keyed by
+// name, Unchanged by default.
+type fakeApplier struct {
+	actions map[string]platformv1.ClusterApplyAction
+	opts    []platformv1.ClusterApplyOptions
+}
+
+func (f *fakeApplier) Apply(ctx context.Context, clusterName string, manifests []byte, opts *platformv1.ClusterApplyOptions) (*platformv1.ClusterApplyResult, error) {
+	f.opts = append(f.opts, *opts)
+	result := &platformv1.ClusterApplyResult{}
+	for _, line := range strings.Split(string(manifests), "\n") {
+		if !strings.HasPrefix(line, "name: ") {
+			continue
+		}
+		name := strings.TrimPrefix(line, "name: ")
+		action, ok := f.actions[name]
+		if !ok {
+			action = platformv1.ClusterApplyActionUnchanged
+		}
+		result.Items = append(result.Items, platformv1.ClusterApplyObjectResult{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: name, Action: action})
+	}
+	return result, nil
+}
+
+func newTestController(t *testing.T, source source, applier applier, clusters ...*platformv1.Cluster) *Controller {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, cluster := range clusters {
+		if err := indexer.Add(cluster); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &Controller{
+		clusterLister: platformv1lister.NewClusterLister(indexer),
+		source:        source,
+		applier:       applier,
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	applier := &fakeApplier{actions: map[string]platformv1.ClusterApplyAction{
+		"changed": platformv1.ClusterApplyActionConfigured,
+	}}
+	c := newTestController(t,
+		&fakeSource{manifests: "name: changed\n---\nname: same\n", revision: "abc"},
+		applier,
+		controllertest.NewCluster("cls-a", "default", platformv1.ClusterRunning, nil),
+		controllertest.NewCluster("cls-b", "default", platformv1.ClusterInitializing, map[string]string{"env": "prod"}),
+		controllertest.NewCluster("cls-c", "other", platformv1.ClusterRunning, map[string]string{"env": "prod"}),
+	)
+	sync := &platformv1.ClusterSync{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Generation: 2},
+		Spec: platformv1.ClusterSyncSpec{
+			TenantID:        "default",
+			ClusterNames:    []string{"cls-a"},
+			ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			Prune:           true,
+		},
+	}
+
+	status := c.reconcile(context.Background(), sync)
+	if status.Phase != platformv1.ClusterSyncFailed || status.Revision != "abc" || status.ObservedGeneration != 2 {
+		t.Fatalf("reconcile() = %s/%s/%d, want Failed/abc/2", status.Phase, status.Revision, status.ObservedGeneration)
+	}
+	if len(status.Clusters) != 2 {
+		t.Fatalf("reconcile() synced %d clusters, want cls-a and cls-b", len(status.Clusters))
+	}
+
+	a := status.Clusters[0]
+	if a.ClusterName != "cls-a" || a.Phase != platformv1.ClusterSyncSynced || a.Revision != "abc" {
+		t.Errorf("cluster cls-a = %+v, want synced at abc", a)
+	}
+	if len(a.Drift) != 1 || a.Drift[0].Name != "changed" || a.Drift[0].Action != platformv1.ClusterApplyActionConfigured {
+		t.Errorf("cluster cls-a drift = %+v, want the configured object only", a.Drift)
+	}
+
+	b := status.Clusters[1]
+	if b.ClusterName != "cls-b" || b.Phase != platformv1.ClusterSyncFailed || b.Revision != "" {
+		t.Errorf("cluster cls-b = %+v, want failed without revision", b)
+	}
+
+	if len(applier.opts) != 1 {
+		t.Fatalf("applied %d times, want once", len(applier.opts))
+	}
+	opts := applier.opts[0]
+	if !opts.ServerSide || !opts.Force || !opts.Prune || opts.ApplySet != "cs-app" || opts.FieldManager != fieldManager {
+		t.Errorf("apply options = %+v", opts)
+	}
+}
+
+func TestReconcileSourceError(t *testing.T) {
+	c := newTestController(t, &fakeSource{err: errors.New("fetch failed")}, &fakeApplier{})
+	sync := &platformv1.ClusterSync{
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+		Spec:       platformv1.ClusterSyncSpec{ClusterNames: []string{"cls-a"}},
+		Status:     platformv1.ClusterSyncStatus{Revision: "abc"},
+	}
+
+	status := c.reconcile(context.Background(), sync)
+	if status.Phase != platformv1.ClusterSyncFailed || status.Message != "fetch failed" || status.Revision != "abc" {
+		t.Errorf("reconcile() = %+v, want failed keeping the revision", status)
+	}
+
+	sync.Spec.Suspend = true
+	if status := c.reconcile(context.Background(), sync); status.Phase != platformv1.ClusterSyncSuspended {
+		t.Errorf("reconcile() = %s, want Suspended", status.Phase)
+	}
+}
+
+func TestApplySetName(t *testing.T) {
+	sync := &platformv1.ClusterSync{ObjectMeta: metav1.ObjectMeta{Name: "app"}}
+	if name := applySetName(sync); name != "cs-app" {
+		t.Errorf("applySetName() = %s, want cs-app", name)
+	}
+	sync.Name = strings.Repeat("a", 63)
+	name := applySetName(sync)
+	if len(name) > 63 || !strings.HasPrefix(name, "cs-") {
+		t.Errorf("applySetName() = %s, want a hashed label value", name)
+	}
+}
+
+func git(t *testing.T, dir string, args ...string) string {
+	args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v: %s", args, err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+func writeFile(t *testing.T, file, content string) {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGitSourceRender(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	root, err := ioutil.TempDir("", "clustersync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	remote := filepath.Join(root, "remote.git")
+	work := filepath.Join(root, "work")
+	git(t, root, "init", "--quiet", "--bare", remote)
+	git(t, root, "init", "--quiet", work)
+	writeFile(t, filepath.Join(work, "plain", "a.yaml"), "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n")
+	writeFile(t, filepath.Join(work, "plain", "b", "b.json"), `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}`)
+	writeFile(t, filepath.Join(work, "plain", ".hidden", "c.yaml"), "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: c\n")
+	writeFile(t, filepath.Join(work, "base", "kustomization.yaml"), "resources:\n- cm.yaml\n")
+	writeFile(t, filepath.Join(work, "base", "cm.yaml"), "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\n")
+	writeFile(t, filepath.Join(work, "overlay", "kustomization.yaml"), "resources:\n- ../base\nnamePrefix: prod-\n")
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "init")
+	git(t, work, "push", "--quiet", remote, "HEAD:refs/heads/master")
+	revision := git(t, work, "rev-parse", "HEAD")
+
+	s := newGitSource(filepath.Join(root, "cache"), time.Minute, true)
+	sync := &platformv1.ClusterSync{
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+		Spec: platformv1.ClusterSyncSpec{
+			Source: platformv1.ClusterSyncSource{URL: remote, Branch: "master", Path: "plain"},
+		},
+	}
+	manifests, rev, err := s.Render(context.Background(), sync)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if rev != revision {
+		t.Errorf("Render() revision = %s, want %s", rev, revision)
+	}
+	if got := string(manifests); !strings.Contains(got, "name: a") || !strings.Contains(got, "name: b") || strings.Contains(got, "name: c") {
+		t.Errorf("Render() plain manifests = %s", got)
+	}
+
+	writeFile(t, filepath.Join(work, "base", "cm.yaml"), "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\ndata:\n  key: value\n")
+	git(t, work, "commit", "--quiet", "-am", "update")
+	git(t, work, "push", "--quiet", remote, "HEAD:refs/heads/master")
+	sync.Spec.Source.Path = "overlay"
+	manifests, rev, err = s.Render(context.Background(), sync)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if rev != git(t, work, "rev-parse", "HEAD") {
+		t.Errorf("Render() revision = %s, want the new commit", rev)
+	}
+	if got := string(manifests); !strings.Contains(got, "name: prod-app") || !strings.Contains(got, "key: value") {
+		t.Errorf("Render() kustomized manifests = %s", got)
+	}
+
+	sync.Spec.Source.Path = "../../.."
+	if manifests, _, err := s.Render(context.Background(), sync); err != nil || !strings.Contains(string(manifests), "name: a") {
+		t.Errorf("Render() did not keep the path in the repository: %v", err)
+	}
+
+	if err := s.Remove("app"); err != nil {
+		t.Fatal(err)
+	}
+	local := newGitSource(filepath.Join(root, "cache"), time.Minute, false)
+	if _, _, err := local.Render(context.Background(), sync); err == nil {
+		t.Errorf("Render() expected error for local repository")
+	}
+}
+
+func TestRenderKustomizationConfined(t *testing.T) {
+	root, err := ioutil.TempDir("", "clustersync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	repo := filepath.Join(root, "repo")
+	writeFile(t, filepath.Join(root, "secret.yaml"), "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: secret\n")
+	writeFile(t, filepath.Join(root, "outside", "kustomization.yaml"), "resources:\n- ../secret.yaml\n")
+	writeFile(t, filepath.Join(repo, "app", "cm.yaml"), "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\n")
+	writeFile(t, filepath.Join(repo, "app", "kustomization.yaml"), "resources:\n- cm.yaml\n- link.yaml\n")
+	writeFile(t, filepath.Join(repo, "linked", "cm.yaml"), "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: linked\n")
+	if err := os.Symlink(filepath.Join(repo, "linked", "cm.yaml"), filepath.Join(repo, "app", "link.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(repo, "link", "kustomization.yaml"), "resources:\n- secret.yaml\n")
+	if err := os.Symlink(filepath.Join(root, "secret.yaml"), filepath.Join(repo, "link", "secret.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(repo, "parent", "kustomization.yaml"), "resources:\n- ../../outside\n")
+	writeFile(t, filepath.Join(repo, "app", "remote", "kustomization.yaml"), "bases:\n- github.com/org/repo//deploy?ref=v1\n")
+	writeFile(t, filepath.Join(repo, "remote", "kustomization.yaml"), "resources:\n- ../app/remote\n")
+
+	manifests, err := render(repo, "app")
+	if err != nil || !strings.Contains(string(manifests), "name: linked") {
+		t.Errorf("render() = %s, %v, expected the symbolic link in the repository followed", manifests, err)
+	}
+	for _, path := range []string{"link", "parent"} {
+		if manifests, err := render(repo, path); err == nil && strings.Contains(string(manifests), "name: secret") {
+			t.Errorf("render(%s) read the file out of the repository", path)
+		}
+	}
+	if _, err := render(repo, "remote"); err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Errorf("render(remote) error = %v, expected the remote base refused", err)
+	}
+}
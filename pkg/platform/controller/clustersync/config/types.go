@@ -0,0 +1,37 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package config
+
+import "time"
+
+// ClusterSyncControllerConfiguration contains elements describing
+// ClusterSyncController.
+type ClusterSyncControllerConfiguration struct {
+	// WorkDir is the directory the git repositories of cluster syncs are
+	// fetched into.
+	WorkDir string
+	// GitTimeout is the timeout of every git command.
+	GitTimeout time.Duration
+	// AllowLocalRepositories allows the sources to be local paths or file://
+	// urls of the controller host, which is only intended for testing.
+	AllowLocalRepositories bool
+	// ConcurrentClusterSyncSyncs is the number of cluster sync objects that
+	// are allowed to sync concurrently.
+	ConcurrentClusterSyncSyncs int
+}
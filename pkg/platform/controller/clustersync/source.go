@@ -0,0 +1,363 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clustersync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+const (
+	// remoteProtocols are the git protocols allowed for the sources.
+	remoteProtocols = "http:https:ssh:git"
+	// localProtocol is the git protocol of local paths and file:// urls.
+	localProtocol = "file"
+)
+
+// source fetches the git repository of a cluster sync and renders its
+// manifests.
+type source interface {
+	Render(ctx context.Context, sync *platformv1.ClusterSync) (manifests []byte, revision string, err error)
+	Remove(name string) error
+}
+
+// gitSource keeps a shallow clone of the source of every cluster sync under
+// the work directory.
+type gitSource struct {
+	workDir        string
+	timeout        time.Duration
+	allowProtocols string
+}
+
+func newGitSource(workDir string, timeout time.Duration, allowLocal bool) *gitSource {
+	allowProtocols := remoteProtocols
+	if allowLocal {
+		allowProtocols += ":" + localProtocol
+	}
+	return &gitSource{
+		workDir:        workDir,
+		timeout:        timeout,
+		allowProtocols: allowProtocols,
+	}
+}
+
+// Render fetches the head of the source branch and renders the manifests
+// under the source path.
+func (s *gitSource) Render(ctx context.Context, sync *platformv1.ClusterSync) ([]byte, string, error) {
+	repo := filepath.Join(s.workDir, sync.Name)
+	if _, err := os.Stat(filepath.Join(repo, ".git")); os.IsNotExist(err) {
+		if err := os.MkdirAll(repo, 0700); err != nil {
+			return nil, "", err
+		}
+		if _, err := s.git(ctx, repo, "init", "--quiet"); err != nil {
+			return nil, "", err
+		}
+	}
+	src := sync.Spec.Source
+	if _, err := s.git(ctx, repo, "fetch", "--quiet", "--depth", "1", "--", src.URL, src.Branch); err != nil {
+		return nil, "", err
+	}
+	if _, err := s.git(ctx, repo, "checkout", "--quiet", "--force", "FETCH_HEAD"); err != nil {
+		return nil, "", err
+	}
+	revision, err := s.git(ctx, repo, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, "", err
+	}
+
+	manifests, err := render(repo, src.Path)
+	if err != nil {
+		return nil, revision, err
+	}
+	return manifests, revision, nil
+}
+
+// Remove deletes the clone of the cluster sync.
+func (s *gitSource) Remove(name string) error {
+	return os.RemoveAll(filepath.Join(s.workDir, name))
+}
+
+func (s *gitSource) git(ctx context.Context, dir string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_ALLOW_PROTOCOL="+s.allowProtocols)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s failed: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// render returns the manifests under the path of the repository, built by
+// kustomize if the path contains a kustomization file, otherwise the yaml and
+// json files under it in lexical order.
+func render(repo, path string) ([]byte, error) {
+	// Cleaning the path as an absolute one keeps it inside the repository.
+	dir := filepath.Join(repo, filepath.Clean("/"+path))
+	resolvedRepo, err := filepath.EvalSymlinks(repo)
+	if err != nil {
+		return nil, err
+	}
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !inRepository(resolvedRepo, resolvedDir) {
+		return nil, fmt.Errorf("%s is out of the repository", path)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", path)
+	}
+
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return kustomize(resolvedRepo, resolvedDir)
+		}
+	}
+
+	var manifests bytes.Buffer
+	err = filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if file != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// Symbolic links are skipped so that nothing out of the repository
+		// is read.
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		var data []byte
+		switch filepath.Ext(file) {
+		case ".yaml", ".yml":
+			if data, err = ioutil.ReadFile(file); err != nil {
+				return err
+			}
+		case ".json":
+			if data, err = ioutil.ReadFile(file); err != nil {
+				return err
+			}
+			if data, err = yaml.JSONToYAML(data); err != nil {
+				return fmt.Errorf("%s: %v", strings.TrimPrefix(file, repo+"/"), err)
+			}
+		default:
+			return nil
+		}
+		manifests.WriteString("---\n")
+		manifests.Write(data)
+		manifests.WriteString("\n")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return manifests.Bytes(), nil
+}
+
+// inRepository checks whether the resolved path is the repository or under it.
+func inRepository(repo, path string) bool {
+	return path == repo || strings.HasPrefix(path, repo+string(filepath.Separator))
+}
+
+// kustomize builds the kustomization under dir of the repository. The files
+// of the repository are copied to an in memory file system for kustomize, so
+// that nothing out of the repository is read, and the kustomizations
+// referring to remote bases or files are refused.
+func kustomize(repo, dir string) ([]byte, error) {
+	fSys, err := repositoryFs(repo)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkKustomizationDir(fSys, repo, dir, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	resources, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fSys, dir)
+	if err != nil {
+		return nil, err
+	}
+	return resources.AsYaml()
+}
+
+// repositoryFs copies the regular files of the resolved repository to an in
+// memory file system. Symbolic links to files are only followed if they
+// resolve into the repository, the ones to directories are skipped.
+func repositoryFs(repo string) (filesys.FileSystem, error) {
+	fSys := filesys.MakeFsInMemory()
+	err := filepath.Walk(repo, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return fSys.MkdirAll(file)
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			resolved, err := filepath.EvalSymlinks(file)
+			if err != nil || !inRepository(repo, resolved) {
+				return nil
+			}
+			if info, err = os.Stat(resolved); err != nil {
+				return nil
+			}
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		return fSys.WriteFile(file, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fSys, nil
+}
+
+// checkKustomizationDir checks the kustomization in dir and the ones of its
+// bases and components in the repository.
+func checkKustomizationDir(fSys filesys.FileSystem, repo, dir string, checked map[string]bool) error {
+	if checked[dir] {
+		return nil
+	}
+	checked[dir] = true
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		file := filepath.Join(dir, name)
+		if !fSys.Exists(file) {
+			continue
+		}
+		data, err := fSys.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		k, err := checkKustomization(data)
+		if err != nil {
+			return fmt.Errorf("%s: %v", strings.TrimPrefix(file, repo+"/"), err)
+		}
+		for _, ref := range append(k.Resources, k.Components...) {
+			base := filepath.Join(dir, ref)
+			if !fSys.IsDir(base) {
+				continue
+			}
+			if err := checkKustomizationDir(fSys, repo, base, checked); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// checkKustomization refuses the kustomization referring to remote bases or
+// files, which kustomize fetches regardless of the file system, and helm
+// charts, which are pulled from chart repositories.
+func checkKustomization(data []byte) (*types.Kustomization, error) {
+	k := &types.Kustomization{}
+	if err := yaml.Unmarshal(data, k); err != nil {
+		return nil, err
+	}
+	k.FixKustomizationPostUnmarshalling()
+	if len(k.HelmCharts) != 0 || len(k.HelmChartInflationGenerator) != 0 {
+		return nil, fmt.Errorf("helm charts are not allowed")
+	}
+
+	var refs []string
+	for _, one := range [][]string{k.Resources, k.Components, k.Crds, k.Configurations, k.Generators, k.Transformers, k.Validators} {
+		refs = append(refs, one...)
+	}
+	for _, patch := range k.PatchesStrategicMerge {
+		// inline patches are not loaded
+		if !strings.Contains(string(patch), "\n") {
+			refs = append(refs, string(patch))
+		}
+	}
+	for _, patch := range append(k.Patches, k.PatchesJson6902...) {
+		refs = append(refs, patch.Path)
+	}
+	for _, replacement := range k.Replacements {
+		refs = append(refs, replacement.Path)
+	}
+	refs = append(refs, k.OpenAPI["path"])
+	var sources []types.KvPairSources
+	for _, generator := range k.ConfigMapGenerator {
+		sources = append(sources, generator.KvPairSources)
+	}
+	for _, generator := range k.SecretGenerator {
+		sources = append(sources, generator.KvPairSources)
+	}
+	for _, source := range sources {
+		for _, file := range source.FileSources {
+			// a file source is in the form of [{key}=]{path}
+			refs = append(refs, file[strings.Index(file, "=")+1:])
+		}
+		refs = append(refs, source.EnvSources...)
+	}
+
+	for _, ref := range refs {
+		if remoteReference(ref) {
+			return nil, fmt.Errorf("remote %q is not allowed", ref)
+		}
+	}
+	return k, nil
+}
+
+// remoteHostPrefixes are the prefixes of the git repositories and urls
+// recognized by kustomize.
+var remoteHostPrefixes = []string{"git::", "gh:", "ssh://", "https://", "http://", "git@", "github.com:", "github.com/"}
+
+// remoteReference checks whether kustomize fetches the reference remotely.
+func remoteReference(ref string) bool {
+	ref = strings.ToLower(strings.TrimSpace(ref))
+	for _, prefix := range remoteHostPrefixes {
+		if strings.HasPrefix(ref, prefix) {
+			return true
+		}
+	}
+	return strings.Contains(ref, "://") || strings.Contains(ref, "_git/")
+}
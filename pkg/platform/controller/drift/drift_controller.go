@@ -0,0 +1,335 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	driftconfig "tkestack.io/tke/pkg/platform/controller/drift/config"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	// ConditionTypeConfigurationDrifted is the condition raised on a cluster
+	// while the configuration on its masters or worker machines drifts from
+	// the spec.
+	ConditionTypeConfigurationDrifted = "ConfigurationDrifted"
+	reasonConfigurationDrifted        = "ConfigurationDrifted"
+)
+
+// Controller compares the configuration on the masters and the worker machines
+// of clusters with the one rendered from the spec, reports the drift in the
+// cluster status and re-applies the rendered configuration if auto correction
+// is enabled.
+type Controller struct {
+	queue          workqueue.RateLimitingInterface
+	lister         platformv1lister.ClusterLister
+	listerSynced   cache.InformerSynced
+	log            log.Logger
+	platformClient platformversionedclient.PlatformV1Interface
+	config         driftconfig.DriftControllerConfiguration
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	clusterInformer platformv1informer.ClusterInformer,
+	configuration driftconfig.DriftControllerConfiguration) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(30*time.Second, 30*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "drift"),
+		log:            log.WithName("DriftController"),
+		platformClient: platformclient,
+		config:         configuration,
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("drift_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	clusterInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addCluster,
+			UpdateFunc: c.updateCluster,
+		},
+		configuration.DriftSyncPeriod,
+	)
+	c.lister = clusterInformer.Lister()
+	c.listerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) addCluster(obj interface{}) {
+	c.enqueue(obj.(*platformv1.Cluster))
+}
+
+// updateCluster only enqueues resync events and changes of spec or phase,
+// reading configuration over ssh on every status change is too expensive.
+func (c *Controller) updateCluster(old, obj interface{}) {
+	oldCluster := old.(*platformv1.Cluster)
+	cluster := obj.(*platformv1.Cluster)
+	if oldCluster.ResourceVersion != cluster.ResourceVersion &&
+		oldCluster.Status.Phase == cluster.Status.Phase &&
+		reflect.DeepEqual(oldCluster.Spec, cluster.Spec) {
+		return
+	}
+	c.enqueue(cluster)
+}
+
+func (c *Controller) enqueue(cluster *platformv1.Cluster) {
+	if len(cluster.Spec.Machines) == 0 {
+		return
+	}
+	c.queue.Add(cluster.Name)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting drift controller")
+	defer log.Info("Shutting down drift controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for drift caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of cluster objects.
+// Each cluster can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error checking configuration drift of cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncCluster(key string) error {
+	ctx := c.log.WithValues("cluster", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished checking configuration drift of cluster", "processTime", time.Since(startTime).String())
+	}()
+
+	cluster, err := c.lister.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if cluster.DeletionTimestamp != nil || cluster.Status.Phase != platformv1.ClusterRunning ||
+		len(cluster.Spec.Machines) == 0 {
+		return nil
+	}
+	provider, err := clusterprovider.GetProvider(cluster.Spec.Type)
+	if err != nil {
+		return err
+	}
+	detector, ok := provider.(clusterprovider.DriftDetector)
+	if !ok {
+		return nil
+	}
+
+	return c.reconcile(ctx, detector, cluster.DeepCopy())
+}
+
+func (c *Controller) reconcile(ctx context.Context, detector clusterprovider.DriftDetector, cluster *platformv1.Cluster) error {
+	v1Cluster, err := clusterprovider.GetV1Cluster(ctx, c.platformClient, cluster, clusterprovider.AdminUsername)
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs     []error
+		messages []string
+	)
+	check := func(ip string, detect func() ([]clusterprovider.Drift, error), correct func([]clusterprovider.Drift) error) {
+		found, err := c.checkNode(ctx, ip, detect, correct)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if len(found) > 0 {
+			messages = append(messages, DriftMessage(ip, found))
+		}
+	}
+	for _, machine := range v1Cluster.Spec.Machines {
+		machine := machine
+		check(machine.IP, func() ([]clusterprovider.Drift, error) {
+			return detector.DetectDrift(ctx, v1Cluster, machine)
+		}, func(found []clusterprovider.Drift) error {
+			return detector.CorrectDrift(ctx, v1Cluster, machine, found)
+		})
+	}
+
+	workers, err := c.platformClient.Machines().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, cluster.Name).String(),
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		for i := range workers.Items {
+			worker := &workers.Items[i]
+			if worker.Status.Phase != platformv1.MachineRunning {
+				continue
+			}
+			check(worker.Spec.IP, func() ([]clusterprovider.Drift, error) {
+				return detector.DetectMachineDrift(ctx, v1Cluster, worker)
+			}, func(found []clusterprovider.Drift) error {
+				return detector.CorrectMachineDrift(ctx, v1Cluster, worker, found)
+			})
+		}
+	}
+
+	if err := c.updateStatus(ctx, cluster.Name, strings.Join(messages, "; ")); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// checkNode detects the drift of the configuration on the node, and corrects
+// it if auto correction is enabled. It returns the drift left on the node.
+func (c *Controller) checkNode(ctx context.Context, ip string, detect func() ([]clusterprovider.Drift, error), correct func([]clusterprovider.Drift) error) ([]clusterprovider.Drift, error) {
+	found, err := detect()
+	if err != nil {
+		return nil, fmt.Errorf("detect drift of %s error: %w", ip, err)
+	}
+	if len(found) == 0 || !c.config.AutoCorrect {
+		return found, nil
+	}
+
+	log.FromContext(ctx).Info("Correcting configuration drift", "node", ip, "drift", DriftMessage(ip, found))
+	var errs []error
+	if err := correct(found); err != nil {
+		errs = append(errs, fmt.Errorf("correct drift of %s error: %w", ip, err))
+	}
+	found, err = detect()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("detect drift of %s error: %w", ip, err))
+	}
+	return found, utilerrors.NewAggregate(errs)
+}
+
+func (c *Controller) updateStatus(ctx context.Context, name string, message string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := c.platformClient.Clusters().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !SetDriftCondition(cluster, message) {
+			return nil
+		}
+		_, err = c.platformClient.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// DriftMessage describes the drift of the configuration on a node.
+func DriftMessage(node string, drifts []clusterprovider.Drift) string {
+	messages := make([]string, 0, len(drifts))
+	for _, one := range drifts {
+		messages = append(messages, fmt.Sprintf("%s %s", one.Component, one.Message))
+	}
+	return fmt.Sprintf("%s: %s", node, strings.Join(messages, ", "))
+}
+
+// SetDriftCondition raises the drift condition on the cluster with the
+// message if it is not empty, and removes the condition otherwise. It returns
+// whether the cluster status is changed.
+func SetDriftCondition(cluster *platformv1.Cluster, message string) bool {
+	existing := cluster.GetCondition(ConditionTypeConfigurationDrifted)
+	if message == "" {
+		if existing == nil {
+			return false
+		}
+		conditions := make([]platformv1.ClusterCondition, 0, len(cluster.Status.Conditions))
+		for _, condition := range cluster.Status.Conditions {
+			if condition.Type != ConditionTypeConfigurationDrifted {
+				conditions = append(conditions, condition)
+			}
+		}
+		cluster.Status.Conditions = conditions
+		return true
+	}
+	if existing != nil && existing.Message == message {
+		return false
+	}
+
+	// The condition is true while the configuration drifts, a false or
+	// unknown condition would block the provider handlers of the cluster.
+	cluster.SetCondition(platformv1.ClusterCondition{
+		Type:    ConditionTypeConfigurationDrifted,
+		Status:  platformv1.ConditionTrue,
+		Reason:  reasonConfigurationDrifted,
+		Message: message,
+	}, false)
+
+	return true
+}
@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	driftconfig "tkestack.io/tke/pkg/platform/controller/drift/config"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+)
+
+func TestDriftMessage(t *testing.T) {
+	got := DriftMessage("10.0.0.1", []clusterprovider.Drift{
+		{Component: "kube-apiserver", Message: `--audit-policy-file is missing, expected "/etc/kubernetes/audit-policy.yaml"`},
+		{Component: "kubelet", Message: "maxPods is 110, expected 256"},
+	})
+	expected := `10.0.0.1: kube-apiserver --audit-policy-file is missing, expected "/etc/kubernetes/audit-policy.yaml", kubelet maxPods is 110, expected 256`
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSetDriftCondition(t *testing.T) {
+	cluster := &platformv1.Cluster{}
+	cluster.SetCondition(platformv1.ClusterCondition{Type: "HealthCheck", Status: platformv1.ConditionTrue}, false)
+
+	if !SetDriftCondition(cluster, "10.0.0.1: kubelet maxPods is 110, expected 256") {
+		t.Errorf("expected the status to be changed")
+	}
+	condition := cluster.GetCondition(ConditionTypeConfigurationDrifted)
+	if condition == nil || condition.Status != platformv1.ConditionTrue {
+		t.Fatalf("expected a true drift condition, got %v", condition)
+	}
+	if SetDriftCondition(cluster, "10.0.0.1: kubelet maxPods is 110, expected 256") {
+		t.Errorf("expected the status not to be changed by the same drift")
+	}
+	if !SetDriftCondition(cluster, "") {
+		t.Errorf("expected the status to be changed when the drift is gone")
+	}
+	if cluster.GetCondition(ConditionTypeConfigurationDrifted) != nil {
+		t.Errorf("expected the drift condition to be removed")
+	}
+	if cluster.GetCondition("HealthCheck") == nil {
+		t.Errorf("expected other conditions to be kept")
+	}
+	if SetDriftCondition(cluster, "") {
+		t.Errorf("expected the status not to be changed without drift")
+	}
+}
+
+func TestCheckNode(t *testing.T) {
+	drift := clusterprovider.Drift{Component: "containerd", Message: "/etc/containerd/config.toml differs from the rendered config"}
+	tests := []struct {
+		name        string
+		autoCorrect bool
+		correctErr  error
+		wantDrifts  int
+		wantCorrect int
+		wantErr     bool
+	}{
+		{"report only", false, nil, 1, 0, false},
+		{"corrected", true, nil, 0, 1, false},
+		{"correct error", true, errors.New("restart containerd error"), 1, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Controller{config: driftconfig.DriftControllerConfiguration{AutoCorrect: tt.autoCorrect}}
+			drifted, corrected := true, 0
+			found, err := c.checkNode(context.TODO(), "10.0.0.2", func() ([]clusterprovider.Drift, error) {
+				if drifted {
+					return []clusterprovider.Drift{drift}, nil
+				}
+				return nil, nil
+			}, func([]clusterprovider.Drift) error {
+				corrected++
+				if tt.correctErr != nil {
+					return tt.correctErr
+				}
+				drifted = false
+				return nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkNode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(found) != tt.wantDrifts {
+				t.Errorf("checkNode() = %v, want %d drifts", found, tt.wantDrifts)
+			}
+			if corrected != tt.wantCorrect {
+				t.Errorf("corrected %d times, want %d", corrected, tt.wantCorrect)
+			}
+		})
+	}
+}
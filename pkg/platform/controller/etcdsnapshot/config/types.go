@@ -0,0 +1,37 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package config
+
+import "time"
+
+// EtcdSnapshotControllerConfiguration contains elements describing EtcdSnapshotController.
+type EtcdSnapshotControllerConfiguration struct {
+	// EtcdSnapshotSyncPeriod is the period for syncing etcd snapshot
+	// life-cycle updates.
+	EtcdSnapshotSyncPeriod time.Duration
+	// ScheduleInterval is the period for checking whether a scheduled
+	// snapshot is due for every cluster.
+	ScheduleInterval time.Duration
+	// ConcurrentEtcdSnapshotSyncs is the number of etcd snapshot objects that
+	// are allowed to sync concurrently.
+	ConcurrentEtcdSnapshotSyncs int
+	// RegistryStorageDir is the root directory of the tke-registry
+	// filesystem storage mounted into the controller.
+	RegistryStorageDir string
+}
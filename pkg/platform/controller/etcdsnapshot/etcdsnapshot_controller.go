@@ -0,0 +1,572 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcdsnapshot
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"golang.org/x/time/rate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	etcdsnapshotconfig "tkestack.io/tke/pkg/platform/controller/etcdsnapshot/config"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/etcd"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	utiletcd "tkestack.io/tke/pkg/util/etcd"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	etcdClientPort  = 2379
+	etcdDialTimeout = 10 * time.Second
+	snapshotTimeout = 10 * time.Minute
+	restoreTimeout  = 30 * time.Minute
+)
+
+// Controller is responsible for taking, storing, pruning and restoring etcd
+// snapshots of clusters.
+type Controller struct {
+	queue               workqueue.RateLimitingInterface
+	lister              platformv1lister.EtcdSnapshotLister
+	listerSynced        cache.InformerSynced
+	clusterLister       platformv1lister.ClusterLister
+	clusterListerSynced cache.InformerSynced
+	log                 log.Logger
+	platformClient      platformversionedclient.PlatformV1Interface
+	config              etcdsnapshotconfig.EtcdSnapshotControllerConfiguration
+	finalizerToken      platformv1.FinalizerName
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	etcdSnapshotInformer platformv1informer.EtcdSnapshotInformer,
+	clusterInformer platformv1informer.ClusterInformer,
+	configuration etcdsnapshotconfig.EtcdSnapshotControllerConfiguration,
+	finalizerToken platformv1.FinalizerName) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(5*time.Second, 10*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "etcdsnapshot"),
+		log:            log.WithName("EtcdSnapshotController"),
+		platformClient: platformclient,
+		config:         configuration,
+		finalizerToken: finalizerToken,
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("etcdsnapshot_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	etcdSnapshotInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addEtcdSnapshot,
+			UpdateFunc: c.updateEtcdSnapshot,
+		},
+		configuration.EtcdSnapshotSyncPeriod,
+	)
+	c.lister = etcdSnapshotInformer.Lister()
+	c.listerSynced = etcdSnapshotInformer.Informer().HasSynced
+	c.clusterLister = clusterInformer.Lister()
+	c.clusterListerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) addEtcdSnapshot(obj interface{}) {
+	snapshot := obj.(*platformv1.EtcdSnapshot)
+	c.log.Info("Adding etcd snapshot", "etcdsnapshot", snapshot.Name)
+	c.enqueue(snapshot)
+}
+
+func (c *Controller) updateEtcdSnapshot(old, obj interface{}) {
+	oldSnapshot := old.(*platformv1.EtcdSnapshot)
+	snapshot := obj.(*platformv1.EtcdSnapshot)
+	if oldSnapshot.ResourceVersion != snapshot.ResourceVersion &&
+		oldSnapshot.Status.Phase == snapshot.Status.Phase &&
+		oldSnapshot.DeletionTimestamp.Equal(snapshot.DeletionTimestamp) {
+		return
+	}
+	c.log.Info("Updating etcd snapshot", "etcdsnapshot", snapshot.Name)
+	c.enqueue(snapshot)
+}
+
+func (c *Controller) enqueue(obj *platformv1.EtcdSnapshot) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting etcd snapshot controller")
+	defer log.Info("Shutting down etcd snapshot controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.clusterListerSynced); !ok {
+		return fmt.Errorf("failed to wait for etcd snapshot caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	go wait.Until(c.schedule, c.config.ScheduleInterval, stopCh)
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of etcd snapshot objects.
+// Each etcd snapshot can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncEtcdSnapshot(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing etcd snapshot %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncEtcdSnapshot(key string) error {
+	ctx := c.log.WithValues("etcdsnapshot", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished syncing etcd snapshot", "processTime", time.Since(startTime).String())
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.lister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Info("Etcd snapshot has been deleted")
+			return nil
+		}
+		return err
+	}
+
+	ctx = log.FromContext(ctx).WithValues("cluster", snapshot.Spec.ClusterName).WithContext(ctx)
+
+	return c.reconcile(ctx, snapshot.DeepCopy())
+}
+
+func (c *Controller) reconcile(ctx context.Context, snapshot *platformv1.EtcdSnapshot) error {
+	if snapshot.DeletionTimestamp != nil && snapshot.Status.Phase != platformv1.EtcdSnapshotRestoring {
+		return c.finalize(ctx, snapshot)
+	}
+
+	switch snapshot.Status.Phase {
+	case platformv1.EtcdSnapshotPending, platformv1.EtcdSnapshotRunning:
+		return c.take(ctx, snapshot)
+	case platformv1.EtcdSnapshotRestoring:
+		return c.restore(ctx, snapshot)
+	case platformv1.EtcdSnapshotCompleted, platformv1.EtcdSnapshotFailed:
+		return nil
+	default:
+		log.FromContext(ctx).Info("unknown etcd snapshot phase", "status.phase", snapshot.Status.Phase)
+	}
+
+	return nil
+}
+
+// take streams a snapshot from the etcd of cluster and stores it in the target.
+func (c *Controller) take(ctx context.Context, snapshot *platformv1.EtcdSnapshot) error {
+	cluster, err := clusterprovider.GetV1ClusterByName(ctx, c.platformClient, snapshot.Spec.ClusterName, clusterprovider.AdminUsername)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return c.fail(ctx, snapshot, "ClusterNotFound", err)
+		}
+		return err
+	}
+
+	snapshot.Status.Phase = platformv1.EtcdSnapshotRunning
+	snapshot.Status.StartTime = metav1.Now()
+	snapshot, err = c.platformClient.EtcdSnapshots().UpdateStatus(ctx, snapshot, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	target, err := NewTarget(cluster, &snapshot.Spec.Target, c.config.RegistryStorageDir)
+	if err != nil {
+		return c.fail(ctx, snapshot, "InvalidTarget", err)
+	}
+
+	// the snapshot is spooled to a temporary file, it may be too large to
+	// hold in memory
+	f, err := ioutil.TempFile("", "etcd-snapshot-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+	snapshotCtx, cancel := context.WithTimeout(ctx, snapshotTimeout)
+	defer cancel()
+	revision, err := snapshotCluster(snapshotCtx, cluster, f)
+	if err != nil {
+		return c.fail(ctx, snapshot, "SnapshotFailed", err)
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	location, err := target.Put(ctx, snapshot.Name+".db", f)
+	if err != nil {
+		return c.fail(ctx, snapshot, "StoreFailed", err)
+	}
+
+	snapshot.Status.Phase = platformv1.EtcdSnapshotCompleted
+	snapshot.Status.Location = location
+	snapshot.Status.SizeInBytes = size
+	snapshot.Status.Revision = revision
+	snapshot.Status.CompletionTime = metav1.Now()
+	snapshot.Status.Reason = ""
+	snapshot.Status.Message = ""
+	_, err = c.platformClient.EtcdSnapshots().UpdateStatus(ctx, snapshot, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Etcd snapshot completed", "location", location, "revision", revision)
+
+	return nil
+}
+
+func (c *Controller) fail(ctx context.Context, snapshot *platformv1.EtcdSnapshot, reason string, err error) error {
+	log.FromContext(ctx).Error(err, "Etcd snapshot failed", "reason", reason)
+	snapshot.Status.Phase = platformv1.EtcdSnapshotFailed
+	snapshot.Status.CompletionTime = metav1.Now()
+	snapshot.Status.Reason = reason
+	snapshot.Status.Message = err.Error()
+	_, err = c.platformClient.EtcdSnapshots().UpdateStatus(ctx, snapshot, metav1.UpdateOptions{})
+	return err
+}
+
+// restore replaces the etcd data of cluster with the snapshot. The errors
+// before the data of masters is touched are retried, such as a failed
+// download of the snapshot or an unreachable master. Once the data is
+// touched the outcome is recorded on the snapshot and never retried
+// automatically, a failed restore needs a human to look at the masters.
+func (c *Controller) restore(ctx context.Context, snapshot *platformv1.EtcdSnapshot) error {
+	log.FromContext(ctx).Info("Restoring cluster from etcd snapshot")
+
+	touched, err := c.doRestore(ctx, snapshot)
+	if err != nil && !touched {
+		return fmt.Errorf("restore cluster from etcd snapshot error: %w", err)
+	}
+	snapshot.Status.Phase = platformv1.EtcdSnapshotCompleted
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Restore cluster from etcd snapshot failed")
+		snapshot.Status.Reason = "RestoreFailed"
+		snapshot.Status.Message = err.Error()
+	} else {
+		log.FromContext(ctx).Info("Cluster has been restored from etcd snapshot")
+		snapshot.Status.LastRestoreTime = metav1.Now()
+		snapshot.Status.Reason = ""
+		snapshot.Status.Message = ""
+	}
+	_, err = c.platformClient.EtcdSnapshots().UpdateStatus(ctx, snapshot, metav1.UpdateOptions{})
+	return err
+}
+
+// doRestore restores the snapshot to the masters of cluster and waits for
+// the cluster to serve, it reports whether the data of masters is touched.
+func (c *Controller) doRestore(ctx context.Context, snapshot *platformv1.EtcdSnapshot) (bool, error) {
+	cluster, err := clusterprovider.GetV1ClusterByName(ctx, c.platformClient, snapshot.Spec.ClusterName, clusterprovider.AdminUsername)
+	if err != nil {
+		return false, err
+	}
+	target, err := NewTarget(cluster, &snapshot.Spec.Target, c.config.RegistryStorageDir)
+	if err != nil {
+		return false, err
+	}
+	f, err := ioutil.TempFile("", "etcd-snapshot-")
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+	if err := target.Get(ctx, snapshot.Status.Location, f); err != nil {
+		return false, err
+	}
+
+	masters := make([]ssh.Interface, 0, len(cluster.Spec.Machines))
+	for _, machine := range cluster.Spec.Machines {
+		s, err := machine.SSH()
+		if err != nil {
+			return false, err
+		}
+		masters = append(masters, s)
+	}
+	err = etcd.Restore(masters, &etcd.RestoreOption{
+		Snapshot:         f,
+		ContainerRuntime: cluster.Spec.Features.ContainerRuntime,
+	})
+	if err != nil {
+		var restoreErr *etcd.RestoreError
+		return errors.As(err, &restoreErr), err
+	}
+
+	clientset, err := cluster.Clientset()
+	if err != nil {
+		return true, err
+	}
+	return true, wait.PollImmediate(10*time.Second, restoreTimeout, func() (bool, error) {
+		_, err := clientset.Discovery().ServerVersion()
+		return err == nil, nil
+	})
+}
+
+// finalize removes the snapshot from the target and then the finalizer.
+func (c *Controller) finalize(ctx context.Context, snapshot *platformv1.EtcdSnapshot) error {
+	if snapshot.Status.Location != "" {
+		cluster, err := clusterprovider.GetV1ClusterByName(ctx, c.platformClient, snapshot.Spec.ClusterName, clusterprovider.AdminUsername)
+		switch {
+		case apierrors.IsNotFound(err):
+			log.FromContext(ctx).Info("Cluster has been deleted, skip deleting etcd snapshot from target", "location", snapshot.Status.Location)
+		case err != nil:
+			return err
+		default:
+			target, err := NewTarget(cluster, &snapshot.Spec.Target, c.config.RegistryStorageDir)
+			if err != nil {
+				return err
+			}
+			if err := target.Delete(ctx, snapshot.Status.Location); err != nil {
+				return err
+			}
+		}
+	}
+
+	finalizerSet := sets.NewString()
+	for _, one := range snapshot.Spec.Finalizers {
+		if one != c.finalizerToken {
+			finalizerSet.Insert(string(one))
+		}
+	}
+	snapshot.Spec.Finalizers = make([]platformv1.FinalizerName, 0, finalizerSet.Len())
+	for _, value := range finalizerSet.List() {
+		snapshot.Spec.Finalizers = append(snapshot.Spec.Finalizers, platformv1.FinalizerName(value))
+	}
+	err := c.platformClient.RESTClient().Put().
+		Resource("etcdsnapshots").
+		Name(snapshot.Name).
+		SubResource("finalize").
+		Body(snapshot).
+		Do(ctx).
+		Error()
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	if len(snapshot.Spec.Finalizers) != 0 {
+		return nil
+	}
+	err = c.platformClient.EtcdSnapshots().Delete(ctx, snapshot.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// schedule creates the scheduled snapshots which are due and prunes the
+// scheduled snapshots exceeding the retention of every cluster.
+func (c *Controller) schedule() {
+	ctx := c.log.WithValues("schedule", time.Now().Unix()).WithContext(context.TODO())
+
+	clusters, err := c.clusterLister.List(labels.Everything())
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	snapshots, err := c.lister.List(labels.Everything())
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	snapshotsByCluster := make(map[string][]*platformv1.EtcdSnapshot)
+	for _, snapshot := range snapshots {
+		if snapshot.Spec.Type != platformv1.EtcdSnapshotScheduled {
+			continue
+		}
+		snapshotsByCluster[snapshot.Spec.ClusterName] = append(snapshotsByCluster[snapshot.Spec.ClusterName], snapshot)
+	}
+
+	for _, cluster := range clusters {
+		backup := cluster.Spec.Features.EtcdBackup
+		if backup == nil || cluster.Spec.Etcd == nil || cluster.Spec.Etcd.Local == nil {
+			continue
+		}
+		scheduled := snapshotsByCluster[cluster.Name]
+		if cluster.Status.Phase == platformv1.ClusterRunning && backup.IntervalInSecond > 0 &&
+			IsScheduleDue(scheduled, time.Duration(backup.IntervalInSecond)*time.Second, time.Now()) {
+			if err := c.createScheduled(ctx, cluster); err != nil {
+				log.FromContext(ctx).Error(err, "Create scheduled etcd snapshot failed", "cluster", cluster.Name)
+			}
+		}
+		for _, snapshot := range ExpiredSnapshots(scheduled, int(backup.MaxBackups)) {
+			log.FromContext(ctx).Info("Pruning expired etcd snapshot", "cluster", cluster.Name, "etcdsnapshot", snapshot.Name)
+			err := c.platformClient.EtcdSnapshots().Delete(ctx, snapshot.Name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				log.FromContext(ctx).Error(err, "Prune etcd snapshot failed", "etcdsnapshot", snapshot.Name)
+			}
+		}
+	}
+}
+
+func (c *Controller) createScheduled(ctx context.Context, cluster *platformv1.Cluster) error {
+	snapshot := &platformv1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-%s", cluster.Name, strconv.FormatInt(time.Now().Unix(), 10)),
+		},
+		Spec: platformv1.EtcdSnapshotSpec{
+			TenantID:    cluster.Spec.TenantID,
+			ClusterName: cluster.Name,
+			Type:        platformv1.EtcdSnapshotScheduled,
+			Target:      cluster.Spec.Features.EtcdBackup.Target,
+		},
+	}
+	_, err := c.platformClient.EtcdSnapshots().Create(ctx, snapshot, metav1.CreateOptions{})
+	return err
+}
+
+// IsScheduleDue returns whether a new scheduled snapshot should be taken.
+// No snapshot is scheduled while a previous one is still in progress.
+func IsScheduleDue(scheduled []*platformv1.EtcdSnapshot, interval time.Duration, now time.Time) bool {
+	var latest time.Time
+	for _, snapshot := range scheduled {
+		if snapshot.Status.Phase == platformv1.EtcdSnapshotPending || snapshot.Status.Phase == platformv1.EtcdSnapshotRunning {
+			return false
+		}
+		if snapshot.CreationTimestamp.Time.After(latest) {
+			latest = snapshot.CreationTimestamp.Time
+		}
+	}
+	return now.Sub(latest) >= interval
+}
+
+// ExpiredSnapshots returns the finished scheduled snapshots beyond the newest
+// maxBackups ones. A snapshot used by a restore is never expired.
+func ExpiredSnapshots(scheduled []*platformv1.EtcdSnapshot, maxBackups int) []*platformv1.EtcdSnapshot {
+	var finished []*platformv1.EtcdSnapshot
+	for _, snapshot := range scheduled {
+		if snapshot.DeletionTimestamp != nil {
+			continue
+		}
+		if snapshot.Status.Phase == platformv1.EtcdSnapshotCompleted || snapshot.Status.Phase == platformv1.EtcdSnapshotFailed {
+			finished = append(finished, snapshot)
+		}
+	}
+	if maxBackups <= 0 || len(finished) <= maxBackups {
+		return nil
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].CreationTimestamp.After(finished[j].CreationTimestamp.Time)
+	})
+	return finished[maxBackups:]
+}
+
+// snapshotCluster writes a snapshot of the local etcd of cluster into w.
+func snapshotCluster(ctx context.Context, cluster *typesv1.Cluster, w io.Writer) (int64, error) {
+	credential := cluster.ClusterCredential
+	if credential == nil || credential.ETCDCACert == nil || credential.ETCDAPIClientCert == nil || credential.ETCDAPIClientKey == nil {
+		return 0, fmt.Errorf("etcd client certificates of cluster %s are missing", cluster.Name)
+	}
+	cert, err := tls.X509KeyPair(credential.ETCDAPIClientCert, credential.ETCDAPIClientKey)
+	if err != nil {
+		return 0, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(credential.ETCDCACert) {
+		return 0, fmt.Errorf("invalid etcd ca certificate of cluster %s", cluster.Name)
+	}
+
+	endpoints := make([]string, 0, len(cluster.Spec.Machines))
+	for _, machine := range cluster.Spec.Machines {
+		endpoints = append(endpoints, "https://"+net.JoinHostPort(machine.IP, strconv.Itoa(etcdClientPort)))
+	}
+	db, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: etcdDialTimeout,
+		TLS: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      pool,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	return utiletcd.Snapshot(ctx, db, w)
+}
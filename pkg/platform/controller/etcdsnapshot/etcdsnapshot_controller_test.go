@@ -0,0 +1,182 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcdsnapshot
+
+import (
+	"context"
+	"errors"
+	"path"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func newEtcdSnapshotForTest(name string, created time.Time, phase platformv1.EtcdSnapshotPhase) *platformv1.EtcdSnapshot {
+	return &platformv1.EtcdSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: platformv1.EtcdSnapshotSpec{
+			ClusterName: "global",
+			Type:        platformv1.EtcdSnapshotScheduled,
+		},
+		Status: platformv1.EtcdSnapshotStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func TestIsScheduleDue(t *testing.T) {
+	now := time.Now()
+	interval := time.Hour
+	tests := []struct {
+		name      string
+		scheduled []*platformv1.EtcdSnapshot
+		want      bool
+	}{
+		{
+			name: "no snapshot",
+			want: true,
+		},
+		{
+			name: "latest snapshot is recent",
+			scheduled: []*platformv1.EtcdSnapshot{
+				newEtcdSnapshotForTest("a", now.Add(-2*time.Hour), platformv1.EtcdSnapshotCompleted),
+				newEtcdSnapshotForTest("b", now.Add(-time.Minute), platformv1.EtcdSnapshotCompleted),
+			},
+			want: false,
+		},
+		{
+			name: "latest snapshot is old",
+			scheduled: []*platformv1.EtcdSnapshot{
+				newEtcdSnapshotForTest("a", now.Add(-2*time.Hour), platformv1.EtcdSnapshotFailed),
+			},
+			want: true,
+		},
+		{
+			name: "snapshot in progress",
+			scheduled: []*platformv1.EtcdSnapshot{
+				newEtcdSnapshotForTest("a", now.Add(-2*time.Hour), platformv1.EtcdSnapshotRunning),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsScheduleDue(tt.scheduled, interval, now); got != tt.want {
+				t.Errorf("IsScheduleDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpiredSnapshots(t *testing.T) {
+	now := time.Now()
+	deleting := newEtcdSnapshotForTest("deleting", now.Add(-10*time.Hour), platformv1.EtcdSnapshotCompleted)
+	deleting.DeletionTimestamp = &metav1.Time{Time: now}
+	scheduled := []*platformv1.EtcdSnapshot{
+		newEtcdSnapshotForTest("oldest", now.Add(-4*time.Hour), platformv1.EtcdSnapshotCompleted),
+		newEtcdSnapshotForTest("newest", now.Add(-1*time.Hour), platformv1.EtcdSnapshotCompleted),
+		newEtcdSnapshotForTest("restoring", now.Add(-5*time.Hour), platformv1.EtcdSnapshotRestoring),
+		newEtcdSnapshotForTest("failed", now.Add(-3*time.Hour), platformv1.EtcdSnapshotFailed),
+		newEtcdSnapshotForTest("middle", now.Add(-2*time.Hour), platformv1.EtcdSnapshotCompleted),
+		deleting,
+	}
+
+	tests := []struct {
+		name       string
+		maxBackups int
+		want       []string
+	}{
+		{
+			name:       "retain two",
+			maxBackups: 2,
+			want:       []string{"failed", "oldest"},
+		},
+		{
+			name:       "retain more than existing",
+			maxBackups: 10,
+			want:       nil,
+		},
+		{
+			name:       "no retention",
+			maxBackups: 0,
+			want:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpiredSnapshots(scheduled, tt.maxBackups)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ExpiredSnapshots() returns %d snapshots, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].Name != tt.want[i] {
+					t.Errorf("ExpiredSnapshots()[%d] = %s, want %s", i, got[i].Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		wantErr  bool
+	}{
+		{location: "/var/lib/etcd-snapshots/es-1.db"},
+		{location: "/var/lib/etcd-snapshots/../../../etc/shadow", wantErr: true},
+		{location: "/var/lib/etcd-snapshots", wantErr: true},
+		{location: "/var/lib/etcd-snapshots-other/es-1.db", wantErr: true},
+		{location: "/tmp/x; rm -rf /", wantErr: true},
+	}
+	for _, tt := range tests {
+		err := checkLocation("/var/lib/etcd-snapshots", path.Clean(tt.location))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("checkLocation(%q) error = %v, wantErr %v", tt.location, err, tt.wantErr)
+		}
+	}
+	if got := shellQuote("/a/it's.db"); got != `'/a/it'\''s.db'` {
+		t.Errorf("shellQuote() = %s", got)
+	}
+}
+
+func TestRestoreRetriesTransientError(t *testing.T) {
+	snapshot := newEtcdSnapshotForTest("restore", time.Now(), platformv1.EtcdSnapshotRestoring)
+	client := fake.NewSimpleClientset(snapshot)
+	client.PrependReactor("get", "clusters", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	c := &Controller{platformClient: client.PlatformV1()}
+
+	if err := c.restore(context.Background(), snapshot.DeepCopy()); err == nil {
+		t.Fatal("restore() expected the error to be retried")
+	}
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" {
+			t.Errorf("restore() records the outcome of an untouched restore: %v", action)
+		}
+	}
+}
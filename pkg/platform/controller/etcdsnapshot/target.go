@@ -0,0 +1,283 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcdsnapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+const (
+	// defaultLocalDir is the directory on master which stores the snapshots
+	// when the local target does not specify one.
+	defaultLocalDir = "/var/lib/etcd-snapshots"
+	defaultS3Region = "us-east-1"
+)
+
+// Target stores the content of etcd snapshots.
+type Target interface {
+	// Put stores the snapshot read from r with the given name and returns
+	// its location.
+	Put(ctx context.Context, name string, r io.ReadSeeker) (string, error)
+	// Get writes the content of the snapshot at the location into w.
+	Get(ctx context.Context, location string, w io.Writer) error
+	// Delete removes the snapshot at the location, it is not an error if the
+	// snapshot does not exist.
+	Delete(ctx context.Context, location string) error
+}
+
+// NewTarget returns the Target described by target for the cluster.
+// registryDir is the root directory of the tke-registry filesystem storage.
+func NewTarget(cluster *typesv1.Cluster, target *platformv1.EtcdSnapshotTarget, registryDir string) (Target, error) {
+	switch {
+	case target.Local != nil:
+		return newLocalTarget(cluster, target.Local)
+	case target.S3 != nil:
+		return newS3Target(cluster, target.S3)
+	case target.Registry != nil:
+		if registryDir == "" {
+			return nil, fmt.Errorf("registry storage directory is not configured")
+		}
+		return &fileTarget{dir: filepath.Join(registryDir, filepath.Clean("/"+target.Registry.Prefix), cluster.Name)}, nil
+	default:
+		return nil, fmt.Errorf("no etcd snapshot target is specified")
+	}
+}
+
+// localTarget stores the snapshots in a directory of a master via ssh.
+type localTarget struct {
+	machine platformv1.ClusterMachine
+	dir     string
+}
+
+func newLocalTarget(cluster *typesv1.Cluster, target *platformv1.LocalSnapshotTarget) (*localTarget, error) {
+	if len(cluster.Spec.Machines) == 0 {
+		return nil, fmt.Errorf("cluster %s has no master", cluster.Name)
+	}
+	t := &localTarget{machine: cluster.Spec.Machines[0], dir: target.Dir}
+	if target.IP != "" {
+		found := false
+		for _, machine := range cluster.Spec.Machines {
+			if machine.IP == target.IP {
+				t.machine = machine
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%s is not a master of cluster %s", target.IP, cluster.Name)
+		}
+	}
+	if t.dir == "" {
+		t.dir = defaultLocalDir
+	}
+
+	return t, nil
+}
+
+func (t *localTarget) Put(ctx context.Context, name string, r io.ReadSeeker) (string, error) {
+	s, err := t.machine.SSH()
+	if err != nil {
+		return "", err
+	}
+	location := path.Join(t.dir, name)
+	if err := s.WriteFile(r, location); err != nil {
+		return "", err
+	}
+	return location, nil
+}
+
+func (t *localTarget) Get(ctx context.Context, location string, w io.Writer) error {
+	if err := checkLocation(path.Clean(t.dir), path.Clean(location)); err != nil {
+		return err
+	}
+	s, err := t.machine.SSH()
+	if err != nil {
+		return err
+	}
+	return s.StreamFile(shellQuote(path.Clean(location)), w)
+}
+
+func (t *localTarget) Delete(ctx context.Context, location string) error {
+	if err := checkLocation(path.Clean(t.dir), path.Clean(location)); err != nil {
+		return err
+	}
+	s, err := t.machine.SSH()
+	if err != nil {
+		return err
+	}
+	_, err = s.CombinedOutput(fmt.Sprintf("rm -f %s", shellQuote(path.Clean(location))))
+	return err
+}
+
+// fileTarget stores the snapshots in a directory mounted into the controller,
+// which is the filesystem storage shared with tke-registry.
+type fileTarget struct {
+	dir string
+}
+
+func (t *fileTarget) Put(ctx context.Context, name string, r io.ReadSeeker) (string, error) {
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return "", err
+	}
+	location := filepath.Join(t.dir, name)
+	f, err := os.OpenFile(location, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return location, nil
+}
+
+func (t *fileTarget) Get(ctx context.Context, location string, w io.Writer) error {
+	if err := checkLocation(filepath.Clean(t.dir), filepath.Clean(location)); err != nil {
+		return err
+	}
+	f, err := os.Open(filepath.Clean(location))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func (t *fileTarget) Delete(ctx context.Context, location string) error {
+	if err := checkLocation(filepath.Clean(t.dir), filepath.Clean(location)); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Clean(location))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// s3Target stores the snapshots in an S3 compatible object storage.
+type s3Target struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func newS3Target(cluster *typesv1.Cluster, target *platformv1.S3SnapshotTarget) (*s3Target, error) {
+	if cluster.ClusterCredential == nil || len(cluster.ClusterCredential.S3SecretAccessKeys[target.AccessKeyID]) == 0 {
+		return nil, fmt.Errorf("secret access key of %s is not found in the credential of cluster %s", target.AccessKeyID, cluster.Name)
+	}
+	region := target.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(target.AccessKeyID, string(cluster.ClusterCredential.S3SecretAccessKeys[target.AccessKeyID]), ""),
+		Endpoint:         aws.String(target.Endpoint),
+		Region:           aws.String(region),
+		DisableSSL:       aws.Bool(target.Insecure),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Target{
+		client: s3.New(sess),
+		bucket: target.Bucket,
+		prefix: target.Prefix,
+	}, nil
+}
+
+func (t *s3Target) Put(ctx context.Context, name string, r io.ReadSeeker) (string, error) {
+	key := path.Join(t.prefix, name)
+	_, err := t.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(key),
+		Body:   r,
+	})
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (t *s3Target) Get(ctx context.Context, location string, w io.Writer) error {
+	if err := t.checkKey(location); err != nil {
+		return err
+	}
+	out, err := t.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(location),
+	})
+	if err != nil {
+		return err
+	}
+	defer out.Body.Close()
+	_, err = io.Copy(w, out.Body)
+	return err
+}
+
+func (t *s3Target) Delete(ctx context.Context, location string) error {
+	if err := t.checkKey(location); err != nil {
+		return err
+	}
+	_, err := t.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(location),
+	})
+	return err
+}
+
+func (t *s3Target) checkKey(key string) error {
+	if path.Clean(t.prefix) == "." {
+		return nil
+	}
+	return checkLocation(path.Clean(t.prefix), path.Clean(key))
+}
+
+// checkLocation returns an error unless the cleaned location is a file under
+// the cleaned dir, the location comes from the status of snapshot which must
+// not be trusted to name any other file.
+func checkLocation(dir, location string) error {
+	if !strings.HasPrefix(location, strings.TrimSuffix(dir, "/")+"/") {
+		return fmt.Errorf("location %s is outside of %s", location, dir)
+	}
+	return nil
+}
+
+// shellQuote quotes s as a single word of shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
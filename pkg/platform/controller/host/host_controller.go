@@ -0,0 +1,217 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package host
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	hostconfig "tkestack.io/tke/pkg/platform/controller/host/config"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+// Controller is responsible for probing the hosts of the inventory and
+// recording their reachability and system information in status.
+type Controller struct {
+	queue          workqueue.RateLimitingInterface
+	lister         platformv1lister.HostLister
+	listerSynced   cache.InformerSynced
+	log            log.Logger
+	platformClient platformversionedclient.PlatformV1Interface
+	config         hostconfig.HostControllerConfiguration
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	hostInformer platformv1informer.HostInformer,
+	configuration hostconfig.HostControllerConfiguration) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(5*time.Second, 5*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "host"),
+		log:            log.WithName("HostController"),
+		platformClient: platformclient,
+		config:         configuration,
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("host_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	// The resync of the informer triggers the periodic probe of every host.
+	hostInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addHost,
+			UpdateFunc: c.updateHost,
+		},
+		configuration.HostProbePeriod,
+	)
+	c.lister = hostInformer.Lister()
+	c.listerSynced = hostInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) addHost(obj interface{}) {
+	host := obj.(*platformv1.Host)
+	c.log.Info("Adding host", "host", host.Name)
+	c.enqueue(host.Name)
+}
+
+func (c *Controller) updateHost(old, obj interface{}) {
+	oldHost := old.(*platformv1.Host)
+	host := obj.(*platformv1.Host)
+	if oldHost.ResourceVersion != host.ResourceVersion &&
+		apiequality.Semantic.DeepEqual(oldHost.Spec, host.Spec) {
+		return
+	}
+	c.enqueue(host.Name)
+}
+
+func (c *Controller) enqueue(name string) {
+	c.queue.Add(name)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting host controller")
+	defer log.Info("Shutting down host controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for host caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of host objects.
+// Each host can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncHost(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing host %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncHost(key string) error {
+	ctx := c.log.WithValues("host", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished syncing host", "processTime", time.Since(startTime).String())
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	host, err := c.lister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Info("Host has been deleted")
+			return nil
+		}
+		return err
+	}
+
+	host = host.DeepCopy()
+	host.Status = probe(host)
+	_, err = c.platformClient.Hosts().UpdateStatus(ctx, host, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// probe logins the host with its credential and returns the observed status.
+func probe(host *platformv1.Host) platformv1.HostStatus {
+	status := host.Status.DeepCopy()
+	status.LastProbeTime = metav1.Now()
+	status.Reason = ""
+	status.Message = ""
+
+	s, err := host.Spec.SSH()
+	if err != nil {
+		status.Phase = platformv1.HostUnreachable
+		status.Reason = "InvalidCredential"
+		status.Message = err.Error()
+		return *status
+	}
+	if err := s.Ping(); err != nil {
+		status.Phase = platformv1.HostUnreachable
+		status.Reason = "SSHFailed"
+		status.Message = err.Error()
+		return *status
+	}
+
+	status.Phase = platformv1.HostReachable
+	info, capacity, err := SystemInfo(s)
+	if err != nil {
+		status.Reason = "ProbeFailed"
+		status.Message = err.Error()
+		return *status
+	}
+	status.MachineInfo = info
+	status.Capacity = capacity
+
+	return *status
+}
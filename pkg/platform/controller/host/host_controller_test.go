@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package host
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/ssh"
+	"tkestack.io/tke/pkg/util/ssh/sshtest"
+)
+
+// newSSH answers CombinedOutput with canned outputs keyed by command.
+func newSSH(outputs map[string]string) *sshtest.Fake {
+	return &sshtest.Fake{
+		CombinedOutputFunc: func(cmd string) ([]byte, error) {
+			output, ok := outputs[cmd]
+			if !ok {
+				return nil, errors.New("command not found")
+			}
+			return []byte(output), nil
+		},
+	}
+}
+
+func TestSystemInfo(t *testing.T) {
+	s := newSSH(map[string]string{
+		"uname -r":                            "5.4.119-19-0009\n",
+		"uname -m":                            "aarch64\n",
+		"cat /etc/os-release":                 "NAME=\"TencentOS Server\"\nPRETTY_NAME=\"TencentOS Server 3.1\"\nID=\"tencentos\"\n",
+		"cat /etc/machine-id":                 "0123456789abcdef\n",
+		"cat /proc/sys/kernel/random/boot_id": "boot-id\n",
+		"nproc --all":                         "8\n",
+		`grep 'MemTotal:' /proc/meminfo | grep -oP '\d+'`: "16384\n",
+	})
+
+	info, capacity, err := SystemInfo(s)
+	if err != nil {
+		t.Fatalf("SystemInfo() error = %v", err)
+	}
+	want := platformv1.MachineSystemInfo{
+		MachineID:       "0123456789abcdef",
+		BootID:          "boot-id",
+		KernelVersion:   "5.4.119-19-0009",
+		OSImage:         "TencentOS Server 3.1",
+		OperatingSystem: "linux",
+		Architecture:    "arm64",
+	}
+	if info != want {
+		t.Errorf("SystemInfo() info = %+v, want %+v", info, want)
+	}
+	if cpu := capacity[corev1.ResourceCPU.String()]; cpu.Value() != 8 {
+		t.Errorf("SystemInfo() cpu = %s, want 8", cpu.String())
+	}
+	if memory := capacity[corev1.ResourceMemory.String()]; memory.Value() != 16384*1024 {
+		t.Errorf("SystemInfo() memory = %s, want 16Mi", memory.String())
+	}
+}
+
+func TestSystemInfoError(t *testing.T) {
+	if _, _, err := SystemInfo(newSSH(nil)); err == nil {
+		t.Errorf("SystemInfo() expected error for unresponsive host")
+	}
+}
+
+func TestProbeInvalidCredential(t *testing.T) {
+	host := &platformv1.Host{
+		Spec: platformv1.HostSpec{
+			IP:             "10.0.0.1",
+			Port:           22,
+			CredentialName: "missing",
+		},
+	}
+	ssh.SetCredentialResolver(func(name string) (*ssh.Credential, error) {
+		return nil, errors.New("not found")
+	})
+	defer ssh.SetCredentialResolver(nil)
+
+	status := probe(host)
+	if status.Phase != platformv1.HostUnreachable || status.Reason != "InvalidCredential" {
+		t.Errorf("probe() = %s/%s, want %s/InvalidCredential", status.Phase, status.Reason, platformv1.HostUnreachable)
+	}
+	if status.LastProbeTime.IsZero() {
+		t.Errorf("probe() did not record the probe time")
+	}
+}
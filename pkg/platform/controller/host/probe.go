@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package host
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+// SystemInfo collects the system information and the cpu and memory
+// capacity of the host behind s.
+func SystemInfo(s ssh.Interface) (platformv1.MachineSystemInfo, platformv1.ResourceList, error) {
+	info := platformv1.MachineSystemInfo{
+		OperatingSystem: "linux",
+	}
+
+	kernel, err := s.CombinedOutput("uname -r")
+	if err != nil {
+		return info, nil, err
+	}
+	info.KernelVersion = strings.TrimSpace(string(kernel))
+	arch, err := s.CombinedOutput("uname -m")
+	if err != nil {
+		return info, nil, err
+	}
+	info.Architecture = normalizeArch(strings.TrimSpace(string(arch)))
+	osRelease, err := s.CombinedOutput("cat /etc/os-release")
+	if err != nil {
+		return info, nil, err
+	}
+	info.OSImage = osImage(string(osRelease))
+
+	// These ids are informative only, hosts may not expose all of them.
+	info.MachineID = readID(s, "/etc/machine-id")
+	info.BootID = readID(s, "/proc/sys/kernel/random/boot_id")
+	info.SystemUUID = readID(s, "/sys/class/dmi/id/product_uuid")
+
+	cpu, err := ssh.NumCPU(s)
+	if err != nil {
+		return info, nil, fmt.Errorf("get cpu number error: %w", err)
+	}
+	memory, err := ssh.MemoryCapacity(s)
+	if err != nil {
+		return info, nil, fmt.Errorf("get memory capacity error: %w", err)
+	}
+	capacity := platformv1.ResourceList{
+		corev1.ResourceCPU.String():    *resource.NewQuantity(int64(cpu), resource.DecimalSI),
+		corev1.ResourceMemory.String(): *resource.NewQuantity(int64(memory), resource.BinarySI),
+	}
+
+	return info, capacity, nil
+}
+
+func readID(s ssh.Interface, file string) string {
+	output, err := s.CombinedOutput("cat " + file)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// osImage returns PRETTY_NAME of the os-release content.
+func osImage(osRelease string) string {
+	for _, line := range strings.Split(osRelease, "\n") {
+		if strings.HasPrefix(line, "PRETTY_NAME=") {
+			return strings.Trim(strings.TrimPrefix(line, "PRETTY_NAME="), `"`)
+		}
+	}
+	return ""
+}
+
+// normalizeArch maps the machine hardware name to the architecture name
+// used by kubernetes.
+func normalizeArch(machine string) string {
+	switch machine {
+	case "x86_64":
+		return "amd64"
+	case "aarch64":
+		return "arm64"
+	default:
+		return machine
+	}
+}
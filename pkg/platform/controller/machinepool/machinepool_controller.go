@@ -0,0 +1,519 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/time/rate"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	machinepoolconfig "tkestack.io/tke/pkg/platform/controller/machinepool/config"
+	machineprovider "tkestack.io/tke/pkg/platform/provider/machine"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+// Controller is responsible for creating and deleting the machines of
+// machine pools to converge to the desired number of replicas.
+type Controller struct {
+	queue               workqueue.RateLimitingInterface
+	lister              platformv1lister.MachinePoolLister
+	listerSynced        cache.InformerSynced
+	machineListerSynced cache.InformerSynced
+	log                 log.Logger
+	platformClient      platformversionedclient.PlatformV1Interface
+	config              machinepoolconfig.MachinePoolControllerConfiguration
+	finalizerToken      platformv1.FinalizerName
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	machinePoolInformer platformv1informer.MachinePoolInformer,
+	machineInformer platformv1informer.MachineInformer,
+	configuration machinepoolconfig.MachinePoolControllerConfiguration,
+	finalizerToken platformv1.FinalizerName) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(5*time.Second, 5*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "machinepool"),
+		log:            log.WithName("MachinePoolController"),
+		platformClient: platformclient,
+		config:         configuration,
+		finalizerToken: finalizerToken,
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("machinepool_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	machinePoolInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addMachinePool,
+			UpdateFunc: c.updateMachinePool,
+		},
+		configuration.MachinePoolSyncPeriod,
+	)
+	c.lister = machinePoolInformer.Lister()
+	c.listerSynced = machinePoolInformer.Informer().HasSynced
+
+	machineInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueMachinePoolOfMachine,
+			UpdateFunc: c.updateMachine,
+			DeleteFunc: c.enqueueMachinePoolOfMachine,
+		},
+	)
+	c.machineListerSynced = machineInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) addMachinePool(obj interface{}) {
+	pool := obj.(*platformv1.MachinePool)
+	c.log.Info("Adding machine pool", "machinepool", pool.Name)
+	c.enqueue(pool.Name)
+}
+
+func (c *Controller) updateMachinePool(old, obj interface{}) {
+	oldPool := old.(*platformv1.MachinePool)
+	pool := obj.(*platformv1.MachinePool)
+	if oldPool.ResourceVersion != pool.ResourceVersion &&
+		apiequality.Semantic.DeepEqual(oldPool.Spec, pool.Spec) &&
+		oldPool.DeletionTimestamp.Equal(pool.DeletionTimestamp) {
+		return
+	}
+	c.log.Info("Updating machine pool", "machinepool", pool.Name)
+	c.enqueue(pool.Name)
+}
+
+func (c *Controller) updateMachine(old, obj interface{}) {
+	oldMachine := old.(*platformv1.Machine)
+	machine := obj.(*platformv1.Machine)
+	if oldMachine.Status.Phase == machine.Status.Phase &&
+		oldMachine.DeletionTimestamp.Equal(machine.DeletionTimestamp) {
+		return
+	}
+	c.enqueueMachinePoolOfMachine(machine)
+}
+
+func (c *Controller) enqueueMachinePoolOfMachine(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	machine, ok := obj.(*platformv1.Machine)
+	if !ok {
+		return
+	}
+	if name, ok := machine.Labels[platformv1.MachinePoolLabel]; ok && name != "" {
+		c.enqueue(name)
+	}
+}
+
+func (c *Controller) enqueue(name string) {
+	c.queue.Add(name)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting machine pool controller")
+	defer log.Info("Shutting down machine pool controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.machineListerSynced); !ok {
+		return fmt.Errorf("failed to wait for machine pool caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of machine pool objects.
+// Each machine pool can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncMachinePool(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing machine pool %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncMachinePool(key string) error {
+	ctx := c.log.WithValues("machinepool", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished syncing machine pool", "processTime", time.Since(startTime).String())
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	pool, err := c.lister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Info("Machine pool has been deleted")
+			return nil
+		}
+		return err
+	}
+
+	ctx = log.FromContext(ctx).WithValues("cluster", pool.Spec.ClusterName).WithContext(ctx)
+
+	if pool.DeletionTimestamp != nil {
+		return c.terminate(ctx, pool.DeepCopy())
+	}
+	return c.reconcile(ctx, pool.DeepCopy())
+}
+
+// reconcile creates or deletes the machines of pool to converge to the
+// desired number of replicas.
+func (c *Controller) reconcile(ctx context.Context, pool *platformv1.MachinePool) error {
+	// Machines are listed from the apiserver rather than the informer cache,
+	// so that a machine just created is never created twice.
+	clusterMachines, err := c.listClusterMachines(ctx, pool.Spec.ClusterName)
+	if err != nil {
+		return err
+	}
+	machines := activeMachines(pool, clusterMachines)
+	usedIPs := sets.NewString()
+	for _, machine := range clusterMachines {
+		usedIPs.Insert(machine.Spec.IP)
+	}
+	inventory, usedHosts, err := c.listHosts(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	status := pool.Status.DeepCopy()
+	status.Reason = ""
+	status.Message = ""
+
+	diff := int(pool.Spec.Replicas) - len(machines)
+	switch {
+	case diff > 0:
+		cluster, err := c.platformClient.Clusters().Get(ctx, pool.Spec.ClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if cluster.Status.Phase != platformv1.ClusterRunning {
+			status.Reason = "ClusterNotRunning"
+			status.Message = fmt.Sprintf("waiting for cluster in phase %s to be running", cluster.Status.Phase)
+			break
+		}
+		for _, machine := range cluster.Spec.Machines {
+			usedIPs.Insert(machine.IP)
+		}
+		hosts := AvailableHosts(pool, inventory, usedHosts, usedIPs)
+		// Machines without hosts of inventory are created on the hosts
+		// provisioned by provider.
+		if provider, err := machineprovider.GetProvider(pool.Spec.Type); err == nil && machineprovider.ProvisionsHosts(provider) {
+			for len(hosts) < diff {
+				hosts = append(hosts, platformv1.Host{})
+			}
+		}
+		if len(hosts) < diff {
+			status.Reason = "InsufficientHosts"
+			status.Message = fmt.Sprintf("%d more machines are desired but only %d hosts are available", diff, len(hosts))
+			diff = len(hosts)
+		}
+		for _, host := range hosts[:diff] {
+			machine, err := c.platformClient.Machines().Create(ctx, newMachine(pool, host), metav1.CreateOptions{})
+			if err != nil {
+				return err
+			}
+			log.FromContext(ctx).Info("Created machine", "machine", machine.Name, "ip", machine.Spec.IP)
+			machines = append(machines, *machine)
+			usedHosts.Insert(host.Name)
+			usedIPs.Insert(host.Spec.IP)
+		}
+	case diff < 0:
+		for _, machine := range MachinesToDelete(machines, -diff) {
+			err := c.platformClient.Machines().Delete(ctx, machine.Name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			log.FromContext(ctx).Info("Deleted machine", "machine", machine.Name, "ip", machine.Spec.IP)
+		}
+		machines = activeMachines(pool, machines)
+	}
+
+	status.Replicas = int32(len(machines))
+	status.ReadyReplicas = 0
+	for _, machine := range machines {
+		if machine.Status.Phase == platformv1.MachineRunning {
+			status.ReadyReplicas++
+		}
+	}
+	status.AvailableHosts = int32(len(AvailableHosts(pool, inventory, usedHosts, usedIPs)))
+	status.Phase = platformv1.MachinePoolScaling
+	if status.Replicas == pool.Spec.Replicas && status.ReadyReplicas == pool.Spec.Replicas {
+		status.Phase = platformv1.MachinePoolRunning
+	}
+
+	if apiequality.Semantic.DeepEqual(&pool.Status, status) {
+		return nil
+	}
+	pool.Status = *status
+	_, err = c.platformClient.MachinePools().UpdateStatus(ctx, pool, metav1.UpdateOptions{})
+	return err
+}
+
+// terminate deletes all machines of pool and then removes the finalizer.
+func (c *Controller) terminate(ctx context.Context, pool *platformv1.MachinePool) error {
+	clusterMachines, err := c.listClusterMachines(ctx, pool.Spec.ClusterName)
+	if err != nil {
+		return err
+	}
+	var remaining int
+	for _, machine := range clusterMachines {
+		if machine.Labels[platformv1.MachinePoolLabel] != pool.Name {
+			continue
+		}
+		remaining++
+		if machine.DeletionTimestamp != nil {
+			continue
+		}
+		err := c.platformClient.Machines().Delete(ctx, machine.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		log.FromContext(ctx).Info("Deleted machine", "machine", machine.Name, "ip", machine.Spec.IP)
+	}
+	if remaining != 0 {
+		// the pool is enqueued again when its machines are gone.
+		return nil
+	}
+
+	finalizerSet := sets.NewString()
+	for _, one := range pool.Spec.Finalizers {
+		if one != c.finalizerToken {
+			finalizerSet.Insert(string(one))
+		}
+	}
+	pool.Spec.Finalizers = make([]platformv1.FinalizerName, 0, finalizerSet.Len())
+	for _, value := range finalizerSet.List() {
+		pool.Spec.Finalizers = append(pool.Spec.Finalizers, platformv1.FinalizerName(value))
+	}
+	err = c.platformClient.RESTClient().Put().
+		Resource("machinepools").
+		Name(pool.Name).
+		SubResource("finalize").
+		Body(pool).
+		Do(ctx).
+		Error()
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	if len(pool.Spec.Finalizers) != 0 {
+		return nil
+	}
+	err = c.platformClient.MachinePools().Delete(ctx, pool.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *Controller) listClusterMachines(ctx context.Context, clusterName string) ([]platformv1.Machine, error) {
+	fieldSelector := fields.OneTermEqualSelector("spec.clusterName", clusterName).String()
+	machineList, err := c.platformClient.Machines().List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return nil, err
+	}
+	return machineList.Items, nil
+}
+
+// listHosts returns the hosts of the inventory selected by pool and the names
+// of the hosts used by the machines of any pool.
+func (c *Controller) listHosts(ctx context.Context, pool *platformv1.MachinePool) ([]platformv1.Host, sets.String, error) {
+	if pool.Spec.HostSelector == nil {
+		return nil, sets.NewString(), nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pool.Spec.HostSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+	hostList, err := c.platformClient.Hosts().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+	machineList, err := c.platformClient.Machines().List(ctx, metav1.ListOptions{LabelSelector: platformv1.MachineHostLabel})
+	if err != nil {
+		return nil, nil, err
+	}
+	usedHosts := sets.NewString()
+	for _, machine := range machineList.Items {
+		usedHosts.Insert(machine.Labels[platformv1.MachineHostLabel])
+	}
+	return hostList.Items, usedHosts, nil
+}
+
+// activeMachines returns the machines of pool which are not being deleted.
+func activeMachines(pool *platformv1.MachinePool, machines []platformv1.Machine) []platformv1.Machine {
+	var result []platformv1.Machine
+	for _, machine := range machines {
+		if machine.Labels[platformv1.MachinePoolLabel] != pool.Name || machine.DeletionTimestamp != nil {
+			continue
+		}
+		result = append(result, machine)
+	}
+	return result
+}
+
+// AvailableHosts returns the reachable hosts of the inventory in the tenant
+// of pool which are not used by any machine, in the order of their names.
+func AvailableHosts(pool *platformv1.MachinePool, hosts []platformv1.Host, usedHosts sets.String, usedIPs sets.String) []platformv1.Host {
+	var result []platformv1.Host
+	for _, host := range hosts {
+		if host.Spec.TenantID != pool.Spec.TenantID ||
+			host.Status.Phase != platformv1.HostReachable ||
+			usedHosts.Has(host.Name) ||
+			usedIPs.Has(host.Spec.IP) {
+			continue
+		}
+		result = append(result, host)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// MachinesToDelete returns count machines to delete when scaling down.
+// Machines marked by MachineDeleteAnnotation go first, then failed and
+// initializing machines, then the newest ones.
+func MachinesToDelete(machines []platformv1.Machine, count int) []platformv1.Machine {
+	if count <= 0 {
+		return nil
+	}
+	candidates := make([]platformv1.Machine, len(machines))
+	copy(candidates, machines)
+	rank := func(machine *platformv1.Machine) int {
+		if _, ok := machine.Annotations[platformv1.MachineDeleteAnnotation]; ok {
+			return 0
+		}
+		switch machine.Status.Phase {
+		case platformv1.MachineFailed:
+			return 1
+		case platformv1.MachineInitializing:
+			return 2
+		default:
+			return 3
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ri, rj := rank(&candidates[i]), rank(&candidates[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if !candidates[i].CreationTimestamp.Equal(&candidates[j].CreationTimestamp) {
+			return candidates[j].CreationTimestamp.Before(&candidates[i].CreationTimestamp)
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+	return candidates[:count]
+}
+
+// newMachine returns a machine of pool on host, the labels of the host are
+// added to the ones of the template. The machine logins the host by the ssh
+// credential of the host.
+func newMachine(pool *platformv1.MachinePool, host platformv1.Host) *platformv1.Machine {
+	labels := make(map[string]string)
+	for k, v := range pool.Spec.Template.Labels {
+		labels[k] = v
+	}
+	for k, v := range host.Spec.Labels {
+		labels[k] = v
+	}
+	objectLabels := map[string]string{
+		platformv1.MachinePoolLabel: pool.Name,
+	}
+	if host.Name != "" {
+		objectLabels[platformv1.MachineHostLabel] = host.Name
+	}
+
+	return &platformv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: pool.Name + "-",
+			Labels:       objectLabels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(pool, platformv1.SchemeGroupVersion.WithKind("MachinePool")),
+			},
+		},
+		Spec: platformv1.MachineSpec{
+			TenantID:               pool.Spec.TenantID,
+			ClusterName:            pool.Spec.ClusterName,
+			Type:                   pool.Spec.Type,
+			IP:                     host.Spec.IP,
+			Port:                   host.Spec.Port,
+			CredentialName:         host.Spec.CredentialName,
+			Labels:                 labels,
+			Taints:                 pool.Spec.Template.Taints,
+			KubeletExtraArgs:       pool.Spec.Template.KubeletExtraArgs,
+			DockerExtraArgs:        pool.Spec.Template.DockerExtraArgs,
+			ContainerRuntimeConfig: pool.Spec.Template.ContainerRuntimeConfig,
+		},
+	}
+}
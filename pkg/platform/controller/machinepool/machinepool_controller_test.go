@@ -0,0 +1,154 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package machinepool
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func newMachineForTest(name string, created time.Time, phase platformv1.MachinePhase) platformv1.Machine {
+	return platformv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: platformv1.MachineStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func newHostForTest(name string, tenantID string, ip string, phase platformv1.HostPhase) platformv1.Host {
+	return platformv1.Host{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       platformv1.HostSpec{TenantID: tenantID, IP: ip, Port: 22, CredentialName: "root"},
+		Status:     platformv1.HostStatus{Phase: phase},
+	}
+}
+
+func TestAvailableHosts(t *testing.T) {
+	pool := &platformv1.MachinePool{Spec: platformv1.MachinePoolSpec{TenantID: "default"}}
+	hosts := []platformv1.Host{
+		newHostForTest("host-3", "default", "10.0.0.3", platformv1.HostReachable),
+		newHostForTest("host-1", "default", "10.0.0.1", platformv1.HostReachable),
+		newHostForTest("host-2", "default", "10.0.0.2", platformv1.HostReachable),
+		newHostForTest("host-4", "default", "10.0.0.4", platformv1.HostUnreachable),
+		newHostForTest("host-5", "other", "10.0.0.5", platformv1.HostReachable),
+		newHostForTest("host-6", "default", "10.0.0.6", platformv1.HostReachable),
+	}
+
+	got := AvailableHosts(pool, hosts, sets.NewString("host-6"), sets.NewString("10.0.0.2"))
+	var names []string
+	for _, host := range got {
+		names = append(names, host.Name)
+	}
+	if want := []string{"host-1", "host-3"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("AvailableHosts() = %v, want %v", names, want)
+	}
+	if got := AvailableHosts(pool, hosts, sets.NewString("host-1", "host-3", "host-6"), sets.NewString("10.0.0.2")); len(got) != 0 {
+		t.Errorf("AvailableHosts() = %v, want none", got)
+	}
+}
+
+func TestMachinesToDelete(t *testing.T) {
+	now := time.Now()
+	machines := []platformv1.Machine{
+		newMachineForTest("oldest", now.Add(-3*time.Hour), platformv1.MachineRunning),
+		newMachineForTest("newest", now, platformv1.MachineRunning),
+		newMachineForTest("initializing", now.Add(-2*time.Hour), platformv1.MachineInitializing),
+		newMachineForTest("failed", now.Add(-4*time.Hour), platformv1.MachineFailed),
+	}
+
+	tests := []struct {
+		name   string
+		count  int
+		marked string
+		want   []string
+	}{
+		{name: "none", count: 0, want: nil},
+		{name: "unhealthy first", count: 2, want: []string{"failed", "initializing"}},
+		{name: "then newest", count: 3, want: []string{"failed", "initializing", "newest"}},
+		{name: "more than all", count: 5, want: []string{"failed", "initializing", "newest", "oldest"}},
+		{name: "marked first", count: 2, marked: "oldest", want: []string{"oldest", "failed"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := make([]platformv1.Machine, len(machines))
+			for i := range machines {
+				machines[i].DeepCopyInto(&candidates[i])
+				if candidates[i].Name == tt.marked {
+					candidates[i].Annotations = map[string]string{platformv1.MachineDeleteAnnotation: ""}
+				}
+			}
+			var names []string
+			for _, machine := range MachinesToDelete(candidates, tt.count) {
+				names = append(names, machine.Name)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("MachinesToDelete() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMachine(t *testing.T) {
+	pool := &platformv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "workers", UID: "uid"},
+		Spec: platformv1.MachinePoolSpec{
+			TenantID:    "default",
+			ClusterName: "cls-test",
+			Type:        "Baremetal",
+			Template: platformv1.MachineTemplateSpec{
+				Labels:           map[string]string{"pool": "workers", "zone": "a"},
+				Taints:           []corev1.Taint{{Key: "dedicated", Value: "workers", Effect: corev1.TaintEffectNoSchedule}},
+				KubeletExtraArgs: map[string]string{"max-pods": "64"},
+				ContainerRuntimeConfig: &platformv1.ContainerRuntimeConfig{
+					Snapshotter: "native",
+				},
+			},
+		},
+	}
+	host := newHostForTest("host-1", "default", "10.0.0.1", platformv1.HostReachable)
+	host.Spec.Labels = map[string]string{"zone": "b"}
+
+	machine := newMachine(pool, host)
+	if machine.Labels[platformv1.MachinePoolLabel] != "workers" || machine.Labels[platformv1.MachineHostLabel] != "host-1" {
+		t.Errorf("machine labels = %v, want the pool and host labels", machine.Labels)
+	}
+	if machine.Spec.IP != host.Spec.IP || machine.Spec.CredentialName != "root" || len(machine.Spec.Password) != 0 || machine.Spec.ClusterName != "cls-test" || machine.Spec.TenantID != "default" {
+		t.Errorf("unexpected machine spec %+v", machine.Spec)
+	}
+	if want := map[string]string{"pool": "workers", "zone": "b"}; !reflect.DeepEqual(machine.Spec.Labels, want) {
+		t.Errorf("machine labels = %v, want %v", machine.Spec.Labels, want)
+	}
+	if len(machine.Spec.Taints) != 1 || machine.Spec.KubeletExtraArgs["max-pods"] != "64" ||
+		machine.Spec.ContainerRuntimeConfig == nil || machine.Spec.ContainerRuntimeConfig.Snapshotter != "native" {
+		t.Errorf("machine does not inherit template: %+v", machine.Spec)
+	}
+	if ref := metav1.GetControllerOf(machine); ref == nil || ref.Kind != "MachinePool" || ref.Name != "workers" {
+		t.Errorf("unexpected controller reference %v", ref)
+	}
+}
@@ -0,0 +1,32 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package config
+
+import "time"
+
+// MultiClusterDeploymentControllerConfiguration contains elements describing
+// MultiClusterDeploymentController.
+type MultiClusterDeploymentControllerConfiguration struct {
+	// ResyncPeriod is the period of propagating every multi cluster
+	// deployment again to correct the drift of the target clusters.
+	ResyncPeriod time.Duration
+	// ConcurrentMultiClusterDeploymentSyncs is the number of multi cluster
+	// deployment objects that are allowed to sync concurrently.
+	ConcurrentMultiClusterDeploymentSyncs int
+}
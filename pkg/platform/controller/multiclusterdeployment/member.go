@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multiclusterdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	restclient "k8s.io/client-go/rest"
+	"tkestack.io/tke/api/client/clientset/versioned/scheme"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+)
+
+// memberClient manages the deployments of the target clusters.
+type memberClient interface {
+	// Apply applies the deployment, the conflicts with other field managers
+	// are overridden only if force is set.
+	Apply(ctx context.Context, clusterName string, deployment *appsv1.Deployment, force bool) error
+	Get(ctx context.Context, clusterName, namespace, name string) (*appsv1.Deployment, error)
+	Delete(ctx context.Context, clusterName, namespace, name string) error
+}
+
+// proxyClient applies the deployments through the apply subresource of
+// clusters, and reads and deletes them through the proxy subresource.
+type proxyClient struct {
+	client restclient.Interface
+}
+
+func (c *proxyClient) Apply(ctx context.Context, clusterName string, deployment *appsv1.Deployment, force bool) error {
+	manifest, err := json.Marshal(deployment)
+	if err != nil {
+		return err
+	}
+	opts := &platformv1.ClusterApplyOptions{
+		ServerSide:   true,
+		FieldManager: fieldManager,
+		Force:        force,
+	}
+	body, err := c.client.Post().
+		Resource("clusters").
+		Name(clusterName).
+		SubResource("apply").
+		VersionedParams(opts, scheme.ParameterCodec).
+		Body(manifest).
+		DoRaw(ctx)
+
+	result := &platformv1.ClusterApplyResult{}
+	if jsonErr := json.Unmarshal(body, result); jsonErr != nil || result.Kind != "ClusterApplyResult" {
+		if err == nil {
+			err = fmt.Errorf("unexpected response of apply: %s", string(body))
+		}
+		return err
+	}
+	var failures []string
+	for _, item := range result.Items {
+		if item.Action == platformv1.ClusterApplyActionFailed {
+			failures = append(failures, item.Message)
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to apply deployment: %s", strings.Join(failures, "; "))
+	}
+	return err
+}
+
+func (c *proxyClient) Get(ctx context.Context, clusterName, namespace, name string) (*appsv1.Deployment, error) {
+	body, err := c.proxy(c.client.Get(), clusterName, namespace, name).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deployment := &appsv1.Deployment{}
+	if err := json.Unmarshal(body, deployment); err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+func (c *proxyClient) Delete(ctx context.Context, clusterName, namespace, name string) error {
+	_, err := c.proxy(c.client.Delete(), clusterName, namespace, name).DoRaw(ctx)
+	return err
+}
+
+func (c *proxyClient) proxy(request *restclient.Request, clusterName, namespace, name string) *restclient.Request {
+	return request.
+		Resource("clusters").
+		Name(clusterName).
+		SubResource("proxy").
+		Param("path", path.Join("/apis/apps/v1/namespaces", namespace, "deployments", name)).
+		SetHeader(filter.ClusterNameHeaderKey, clusterName)
+}
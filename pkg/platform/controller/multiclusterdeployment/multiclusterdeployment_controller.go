@@ -0,0 +1,454 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multiclusterdeployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	multiclusterdeploymentconfig "tkestack.io/tke/pkg/platform/controller/multiclusterdeployment/config"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	// fieldManager is the manager of the fields of the propagated deployments.
+	fieldManager = "tke-multiclusterdeployment"
+	// concurrentClusterSyncs is the number of target clusters of a multi
+	// cluster deployment synced concurrently.
+	concurrentClusterSyncs = 8
+	// progressingInterval is the period of refreshing the status of a multi
+	// cluster deployment while it is rolling out.
+	progressingInterval = 15 * time.Second
+	defaultResyncPeriod = 5 * time.Minute
+)
+
+// Controller is responsible for propagating multi cluster deployments to
+// their target clusters and aggregating the status of the deployments back.
+type Controller struct {
+	queue               workqueue.RateLimitingInterface
+	lister              platformv1lister.MultiClusterDeploymentLister
+	listerSynced        cache.InformerSynced
+	clusterLister       platformv1lister.ClusterLister
+	clusterListerSynced cache.InformerSynced
+	log                 log.Logger
+	platformClient      platformversionedclient.PlatformV1Interface
+	member              memberClient
+	resyncPeriod        time.Duration
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformclient platformversionedclient.PlatformV1Interface,
+	multiClusterDeploymentInformer platformv1informer.MultiClusterDeploymentInformer,
+	clusterInformer platformv1informer.ClusterInformer,
+	configuration multiclusterdeploymentconfig.MultiClusterDeploymentControllerConfiguration) *Controller {
+	rateLimit := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(5*time.Second, 5*time.Minute),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	resyncPeriod := configuration.ResyncPeriod
+	if resyncPeriod <= 0 {
+		resyncPeriod = defaultResyncPeriod
+	}
+	c := &Controller{
+		queue:          workqueue.NewNamedRateLimitingQueue(rateLimit, "multiclusterdeployment"),
+		log:            log.WithName("MultiClusterDeploymentController"),
+		platformClient: platformclient,
+		member:         &proxyClient{client: platformclient.RESTClient()},
+		resyncPeriod:   resyncPeriod,
+	}
+
+	if platformclient != nil && platformclient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("multiclusterdeployment_controller", platformclient.RESTClient().GetRateLimiter())
+	}
+
+	multiClusterDeploymentInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addMultiClusterDeployment,
+			UpdateFunc: c.updateMultiClusterDeployment,
+		},
+	)
+	c.lister = multiClusterDeploymentInformer.Lister()
+	c.listerSynced = multiClusterDeploymentInformer.Informer().HasSynced
+	c.clusterLister = clusterInformer.Lister()
+	c.clusterListerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) addMultiClusterDeployment(obj interface{}) {
+	deployment := obj.(*platformv1.MultiClusterDeployment)
+	c.log.Info("Adding multi cluster deployment", "multiClusterDeployment", deployment.Name)
+	c.queue.Add(deployment.Name)
+}
+
+// updateMultiClusterDeployment only enqueues the spec changes and deletions,
+// the status updates of the controller itself are ignored and every multi
+// cluster deployment is requeued periodically by syncMultiClusterDeployment.
+func (c *Controller) updateMultiClusterDeployment(old, obj interface{}) {
+	oldDeployment := old.(*platformv1.MultiClusterDeployment)
+	deployment := obj.(*platformv1.MultiClusterDeployment)
+	if oldDeployment.Generation == deployment.Generation &&
+		apiequality.Semantic.DeepEqual(oldDeployment.Spec, deployment.Spec) &&
+		oldDeployment.DeletionTimestamp.Equal(deployment.DeletionTimestamp) {
+		return
+	}
+	c.queue.Add(deployment.Name)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting multi cluster deployment controller")
+	defer log.Info("Shutting down multi cluster deployment controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.clusterListerSynced); !ok {
+		return fmt.Errorf("failed to wait for multi cluster deployment caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of multi cluster deployment objects.
+// Each multi cluster deployment can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncMultiClusterDeployment(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing multi cluster deployment %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncMultiClusterDeployment(key string) error {
+	ctx := c.log.WithValues("multiClusterDeployment", key).WithContext(context.TODO())
+
+	startTime := time.Now()
+	defer func() {
+		log.FromContext(ctx).Info("Finished syncing multi cluster deployment", "processTime", time.Since(startTime).String())
+	}()
+
+	deployment, err := c.lister.Get(key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Info("Multi cluster deployment has been deleted")
+			return nil
+		}
+		return err
+	}
+
+	if deployment.DeletionTimestamp != nil {
+		return c.delete(ctx, deployment)
+	}
+
+	status := c.reconcile(ctx, deployment)
+	if !apiequality.Semantic.DeepEqual(status, &deployment.Status) {
+		deployment = deployment.DeepCopy()
+		deployment.Status = *status
+		if _, err := c.platformClient.MultiClusterDeployments().UpdateStatus(ctx, deployment, metav1.UpdateOptions{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	if status.Phase == platformv1.MultiClusterDeploymentProgressing {
+		c.queue.AddAfter(key, progressingInterval)
+	} else {
+		c.queue.AddAfter(key, c.resyncPeriod)
+	}
+	return nil
+}
+
+// reconcile propagates the deployment to every target cluster, removes it
+// from the clusters no longer targeted, and returns the observed status.
+func (c *Controller) reconcile(ctx context.Context, deployment *platformv1.MultiClusterDeployment) *platformv1.MultiClusterDeploymentStatus {
+	status := deployment.Status.DeepCopy()
+	status.ObservedGeneration = deployment.Generation
+	status.LastSyncTime = metav1.Now()
+
+	clusterNames, err := c.targetClusters(deployment)
+	if err != nil {
+		status.Phase = platformv1.MultiClusterDeploymentFailed
+		status.Message = err.Error()
+		return status
+	}
+
+	// Only the running clusters share the replicas, so that the replicas of
+	// an unavailable cluster are moved to the others.
+	var running []string
+	unavailable := make(map[string]string)
+	for _, clusterName := range clusterNames {
+		if message := c.checkCluster(deployment, clusterName); message != "" {
+			unavailable[clusterName] = message
+			continue
+		}
+		running = append(running, clusterName)
+	}
+	replicas := splitReplicas(deployment, running)
+
+	targets := sets.NewString(clusterNames...)
+	var removed []string
+	for _, clusterStatus := range deployment.Status.Clusters {
+		if !targets.Has(clusterStatus.ClusterName) {
+			removed = append(removed, clusterStatus.ClusterName)
+		}
+	}
+
+	clusters := make([]platformv1.MultiClusterDeploymentClusterStatus, len(clusterNames)+len(removed))
+	workqueue.ParallelizeUntil(ctx, concurrentClusterSyncs, len(clusters), func(i int) {
+		if i >= len(clusterNames) {
+			clusters[i] = c.removeFromCluster(ctx, deployment, removed[i-len(clusterNames)])
+			return
+		}
+		clusterName := clusterNames[i]
+		if message, ok := unavailable[clusterName]; ok {
+			clusters[i] = platformv1.MultiClusterDeploymentClusterStatus{
+				ClusterName: clusterName,
+				Phase:       platformv1.MultiClusterDeploymentFailed,
+				Message:     message,
+			}
+			return
+		}
+		clusters[i] = c.syncCluster(ctx, deployment, clusterName, replicas[clusterName])
+	})
+
+	status.Clusters = nil
+	status.Replicas = 0
+	status.UpdatedReplicas = 0
+	status.ReadyReplicas = 0
+	status.AvailableReplicas = 0
+	var failed, progressing []string
+	for _, clusterStatus := range clusters {
+		// The clusters whose deployments have been removed are dropped,
+		// those failed to be removed are kept to be retried.
+		if clusterStatus.ClusterName == "" {
+			continue
+		}
+		status.Clusters = append(status.Clusters, clusterStatus)
+		status.Replicas += clusterStatus.Replicas
+		status.UpdatedReplicas += clusterStatus.UpdatedReplicas
+		status.ReadyReplicas += clusterStatus.ReadyReplicas
+		status.AvailableReplicas += clusterStatus.AvailableReplicas
+		switch clusterStatus.Phase {
+		case platformv1.MultiClusterDeploymentFailed:
+			failed = append(failed, clusterStatus.ClusterName)
+		case platformv1.MultiClusterDeploymentProgressing:
+			progressing = append(progressing, clusterStatus.ClusterName)
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		status.Phase = platformv1.MultiClusterDeploymentFailed
+		status.Message = fmt.Sprintf("failed to propagate to clusters: %s", strings.Join(failed, ", "))
+	case len(clusterNames) == 0:
+		status.Phase = platformv1.MultiClusterDeploymentPending
+		status.Message = "no target clusters"
+	case len(progressing) > 0:
+		status.Phase = platformv1.MultiClusterDeploymentProgressing
+		status.Message = fmt.Sprintf("rolling out in clusters: %s", strings.Join(progressing, ", "))
+	default:
+		status.Phase = platformv1.MultiClusterDeploymentAvailable
+		status.Message = ""
+	}
+	return status
+}
+
+// targetClusters returns the sorted names of the clusters listed by name or
+// selected by labels.
+func (c *Controller) targetClusters(deployment *platformv1.MultiClusterDeployment) ([]string, error) {
+	placement := deployment.Spec.Placement
+	names := sets.NewString(placement.ClusterNames...)
+	if placement.ClusterSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(placement.ClusterSelector)
+		if err != nil {
+			return nil, err
+		}
+		clusters, err := c.clusterLister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			if cluster.Spec.TenantID == deployment.Spec.TenantID {
+				names.Insert(cluster.Name)
+			}
+		}
+	}
+	return names.List(), nil
+}
+
+// checkCluster returns why the deployment can not be propagated to the
+// cluster, or empty if it can.
+func (c *Controller) checkCluster(deployment *platformv1.MultiClusterDeployment, clusterName string) string {
+	cluster, err := c.clusterLister.Get(clusterName)
+	if err != nil || cluster.Spec.TenantID != deployment.Spec.TenantID {
+		return fmt.Sprintf("cluster %s not found", clusterName)
+	}
+	if cluster.Status.Phase != platformv1.ClusterRunning {
+		return fmt.Sprintf("cluster is %s", cluster.Status.Phase)
+	}
+	return ""
+}
+
+// syncCluster applies the deployment of the cluster by server-side apply and
+// reads back its status. A deployment of the same name not propagated by the
+// multi cluster deployment is reported as a conflict and left untouched, the
+// conflicts with other field managers are only overridden on the deployments
+// propagated by it.
+func (c *Controller) syncCluster(ctx context.Context, deployment *platformv1.MultiClusterDeployment, clusterName string, replicas int32) platformv1.MultiClusterDeploymentClusterStatus {
+	status := platformv1.MultiClusterDeploymentClusterStatus{
+		ClusterName: clusterName,
+		Phase:       platformv1.MultiClusterDeploymentFailed,
+		Replicas:    replicas,
+	}
+
+	existing, err := c.member.Get(ctx, clusterName, deployment.Spec.Namespace, deployment.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.FromContext(ctx).Error(err, "Failed to get the deployment", "clusterName", clusterName)
+		status.Message = err.Error()
+		return status
+	}
+	owned := err == nil
+	if owned && existing.Labels[platformv1.MultiClusterDeploymentLabel] != deployment.Name {
+		status.Message = fmt.Sprintf("conflict: deployment %s/%s already exists and is not propagated by the multi cluster deployment",
+			deployment.Spec.Namespace, deployment.Name)
+		return status
+	}
+
+	if err := c.member.Apply(ctx, clusterName, renderDeployment(deployment, clusterName, replicas), owned); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to apply the deployment", "clusterName", clusterName)
+		status.Message = err.Error()
+		return status
+	}
+	applied, err := c.member.Get(ctx, clusterName, deployment.Spec.Namespace, deployment.Name)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to get the deployment", "clusterName", clusterName)
+		status.Message = err.Error()
+		return status
+	}
+
+	status.Phase = deploymentPhase(applied)
+	status.UpdatedReplicas = applied.Status.UpdatedReplicas
+	status.ReadyReplicas = applied.Status.ReadyReplicas
+	status.AvailableReplicas = applied.Status.AvailableReplicas
+	return status
+}
+
+// removeFromCluster deletes the deployment from the cluster, and returns an
+// empty status once it is gone or the cluster does not exist any more.
+func (c *Controller) removeFromCluster(ctx context.Context, deployment *platformv1.MultiClusterDeployment, clusterName string) platformv1.MultiClusterDeploymentClusterStatus {
+	if err := c.deleteFromCluster(ctx, deployment, clusterName); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to delete the deployment", "clusterName", clusterName)
+		return platformv1.MultiClusterDeploymentClusterStatus{
+			ClusterName: clusterName,
+			Phase:       platformv1.MultiClusterDeploymentFailed,
+			Message:     fmt.Sprintf("failed to delete the deployment: %v", err),
+		}
+	}
+	return platformv1.MultiClusterDeploymentClusterStatus{}
+}
+
+// deleteFromCluster deletes the deployment from the cluster if it was
+// propagated by the multi cluster deployment.
+func (c *Controller) deleteFromCluster(ctx context.Context, deployment *platformv1.MultiClusterDeployment, clusterName string) error {
+	if _, err := c.clusterLister.Get(clusterName); apierrors.IsNotFound(err) {
+		return nil
+	}
+	existing, err := c.member.Get(ctx, clusterName, deployment.Spec.Namespace, deployment.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if existing.Labels[platformv1.MultiClusterDeploymentLabel] != deployment.Name {
+		return nil
+	}
+	if err := c.member.Delete(ctx, clusterName, deployment.Spec.Namespace, deployment.Name); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// delete removes the deployments from all clusters they were propagated to,
+// then removes the finalizer to let the multi cluster deployment go.
+func (c *Controller) delete(ctx context.Context, deployment *platformv1.MultiClusterDeployment) error {
+	finalizers := sets.NewString(deployment.Finalizers...)
+	if !finalizers.Has(platformv1.MultiClusterDeploymentFinalizer) {
+		return nil
+	}
+
+	var errs []string
+	for _, clusterStatus := range deployment.Status.Clusters {
+		if err := c.deleteFromCluster(ctx, deployment, clusterStatus.ClusterName); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", clusterStatus.ClusterName, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to delete the deployments from clusters: %s", strings.Join(errs, "; "))
+	}
+
+	deployment = deployment.DeepCopy()
+	deployment.Finalizers = finalizers.Delete(platformv1.MultiClusterDeploymentFinalizer).List()
+	if _, err := c.platformClient.MultiClusterDeployments().Update(ctx, deployment, metav1.UpdateOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	log.FromContext(ctx).Info("Deployments have been deleted from all clusters")
+	return nil
+}
@@ -0,0 +1,316 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multiclusterdeployment
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/controllertest"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestSplitReplicas(t *testing.T) {
+	testCases := []struct {
+		name      string
+		replicas  int32
+		weights   []platformv1.MultiClusterWeight
+		overrides []platformv1.MultiClusterOverride
+		clusters  []string
+		want      map[string]int32
+	}{
+		{
+			name:     "even",
+			replicas: 5,
+			clusters: []string{"cls-b", "cls-a"},
+			want:     map[string]int32{"cls-a": 3, "cls-b": 2},
+		},
+		{
+			name:     "weighted",
+			replicas: 10,
+			weights: []platformv1.MultiClusterWeight{
+				{ClusterName: "cls-a", Weight: 2},
+				{ClusterName: "cls-c", Weight: 0},
+			},
+			clusters: []string{"cls-a", "cls-b", "cls-c"},
+			want:     map[string]int32{"cls-a": 7, "cls-b": 3, "cls-c": 0},
+		},
+		{
+			name:     "overridden",
+			replicas: 4,
+			overrides: []platformv1.MultiClusterOverride{
+				{ClusterName: "cls-a", Replicas: int32Ptr(3)},
+			},
+			clusters: []string{"cls-a", "cls-b", "cls-c"},
+			want:     map[string]int32{"cls-a": 3, "cls-b": 1, "cls-c": 0},
+		},
+		{
+			name:     "overridden beyond total",
+			replicas: 2,
+			overrides: []platformv1.MultiClusterOverride{
+				{ClusterName: "cls-a", Replicas: int32Ptr(3)},
+			},
+			clusters: []string{"cls-a", "cls-b"},
+			want:     map[string]int32{"cls-a": 3, "cls-b": 0},
+		},
+		{
+			name:     "all weigh zero",
+			replicas: 2,
+			weights:  []platformv1.MultiClusterWeight{{ClusterName: "cls-a", Weight: 0}},
+			clusters: []string{"cls-a"},
+			want:     map[string]int32{"cls-a": 0},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deployment := &platformv1.MultiClusterDeployment{
+				Spec: platformv1.MultiClusterDeploymentSpec{
+					Replicas:  int32Ptr(tc.replicas),
+					Placement: platformv1.MultiClusterPlacement{Weights: tc.weights},
+					Overrides: tc.overrides,
+				},
+			}
+			if got := splitReplicas(deployment, tc.clusters); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("splitReplicas() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func newMultiClusterDeployment() *platformv1.MultiClusterDeployment {
+	return &platformv1.MultiClusterDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Generation: 3},
+		Spec: platformv1.MultiClusterDeploymentSpec{
+			TenantID:  "default",
+			Namespace: "default",
+			Replicas:  int32Ptr(3),
+			Selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "web",
+						Image: "nginx:1.20",
+						Env:   []corev1.EnvVar{{Name: "REGION", Value: "default"}, {Name: "DEBUG", Value: "false"}},
+					}},
+				},
+			},
+			Placement: platformv1.MultiClusterPlacement{
+				ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+			Overrides: []platformv1.MultiClusterOverride{{
+				ClusterName: "cls-b",
+				Containers: []platformv1.MultiClusterContainerOverride{{
+					Name:  "web",
+					Image: "mirror.local/nginx:1.20",
+					Env:   []corev1.EnvVar{{Name: "REGION", Value: "b"}, {Name: "ZONE", Value: "b1"}},
+				}},
+			}},
+		},
+	}
+}
+
+func TestRenderDeployment(t *testing.T) {
+	deployment := newMultiClusterDeployment()
+
+	rendered := renderDeployment(deployment, "cls-b", 2)
+	if rendered.Name != "web" || rendered.Namespace != "default" || *rendered.Spec.Replicas != 2 {
+		t.Errorf("renderDeployment() = %s/%s with %d replicas", rendered.Namespace, rendered.Name, *rendered.Spec.Replicas)
+	}
+	if rendered.Labels[platformv1.MultiClusterDeploymentLabel] != "web" {
+		t.Errorf("renderDeployment() labels = %v", rendered.Labels)
+	}
+	container := rendered.Spec.Template.Spec.Containers[0]
+	if container.Image != "mirror.local/nginx:1.20" {
+		t.Errorf("renderDeployment() image = %s", container.Image)
+	}
+	wantEnv := []corev1.EnvVar{{Name: "REGION", Value: "b"}, {Name: "DEBUG", Value: "false"}, {Name: "ZONE", Value: "b1"}}
+	if !reflect.DeepEqual(container.Env, wantEnv) {
+		t.Errorf("renderDeployment() env = %v, want %v", container.Env, wantEnv)
+	}
+
+	if container := renderDeployment(deployment, "cls-a", 1).Spec.Template.Spec.Containers[0]; container.Image != "nginx:1.20" || len(container.Env) != 2 {
+		t.Errorf("renderDeployment() overrode another cluster: %+v", container)
+	}
+	if deployment.Spec.Template.Spec.Containers[0].Image != "nginx:1.20" {
+		t.Errorf("renderDeployment() modified the template")
+	}
+}
+
+// fakeMember keeps the deployments of the clusters in memory, every applied
+// deployment is reported as rolled out.
+type fakeMember struct {
+	sync.Mutex
+	deployments map[string]*appsv1.Deployment
+	failures    map[string]error
+}
+
+func (f *fakeMember) Apply(ctx context.Context, clusterName string, deployment *appsv1.Deployment, force bool) error {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.failures[clusterName]; err != nil {
+		return err
+	}
+	if _, ok := f.deployments[clusterName]; ok && !force {
+		return apierrors.NewConflict(appsv1.Resource("deployments"), deployment.Name, fmt.Errorf("apply failed with conflicts"))
+	}
+	applied := deployment.DeepCopy()
+	replicas := *applied.Spec.Replicas
+	applied.Status = appsv1.DeploymentStatus{Replicas: replicas, UpdatedReplicas: replicas, ReadyReplicas: replicas, AvailableReplicas: replicas}
+	f.deployments[clusterName] = applied
+	return nil
+}
+
+func (f *fakeMember) Get(ctx context.Context, clusterName, namespace, name string) (*appsv1.Deployment, error) {
+	f.Lock()
+	defer f.Unlock()
+	deployment, ok := f.deployments[clusterName]
+	if !ok {
+		return nil, apierrors.NewNotFound(appsv1.Resource("deployments"), name)
+	}
+	return deployment.DeepCopy(), nil
+}
+
+func (f *fakeMember) Delete(ctx context.Context, clusterName, namespace, name string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.deployments, clusterName)
+	return nil
+}
+
+func newTestController(t *testing.T, member memberClient, clusters ...*platformv1.Cluster) *Controller {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, cluster := range clusters {
+		if err := indexer.Add(cluster); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &Controller{
+		clusterLister: platformv1lister.NewClusterLister(indexer),
+		member:        member,
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	prod := map[string]string{"env": "prod"}
+	member := &fakeMember{
+		deployments: map[string]*appsv1.Deployment{
+			"cls-old": {ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{platformv1.MultiClusterDeploymentLabel: "web"}}},
+		},
+		failures: map[string]error{"cls-c": fmt.Errorf("namespace not found")},
+	}
+	c := newTestController(t, member,
+		controllertest.NewCluster("cls-a", "default", platformv1.ClusterRunning, prod),
+		controllertest.NewCluster("cls-b", "default", platformv1.ClusterRunning, prod),
+		controllertest.NewCluster("cls-c", "default", platformv1.ClusterRunning, prod),
+		controllertest.NewCluster("cls-d", "default", platformv1.ClusterInitializing, prod),
+		controllertest.NewCluster("cls-e", "other", platformv1.ClusterRunning, prod),
+		controllertest.NewCluster("cls-old", "default", platformv1.ClusterRunning, nil),
+	)
+	deployment := newMultiClusterDeployment()
+	deployment.Status.Clusters = []platformv1.MultiClusterDeploymentClusterStatus{{ClusterName: "cls-old"}}
+
+	status := c.reconcile(context.Background(), deployment)
+	if status.Phase != platformv1.MultiClusterDeploymentFailed || status.ObservedGeneration != 3 {
+		t.Errorf("reconcile() = %s/%d, want Failed/3", status.Phase, status.ObservedGeneration)
+	}
+	if status.Replicas != 3 || status.AvailableReplicas != 2 {
+		t.Errorf("reconcile() replicas = %d/%d, want 2 of 3 available", status.AvailableReplicas, status.Replicas)
+	}
+
+	want := map[string]platformv1.MultiClusterDeploymentPhase{
+		"cls-a": platformv1.MultiClusterDeploymentAvailable,
+		"cls-b": platformv1.MultiClusterDeploymentAvailable,
+		"cls-c": platformv1.MultiClusterDeploymentFailed,
+		"cls-d": platformv1.MultiClusterDeploymentFailed,
+	}
+	got := make(map[string]platformv1.MultiClusterDeploymentPhase)
+	for _, clusterStatus := range status.Clusters {
+		got[clusterStatus.ClusterName] = clusterStatus.Phase
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("reconcile() clusters = %v, want %v", got, want)
+	}
+
+	if _, ok := member.deployments["cls-old"]; ok {
+		t.Errorf("reconcile() did not remove the deployment of the untargeted cluster")
+	}
+	if image := member.deployments["cls-b"].Spec.Template.Spec.Containers[0].Image; image != "mirror.local/nginx:1.20" {
+		t.Errorf("reconcile() applied image %s to cls-b", image)
+	}
+
+	// Once the failed clusters recover, all replicas are available.
+	delete(member.failures, "cls-c")
+	deployment.Spec.Placement.ClusterSelector = nil
+	deployment.Spec.Placement.ClusterNames = []string{"cls-a", "cls-c"}
+	deployment.Status = *status
+	status = c.reconcile(context.Background(), deployment)
+	if status.Phase != platformv1.MultiClusterDeploymentAvailable || status.AvailableReplicas != 3 || len(status.Clusters) != 2 {
+		t.Errorf("reconcile() = %+v, want all replicas available in 2 clusters", status)
+	}
+	if _, ok := member.deployments["cls-b"]; ok {
+		t.Errorf("reconcile() did not remove the deployment of cls-b")
+	}
+}
+
+func TestReconcileConflict(t *testing.T) {
+	member := &fakeMember{
+		deployments: map[string]*appsv1.Deployment{
+			"cls-a": {ObjectMeta: metav1.ObjectMeta{Name: "web"}},
+		},
+	}
+	c := newTestController(t, member,
+		controllertest.NewCluster("cls-a", "default", platformv1.ClusterRunning, nil),
+		controllertest.NewCluster("cls-b", "default", platformv1.ClusterRunning, nil),
+	)
+	deployment := newMultiClusterDeployment()
+	deployment.Spec.Placement.ClusterSelector = nil
+	deployment.Spec.Placement.ClusterNames = []string{"cls-a", "cls-b"}
+
+	status := c.reconcile(context.Background(), deployment)
+	if status.Phase != platformv1.MultiClusterDeploymentFailed || len(status.Clusters) != 2 {
+		t.Fatalf("reconcile() = %+v, want Failed in 2 clusters", status)
+	}
+	if clusterStatus := status.Clusters[0]; clusterStatus.Phase != platformv1.MultiClusterDeploymentFailed || !strings.HasPrefix(clusterStatus.Message, "conflict:") {
+		t.Errorf("reconcile() status of cls-a = %+v, want a conflict", clusterStatus)
+	}
+	if _, ok := member.deployments["cls-a"].Labels[platformv1.MultiClusterDeploymentLabel]; ok {
+		t.Errorf("reconcile() applied the deployment not propagated by it")
+	}
+
+	// the deployment propagated before is applied again with force
+	status = c.reconcile(context.Background(), deployment)
+	if clusterStatus := status.Clusters[1]; clusterStatus.Phase != platformv1.MultiClusterDeploymentAvailable {
+		t.Errorf("reconcile() status of cls-b = %+v, want Available", clusterStatus)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multiclusterdeployment
+
+import (
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// splitReplicas returns the replicas of every cluster. The clusters with an
+// override of replicas get exactly those, and the rest of the total replicas
+// is split among the other clusters in proportion to their weights, by the
+// largest remainder method with ties broken by the cluster names.
+func splitReplicas(deployment *platformv1.MultiClusterDeployment, clusterNames []string) map[string]int32 {
+	weights := make(map[string]int64)
+	for _, weight := range deployment.Spec.Placement.Weights {
+		weights[weight.ClusterName] = int64(weight.Weight)
+	}
+	fixed := make(map[string]int32)
+	for _, override := range deployment.Spec.Overrides {
+		if override.Replicas != nil {
+			fixed[override.ClusterName] = *override.Replicas
+		}
+	}
+
+	var total int64 = 1
+	if deployment.Spec.Replicas != nil {
+		total = int64(*deployment.Spec.Replicas)
+	}
+
+	names := append([]string(nil), clusterNames...)
+	sort.Strings(names)
+
+	result := make(map[string]int32, len(names))
+	var weighted []string
+	var sum int64
+	for _, name := range names {
+		if replicas, ok := fixed[name]; ok {
+			result[name] = replicas
+			total -= int64(replicas)
+			continue
+		}
+		weight, ok := weights[name]
+		if !ok {
+			weight = 1
+		}
+		weights[name] = weight
+		weighted = append(weighted, name)
+		sum += weight
+	}
+	if total < 0 {
+		total = 0
+	}
+	if sum == 0 {
+		for _, name := range weighted {
+			result[name] = 0
+		}
+		return result
+	}
+
+	remainders := make(map[string]int64, len(weighted))
+	left := total
+	for _, name := range weighted {
+		share := total * weights[name]
+		result[name] = int32(share / sum)
+		remainders[name] = share % sum
+		left -= share / sum
+	}
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return remainders[weighted[i]] > remainders[weighted[j]]
+	})
+	for i := int64(0); i < left; i++ {
+		result[weighted[i]]++
+	}
+	return result
+}
+
+// renderDeployment returns the deployment of the cluster with the overrides
+// of the cluster applied.
+func renderDeployment(deployment *platformv1.MultiClusterDeployment, clusterName string, replicas int32) *appsv1.Deployment {
+	template := deployment.Spec.Template.DeepCopy()
+	for _, override := range deployment.Spec.Overrides {
+		if override.ClusterName == clusterName {
+			overrideContainers(template.Spec.Containers, override.Containers)
+		}
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Spec.Namespace,
+			Labels: map[string]string{
+				platformv1.MultiClusterDeploymentLabel: deployment.Name,
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: deployment.Spec.Selector.DeepCopy(),
+			Template: *template,
+		},
+	}
+}
+
+// overrideContainers replaces the images of the containers and merges the
+// environment variables into them by name.
+func overrideContainers(containers []corev1.Container, overrides []platformv1.MultiClusterContainerOverride) {
+	for _, override := range overrides {
+		for i := range containers {
+			container := &containers[i]
+			if container.Name != override.Name {
+				continue
+			}
+			if override.Image != "" {
+				container.Image = override.Image
+			}
+			for _, env := range override.Env {
+				merged := false
+				for j := range container.Env {
+					if container.Env[j].Name == env.Name {
+						container.Env[j] = env
+						merged = true
+						break
+					}
+				}
+				if !merged {
+					container.Env = append(container.Env, env)
+				}
+			}
+		}
+	}
+}
+
+// deploymentPhase returns Available if the deployment has rolled out and all
+// of its replicas are available, otherwise Progressing.
+func deploymentPhase(deployment *appsv1.Deployment) platformv1.MultiClusterDeploymentPhase {
+	var replicas int32 = 1
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	if status.ObservedGeneration >= deployment.Generation &&
+		status.Replicas == replicas &&
+		status.UpdatedReplicas == replicas &&
+		status.AvailableReplicas == replicas {
+		return platformv1.MultiClusterDeploymentAvailable
+	}
+	return platformv1.MultiClusterDeploymentProgressing
+}
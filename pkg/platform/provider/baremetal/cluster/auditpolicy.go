@@ -0,0 +1,350 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// auditPolicyBackupFile keeps the audit policy during reconfiguring
+	auditPolicyBackupFile = constants.KubernetesAuditPolicyConfigFile + ".tke-backup"
+	// apiServerManifestBackupDir keeps the kube-apiserver manifest while the
+	// static pod is stopped
+	apiServerManifestBackupDir = constants.KubernetesDir + "tke-apiserver-restart"
+)
+
+// EnsureAuditPolicy rolls out the audit policy of the cluster master by
+// master. kube-apiserver is only restarted on the masters whose policy file is
+// changed. The rollout is all or nothing: a master failing to become healthy
+// with the new policy is rolled back together with the masters switched
+// before it. The result is recorded in the status of the AuditPolicy. The
+// default policy is rolled out if the cluster is created without one, or
+// again after the AuditPolicy is deleted. It runs on update as well to roll
+// out the changed AuditPolicy.
+func (p *Provider) EnsureAuditPolicy(ctx context.Context, c *v1.Cluster) error {
+	if !p.Config.AuditEnabled() || p.PlatformClient == nil {
+		return nil
+	}
+	policy, err := p.getAuditPolicy(ctx, c)
+	if err != nil {
+		return err
+	}
+	// a failed generation is not retried until the spec is changed
+	if policy != nil && policy.Status.Phase != platformv1.AuditPolicyPending &&
+		policy.Status.ObservedGeneration == policy.Generation {
+		return nil
+	}
+	data, err := renderAuditPolicy(policy)
+	if err != nil {
+		return err
+	}
+	hash := auditPolicyHash(data)
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	// the default policy of a running cluster is only rolled out again if the
+	// masters run the policy of a deleted AuditPolicy
+	if policy == nil && c.Status.Phase == platformv1.ClusterRunning {
+		rolledOut, err := auditPolicyRolledOut(ctx, client, c, hash)
+		if err != nil || rolledOut {
+			return err
+		}
+		log.FromContext(ctx).Info("Restoring the default audit policy of the deleted AuditPolicy")
+	}
+	defer p.startRollout(c.Name)()
+
+	var switched []auditPolicySwitch
+	for _, machine := range c.Spec.Machines {
+		var one *auditPolicySwitch
+		one, err = p.reconfigureAuditPolicy(ctx, client, machine, data)
+		if err != nil {
+			err = errors.Wrap(err, machine.IP)
+			if rollbackErr := p.rollbackAuditPolicy(ctx, client, switched); rollbackErr != nil {
+				err = fmt.Errorf("rollback switched masters error: %v, reconfigure error: %w", rollbackErr, err)
+			}
+			break
+		}
+		if one != nil {
+			switched = append(switched, *one)
+		}
+	}
+	if err == nil {
+		err = recordAuditPolicyHash(ctx, client, c, hash)
+	}
+	if policy == nil {
+		return err
+	}
+
+	policy.Status.ObservedGeneration = policy.Generation
+	policy.Status.LastTransitionTime = metav1.Now()
+	if err != nil {
+		policy.Status.Phase = platformv1.AuditPolicyFailed
+		policy.Status.Message = err.Error()
+	} else {
+		policy.Status.Phase = platformv1.AuditPolicyApplied
+		policy.Status.Message = ""
+	}
+	if _, updateErr := p.PlatformClient.AuditPolicies().UpdateStatus(ctx, policy, metav1.UpdateOptions{}); updateErr != nil {
+		if err != nil {
+			return fmt.Errorf("update audit policy status error: %v, rollout error: %w", updateErr, err)
+		}
+		return updateErr
+	}
+
+	return err
+}
+
+// auditPolicySwitch is a master switched to the new audit policy.
+type auditPolicySwitch struct {
+	machine  platformv1.ClusterMachine
+	previous []byte
+}
+
+// rollbackAuditPolicy restores the previous audit policy on the switched
+// masters in the reverse order.
+func (p *Provider) rollbackAuditPolicy(ctx context.Context, client kubernetes.Interface, switched []auditPolicySwitch) error {
+	var errs []error
+	for i := len(switched) - 1; i >= 0; i-- {
+		one := switched[i]
+		log.FromContext(ctx).Info("Rolling back audit policy", "node", one.machine.IP)
+		if len(one.previous) == 0 {
+			errs = append(errs, fmt.Errorf("%s: no audit policy to roll back to", one.machine.IP))
+			continue
+		}
+		if _, err := p.reconfigureAuditPolicy(ctx, client, one.machine, one.previous); err != nil {
+			errs = append(errs, errors.Wrap(err, one.machine.IP))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// auditPolicyRolledOut tells whether the masters run the audit policy with
+// the hash. A master without the hash recorded is considered running the
+// default policy, which it was created with.
+func auditPolicyRolledOut(ctx context.Context, client kubernetes.Interface, c *v1.Cluster, hash string) (bool, error) {
+	for _, machine := range c.Spec.Machines {
+		node, err := apiclient.GetNodeByMachineIP(ctx, client, machine.IP)
+		if err != nil {
+			return false, errors.Wrap(err, machine.IP)
+		}
+		if rolledOut, ok := node.Annotations[constants.AnnotationAuditPolicyHash]; ok && rolledOut != hash {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// recordAuditPolicyHash records the hash of the audit policy rolled out on
+// the masters.
+func recordAuditPolicyHash(ctx context.Context, client kubernetes.Interface, c *v1.Cluster, hash string) error {
+	for _, machine := range c.Spec.Machines {
+		node, err := apiclient.GetNodeByMachineIP(ctx, client, machine.IP)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		if node.Annotations[constants.AnnotationAuditPolicyHash] == hash {
+			continue
+		}
+		err = patchNodeAnnotations(ctx, client, node.Name, map[string]interface{}{
+			constants.AnnotationAuditPolicyHash: hash,
+		})
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+	}
+	return nil
+}
+
+func auditPolicyHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// getAuditPolicy returns the AuditPolicy of the cluster, nil is returned if
+// the cluster has none.
+func (p *Provider) getAuditPolicy(ctx context.Context, c *v1.Cluster) (*platformv1.AuditPolicy, error) {
+	if p.PlatformClient == nil {
+		return nil, nil
+	}
+	policies, err := p.PlatformClient.AuditPolicies().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", c.Name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+	return &policies.Items[0], nil
+}
+
+// renderAuditPolicy returns the audit policy file of the AuditPolicy, the
+// default policy shipped with the provider is returned if the AuditPolicy is
+// nil or has no rules.
+func renderAuditPolicy(policy *platformv1.AuditPolicy) ([]byte, error) {
+	if policy == nil || len(policy.Spec.Rules) == 0 {
+		return ioutil.ReadFile(constants.AuditPolicyConfigFile)
+	}
+	return yaml.Marshal(convertAuditPolicy(&policy.Spec))
+}
+
+// convertAuditPolicy converts the spec of an AuditPolicy to the policy read
+// by kube-apiserver.
+func convertAuditPolicy(spec *platformv1.AuditPolicySpec) *auditv1.Policy {
+	policy := &auditv1.Policy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: auditv1.SchemeGroupVersion.String(),
+			Kind:       "Policy",
+		},
+		OmitStages: convertAuditStages(spec.OmitStages),
+	}
+	for _, rule := range spec.Rules {
+		r := auditv1.PolicyRule{
+			Level:           auditv1.Level(rule.Level),
+			Users:           rule.Users,
+			UserGroups:      rule.UserGroups,
+			Verbs:           rule.Verbs,
+			Namespaces:      rule.Namespaces,
+			NonResourceURLs: rule.NonResourceURLs,
+			OmitStages:      convertAuditStages(rule.OmitStages),
+		}
+		for _, resources := range rule.Resources {
+			r.Resources = append(r.Resources, auditv1.GroupResources{
+				Group:         resources.Group,
+				Resources:     resources.Resources,
+				ResourceNames: resources.ResourceNames,
+			})
+		}
+		policy.Rules = append(policy.Rules, r)
+	}
+	return policy
+}
+
+func convertAuditStages(stages []platformv1.AuditStage) []auditv1.Stage {
+	var result []auditv1.Stage
+	for _, stage := range stages {
+		result = append(result, auditv1.Stage(stage))
+	}
+	return result
+}
+
+// reconfigureAuditPolicy writes the audit policy on the master, restarts
+// kube-apiserver and restores the previous policy if kube-apiserver is not
+// healthy with the new one. It returns the switch of the master, nil is
+// returned if the policy is not changed.
+func (p *Provider) reconfigureAuditPolicy(ctx context.Context, client kubernetes.Interface,
+	machine platformv1.ClusterMachine, data []byte) (*auditPolicySwitch, error) {
+	s, err := machine.SSHWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.ReadFile(constants.KubernetesAuditPolicyConfigFile)
+	if err == nil && bytes.Equal(current, data) {
+		return nil, nil
+	}
+	node, err := apiclient.GetNodeByMachineIP(ctx, client, machine.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	log.FromContext(ctx).Info("Reconfiguring audit policy", "node", machine.IP)
+	if len(current) != 0 {
+		if err := s.WriteFile(bytes.NewReader(current), auditPolicyBackupFile); err != nil {
+			return nil, errors.Wrap(err, "backup audit policy error")
+		}
+	}
+	err = s.WriteFile(bytes.NewReader(data), constants.KubernetesAuditPolicyConfigFile)
+	if err == nil {
+		err = restartAPIServer(ctx, s, client, node.Name)
+	}
+	if err == nil {
+		_, _ = s.CombinedOutput(fmt.Sprintf("rm -f %s", auditPolicyBackupFile))
+		return &auditPolicySwitch{machine: machine, previous: current}, nil
+	}
+
+	log.FromContext(ctx).Error(err, "Reconfigure audit policy failed, rolling back", "node", machine.IP)
+	if len(current) == 0 {
+		return nil, fmt.Errorf("no audit policy to roll back to, reconfigure error: %w", err)
+	}
+	if _, rollbackErr := s.CombinedOutput(fmt.Sprintf("mv -f %s %s", auditPolicyBackupFile, constants.KubernetesAuditPolicyConfigFile)); rollbackErr != nil {
+		return nil, fmt.Errorf("rollback audit policy error: %v, reconfigure error: %w", rollbackErr, err)
+	}
+	if restartErr := restartAPIServer(ctx, s, client, node.Name); restartErr != nil {
+		return nil, fmt.Errorf("restart kube-apiserver after rollback error: %v, reconfigure error: %w", restartErr, err)
+	}
+
+	return nil, fmt.Errorf("rolled back after reconfigure error: %w", err)
+}
+
+// restartAPIServer restarts the kube-apiserver static pod on the master by
+// moving its manifest out of the manifest dir until kubelet stops it, the
+// static pod is not recreated by kubelet if only the files it reads change.
+func restartAPIServer(ctx context.Context, s ssh.Interface, client kubernetes.Interface, nodeName string) error {
+	manifest := constants.KubeAPIServerPodManifestFile
+	backup := path.Join(apiServerManifestBackupDir, path.Base(manifest))
+	cmd := fmt.Sprintf("mkdir -p %s && mv -f %s %s", apiServerManifestBackupDir, manifest, backup)
+	if _, err := s.CombinedOutput(cmd); err != nil {
+		return errors.Wrap(err, "move kube-apiserver manifest error")
+	}
+	stopErr := wait.PollImmediate(time.Second, 2*time.Minute, func() (bool, error) {
+		_, _, exit, err := s.Exec("pgrep -x kube-apiserver")
+		if err != nil {
+			return false, nil
+		}
+		return exit != 0, nil
+	})
+	if _, err := s.CombinedOutput(fmt.Sprintf("mv -f %s %s", backup, manifest)); err != nil {
+		return errors.Wrap(err, "restore kube-apiserver manifest error")
+	}
+	if stopErr != nil {
+		return errors.Wrap(stopErr, "wait for kube-apiserver to stop error")
+	}
+	if err := kubeadm.WaitForAPIServer(s); err != nil {
+		return errors.Wrap(err, "wait for kube-apiserver error")
+	}
+
+	return waitForStaticPod(ctx, client, nodeName, staticPods[0], "", map[string]string{
+		"audit-policy-file": constants.KubernetesAuditPolicyConfigFile,
+	})
+}
@@ -0,0 +1,129 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func TestRenderAuditPolicy(t *testing.T) {
+	policy := &platformv1.AuditPolicy{
+		Spec: platformv1.AuditPolicySpec{
+			ClusterName: "cls-test",
+			OmitStages:  []platformv1.AuditStage{platformv1.AuditStageRequestReceived},
+			Rules: []platformv1.AuditPolicyRule{
+				{
+					Level: platformv1.AuditLevelNone,
+					Resources: []platformv1.AuditGroupResources{
+						{Group: "coordination.k8s.io", Resources: []string{"leases"}},
+					},
+				},
+				{
+					Level:      platformv1.AuditLevelMetadata,
+					Namespaces: []string{"default"},
+				},
+			},
+		},
+	}
+	data, err := renderAuditPolicy(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `apiVersion: audit.k8s.io/v1
+kind: Policy
+metadata:
+  creationTimestamp: null
+omitStages:
+- RequestReceived
+rules:
+- level: None
+  resources:
+  - group: coordination.k8s.io
+    resources:
+    - leases
+- level: Metadata
+  namespaces:
+  - default
+`
+	if string(data) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, data)
+	}
+}
+
+func TestAuditPolicyRolledOut(t *testing.T) {
+	c := &v1.Cluster{Cluster: &platformv1.Cluster{
+		Spec: platformv1.ClusterSpec{
+			Machines: []platformv1.ClusterMachine{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+		},
+	}}
+	newNode := func(name, hash string) *corev1.Node {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if hash != "" {
+			node.Annotations = map[string]string{constants.AnnotationAuditPolicyHash: hash}
+		}
+		return node
+	}
+	tests := []struct {
+		name  string
+		nodes []*corev1.Node
+		want  bool
+	}{
+		{"created with the default policy", []*corev1.Node{newNode("10.0.0.1", ""), newNode("10.0.0.2", "")}, true},
+		{"default policy rolled out", []*corev1.Node{newNode("10.0.0.1", "default"), newNode("10.0.0.2", "default")}, true},
+		{"policy of a deleted AuditPolicy", []*corev1.Node{newNode("10.0.0.1", "default"), newNode("10.0.0.2", "custom")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(tt.nodes[0], tt.nodes[1])
+			got, err := auditPolicyRolledOut(context.TODO(), client, c, "default")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("auditPolicyRolledOut() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordAuditPolicyHash(t *testing.T) {
+	c := &v1.Cluster{Cluster: &platformv1.Cluster{
+		Spec: platformv1.ClusterSpec{
+			Machines: []platformv1.ClusterMachine{{IP: "10.0.0.1"}},
+		},
+	}}
+	client := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "10.0.0.1"}})
+	if err := recordAuditPolicyHash(context.TODO(), client, c, "custom"); err != nil {
+		t.Fatal(err)
+	}
+	if rolledOut, _ := auditPolicyRolledOut(context.TODO(), client, c, "custom"); !rolledOut {
+		t.Errorf("expected the recorded policy to be rolled out")
+	}
+	if rolledOut, _ := auditPolicyRolledOut(context.TODO(), client, c, "default"); rolledOut {
+		t.Errorf("expected the default policy not to be rolled out")
+	}
+}
@@ -0,0 +1,479 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/containerd"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// containerdBackupFile keeps the containerd config during reconfiguring
+	containerdBackupFile = containerd.ConfigFile + ".tke-backup"
+	// conditionTypeContainerRuntimeConfigRolledBack is the condition raised on
+	// the cluster while the container runtime config in the spec is rolled back.
+	conditionTypeContainerRuntimeConfigRolledBack = "ContainerRuntimeConfigRolledBack"
+)
+
+// EnsureContainerRuntimeConfig rolls out the changed containerd config node by
+// node, the masters first and then the worker machines of the cluster. The
+// hash of the container runtime config rolled out is recorded on the node, so
+// containerd is only restarted on the nodes whose config is changed in the
+// spec. The rollout is all or nothing: a node not becoming ready with the new
+// config is rolled back together with the nodes switched before it, and the
+// cluster keeps the ContainerRuntimeConfigRolledBack condition until the
+// failed config is changed in the spec.
+func (p *Provider) EnsureContainerRuntimeConfig(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.ContainerRuntime == platformv1.Docker {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	nodes, err := p.getContainerdNodes(ctx, c)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		object, err := apiclient.GetNodeByMachineIP(ctx, client, node.IP)
+		if err != nil {
+			return errors.Wrap(err, node.IP)
+		}
+		if object.Annotations[constants.AnnotationContainerRuntimeConfigFailedHash] == containerd.ConfigHash(node.config) {
+			log.FromContext(ctx).Info("Skip reconfiguring containerd with the config rolled back", "node", node.IP)
+			// keep the reason of the rollback raised in the rollout
+			if c.GetCondition(conditionTypeContainerRuntimeConfigRolledBack) == nil {
+				setRolledBackCondition(c.Cluster, conditionTypeContainerRuntimeConfigRolledBack,
+					fmt.Sprintf("%s: %v, it is not rolled out again until it is changed", node.IP, errContainerdRolledBack))
+			}
+			return nil
+		}
+	}
+	setRolledBackCondition(c.Cluster, conditionTypeContainerRuntimeConfigRolledBack, "")
+	defer p.startRollout(c.Name)()
+
+	var switched []*containerdSwitch
+	for _, node := range nodes {
+		one, err := p.reconfigureContainerd(ctx, client, node, false)
+		if err != nil {
+			err = errors.Wrap(err, node.IP)
+			if errors.Is(err, errContainerdRolledBack) {
+				setRolledBackCondition(c.Cluster, conditionTypeContainerRuntimeConfigRolledBack, err.Error())
+			}
+			if rollbackErr := rollbackContainerd(ctx, client, switched); rollbackErr != nil {
+				return fmt.Errorf("rollback switched nodes error: %v, reconfigure error: %w", rollbackErr, err)
+			}
+			return err
+		}
+		if one != nil {
+			switched = append(switched, one)
+		}
+	}
+	for _, one := range switched {
+		removeContainerdBackup(ctx, one.node)
+	}
+
+	return nil
+}
+
+// containerdNode is a node of the cluster with the containerd config it is
+// expected to run with.
+type containerdNode struct {
+	platformv1.ClusterMachine
+	// config is the container runtime config of the node merged from the
+	// cluster and the machine.
+	config platformv1.ContainerRuntimeConfig
+	// option renders the config file the same way as the node is installed.
+	option *containerd.Option
+}
+
+// getContainerdNodes returns the masters and the running worker machines of
+// the cluster, the worker machines are converted to cluster machines.
+func (p *Provider) getContainerdNodes(ctx context.Context, c *v1.Cluster) ([]containerdNode, error) {
+	var nodes []containerdNode
+	for _, machine := range c.Spec.Machines {
+		nodes = append(nodes, p.masterContainerdNode(c, machine))
+	}
+	if p.PlatformClient == nil {
+		return nodes, nil
+	}
+	workers, err := p.PlatformClient.Machines().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, worker := range workers.Items {
+		if worker.Status.Phase != platformv1.MachineRunning {
+			continue
+		}
+		nodes = append(nodes, p.workerContainerdNode(c, &worker))
+	}
+
+	return nodes, nil
+}
+
+// workerContainerdNode returns the worker machine converted to a cluster
+// machine with the containerd config of it.
+func (p *Provider) workerContainerdNode(c *v1.Cluster, worker *platformv1.Machine) containerdNode {
+	return containerdNode{
+		ClusterMachine: platformv1.ClusterMachine{
+			IP:                     worker.Spec.IP,
+			Port:                   worker.Spec.Port,
+			Username:               worker.Spec.Username,
+			Password:               worker.Spec.Password,
+			PrivateKey:             worker.Spec.PrivateKey,
+			PassPhrase:             worker.Spec.PassPhrase,
+			Labels:                 worker.Spec.Labels,
+			CredentialName:         worker.Spec.CredentialName,
+			ContainerRuntimeConfig: worker.Spec.ContainerRuntimeConfig,
+		},
+		config: containerd.MergeConfig(c.Spec.ContainerRuntimeConfig, worker.Spec.ContainerRuntimeConfig),
+		option: containerd.MachineOption(p.Config.Registry.Domain, &worker.Spec, c.Spec.ContainerRuntimeConfig),
+	}
+}
+
+// masterContainerdNode returns the master with the containerd config of it.
+func (p *Provider) masterContainerdNode(c *v1.Cluster, machine platformv1.ClusterMachine) containerdNode {
+	return containerdNode{
+		ClusterMachine: machine,
+		config:         containerd.MergeConfig(c.Spec.ContainerRuntimeConfig, machine.ContainerRuntimeConfig),
+		option:         p.getContainerdOption(c, machine),
+	}
+}
+
+// errContainerdRolledBack is returned when the config of a node is rolled
+// back, the rollout stops until the config is changed.
+var errContainerdRolledBack = errors.New("container runtime config has been rolled back")
+
+// containerdSwitch is a node switched to the new containerd config, the
+// previous config is kept in the backup file until the rollout completes.
+type containerdSwitch struct {
+	node     containerdNode
+	nodeName string
+	// annotations are the config hash annotations of the node before it is
+	// switched.
+	annotations map[string]string
+}
+
+// reconfigureContainerd writes the rendered containerd config on the node if
+// the container runtime config of the node is changed since it was rolled
+// out, restarts containerd and restores the previous config if the node is
+// not ready again. The hash of the config is recorded on the node after it is
+// rolled out or rolled back. With force the config file is compared even if
+// the config is rolled out, which corrects the drift of the file. The node is
+// returned if it is switched to the new config.
+func (p *Provider) reconfigureContainerd(ctx context.Context, client kubernetes.Interface, node containerdNode, force bool) (*containerdSwitch, error) {
+	object, err := apiclient.GetNodeByMachineIP(ctx, client, node.IP)
+	if err != nil {
+		return nil, err
+	}
+	hash := containerd.ConfigHash(node.config)
+	switch hash {
+	case object.Annotations[constants.AnnotationContainerRuntimeConfigFailedHash]:
+		log.FromContext(ctx).Info("Skip reconfiguring containerd with the config rolled back", "node", node.IP)
+		return nil, errContainerdRolledBack
+	case object.Annotations[constants.AnnotationContainerRuntimeConfigHash]:
+		if !force {
+			return nil, nil
+		}
+	}
+
+	s, err := node.SSHWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := containerd.Config(node.option)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := s.ReadFile(containerd.ConfigFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s error", containerd.ConfigFile)
+	}
+	// A node without recorded hash is installed before the hash is recorded,
+	// it has the default config unless the spec says otherwise.
+	_, recorded := object.Annotations[constants.AnnotationContainerRuntimeConfigHash]
+	if bytes.Equal(bytes.TrimSpace(actual), bytes.TrimSpace(expected)) ||
+		(!force && !recorded && reflect.DeepEqual(node.config, platformv1.ContainerRuntimeConfig{})) {
+		return nil, setContainerdConfigHash(ctx, client, object.Name, constants.AnnotationContainerRuntimeConfigHash, hash)
+	}
+
+	one := &containerdSwitch{node: node, nodeName: object.Name, annotations: map[string]string{}}
+	for _, key := range []string{constants.AnnotationContainerRuntimeConfigHash, constants.AnnotationContainerRuntimeConfigFailedHash} {
+		if value, ok := object.Annotations[key]; ok {
+			one.annotations[key] = value
+		}
+	}
+	err = writeContainerdConfig(ctx, s, client, node.IP, expected)
+	if err == nil {
+		err = setContainerdConfigHash(ctx, client, object.Name, constants.AnnotationContainerRuntimeConfigHash, hash)
+		if err == nil {
+			return one, nil
+		}
+		if rollbackErr := rollbackContainerd(ctx, client, []*containerdSwitch{one}); rollbackErr != nil {
+			return nil, fmt.Errorf("rollback error: %v, record rolled out config error: %w", rollbackErr, err)
+		}
+		return nil, errors.Wrap(err, "record rolled out config error")
+	}
+	if recordErr := setContainerdConfigHash(ctx, client, object.Name, constants.AnnotationContainerRuntimeConfigFailedHash, hash); recordErr != nil {
+		return nil, fmt.Errorf("record rolled back config error: %v, reconfigure error: %w", recordErr, err)
+	}
+	return nil, err
+}
+
+// writeContainerdConfig writes the config on the node, restarts containerd
+// and restores the previous config if the node is not ready again. The
+// previous config is kept in the backup file after the config is written.
+func writeContainerdConfig(ctx context.Context, s ssh.Interface, client kubernetes.Interface, ip string, config []byte) error {
+	log.FromContext(ctx).Info("Reconfiguring containerd", "node", ip)
+	if _, err := s.CombinedOutput(fmt.Sprintf("cp -f %s %s", containerd.ConfigFile, containerdBackupFile)); err != nil {
+		return errors.Wrap(err, "backup containerd config error")
+	}
+	err := s.WriteFile(bytes.NewReader(config), containerd.ConfigFile)
+	if err == nil {
+		err = restartContainerd(ctx, s, client, ip)
+	}
+	if err == nil {
+		return nil
+	}
+
+	log.FromContext(ctx).Error(err, "Reconfigure containerd failed, rolling back", "node", ip)
+	if rollbackErr := restoreContainerdConfig(ctx, s, client, ip); rollbackErr != nil {
+		return fmt.Errorf("%v, reconfigure error: %w", rollbackErr, err)
+	}
+
+	return fmt.Errorf("%w after reconfigure error: %v", errContainerdRolledBack, err)
+}
+
+// restoreContainerdConfig restores the config from the backup file on the node
+// and restarts containerd, the backup file is removed after the node is ready.
+func restoreContainerdConfig(ctx context.Context, s ssh.Interface, client kubernetes.Interface, ip string) error {
+	if _, err := s.CombinedOutput(fmt.Sprintf("cp -f %s %s", containerdBackupFile, containerd.ConfigFile)); err != nil {
+		return errors.Wrap(err, "rollback containerd config error")
+	}
+	if err := restartContainerd(ctx, s, client, ip); err != nil {
+		return errors.Wrap(err, "restart containerd after rollback error")
+	}
+	_, _ = s.CombinedOutput(fmt.Sprintf("rm -f %s", containerdBackupFile))
+	return nil
+}
+
+// rollbackContainerd restores the previous config and config hash annotations
+// of the switched nodes, the last switched first.
+func rollbackContainerd(ctx context.Context, client kubernetes.Interface, switched []*containerdSwitch) error {
+	var errs []error
+	for i := len(switched) - 1; i >= 0; i-- {
+		one := switched[i]
+		log.FromContext(ctx).Info("Rolling back containerd", "node", one.node.IP)
+		s, err := one.node.SSHWithContext(ctx)
+		if err == nil {
+			err = restoreContainerdConfig(ctx, s, client, one.node.IP)
+		}
+		if err == nil {
+			err = restoreContainerdConfigHash(ctx, client, one.nodeName, one.annotations)
+		}
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, one.node.IP))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// removeContainerdBackup removes the backup file of the switched node after
+// the rollout completes, a failure is only logged.
+func removeContainerdBackup(ctx context.Context, node containerdNode) {
+	s, err := node.SSHWithContext(ctx)
+	if err == nil {
+		_, err = s.CombinedOutput(fmt.Sprintf("rm -f %s", containerdBackupFile))
+	}
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Remove containerd config backup failed", "node", node.IP)
+	}
+}
+
+// setContainerdConfigHash records the hash of config on the node by the
+// annotation key, the other one of the two annotations is removed.
+func setContainerdConfigHash(ctx context.Context, client kubernetes.Interface, nodeName string, key string, hash string) error {
+	other := constants.AnnotationContainerRuntimeConfigFailedHash
+	if key == other {
+		other = constants.AnnotationContainerRuntimeConfigHash
+	}
+	return patchNodeAnnotations(ctx, client, nodeName, map[string]interface{}{key: hash, other: nil})
+}
+
+// restoreContainerdConfigHash restores the config hash annotations of the node
+// to the ones before it was switched.
+func restoreContainerdConfigHash(ctx context.Context, client kubernetes.Interface, nodeName string, annotations map[string]string) error {
+	patch := map[string]interface{}{}
+	for _, key := range []string{constants.AnnotationContainerRuntimeConfigHash, constants.AnnotationContainerRuntimeConfigFailedHash} {
+		patch[key] = nil
+		if value, ok := annotations[key]; ok {
+			patch[key] = value
+		}
+	}
+	return patchNodeAnnotations(ctx, client, nodeName, patch)
+}
+
+func patchNodeAnnotations(ctx context.Context, client kubernetes.Interface, nodeName string, annotations map[string]interface{}) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = client.CoreV1().Nodes().Patch(ctx, nodeName, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// restartContainerd restarts containerd and waits until it is active, its CRI
+// reports the runtime and network ready, and the node is ready. The Ready
+// condition of the node alone lags behind a runtime broken by the restart.
+func restartContainerd(ctx context.Context, s ssh.Interface, client kubernetes.Interface, ip string) error {
+	if _, err := s.CombinedOutput("systemctl restart containerd"); err != nil {
+		return err
+	}
+
+	var lastErr error
+	err := wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
+		if _, err := s.CombinedOutput("systemctl is-active containerd"); err != nil {
+			lastErr = fmt.Errorf("containerd is not active: %w", err)
+			return false, nil
+		}
+		if err := criReady(s); err != nil {
+			lastErr = err
+			return false, nil
+		}
+		node, err := apiclient.GetNodeByMachineIP(ctx, client, ip)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		for _, one := range node.Status.Conditions {
+			if one.Type == corev1.NodeReady && one.Status == corev1.ConditionTrue {
+				return true, nil
+			}
+		}
+		lastErr = fmt.Errorf("node %s is not ready", node.Name)
+		return false, nil
+	})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("wait for containerd error: %w", lastErr)
+	}
+	return err
+}
+
+// criReady returns an error unless the CRI of the node reports all of its
+// conditions, RuntimeReady and NetworkReady, true.
+func criReady(s ssh.Interface) error {
+	out, err := s.CombinedOutput("crictl info")
+	if err != nil {
+		return fmt.Errorf("get CRI status error: %w", err)
+	}
+	info := struct {
+		Status struct {
+			Conditions []struct {
+				Type    string `json:"type"`
+				Status  bool   `json:"status"`
+				Reason  string `json:"reason"`
+				Message string `json:"message"`
+			} `json:"conditions"`
+		} `json:"status"`
+	}{}
+	if err := json.Unmarshal(out, &info); err != nil {
+		return fmt.Errorf("parse CRI status error: %w", err)
+	}
+	if len(info.Status.Conditions) == 0 {
+		return errors.New("CRI reports no condition")
+	}
+	for _, one := range info.Status.Conditions {
+		if !one.Status {
+			return fmt.Errorf("CRI condition %s is false: %s %s", one.Type, one.Reason, one.Message)
+		}
+	}
+	return nil
+}
+
+// EnsureRuntimeClasses creates a RuntimeClass object for each runtime class of
+// the container runtime config and deletes the ones removed from it.
+func (p *Provider) EnsureRuntimeClasses(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.ContainerRuntime == platformv1.Docker {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+
+	expected := map[string]bool{}
+	if c.Spec.ContainerRuntimeConfig != nil {
+		for _, one := range c.Spec.ContainerRuntimeConfig.RuntimeClasses {
+			expected[one.Name] = true
+			err := apiclient.CreateOrUpdateRuntimeClass(ctx, client, &nodev1.RuntimeClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   one.Name,
+					Labels: map[string]string{constants.LabelRuntimeClassManaged: "true"},
+				},
+				Handler: one.Name,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	runtimeClasses, err := client.NodeV1().RuntimeClasses().List(ctx, metav1.ListOptions{LabelSelector: constants.LabelRuntimeClassManaged})
+	if err != nil {
+		return err
+	}
+	for _, one := range runtimeClasses.Items {
+		if expected[one.Name] {
+			continue
+		}
+		err := client.NodeV1().RuntimeClasses().Delete(ctx, one.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
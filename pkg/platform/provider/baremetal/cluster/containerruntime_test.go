@@ -0,0 +1,58 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"testing"
+
+	"tkestack.io/tke/pkg/util/ssh/sshtest"
+)
+
+func TestCRIReady(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    string
+		wantErr bool
+	}{
+		{
+			name: "ready",
+			info: `{"status":{"conditions":[{"type":"RuntimeReady","status":true},{"type":"NetworkReady","status":true}]}}`,
+		},
+		{
+			name:    "runtime not ready",
+			info:    `{"status":{"conditions":[{"type":"RuntimeReady","status":false,"reason":"ContainerdNotReady"},{"type":"NetworkReady","status":true}]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "no condition",
+			info:    `{"status":{}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &sshtest.Fake{CombinedOutputFunc: func(cmd string) ([]byte, error) {
+				return []byte(tt.info), nil
+			}}
+			if err := criReady(s); (err != nil) != tt.wantErr {
+				t.Errorf("criReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
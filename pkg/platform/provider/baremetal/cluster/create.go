@@ -43,10 +43,12 @@ import (
 	"github.com/thoas/go-funk"
 	"helm.sh/helm/v3/pkg/release"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	bootstraputil "k8s.io/cluster-bootstrap/token/util"
@@ -59,6 +61,7 @@ import (
 	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/addons/cniplugins"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/authzwebhook"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/calico"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/containerd"
 	csioperatorimage "tkestack.io/tke/pkg/platform/provider/baremetal/phases/csioperator/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/docker"
@@ -70,6 +73,9 @@ import (
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeconfig"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubelet"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubevip"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/metallb"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/ospackage"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/thirdpartyha"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/preflight"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
@@ -97,11 +103,15 @@ func (p *Provider) EnsureCopyFiles(ctx context.Context, c *v1.Cluster) error {
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	for _, file := range c.Spec.Features.Files {
 		for _, machine := range machines {
-			machineSSH, err := machine.SSH()
+			machineSSH, err := machine.SSHWithContext(ctx)
 			if err != nil {
 				return err
 			}
-			s, err := os.Stat(file.Src)
+			src, err := res.SourceForNode(machineSSH, file.Src)
+			if err != nil {
+				return errors.Wrap(err, machine.IP)
+			}
+			s, err := os.Stat(src)
 			if err != nil {
 				return err
 			}
@@ -109,12 +119,12 @@ func (p *Provider) EnsureCopyFiles(ctx context.Context, c *v1.Cluster) error {
 				if err != nil {
 					return err
 				}
-				err = machineSSH.CopyDir(file.Src, file.Dst)
+				err = machineSSH.CopyDir(src, file.Dst)
 				if err != nil {
 					return err
 				}
 			} else {
-				err = machineSSH.CopyFile(file.Src, file.Dst)
+				err = machineSSH.CopyFile(src, file.Dst)
 				if err != nil {
 					return err
 				}
@@ -153,7 +163,7 @@ func (p *Provider) EnsurePreflight(ctx context.Context, c *v1.Cluster) error {
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -179,7 +189,7 @@ func (p *Provider) EnsureRegistryHosts(ctx context.Context, c *v1.Cluster) error
 	}
 	domains = append(domains, constants.MirrorsRegistryHostName)
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -208,7 +218,7 @@ func (p *Provider) EnsureKernelModule(ctx context.Context, c *v1.Cluster) error
 	for _, machine := range machines {
 		modules := []string{"iptable_nat", "ip_vs", "ip_vs_rr", "ip_vs_wrr", "ip_vs_sh"}
 
-		s, err := machine.SSH()
+		s, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -234,7 +244,7 @@ func (p *Provider) EnsureKernelModule(ctx context.Context, c *v1.Cluster) error
 
 func (p *Provider) EnsureSysctl(ctx context.Context, c *v1.Cluster) error {
 	for _, machine := range c.Spec.Machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -271,7 +281,7 @@ func (p *Provider) EnsureDisableSwap(ctx context.Context, c *v1.Cluster) error {
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -285,6 +295,26 @@ func (p *Provider) EnsureDisableSwap(ctx context.Context, c *v1.Cluster) error {
 	return nil
 }
 
+func (p *Provider) EnsureOSPackages(ctx context.Context, c *v1.Cluster) error {
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	for _, machine := range machines {
+		machineSSH, err := machine.SSHWithContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		option := &ospackage.Option{Repository: p.Config.OSPackages.Repository}
+		err = ospackage.Install(machineSSH, option)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+	}
+
+	return nil
+}
+
 // 因为validate那里没法更新对象（不能存储）
 // PreCrete，在api中错误只能panic，响应不会有报错提示，所以只能挪到这里处理
 func (p *Provider) EnsureClusterComplete(ctx context.Context, cluster *v1.Cluster) error {
@@ -413,6 +443,9 @@ func completePlatformClusterAddresses(cluster *platformv1.Cluster) error {
 		if cluster.Spec.Features.HA.ThirdPartyHA != nil {
 			cluster.AddAddress(platformv1.AddressAdvertise, cluster.Spec.Features.HA.ThirdPartyHA.VIP, cluster.Spec.Features.HA.ThirdPartyHA.VPort)
 		}
+		if cluster.Spec.Features.HA.KubeVIPHA != nil {
+			cluster.AddAddress(platformv1.AddressAdvertise, cluster.Spec.Features.HA.KubeVIPHA.VIP, 6443)
+		}
 	}
 
 	return nil
@@ -440,7 +473,7 @@ func completeCredential(cluster *v1.Cluster) error {
 
 func (p *Provider) EnsureKubeconfig(ctx context.Context, c *v1.Cluster) error {
 	for _, machine := range c.Spec.Machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -468,7 +501,7 @@ func (p *Provider) EnsureNvidiaDriver(ctx context.Context, c *v1.Cluster) error
 		if !gpu.IsEnable(machine.Labels) {
 			continue
 		}
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -490,7 +523,7 @@ func (p *Provider) EnsureNvidiaContainerRuntime(ctx context.Context, c *v1.Clust
 		if !gpu.IsEnable(machine.Labels) {
 			continue
 		}
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -518,7 +551,7 @@ func (p *Provider) getImagePrefix(c *v1.Cluster) string {
 	return containerregistryutil.GetPrefix()
 }
 
-func (p *Provider) EnsureContainerd(ctx context.Context, c *v1.Cluster) error {
+func (p *Provider) getContainerdOption(c *v1.Cluster, machine platformv1.ClusterMachine) *containerd.Option {
 	insecureRegistries := []string{p.Config.Registry.Domain}
 	if c.Spec.TenantID != "" {
 		insecureRegistries = append(insecureRegistries, c.Spec.TenantID+"."+p.Config.Registry.Domain)
@@ -526,17 +559,23 @@ func (p *Provider) EnsureContainerd(ctx context.Context, c *v1.Cluster) error {
 	prefix := p.getImagePrefix(c)
 	option := &containerd.Option{
 		InsecureRegistries: insecureRegistries,
+		IsGPU:              gpu.IsEnable(machine.Labels),
 		SandboxImage:       path.Join(prefix, images.Get().Pause.BaseName()),
 		// for mirror, we just need domain in prefix
-		RegistryMirror: strings.Split(prefix, "/")[0],
+		RegistryMirrors: map[string][]string{strings.Split(prefix, "/")[0]: {"http://mirrors.tke.com"}},
 	}
+	option.ApplyConfig(containerd.MergeConfig(c.Spec.ContainerRuntimeConfig, machine.ContainerRuntimeConfig))
+	return option
+}
+
+func (p *Provider) EnsureContainerd(ctx context.Context, c *v1.Cluster) error {
 	for _, machine := range c.Spec.Machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
 
-		option.IsGPU = gpu.IsEnable(machine.Labels)
+		option := p.getContainerdOption(c, machine)
 		err = containerd.Install(machineSSH, option)
 		if err != nil {
 			return errors.Wrap(err, machine.IP)
@@ -546,23 +585,27 @@ func (p *Provider) EnsureContainerd(ctx context.Context, c *v1.Cluster) error {
 	return nil
 }
 
-func (p *Provider) EnsureDocker(ctx context.Context, c *v1.Cluster) error {
-	machines := map[bool][]platformv1.ClusterMachine{
-		true:  c.Spec.ScalingMachines,
-		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+func (p *Provider) getDockerOption(c *v1.Cluster) *docker.Option {
 	insecureRegistries := fmt.Sprintf(`"%s"`, p.Config.Registry.Domain)
 	if c.Spec.TenantID != "" {
 		insecureRegistries = fmt.Sprintf(`%s,"%s"`, insecureRegistries, c.Spec.TenantID+"."+p.Config.Registry.Domain)
 	}
 	extraArgs := c.Spec.DockerExtraArgs
 	utilruntime.Must(mergo.Merge(&extraArgs, p.Config.Docker.ExtraArgs))
-	option := &docker.Option{
+	return &docker.Option{
 		InsecureRegistries: insecureRegistries,
 		RegistryDomain:     p.Config.Registry.Domain,
 		ExtraArgs:          extraArgs,
 	}
+}
+
+func (p *Provider) EnsureDocker(ctx context.Context, c *v1.Cluster) error {
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	option := p.getDockerOption(c)
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -581,9 +624,9 @@ func (p *Provider) EnsureKubernetesImages(ctx context.Context, c *v1.Cluster) er
 	machines := map[bool][]platformv1.ClusterMachine{
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
-	option := &image.Option{Version: c.Spec.Version, RegistryDomain: p.Config.Registry.Domain, KubeImages: images.KubecomponetNames}
+	option := &image.Option{Version: c.Spec.Version, RegistryDomain: p.Config.Registry.Domain, KubeImages: images.KubecomponetNames, AddonImages: image.NodeAddonImages(c)}
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -601,7 +644,7 @@ func (p *Provider) EnsureConntrackTools(ctx context.Context, c *v1.Cluster) erro
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -620,7 +663,7 @@ func (p *Provider) EnsureKubeadm(ctx context.Context, c *v1.Cluster) error {
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -654,7 +697,7 @@ func (p *Provider) EnsureKeepalivedInit(ctx context.Context, c *v1.Cluster) erro
 	}
 
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -673,7 +716,7 @@ func (p *Provider) EnsureKeepalivedInit(ctx context.Context, c *v1.Cluster) erro
 		KubernetesSvcIP: kubernetesSvcIP,
 	}
 
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -695,7 +738,7 @@ func (p *Provider) EnsureThirdPartyHAInit(ctx context.Context, c *v1.Cluster) er
 	}
 
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -710,7 +753,7 @@ func (p *Provider) EnsureThirdPartyHAInit(ctx context.Context, c *v1.Cluster) er
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -738,7 +781,7 @@ func (p *Provider) EnsureAuthzWebhook(ctx context.Context, c *v1.Cluster) error
 	isGlobalCluster := (c.Cluster.Name == "global")
 	isClusterUpscaling := (c.Status.Phase == platformv1.ClusterUpscaling)
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -768,7 +811,11 @@ func (p *Provider) EnsureAuditConfig(ctx context.Context, c *v1.Cluster) error {
 	machines := map[bool][]platformv1.ClusterMachine{
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
-	auditPolicyData, _ := ioutil.ReadFile(constants.AuditPolicyConfigFile)
+	policy, err := p.getAuditPolicy(ctx, c)
+	if err != nil {
+		return err
+	}
+	auditPolicyData, _ := renderAuditPolicy(policy)
 	auditWebhookConfig, err := template.ParseString(auditWebhookConfig, map[string]interface{}{
 		"AuditBackendAddress": p.Config.Audit.Address,
 		"ClusterName":         c.Name,
@@ -777,7 +824,7 @@ func (p *Provider) EnsureAuditConfig(ctx context.Context, c *v1.Cluster) error {
 		return errors.Wrap(err, "parse auditWebhookConfig error")
 	}
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -814,7 +861,7 @@ func (p *Provider) EnsurePrepareForControlplane(ctx context.Context, c *v1.Clust
 		return errors.Wrap(err, "parse schedulerPolicyConfig error")
 	}
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -845,7 +892,7 @@ func (p *Provider) EnsureKubeadmInitPhaseKubeletStart(ctx context.Context, c *v1
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -867,7 +914,7 @@ func (p *Provider) EnsureKubeadmInitPhaseCerts(ctx context.Context, c *v1.Cluste
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -878,7 +925,7 @@ func (p *Provider) EnsureKubeadmInitPhaseKubeConfig(ctx context.Context, c *v1.C
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -889,7 +936,7 @@ func (p *Provider) EnsureKubeadmInitPhaseControlPlane(ctx context.Context, c *v1
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -900,7 +947,7 @@ func (p *Provider) EnsureKubeadmInitPhaseETCD(ctx context.Context, c *v1.Cluster
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -911,7 +958,7 @@ func (p *Provider) EnsureKubeadmInitPhaseUploadConfig(ctx context.Context, c *v1
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -919,7 +966,7 @@ func (p *Provider) EnsureKubeadmInitPhaseUploadConfig(ctx context.Context, c *v1
 }
 
 func (p *Provider) EnsureKubeadmInitPhaseUploadCerts(ctx context.Context, c *v1.Cluster) error {
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -930,7 +977,7 @@ func (p *Provider) EnsureKubeadmInitPhaseBootstrapToken(ctx context.Context, c *
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -941,7 +988,7 @@ func (p *Provider) EnsureKubeadmInitPhaseAddon(ctx context.Context, c *v1.Cluste
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -952,6 +999,9 @@ func (p *Provider) EnsureGalaxy(ctx context.Context, c *v1.Cluster) error {
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
+	if util.NetworkType(c.Cluster) != platformv1.NetworkGalaxy {
+		return nil
+	}
 	clientset, err := c.ClientsetForBootstrap()
 	if err != nil {
 		return err
@@ -986,7 +1036,7 @@ func (p *Provider) EnsureJoinPhasePreflight(ctx context.Context, c *v1.Cluster)
 		false: c.Spec.Machines[1:]}[len(c.Spec.ScalingMachines) > 0]
 
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1005,7 +1055,7 @@ func (p *Provider) EnsureJoinPhaseControlPlanePrepare(ctx context.Context, c *v1
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines[1:]}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1024,7 +1074,7 @@ func (p *Provider) EnsureJoinPhaseKubeletStart(ctx context.Context, c *v1.Cluste
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines[1:]}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1043,7 +1093,7 @@ func (p *Provider) EnsureJoinPhaseControlPlaneJoinETCD(ctx context.Context, c *v
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines[1:]}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1062,7 +1112,7 @@ func (p *Provider) EnsureJoinPhaseControlPlaneJoinUpdateStatus(ctx context.Conte
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines[1:]}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1080,7 +1130,7 @@ func (p *Provider) EnsureStoreCredential(ctx context.Context, c *v1.Cluster) err
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -1180,7 +1230,7 @@ func (p *Provider) EnsurePatchAnnotation(ctx context.Context, c *v1.Cluster) err
 		constants.KubeSchedulerPodManifestFile:         `    scheduler.alpha.kubernetes.io/critical-pod: ""\n    tke.prometheus.io/scrape: "true"\n    prometheus.io/scheme: "https"\n    prometheus.io/port: "10259"`,
 	}
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1202,7 +1252,7 @@ func (p *Provider) EnsureKubelet(ctx context.Context, c *v1.Cluster) error {
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1222,7 +1272,7 @@ func (p *Provider) EnsureCNIPlugins(ctx context.Context, c *v1.Cluster) error {
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	option := &cniplugins.Option{}
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1240,7 +1290,7 @@ func (p *Provider) EnsureKubeadmInitPhaseWaitControlPlane(ctx context.Context, c
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -1391,7 +1441,7 @@ func (p *Provider) EnsureCilium(ctx context.Context, c *v1.Cluster) error {
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
 	}
-	if !c.Cluster.Spec.Features.EnableCilium {
+	if util.NetworkType(c.Cluster) != platformv1.NetworkCilium {
 		return nil
 	}
 	// old cilium interface should be deleted
@@ -1430,6 +1480,94 @@ func (p *Provider) EnsureCilium(ctx context.Context, c *v1.Cluster) error {
 	return nil
 }
 
+func (p *Provider) EnsureCalico(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	if util.NetworkType(c.Cluster) != platformv1.NetworkCalico {
+		return nil
+	}
+	return p.installCalico(ctx, c)
+}
+
+func (p *Provider) installCalico(ctx context.Context, c *v1.Cluster) error {
+	option, err := calico.NewOption(c.Cluster.Spec.NetworkArgs)
+	if err != nil {
+		return err
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	config, err := c.RESTConfig()
+	if err != nil {
+		return err
+	}
+	aaClient, err := apiextensionsclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	manifestOption := map[string]interface{}{
+		"CalicoNodeImage":            images.Get().CalicoNode.FullName(),
+		"CalicoCNIImage":             images.Get().CalicoCNI.FullName(),
+		"CalicoKubeControllersImage": images.Get().CalicoKubeControllers.FullName(),
+		"ClusterCIDR":                c.Cluster.Spec.ClusterCIDR,
+		"NetworkDevice":              c.Cluster.Spec.NetworkDevice,
+		"Backend":                    option.Backend(),
+		"IPIPMode":                   option.IPIPMode(),
+		"VXLANMode":                  option.VXLANMode(),
+	}
+	err = apiclient.CreateAsResourceWithFile(ctx, client, aaClient, constants.CalicoManifest, manifestOption)
+	if err != nil {
+		return errors.Wrap(err, "install Calico error")
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	// the crds may not be served right after they are created
+	return wait.PollImmediate(5*time.Second, 2*time.Minute, func() (bool, error) {
+		if err := calico.ConfigureBGP(ctx, dynamicClient, option); err != nil {
+			log.FromContext(ctx).Error(err, "configure Calico BGP error")
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+func (p *Provider) EnsureFlannel(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	if util.NetworkType(c.Cluster) != platformv1.NetworkFlannel {
+		return nil
+	}
+	return p.installFlannel(ctx, c)
+}
+
+func (p *Provider) installFlannel(ctx context.Context, c *v1.Cluster) error {
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	backendType := "vxlan"
+	if backendTypeArg, ok := c.Cluster.Spec.NetworkArgs["backendType"]; ok {
+		backendType = backendTypeArg
+	}
+	option := map[string]interface{}{
+		"FlannelImage":  images.Get().Flannel.FullName(),
+		"ClusterCIDR":   c.Cluster.Spec.ClusterCIDR,
+		"NetworkDevice": c.Cluster.Spec.NetworkDevice,
+		"BackendType":   backendType,
+	}
+	err = apiclient.CreateResourceWithFile(ctx, client, constants.FlannelManifest, option)
+	if err != nil {
+		return errors.Wrap(err, "install Flannel error")
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureCSIOperator(ctx context.Context, c *v1.Cluster) error {
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
@@ -1477,7 +1615,7 @@ func (p *Provider) EnsureKeepalivedWithLBOption(ctx context.Context, c *v1.Clust
 	}
 
 	for _, machine := range machines {
-		s, err := machine.SSH()
+		s, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1512,7 +1650,7 @@ func (p *Provider) EnsureThirdPartyHA(ctx context.Context, c *v1.Cluster) error
 	}
 
 	for _, machine := range machines {
-		s, err := machine.SSH()
+		s, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1532,13 +1670,72 @@ func (p *Provider) EnsureThirdPartyHA(ctx context.Context, c *v1.Cluster) error
 	return nil
 }
 
+func (p *Provider) EnsureKubeVIP(ctx context.Context, c *v1.Cluster) error {
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+
+	if c.Spec.Features.HA == nil || c.Spec.Features.HA.KubeVIPHA == nil {
+		return nil
+	}
+	ha := c.Spec.Features.HA.KubeVIPHA
+	networkInterface := ha.Interface
+	if networkInterface == "" {
+		networkInterface = c.Spec.NetworkDevice
+	}
+
+	for _, machine := range machines {
+		s, err := machine.SSHWithContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		option := &kubevip.Option{
+			IP:        machine.IP,
+			VIP:       ha.VIP,
+			Interface: networkInterface,
+		}
+		if ha.Mode == platformv1.KubeVIPBGP {
+			option.BGP = ha.BGP
+		}
+
+		err = kubevip.Install(s, option)
+		if err != nil {
+			return err
+		}
+
+		log.FromContext(ctx).Info("kube-vip created success.", "node", machine.IP)
+	}
+
+	return nil
+}
+
+func (p *Provider) EnsureMetalLB(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	if c.Spec.Features.MetalLB == nil {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	err = metallb.Install(ctx, client, c.Spec.Features.MetalLB)
+	if err != nil {
+		return errors.Wrap(err, "install MetalLB error")
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureCleanup(ctx context.Context, c *v1.Cluster) error {
 	machines := map[bool][]platformv1.ClusterMachine{
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 
 	for _, machine := range machines {
-		_, err := machine.SSH()
+		_, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1562,7 +1759,7 @@ func (p *Provider) setAPIServerHost(ctx context.Context, c *v1.Cluster, ip strin
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -1594,7 +1791,7 @@ func (p *Provider) EnsureClusternetRegistration(ctx context.Context, c *v1.Clust
 	if err != nil {
 		return fmt.Errorf("decode registration command failed: %v", err)
 	}
-	machineSSH, err := c.Spec.Machines[0].SSH()
+	machineSSH, err := c.Spec.Machines[0].SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -1855,7 +2052,7 @@ func (p *Provider) EnsureKubeAPIServerRestart(ctx context.Context, c *v1.Cluster
 	}
 
 	for _, machine := range c.Spec.Machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
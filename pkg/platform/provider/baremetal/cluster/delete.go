@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/etcd"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
 	"tkestack.io/tke/pkg/platform/provider/util/mark"
 	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
@@ -43,9 +44,14 @@ func (p *Provider) EnsureCleanClusterMark(ctx context.Context, c *typesv1.Cluste
 	return nil
 }
 
+// EnsureRemoveETCDMember removes the etcd members of the scaling machines,
+// a member is only removed if the others keep quorum.
 func (p *Provider) EnsureRemoveETCDMember(ctx context.Context, c *v1.Cluster) error {
 	for _, machine := range c.Spec.ScalingMachines {
-		machineSSH, err := machine.SSH()
+		if err := checkRemoveETCDMember(ctx, c, machine.IP); err != nil {
+			return err
+		}
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -57,6 +63,27 @@ func (p *Provider) EnsureRemoveETCDMember(ctx context.Context, c *v1.Cluster) er
 	return nil
 }
 
+// checkRemoveETCDMember returns an error if removing the etcd member on ip
+// leaves the etcd cluster without quorum, a removed member is not checked.
+func checkRemoveETCDMember(ctx context.Context, c *v1.Cluster, ip string) error {
+	db, err := etcd.NewClient(c.ClusterCredential, etcdClientIPs(c, ip))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	members, err := etcd.Members(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if m.IP == ip {
+			return etcd.CheckRemove(members, ip)
+		}
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureRemoveNode(ctx context.Context, c *v1.Cluster) error {
 	client, err := c.Clientset()
 	if err != nil {
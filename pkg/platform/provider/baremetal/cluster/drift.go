@@ -0,0 +1,426 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
+	kubeletv1beta1 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubelet/config/v1beta1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/containerd"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	componentKubelet    = "kubelet"
+	componentContainerd = "containerd"
+)
+
+// staticPod is a control plane component run as static pod by kubeadm.
+type staticPod struct {
+	name     string
+	manifest string
+	// phase is the kubeadm init phase writing the manifest
+	phase string
+}
+
+var staticPods = []staticPod{
+	{name: "kube-apiserver", manifest: constants.KubeAPIServerPodManifestFile, phase: "control-plane apiserver"},
+	{name: "kube-controller-manager", manifest: constants.KubeControllerManagerPodManifestFile, phase: "control-plane controller-manager"},
+	{name: "kube-scheduler", manifest: constants.KubeSchedulerPodManifestFile, phase: "control-plane scheduler"},
+}
+
+var _ clusterprovider.DriftDetector = &Provider{}
+
+// DetectDrift compares the static pod manifests, the kubelet flags and config
+// and the containerd config on the master with the ones rendered from the
+// cluster spec. Only the args rendered by the provider are compared, the
+// defaults added by kubeadm are not.
+func (p *Provider) DetectDrift(ctx context.Context, c *v1.Cluster, machine platformv1.ClusterMachine) ([]clusterprovider.Drift, error) {
+	s, err := machine.SSHWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config := p.getKubeadmInitConfigOnMachine(c, machine.IP)
+	expectedArgs := staticPodExtraArgs(config.ClusterConfiguration)
+
+	var drifts []clusterprovider.Drift
+	for _, pod := range staticPods {
+		data, err := s.ReadFile(pod.manifest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s error", pod.manifest)
+		}
+		args, err := staticPodArgs(data, pod.name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s error", pod.manifest)
+		}
+		drifts = append(drifts, diffArgs(pod.name, args, expectedArgs[pod.name])...)
+	}
+
+	kubeletDrifts, err := p.detectKubeletDrift(s, c, config.KubeletConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	drifts = append(drifts, kubeletDrifts...)
+
+	if c.Spec.Features.ContainerRuntime != platformv1.Docker {
+		expected, err := p.containerdConfig(c, machine)
+		if err != nil {
+			return nil, err
+		}
+		containerdDrifts, err := detectContainerdDrift(s, expected)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, containerdDrifts...)
+	}
+
+	return drifts, nil
+}
+
+// CorrectDrift re-applies the configuration of the drifted components with
+// the same kubeadm phases and templates used to create the cluster.
+func (p *Provider) CorrectDrift(ctx context.Context, c *v1.Cluster, machine platformv1.ClusterMachine, drifts []clusterprovider.Drift) error {
+	drifted := map[string]bool{}
+	for _, drift := range drifts {
+		drifted[drift.Component] = true
+	}
+	if len(drifted) == 0 {
+		return nil
+	}
+	// the rollout of the config restarts the components itself
+	if p.rollingOut(c.Name) {
+		log.FromContext(ctx).Info("Skip correcting drift during rollout", "node", machine.IP)
+		return nil
+	}
+	s, err := machine.SSHWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	err = kubeadm.WriteInitConfig(s, p.getKubeadmInitConfigOnMachine(c, machine.IP))
+	if err != nil {
+		return err
+	}
+
+	if drifted[componentContainerd] {
+		if err := p.correctContainerd(ctx, c, p.masterContainerdNode(c, machine)); err != nil {
+			return err
+		}
+	}
+	if drifted[componentKubelet] {
+		phase := "kubelet-start"
+		if _, ok := p.getKubeletExtraArgs(c)["hostname-override"]; !ok && !c.Spec.HostnameAsNodename {
+			phase += fmt.Sprintf(" --node-name=%s", machine.IP)
+		}
+		if err := kubeadm.Init(s, phase); err != nil {
+			return err
+		}
+	}
+	var pods []staticPod
+	for _, pod := range staticPods {
+		if drifted[pod.name] {
+			pods = append(pods, pod)
+		}
+	}
+	if len(pods) != 0 {
+		rolledOut, err := p.extraArgsRolledOut(ctx, c)
+		if err != nil {
+			return err
+		}
+		// the extra args rolled back or not rolled out yet are left to the rollout
+		if !rolledOut {
+			log.FromContext(ctx).Info("Skip correcting static pods with the extra args not rolled out", "node", machine.IP)
+			pods = nil
+		}
+	}
+	for _, pod := range pods {
+		if err := kubeadm.Init(s, pod.phase); err != nil {
+			return err
+		}
+	}
+
+	return kubeadm.WaitForAPIServer(s)
+}
+
+// DetectMachineDrift compares the kubelet flags and config and the containerd
+// config on the worker machine with the ones rendered from the cluster spec
+// and the machine spec.
+func (p *Provider) DetectMachineDrift(ctx context.Context, c *v1.Cluster, machine *platformv1.Machine) ([]clusterprovider.Drift, error) {
+	s, err := machine.Spec.SSHWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drifts, err := p.detectKubeletDrift(s, c, p.getKubeletConfiguration(c))
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Spec.Features.ContainerRuntime != platformv1.Docker {
+		expected, err := containerd.Config(p.workerContainerdNode(c, machine).option)
+		if err != nil {
+			return nil, err
+		}
+		containerdDrifts, err := detectContainerdDrift(s, expected)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, containerdDrifts...)
+	}
+
+	return drifts, nil
+}
+
+// CorrectMachineDrift re-applies the containerd config on the worker machine
+// the same way as it is rolled out. The kubelet of a worker is configured by
+// kubeadm join, which is not re-run on a joined node, so the drift of the
+// kubelet is only reported.
+func (p *Provider) CorrectMachineDrift(ctx context.Context, c *v1.Cluster, machine *platformv1.Machine, drifts []clusterprovider.Drift) error {
+	drifted := map[string]bool{}
+	for _, drift := range drifts {
+		drifted[drift.Component] = true
+	}
+	if drifted[componentKubelet] {
+		log.FromContext(ctx).Info("Skip correcting kubelet of worker machine", "node", machine.Spec.IP)
+	}
+	if !drifted[componentContainerd] {
+		return nil
+	}
+	if p.rollingOut(c.Name) {
+		log.FromContext(ctx).Info("Skip correcting drift during rollout", "node", machine.Spec.IP)
+		return nil
+	}
+
+	return p.correctContainerd(ctx, c, p.workerContainerdNode(c, machine))
+}
+
+// detectKubeletDrift compares the kubelet flags and config on the node with
+// the rendered ones.
+func (p *Provider) detectKubeletDrift(s ssh.Interface, c *v1.Cluster, expected *kubeletv1beta1.KubeletConfiguration) ([]clusterprovider.Drift, error) {
+	data, err := s.ReadFile(constants.KubeletFlagsEnvFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s error", constants.KubeletFlagsEnvFile)
+	}
+	drifts := diffArgs(componentKubelet, kubeletFlags(data), p.getKubeletExtraArgs(c))
+	data, err = s.ReadFile(constants.KubeletConfigFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s error", constants.KubeletConfigFile)
+	}
+	kubeletDrifts, err := diffKubeletConfiguration(data, expected)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse %s error", constants.KubeletConfigFile)
+	}
+
+	return append(drifts, kubeletDrifts...), nil
+}
+
+// detectContainerdDrift compares the containerd config on the node with the
+// rendered one.
+func detectContainerdDrift(s ssh.Interface, expected []byte) ([]clusterprovider.Drift, error) {
+	data, err := s.ReadFile(containerd.ConfigFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s error", containerd.ConfigFile)
+	}
+	if bytes.Equal(bytes.TrimSpace(data), bytes.TrimSpace(expected)) {
+		return nil, nil
+	}
+	return []clusterprovider.Drift{{
+		Component: componentContainerd,
+		Message:   fmt.Sprintf("%s differs from the rendered config", containerd.ConfigFile),
+	}}, nil
+}
+
+func (p *Provider) containerdConfig(c *v1.Cluster, machine platformv1.ClusterMachine) ([]byte, error) {
+	return containerd.Config(p.getContainerdOption(c, machine))
+}
+
+// extraArgsRolledOut tells whether the extra args of the control plane
+// components in the cluster spec are the ones rolled out.
+func (p *Provider) extraArgsRolledOut(ctx context.Context, c *v1.Cluster) (bool, error) {
+	client, err := c.Clientset()
+	if err != nil {
+		return false, err
+	}
+	cm, applied, err := getKubeadmClusterConfiguration(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	userArgs := userExtraArgs(c)
+	rolledOut, err := rolledOutExtraArgs(cm, applied, userArgs)
+	if err != nil {
+		return false, err
+	}
+	for _, pod := range staticPods {
+		if !equalArgs(rolledOut[pod.name], userArgs[pod.name]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// correctContainerd rewrites the containerd config the same way as it is
+// rolled out, a config rolled back is not corrected.
+func (p *Provider) correctContainerd(ctx context.Context, c *v1.Cluster, node containerdNode) error {
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	one, err := p.reconfigureContainerd(ctx, client, node, true)
+	// the config skipped for being rolled back before is not a failure of
+	// the correction, the one rolled back in the correction is.
+	if err == errContainerdRolledBack {
+		return nil
+	}
+	if one != nil {
+		removeContainerdBackup(ctx, node)
+	}
+	return err
+}
+
+// staticPodExtraArgs returns the extra args of the static pods in the kubeadm
+// cluster configuration by the name of the static pods.
+func staticPodExtraArgs(config *kubeadmv1beta2.ClusterConfiguration) map[string]map[string]string {
+	return map[string]map[string]string{
+		"kube-apiserver":          config.APIServer.ExtraArgs,
+		"kube-controller-manager": config.ControllerManager.ExtraArgs,
+		"kube-scheduler":          config.Scheduler.ExtraArgs,
+	}
+}
+
+// staticPodArgs returns the args in the command of the container of the
+// static pod manifest.
+func staticPodArgs(data []byte, name string) (map[string]string, error) {
+	pod := new(corev1.Pod)
+	if err := yaml.Unmarshal(data, pod); err != nil {
+		return nil, err
+	}
+	return podArgs(pod, name)
+}
+
+// podArgs returns the args in the command of the container of the pod.
+func podArgs(pod *corev1.Pod, name string) (map[string]string, error) {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return commandArgs(append(container.Command, container.Args...)), nil
+		}
+	}
+	return nil, fmt.Errorf("no container %s", name)
+}
+
+// kubeletFlags returns the args in KUBELET_KUBEADM_ARGS of the kubelet flags
+// env file.
+func kubeletFlags(data []byte) map[string]string {
+	for _, line := range strings.Split(string(data), "\n") {
+		value := strings.TrimPrefix(strings.TrimSpace(line), "KUBELET_KUBEADM_ARGS=")
+		if value != strings.TrimSpace(line) {
+			return commandArgs(strings.Fields(strings.Trim(value, `"`)))
+		}
+	}
+	return map[string]string{}
+}
+
+func commandArgs(command []string) map[string]string {
+	args := map[string]string{}
+	for _, one := range command {
+		if !strings.HasPrefix(one, "--") {
+			continue
+		}
+		kv := strings.SplitN(strings.TrimPrefix(one, "--"), "=", 2)
+		if len(kv) == 2 {
+			args[kv[0]] = kv[1]
+		} else {
+			args[kv[0]] = ""
+		}
+	}
+	return args
+}
+
+// diffArgs returns the expected args which are missing or different in the
+// actual args.
+func diffArgs(component string, actual, expected map[string]string) []clusterprovider.Drift {
+	keys := make([]string, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var drifts []clusterprovider.Drift
+	for _, k := range keys {
+		v, ok := actual[k]
+		if !ok {
+			drifts = append(drifts, clusterprovider.Drift{
+				Component: component,
+				Message:   fmt.Sprintf("--%s is missing, expected %q", k, expected[k]),
+			})
+			continue
+		}
+		if v != expected[k] {
+			drifts = append(drifts, clusterprovider.Drift{
+				Component: component,
+				Message:   fmt.Sprintf("--%s is %q, expected %q", k, v, expected[k]),
+			})
+		}
+	}
+
+	return drifts
+}
+
+// diffKubeletConfiguration compares the fields of the kubelet configuration
+// rendered by the provider.
+func diffKubeletConfiguration(data []byte, expected *kubeletv1beta1.KubeletConfiguration) ([]clusterprovider.Drift, error) {
+	actual := new(kubeletv1beta1.KubeletConfiguration)
+	if err := yaml.Unmarshal(data, actual); err != nil {
+		return nil, err
+	}
+
+	var drifts []clusterprovider.Drift
+	if actual.MaxPods != expected.MaxPods {
+		drifts = append(drifts, clusterprovider.Drift{
+			Component: componentKubelet,
+			Message:   fmt.Sprintf("maxPods is %d, expected %d", actual.MaxPods, expected.MaxPods),
+		})
+	}
+	if !reflect.DeepEqual(actual.KubeReserved, expected.KubeReserved) {
+		drifts = append(drifts, clusterprovider.Drift{
+			Component: componentKubelet,
+			Message:   fmt.Sprintf("kubeReserved is %v, expected %v", actual.KubeReserved, expected.KubeReserved),
+		})
+	}
+	if !reflect.DeepEqual(actual.SystemReserved, expected.SystemReserved) {
+		drifts = append(drifts, clusterprovider.Drift{
+			Component: componentKubelet,
+			Message:   fmt.Sprintf("systemReserved is %v, expected %v", actual.SystemReserved, expected.SystemReserved),
+		})
+	}
+
+	return drifts, nil
+}
@@ -0,0 +1,144 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	kubeletv1beta1 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubelet/config/v1beta1"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+)
+
+const apiServerManifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  namespace: kube-system
+spec:
+  containers:
+  - command:
+    - kube-apiserver
+    - --advertise-address=10.0.0.1
+    - --token-auth-file=/etc/kubernetes/known_tokens.csv
+    - --authorization-mode=Node,RBAC
+    image: kube-apiserver:v1.21.4
+    name: kube-apiserver
+`
+
+func TestStaticPodArgs(t *testing.T) {
+	args, err := staticPodArgs([]byte(apiServerManifest), "kube-apiserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"advertise-address":  "10.0.0.1",
+		"token-auth-file":    "/etc/kubernetes/known_tokens.csv",
+		"authorization-mode": "Node,RBAC",
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("expected %v, got %v", expected, args)
+	}
+	if _, err := staticPodArgs([]byte(apiServerManifest), "kube-scheduler"); err == nil {
+		t.Error("expected error for missing container")
+	}
+}
+
+func TestKubeletFlags(t *testing.T) {
+	data := []byte(`KUBELET_KUBEADM_ARGS="--container-runtime=remote --node-ip=10.0.0.1 --pod-infra-container-image=pause:3.2"
+`)
+	expected := map[string]string{
+		"container-runtime":         "remote",
+		"node-ip":                   "10.0.0.1",
+		"pod-infra-container-image": "pause:3.2",
+	}
+	if got := kubeletFlags(data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := kubeletFlags(nil); len(got) != 0 {
+		t.Errorf("expected no flags, got %v", got)
+	}
+}
+
+func TestDiffArgs(t *testing.T) {
+	actual := map[string]string{
+		"token-auth-file":    "/etc/kubernetes/known_tokens.csv",
+		"authorization-mode": "Node,RBAC",
+		"advertise-address":  "10.0.0.1",
+	}
+	expected := map[string]string{
+		"token-auth-file":    "/etc/kubernetes/known_tokens.csv",
+		"authorization-mode": "Node,RBAC,Webhook",
+		"audit-policy-file":  "/etc/kubernetes/audit-policy.yaml",
+	}
+	got := diffArgs("kube-apiserver", actual, expected)
+	want := []clusterprovider.Drift{
+		{Component: "kube-apiserver", Message: `--audit-policy-file is missing, expected "/etc/kubernetes/audit-policy.yaml"`},
+		{Component: "kube-apiserver", Message: `--authorization-mode is "Node,RBAC", expected "Node,RBAC,Webhook"`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := diffArgs("kube-apiserver", expected, expected); len(got) != 0 {
+		t.Errorf("expected no drift, got %v", got)
+	}
+}
+
+func TestDiffKubeletConfiguration(t *testing.T) {
+	expected := &kubeletv1beta1.KubeletConfiguration{
+		MaxPods:        256,
+		KubeReserved:   map[string]string{"cpu": "100m", "memory": "500Mi"},
+		SystemReserved: map[string]string{"cpu": "100m", "memory": "500Mi"},
+	}
+	data := []byte(`apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+maxPods: 110
+kubeReserved:
+  cpu: 100m
+  memory: 500Mi
+systemReserved:
+  cpu: 100m
+  memory: 500Mi
+`)
+	got, err := diffKubeletConfiguration(data, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []clusterprovider.Drift{{Component: "kubelet", Message: "maxPods is 110, expected 256"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestRollingOut(t *testing.T) {
+	p := &Provider{}
+	done := p.startRollout("cls-a")
+	doneAgain := p.startRollout("cls-a")
+	if !p.rollingOut("cls-a") || p.rollingOut("cls-b") {
+		t.Fatalf("rollingOut() doesn't track the rollout of cls-a only")
+	}
+	done()
+	if !p.rollingOut("cls-a") {
+		t.Errorf("rollingOut() = false before all the rollouts are done")
+	}
+	doneAgain()
+	if p.rollingOut("cls-a") {
+		t.Errorf("rollingOut() = true after the rollouts are done")
+	}
+}
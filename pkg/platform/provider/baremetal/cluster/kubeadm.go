@@ -27,12 +27,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	utilsnet "k8s.io/utils/net"
+	platformv1 "tkestack.io/tke/api/platform/v1"
 	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
 	kubeletv1beta1 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubelet/config/v1beta1"
 	kubeproxyv1alpha1 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeproxy/config/v1alpha1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/json"
@@ -40,8 +42,14 @@ import (
 )
 
 func (p *Provider) getKubeadmInitConfig(c *v1.Cluster) *kubeadm.InitConfig {
+	return p.getKubeadmInitConfigOnMachine(c, c.Spec.Machines[0].IP)
+}
+
+// getKubeadmInitConfigOnMachine returns the kubeadm init config to run init
+// phases on the master with machineIP.
+func (p *Provider) getKubeadmInitConfigOnMachine(c *v1.Cluster, machineIP string) *kubeadm.InitConfig {
 	config := new(kubeadm.InitConfig)
-	config.InitConfiguration = p.getInitConfiguration(c)
+	config.InitConfiguration = p.getInitConfiguration(c, machineIP)
 	config.ClusterConfiguration = p.getClusterConfiguration(c)
 	config.KubeProxyConfiguration = p.getKubeProxyConfiguration(c)
 	config.KubeletConfiguration = p.getKubeletConfiguration(c)
@@ -62,7 +70,7 @@ func (p *Provider) getKubeadmJoinConfig(c *v1.Cluster, machineIP string) *kubead
 	} else {
 		kubeletExtraArgs["node-labels"] = apiclient.GetNodeIPV6Label(machineIP)
 	}
-	if c.Cluster.Spec.Features.EnableCilium && c.Cluster.Spec.NetworkArgs["networkMode"] == "underlay" {
+	if util.NetworkType(c.Cluster) == platformv1.NetworkCilium && c.Cluster.Spec.NetworkArgs["networkMode"] == "underlay" {
 		if asn, ok := c.Cluster.Spec.NetworkArgs["asn"]; ok {
 			kubeletExtraArgs["node-labels"] = fmt.Sprintf("%s,%s=%s", kubeletExtraArgs["node-labels"], apiclient.LabelASNCilium, asn)
 		}
@@ -101,18 +109,17 @@ func (p *Provider) getKubeadmJoinConfig(c *v1.Cluster, machineIP string) *kubead
 	}
 }
 
-func (p *Provider) getInitConfiguration(c *v1.Cluster) *kubeadmv1beta2.InitConfiguration {
+func (p *Provider) getInitConfiguration(c *v1.Cluster, machineIP string) *kubeadmv1beta2.InitConfiguration {
 	token, _ := kubeadmv1beta2.NewBootstrapTokenString(*c.ClusterCredential.BootstrapToken)
 
 	nodeRegistration := kubeadmv1beta2.NodeRegistrationOptions{}
 	kubeletExtraArgs := p.getKubeletExtraArgs(c)
-	machineIP := c.Spec.Machines[0].IP
-	if !utilsnet.IsIPv6String(c.Spec.Machines[0].IP) {
+	if !utilsnet.IsIPv6String(machineIP) {
 		kubeletExtraArgs["node-labels"] = fmt.Sprintf("%s=%s", apiclient.LabelMachineIPV4, machineIP)
 	} else {
 		kubeletExtraArgs["node-labels"] = apiclient.GetNodeIPV6Label(machineIP)
 	}
-	if c.Cluster.Spec.Features.EnableCilium && c.Cluster.Spec.NetworkArgs["networkMode"] == "underlay" {
+	if util.NetworkType(c.Cluster) == platformv1.NetworkCilium && c.Cluster.Spec.NetworkArgs["networkMode"] == "underlay" {
 		if asn, ok := c.Cluster.Spec.NetworkArgs["asn"]; ok {
 			kubeletExtraArgs["node-labels"] = fmt.Sprintf("%s,%s=%s", kubeletExtraArgs["node-labels"], apiclient.LabelASNCilium, asn)
 		}
@@ -219,6 +226,8 @@ func (p *Provider) getKubeProxyConfiguration(c *v1.Cluster) *kubeproxyv1alpha1.K
 	if c.Spec.Features.IPVS != nil && *c.Spec.Features.IPVS {
 		config.Mode = "ipvs"
 		config.ClusterCIDR = c.Spec.ClusterCIDR
+		// metallb layer2 mode answers ARP of the load balancer ips by speakers
+		config.IPVS.StrictARP = c.Spec.Features.MetalLB != nil
 		if c.Spec.Features.HA != nil {
 			if c.Spec.Features.HA.TKEHA != nil {
 				config.IPVS.ExcludeCIDRs = []string{fmt.Sprintf("%s/32", c.Spec.Features.HA.TKEHA.VIP)}
@@ -226,6 +235,9 @@ func (p *Provider) getKubeProxyConfiguration(c *v1.Cluster) *kubeproxyv1alpha1.K
 			if c.Spec.Features.HA.ThirdPartyHA != nil {
 				config.IPVS.ExcludeCIDRs = []string{fmt.Sprintf("%s/32", c.Spec.Features.HA.ThirdPartyHA.VIP)}
 			}
+			if c.Spec.Features.HA.KubeVIPHA != nil {
+				config.IPVS.ExcludeCIDRs = []string{fmt.Sprintf("%s/32", c.Spec.Features.HA.KubeVIPHA.VIP)}
+			}
 		}
 	}
 	if utilsnet.IsIPv6CIDRString(c.Spec.ClusterCIDR) {
@@ -236,7 +248,7 @@ func (p *Provider) getKubeProxyConfiguration(c *v1.Cluster) *kubeproxyv1alpha1.K
 }
 
 func (p *Provider) getKubeletConfiguration(c *v1.Cluster) *kubeletv1beta1.KubeletConfiguration {
-	return &kubeletv1beta1.KubeletConfiguration{
+	config := &kubeletv1beta1.KubeletConfiguration{
 		KubeReserved: map[string]string{
 			"cpu":    "100m",
 			"memory": "500Mi",
@@ -247,6 +259,12 @@ func (p *Provider) getKubeletConfiguration(c *v1.Cluster) *kubeletv1beta1.Kubele
 		},
 		MaxPods: *c.Spec.Properties.MaxNodePodNum,
 	}
+	// kubelet must use the same cgroup driver as containerd
+	if c.Spec.ContainerRuntimeConfig != nil && c.Spec.ContainerRuntimeConfig.CgroupDriver != "" {
+		config.CgroupDriver = string(c.Spec.ContainerRuntimeConfig.CgroupDriver)
+	}
+
+	return config
 }
 
 func (p *Provider) getAPIServerExtraArgs(c *v1.Cluster) map[string]string {
@@ -285,7 +303,7 @@ func (p *Provider) getControllerManagerExtraArgs(c *v1.Cluster) map[string]strin
 		args["node-cidr-mask-size"] = fmt.Sprintf("%v", c.Status.NodeCIDRMaskSize)
 		args["service-cluster-ip-range"] = c.Status.ServiceCIDR
 	}
-	if c.Spec.Features.EnableCilium && c.Spec.NetworkArgs["networkMode"] == "overlay" {
+	if util.NetworkType(c.Cluster) == platformv1.NetworkCilium && c.Spec.NetworkArgs["networkMode"] == "overlay" {
 		args["configure-cloud-routes"] = "false"
 		args["allocate-node-cidrs"] = "false"
 	}
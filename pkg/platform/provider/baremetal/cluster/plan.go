@@ -0,0 +1,177 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/containerd"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/docker"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/gpu"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/ospackage"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/preflight"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+const planPreflightCheck = "Preflight"
+
+// plan runs preflight checks on the machines to be installed and renders the
+// configs which would be written to them by create handlers. Checks which
+// depend on earlier handlers, such as ip_forward enabled by EnsureSysctl, may
+// fail before the machines are installed.
+func (p *Provider) plan(ctx context.Context, c *v1.Cluster, plan *platformv1.ClusterPlan) error {
+	var machines []platformv1.ClusterMachine
+	switch c.Status.Phase {
+	case platformv1.ClusterInitializing:
+		machines = c.Spec.Machines
+		if err := p.EnsureClusterComplete(ctx, c); err != nil {
+			return err
+		}
+	case platformv1.ClusterUpscaling:
+		machines = c.Spec.ScalingMachines
+		// credentials of cluster must not be exposed by rendered configs
+		if err := completeCredential(c); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	for _, machine := range machines {
+		plan.Checks = append(plan.Checks, planPreflight(c, machine), planOSPackages(machine))
+	}
+
+	kubeletConf, err := kubeadm.KubeletConf(&kubeadm.Option{
+		RuntimeType: c.Spec.Features.ContainerRuntime,
+		Version:     c.Spec.Version,
+	})
+	if err != nil {
+		return err
+	}
+	for i, machine := range machines {
+		addPlanConfig(plan, kubeadm.KubeletConfFile, machine.IP, kubeletConf)
+
+		var kubeadmConfig []byte
+		if c.Status.Phase == platformv1.ClusterInitializing && i == 0 {
+			kubeadmConfig, err = p.getKubeadmInitConfig(c).Marshal()
+		} else {
+			kubeadmConfig, err = kubeadm.MarshalToYAML(p.getKubeadmJoinConfig(c, machine.IP))
+		}
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		addPlanConfig(plan, constants.KubeadmConfigFileName, machine.IP, kubeadmConfig)
+	}
+
+	if c.Spec.Features.ContainerRuntime == platformv1.Docker {
+		option := p.getDockerOption(c)
+		for _, machine := range machines {
+			option.IsGPU = gpu.IsEnable(machine.Labels)
+			data, err := docker.DaemonConfig(option)
+			if err != nil {
+				return err
+			}
+			addPlanConfig(plan, docker.DaemonFile, machine.IP, data)
+		}
+		return nil
+	}
+	// EnsureContainerd installs containerd on all machines of cluster
+	for _, machine := range c.Spec.Machines {
+		data, err := containerd.Config(p.getContainerdOption(c, machine))
+		if err != nil {
+			return err
+		}
+		addPlanConfig(plan, containerd.ConfigFile, machine.IP, data)
+	}
+
+	return nil
+}
+
+func planPreflight(c *v1.Cluster, machine platformv1.ClusterMachine) platformv1.ClusterPlanCheck {
+	check := platformv1.ClusterPlanCheck{
+		Name: planPreflightCheck,
+		IP:   machine.IP,
+	}
+	machineSSH, err := machine.SSH()
+	if err == nil {
+		// missing packages are reported by planOSPackages, they are installed
+		// by EnsureOSPackages before the preflight checks
+		err = preflight.RunMasterChecks(c, machineSSH, preflight.OSPackagesCheck{}.Name())
+	}
+	if err != nil {
+		check.Message = err.Error()
+		return check
+	}
+	check.Passed = true
+
+	return check
+}
+
+// planOSPackages reports the baseline OS packages which would be installed
+// on the machine by EnsureOSPackages.
+func planOSPackages(machine platformv1.ClusterMachine) platformv1.ClusterPlanCheck {
+	check := platformv1.ClusterPlanCheck{
+		Name: preflight.OSPackagesCheck{}.Name(),
+		IP:   machine.IP,
+	}
+	var family string
+	var missing []string
+	machineSSH, err := machine.SSH()
+	if err == nil {
+		family, err = ospackage.Family(machineSSH)
+	}
+	if err == nil && family != "" {
+		missing, err = ospackage.Missing(machineSSH, family)
+	}
+	if err != nil {
+		check.Message = err.Error()
+		return check
+	}
+	check.Passed = true
+	switch {
+	case family == "":
+		check.Message = "unsupported os family, packages are left to the user"
+	case len(missing) != 0:
+		check.Message = fmt.Sprintf("packages %s will be installed", strings.Join(missing, ","))
+	}
+
+	return check
+}
+
+// addPlanConfig adds the config file of machine to plan, machines with the
+// same config file share one item.
+func addPlanConfig(plan *platformv1.ClusterPlan, path string, ip string, data []byte) {
+	for i, config := range plan.Configs {
+		if config.Path == path && config.Content == string(data) {
+			plan.Configs[i].IPs = append(plan.Configs[i].IPs, ip)
+			return
+		}
+	}
+	plan.Configs = append(plan.Configs, platformv1.ClusterPlanConfig{
+		Path:    path,
+		IPs:     []string{ip},
+		Content: string(data),
+	})
+}
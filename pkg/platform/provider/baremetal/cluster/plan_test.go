@@ -0,0 +1,43 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestAddPlanConfig(t *testing.T) {
+	plan := &platformv1.ClusterPlan{}
+	addPlanConfig(plan, "/etc/a", "1.1.1.1", []byte("a"))
+	addPlanConfig(plan, "/etc/a", "1.1.1.2", []byte("a"))
+	addPlanConfig(plan, "/etc/a", "1.1.1.3", []byte("gpu"))
+	addPlanConfig(plan, "/etc/b", "1.1.1.1", []byte("a"))
+
+	want := []platformv1.ClusterPlanConfig{
+		{Path: "/etc/a", IPs: []string{"1.1.1.1", "1.1.1.2"}, Content: "a"},
+		{Path: "/etc/a", IPs: []string{"1.1.1.3"}, Content: "gpu"},
+		{Path: "/etc/b", IPs: []string{"1.1.1.1"}, Content: "a"},
+	}
+	if !reflect.DeepEqual(plan.Configs, want) {
+		t.Errorf("addPlanConfig() = %+v, want %+v", plan.Configs, want)
+	}
+}
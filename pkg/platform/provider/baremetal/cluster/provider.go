@@ -21,6 +21,7 @@ package cluster
 import (
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/AlekSi/pointer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -77,7 +78,9 @@ func NewProvider() (*Provider, error) {
 			p.EnsureKernelModule,
 			p.EnsureSysctl,
 			p.EnsureDisableSwap,
+			p.EnsureOSPackages,
 			p.EnsurePreflight, // wait basic setting done
+			p.EnsureReplaceMasterPreflight,
 
 			p.EnsureClusterComplete,
 
@@ -119,17 +122,27 @@ func NewProvider() (*Provider, error) {
 
 			p.EnsureGalaxy,
 			p.EnsureCilium,
+			p.EnsureCalico,
+			p.EnsureFlannel,
 
 			p.EnsurePatchAnnotation, // wait rest master ready
 			p.EnsureMarkControlPlane,
 			p.EnsureKeepalivedWithLBOption,
 			p.EnsureThirdPartyHA,
+			p.EnsureKubeVIP,
 			p.EnsureModifyAPIServerHost,
+			// replace master
+			p.EnsureReplaceMasterRemoveETCDMember,
+			p.EnsureReplaceMasterRemoveNode,
+			p.EnsureReplaceMasterComplete,
 			// deploy apps
+			p.EnsureRuntimeClasses,
+			p.EnsureAuditPolicy,
 			p.EnsureNvidiaDevicePlugin,
 			p.EnsureGPUManager,
 			p.EnsureCSIOperator,
 			p.EnsureMetricsServer,
+			p.EnsureMetalLB,
 
 			p.EnsureCleanup,
 			p.EnsureCreateClusterMark,
@@ -138,14 +151,22 @@ func NewProvider() (*Provider, error) {
 		},
 		UpdateHandlers: []clusterprovider.Handler{
 			p.EnsureAPIServerCert,
+			p.EnsureControlPlaneExtraArgs,
+			p.EnsureContainerRuntimeConfig,
+			p.EnsureRuntimeClasses,
+			p.EnsureAuditPolicy,
 			p.EnsureRenewCerts,
 			p.EnsureStoreCredential,
 			p.EnsureKeepalivedWithLBOption,
 			p.EnsureThirdPartyHA,
+			p.EnsureKubeVIP,
+			p.EnsureMetalLB,
+			p.EnsureWorkerUpgrade,
 		},
 		UpgradeHandlers: []clusterprovider.Handler{
 			p.EnsurePreClusterUpgradeHook,
 			p.EnsureUpgradeCoreDNS,
+			p.EnsureUpgradeCNI,
 			p.EnsureUpgradeControlPlaneNode,
 			p.EnsurePostClusterUpgradeHook,
 		},
@@ -159,6 +180,7 @@ func NewProvider() (*Provider, error) {
 		},
 	}
 	p.ScaleUpHandlers = p.CreateHandlers
+	p.PlanFunc = p.plan
 
 	cfg, err := config.New(constants.ConfigFile)
 	if err != nil {
@@ -234,10 +256,25 @@ func (p *Provider) PreCreate(cluster *types.Cluster) error {
 		cluster.Spec.Properties.MaxNodePodNum = pointer.ToInt32(256)
 	}
 	// append SkipConditions when disable the cluster features.
-	if cluster.Spec.Features.EnableCilium {
-		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "EnsureGalaxy")
-	} else {
-		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "EnsureCilium")
+	if cluster.Spec.NetworkType == "" {
+		if cluster.Spec.Features.EnableCilium {
+			cluster.Spec.NetworkType = platform.NetworkCilium
+		} else {
+			cluster.Spec.NetworkType = platform.NetworkGalaxy
+		}
+	}
+	for _, cni := range []struct {
+		networkType platform.NetworkType
+		condition   string
+	}{
+		{platform.NetworkGalaxy, "EnsureGalaxy"},
+		{platform.NetworkCilium, "EnsureCilium"},
+		{platform.NetworkCalico, "EnsureCalico"},
+		{platform.NetworkFlannel, "EnsureFlannel"},
+	} {
+		if cluster.Spec.NetworkType != cni.networkType {
+			cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, cni.condition)
+		}
 	}
 	if !cluster.Spec.Features.EnableMetricsServer {
 		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "EnsureMetricsServer")
@@ -258,3 +295,34 @@ func (p *Provider) PreCreate(cluster *types.Cluster) error {
 
 	return nil
 }
+
+var (
+	rolloutsMu sync.Mutex
+	// rollouts are the numbers of the config rollouts in progress by the
+	// name of the clusters.
+	rollouts = map[string]int{}
+)
+
+// startRollout marks a config rollout of the cluster in progress until the
+// returned function is called.
+func (p *Provider) startRollout(clusterName string) func() {
+	rolloutsMu.Lock()
+	defer rolloutsMu.Unlock()
+	rollouts[clusterName]++
+
+	return func() {
+		rolloutsMu.Lock()
+		defer rolloutsMu.Unlock()
+		rollouts[clusterName]--
+		if rollouts[clusterName] == 0 {
+			delete(rollouts, clusterName)
+		}
+	}
+}
+
+// rollingOut tells whether a config rollout of the cluster is in progress.
+func (p *Provider) rollingOut(clusterName string) bool {
+	rolloutsMu.Lock()
+	defer rolloutsMu.Unlock()
+	return rollouts[clusterName] > 0
+}
@@ -0,0 +1,187 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/etcd"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// replacedMaster returns the master to be replaced when the cluster is
+// upscaling for a master replacement.
+func replacedMaster(c *v1.Cluster) *platformv1.ClusterMachine {
+	if c.Status.Phase != platformv1.ClusterUpscaling {
+		return nil
+	}
+	ip := c.Annotations[platformv1.ReplaceMasterAnno]
+	if ip == "" {
+		return nil
+	}
+	for i := range c.Spec.Machines {
+		if c.Spec.Machines[i].IP == ip {
+			return &c.Spec.Machines[i]
+		}
+	}
+	return nil
+}
+
+// etcdClientIPs returns the ips of the masters serving etcd except the
+// replaced one.
+func etcdClientIPs(c *v1.Cluster, replaced string) []string {
+	ips := []string{}
+	for _, machine := range c.Spec.Machines {
+		if machine.IP == replaced {
+			continue
+		}
+		ips = append(ips, machine.IP)
+	}
+	return ips
+}
+
+func (p *Provider) EnsureReplaceMasterPreflight(ctx context.Context, c *v1.Cluster) error {
+	old := replacedMaster(c)
+	if old == nil {
+		return nil
+	}
+	// the new master has not joined yet
+	ips := []string{}
+	for _, ip := range etcdClientIPs(c, old.IP) {
+		scaling := false
+		for _, machine := range c.Spec.ScalingMachines {
+			scaling = scaling || machine.IP == ip
+		}
+		if !scaling {
+			ips = append(ips, ip)
+		}
+	}
+	db, err := etcd.NewClient(c.ClusterCredential, ips)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	members, err := etcd.Members(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	return etcd.CheckReplace(members, old.IP)
+}
+
+func (p *Provider) EnsureReplaceMasterRemoveETCDMember(ctx context.Context, c *v1.Cluster) error {
+	old := replacedMaster(c)
+	if old == nil {
+		return nil
+	}
+	db, err := etcd.NewClient(c.ClusterCredential, etcdClientIPs(c, old.IP))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return etcd.RemoveMember(ctx, db, old.IP)
+}
+
+func (p *Provider) EnsureReplaceMasterRemoveNode(ctx context.Context, c *v1.Cluster) error {
+	old := replacedMaster(c)
+	if old == nil {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	node, err := apiclient.GetNodeByMachineIP(ctx, client, old.IP)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		err = client.CoreV1().Nodes().Delete(ctx, node.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// the replaced master is usually broken, clean it up as far as possible
+	machineSSH, err := old.SSHWithContext(ctx)
+	if err == nil {
+		err = kubeadm.Reset(machineSSH, "cleanup-node")
+	}
+	if err != nil {
+		log.FromContext(ctx).Info("cleanup replaced master failed, skip", "ip", old.IP, "error", err.Error())
+	}
+
+	return nil
+}
+
+func (p *Provider) EnsureReplaceMasterComplete(ctx context.Context, c *v1.Cluster) error {
+	old := replacedMaster(c)
+	if old == nil {
+		return nil
+	}
+	ip := old.IP
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := p.PlatformClient.Clusters().Get(ctx, c.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		machines := []platformv1.ClusterMachine{}
+		for _, machine := range cluster.Spec.Machines {
+			if machine.IP != ip {
+				machines = append(machines, machine)
+			}
+		}
+		cluster.Spec.Machines = machines
+		delete(cluster.Annotations, platformv1.ReplaceMasterAnno)
+		cluster, err = p.PlatformClient.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+		c.ResourceVersion = cluster.ResourceVersion
+		c.Annotations = cluster.Annotations
+		c.Spec = cluster.Spec
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("remove replaced master %s from cluster error: %w", ip, err)
+	}
+
+	// apiserver certificates follow the real addresses in housekeeping
+	addresses := []platformv1.ClusterAddress{}
+	for _, address := range c.Status.Addresses {
+		if address.Type != platformv1.AddressReal || address.Host != ip {
+			addresses = append(addresses, address)
+		}
+	}
+	c.Status.Addresses = addresses
+	for _, machine := range c.Spec.ScalingMachines {
+		c.AddAddress(platformv1.AddressReal, machine.IP, 6443)
+	}
+
+	return nil
+}
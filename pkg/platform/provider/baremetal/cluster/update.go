@@ -19,19 +19,27 @@
 package cluster
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"path"
 	"reflect"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
 	certutil "k8s.io/client-go/util/cert"
@@ -42,13 +50,15 @@ import (
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
 )
 
 func (p *Provider) EnsureRenewCerts(ctx context.Context, c *v1.Cluster) error {
 	for _, machine := range c.Spec.Machines {
 		logger := log.FromContext(ctx).WithValues("node", machine.IP)
-		s, err := machine.SSH()
+		s, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -89,7 +99,7 @@ func (p *Provider) EnsureAPIServerCert(ctx context.Context, c *v1.Cluster) error
 
 	needUpload := false
 	for _, machine := range c.Spec.Machines {
-		s, err := machine.SSH()
+		s, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -167,24 +177,47 @@ func (p *Provider) EnsureUpgradeCoreDNS(ctx context.Context, c *v1.Cluster) erro
 	return nil
 }
 
-func updateCoreDNSVersion(ctx context.Context, client kubernetes.Interface, version string) error {
+// EnsureUpgradeCNI re-applies the calico or flannel manifests so that the CNI
+// images follow the platform release.
+func (p *Provider) EnsureUpgradeCNI(ctx context.Context, c *v1.Cluster) error {
+	switch util.NetworkType(c.Cluster) {
+	case platformv1.NetworkCalico:
+		return p.installCalico(ctx, c)
+	case platformv1.NetworkFlannel:
+		return p.installFlannel(ctx, c)
+	}
+	return nil
+}
+
+// getKubeadmClusterConfiguration returns the kubeadm-config ConfigMap and the
+// ClusterConfiguration uploaded in it.
+func getKubeadmClusterConfiguration(ctx context.Context, client kubernetes.Interface) (*corev1.ConfigMap, *kubeadmv1beta2.ClusterConfiguration, error) {
 	cm, err := client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, "kubeadm-config", metav1.GetOptions{})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	clsConfigData, err := yaml.ToJSON([]byte(cm.Data["ClusterConfiguration"]))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	clsConfig := kubeadmv1beta2.ClusterConfiguration{}
-	err = json.Unmarshal(clsConfigData, &clsConfig)
+	clsConfig := &kubeadmv1beta2.ClusterConfiguration{}
+	err = json.Unmarshal(clsConfigData, clsConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cm, clsConfig, nil
+}
+
+func updateCoreDNSVersion(ctx context.Context, client kubernetes.Interface, version string) error {
+	cm, clsConfig, err := getKubeadmClusterConfiguration(ctx, client)
 	if err != nil {
 		return err
 	}
 
 	clsConfig.DNS.ImageTag = version
 
-	clsConfigData, err = kubeadm.MarshalToYAML(&clsConfig)
+	clsConfigData, err := kubeadm.MarshalToYAML(clsConfig)
 	if err != nil {
 		return err
 	}
@@ -229,11 +262,11 @@ func (p *Provider) EnsureUpgradeControlPlaneNode(ctx context.Context, c *v1.Clus
 		option.MachineName = machine.Username
 		option.MachineIP = machine.IP
 		option.BootstrapNode = i == 0
-		s, err := machine.SSH()
+		s, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
-		upgraded, err := kubeadm.UpgradeNode(s, client, p.PlatformClient, logger, c, option)
+		upgraded, err := kubeadm.UpgradeNode(ctx, s, client, p.PlatformClient, logger, c, option)
 		if err != nil {
 			return err
 		}
@@ -256,7 +289,348 @@ func (p *Provider) EnsureUpgradeControlPlaneNode(ctx context.Context, c *v1.Clus
 	return nil
 }
 
+// EnsureWorkerUpgrade continues the rolling upgrade of worker machines after it
+// is resumed, or stops it after it is aborted by the worker upgrade annotation.
+func (p *Provider) EnsureWorkerUpgrade(ctx context.Context, c *v1.Cluster) error {
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+
+	return kubeadm.ReconcileWorkerUpgrade(client, p.PlatformClient, c.Cluster)
+}
+
 func (p *Provider) EnsurePostClusterUpgradeHook(ctx context.Context, c *v1.Cluster) error {
 
 	return util.ExcuteCustomizedHook(ctx, c, platformv1.HookPostClusterUpgrade, c.Spec.Machines[:1])
 }
+
+const (
+	// controlPlaneBackupDir keeps the static pod manifests during reconfiguring
+	controlPlaneBackupDir = constants.KubernetesDir + "tmp/tke-control-plane-backup/"
+	// configHashAnnotation is the hash of the manifest set on mirror pods by kubelet
+	configHashAnnotation = "kubernetes.io/config.hash"
+	// conditionTypeExtraArgsRolledBack is the condition raised on the cluster
+	// while the extra args of the control plane in the spec are rolled back.
+	conditionTypeExtraArgsRolledBack = "ExtraArgsRolledBack"
+)
+
+// EnsureControlPlaneExtraArgs rolls out the changed extra args of the control
+// plane components in the cluster spec master by master. The extra args rolled
+// out last time are recorded on the kubeadm-config ConfigMap. The rollout is
+// all or nothing: a master failing to become healthy with the new args is
+// rolled back together with the masters reconfigured before it, and the
+// cluster keeps the ExtraArgsRolledBack condition until the failed args are
+// changed in the spec.
+func (p *Provider) EnsureControlPlaneExtraArgs(ctx context.Context, c *v1.Cluster) error {
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	cm, applied, err := getKubeadmClusterConfiguration(ctx, client)
+	if err != nil {
+		return err
+	}
+	userArgs := userExtraArgs(c)
+	hash, err := extraArgsHash(userArgs)
+	if err != nil {
+		return err
+	}
+	if cm.Annotations[constants.AnnotationControlPlaneExtraArgsFailedHash] == hash {
+		log.FromContext(ctx).Info("Skip reconfiguring control plane with the extra args rolled back")
+		// keep the reason of the rollback raised in the rollout
+		if c.GetCondition(conditionTypeExtraArgsRolledBack) == nil {
+			setRolledBackCondition(c.Cluster, conditionTypeExtraArgsRolledBack,
+				"extra args of the control plane have been rolled back, they are not rolled out again until they are changed")
+		}
+		return nil
+	}
+	setRolledBackCondition(c.Cluster, conditionTypeExtraArgsRolledBack, "")
+	rolledOut, err := rolledOutExtraArgs(cm, applied, userArgs)
+	if err != nil {
+		return err
+	}
+	var pods []staticPod
+	for _, pod := range staticPods {
+		if !equalArgs(rolledOut[pod.name], userArgs[pod.name]) {
+			pods = append(pods, pod)
+		}
+	}
+	if len(pods) == 0 {
+		if _, ok := cm.Annotations[constants.AnnotationControlPlaneExtraArgs]; ok {
+			return nil
+		}
+		return recordExtraArgs(ctx, client, userArgs, "")
+	}
+	defer p.startRollout(c.Name)()
+
+	expectedArgs := staticPodExtraArgs(p.getKubeadmInitConfig(c).ClusterConfiguration)
+	var reconfigured []platformv1.ClusterMachine
+	for _, machine := range c.Spec.Machines {
+		log.FromContext(ctx).Info("Reconfiguring control plane", "node", machine.IP)
+		err := p.reconfigureControlPlane(ctx, c, client, machine, pods, expectedArgs)
+		if err != nil {
+			err = errors.Wrap(err, machine.IP)
+			setRolledBackCondition(c.Cluster, conditionTypeExtraArgsRolledBack, err.Error())
+			if rollbackErr := rollbackControlPlane(ctx, reconfigured, pods); rollbackErr != nil {
+				err = fmt.Errorf("rollback reconfigured masters error: %v, reconfigure error: %w", rollbackErr, err)
+			}
+			if recordErr := recordExtraArgs(ctx, client, nil, hash); recordErr != nil {
+				return fmt.Errorf("record rolled back extra args error: %v, %w", recordErr, err)
+			}
+			return err
+		}
+		reconfigured = append(reconfigured, machine)
+	}
+
+	// record the rolled out extra args
+	err = p.EnsureKubeadmInitPhaseUploadConfig(ctx, c)
+	if err == nil {
+		err = recordExtraArgs(ctx, client, userArgs, "")
+	}
+	if err != nil {
+		return err
+	}
+	for _, machine := range reconfigured {
+		removeControlPlaneBackup(ctx, machine)
+	}
+	return nil
+}
+
+// userExtraArgs returns the extra args of the control plane components
+// supplied in the cluster spec by the name of the static pods.
+func userExtraArgs(c *v1.Cluster) map[string]map[string]string {
+	return map[string]map[string]string{
+		"kube-apiserver":          c.Spec.APIServerExtraArgs,
+		"kube-controller-manager": c.Spec.ControllerManagerExtraArgs,
+		"kube-scheduler":          c.Spec.SchedulerExtraArgs,
+	}
+}
+
+func extraArgsHash(args map[string]map[string]string) (string, error) {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// rolledOutExtraArgs returns the user supplied extra args recorded on the
+// kubeadm-config ConfigMap. For the cluster without the record, the args
+// of the applied cluster configuration with the keys of userArgs are taken.
+func rolledOutExtraArgs(cm *corev1.ConfigMap, applied *kubeadmv1beta2.ClusterConfiguration,
+	userArgs map[string]map[string]string) (map[string]map[string]string, error) {
+	if data, ok := cm.Annotations[constants.AnnotationControlPlaneExtraArgs]; ok {
+		args := map[string]map[string]string{}
+		if err := json.Unmarshal([]byte(data), &args); err != nil {
+			return nil, errors.Wrapf(err, "parse %s error", constants.AnnotationControlPlaneExtraArgs)
+		}
+		return args, nil
+	}
+
+	appliedArgs := staticPodExtraArgs(applied)
+	args := map[string]map[string]string{}
+	for name, one := range userArgs {
+		for k := range one {
+			if v, ok := appliedArgs[name][k]; ok {
+				if args[name] == nil {
+					args[name] = map[string]string{}
+				}
+				args[name][k] = v
+			}
+		}
+	}
+	return args, nil
+}
+
+// recordExtraArgs records the rolled out extra args or the hash of the rolled
+// back ones on the kubeadm-config ConfigMap.
+func recordExtraArgs(ctx context.Context, client kubernetes.Interface, args map[string]map[string]string, failedHash string) error {
+	annotations := map[string]interface{}{}
+	if failedHash != "" {
+		annotations[constants.AnnotationControlPlaneExtraArgsFailedHash] = failedHash
+	} else {
+		data, err := json.Marshal(args)
+		if err != nil {
+			return err
+		}
+		annotations[constants.AnnotationControlPlaneExtraArgs] = string(data)
+		annotations[constants.AnnotationControlPlaneExtraArgsFailedHash] = nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Patch(ctx, "kubeadm-config", types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// reconfigureControlPlane regenerates the static pod manifests on the master
+// and restores the previous manifests if the static pods are not healthy. The
+// previous manifests are kept in the backup dir after the master is
+// reconfigured.
+func (p *Provider) reconfigureControlPlane(ctx context.Context, c *v1.Cluster, client kubernetes.Interface,
+	machine platformv1.ClusterMachine, pods []staticPod, expectedArgs map[string]map[string]string) error {
+	s, err := machine.SSHWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	node, err := apiclient.GetNodeByMachineIP(ctx, client, machine.IP)
+	if err != nil {
+		return err
+	}
+
+	cmds := []string{fmt.Sprintf("rm -rf %s", controlPlaneBackupDir), fmt.Sprintf("mkdir -p %s", controlPlaneBackupDir)}
+	for _, pod := range pods {
+		cmds = append(cmds, fmt.Sprintf("cp -f %s %s", pod.manifest, controlPlaneBackupDir))
+	}
+	if _, err := s.CombinedOutput(strings.Join(cmds, " && ")); err != nil {
+		return errors.Wrap(err, "backup static pod manifests error")
+	}
+	err = kubeadm.WriteInitConfig(s, p.getKubeadmInitConfigOnMachine(c, machine.IP))
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods {
+		err = regenerateStaticPod(ctx, s, client, node.Name, pod, expectedArgs[pod.name])
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	log.FromContext(ctx).Error(err, "Reconfigure control plane failed, rolling back", "node", machine.IP)
+	if rollbackErr := restoreStaticPods(s, pods); rollbackErr != nil {
+		return fmt.Errorf("%v, reconfigure error: %w", rollbackErr, err)
+	}
+
+	return fmt.Errorf("rolled back after reconfigure error: %w", err)
+}
+
+// restoreStaticPods restores the static pod manifests from the backup dir on
+// the master and waits for kube-apiserver, the backup dir is removed after
+// kube-apiserver is healthy.
+func restoreStaticPods(s ssh.Interface, pods []staticPod) error {
+	var cmds []string
+	for _, pod := range pods {
+		cmds = append(cmds, fmt.Sprintf("cp -f %s %s", path.Join(controlPlaneBackupDir, path.Base(pod.manifest)), pod.manifest))
+	}
+	if _, err := s.CombinedOutput(strings.Join(cmds, " && ")); err != nil {
+		return errors.Wrap(err, "rollback static pod manifests error")
+	}
+	if err := kubeadm.WaitForAPIServer(s); err != nil {
+		return errors.Wrap(err, "wait for kube-apiserver after rollback error")
+	}
+	_, _ = s.CombinedOutput(fmt.Sprintf("rm -rf %s", controlPlaneBackupDir))
+	return nil
+}
+
+// rollbackControlPlane restores the static pod manifests of the reconfigured
+// masters, the last reconfigured first.
+func rollbackControlPlane(ctx context.Context, machines []platformv1.ClusterMachine, pods []staticPod) error {
+	var errs []error
+	for i := len(machines) - 1; i >= 0; i-- {
+		machine := machines[i]
+		log.FromContext(ctx).Info("Rolling back control plane", "node", machine.IP)
+		s, err := machine.SSHWithContext(ctx)
+		if err == nil {
+			err = restoreStaticPods(s, pods)
+		}
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, machine.IP))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// removeControlPlaneBackup removes the backup dir of the reconfigured master
+// after the rollout completes, a failure is only logged.
+func removeControlPlaneBackup(ctx context.Context, machine platformv1.ClusterMachine) {
+	s, err := machine.SSHWithContext(ctx)
+	if err == nil {
+		_, err = s.CombinedOutput(fmt.Sprintf("rm -rf %s", controlPlaneBackupDir))
+	}
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Remove control plane backup failed", "node", machine.IP)
+	}
+}
+
+// regenerateStaticPod regenerates the manifest of the static pod and waits
+// until the static pod is recreated from it.
+func regenerateStaticPod(ctx context.Context, s ssh.Interface, client kubernetes.Interface, nodeName string, pod staticPod, expected map[string]string) error {
+	before, err := s.ReadFile(pod.manifest)
+	if err != nil {
+		return err
+	}
+	hash := ""
+	if mirror, err := client.CoreV1().Pods(metav1.NamespaceSystem).Get(ctx, pod.name+"-"+nodeName, metav1.GetOptions{}); err == nil {
+		hash = mirror.Annotations[configHashAnnotation]
+	}
+	if err := kubeadm.Init(s, pod.phase); err != nil {
+		return err
+	}
+	after, err := s.ReadFile(pod.manifest)
+	if err != nil {
+		return err
+	}
+	// kubelet keeps the static pod if the manifest is not changed
+	if bytes.Equal(before, after) {
+		hash = ""
+	}
+
+	return waitForStaticPod(ctx, client, nodeName, pod, hash, expected)
+}
+
+// waitForStaticPod waits until the mirror pod of the static pod on the node
+// is recreated with a config hash other than oldHash, runs with the expected
+// args and is ready.
+func waitForStaticPod(ctx context.Context, client kubernetes.Interface, nodeName string, pod staticPod, oldHash string, expected map[string]string) error {
+	var lastErr error
+	err := wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
+		mirror, err := client.CoreV1().Pods(metav1.NamespaceSystem).Get(ctx, pod.name+"-"+nodeName, metav1.GetOptions{})
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		if oldHash != "" && mirror.Annotations[configHashAnnotation] == oldHash {
+			lastErr = fmt.Errorf("%s is not recreated", mirror.Name)
+			return false, nil
+		}
+		args, err := podArgs(mirror, pod.name)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		if drifts := diffArgs(pod.name, args, expected); len(drifts) != 0 {
+			lastErr = fmt.Errorf("%s %s", pod.name, drifts[0].Message)
+			return false, nil
+		}
+		if !apiclient.IsPodReady(mirror) {
+			lastErr = fmt.Errorf("%s is not ready", mirror.Name)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("wait for %s error: %w", pod.name, lastErr)
+	}
+	return err
+}
+
+func equalArgs(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+)
+
+func TestRolledOutExtraArgs(t *testing.T) {
+	applied := &kubeadmv1beta2.ClusterConfiguration{}
+	applied.APIServer.ExtraArgs = map[string]string{
+		"token-auth-file": constants.TokenFile,
+		"v":               "2",
+	}
+	userArgs := map[string]map[string]string{
+		"kube-apiserver": {"v": "4", "max-requests-inflight": "800"},
+	}
+
+	got, err := rolledOutExtraArgs(&corev1.ConfigMap{}, applied, userArgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]map[string]string{"kube-apiserver": {"v": "2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rolledOutExtraArgs() without record = %v, want %v", got, want)
+	}
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		constants.AnnotationControlPlaneExtraArgs: `{"kube-scheduler":{"v":"3"}}`,
+	}}}
+	got, err = rolledOutExtraArgs(cm, applied, userArgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]map[string]string{"kube-scheduler": {"v": "3"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rolledOutExtraArgs() with record = %v, want %v", got, want)
+	}
+}
+
+func TestExtraArgsHash(t *testing.T) {
+	a, _ := extraArgsHash(map[string]map[string]string{"kube-apiserver": {"v": "2", "a": "b"}})
+	b, _ := extraArgsHash(map[string]map[string]string{"kube-apiserver": {"a": "b", "v": "2"}})
+	c, _ := extraArgsHash(map[string]map[string]string{"kube-apiserver": {"v": "4", "a": "b"}})
+	if a != b || a == c {
+		t.Errorf("extraArgsHash() = %s, %s, %s", a, b, c)
+	}
+}
@@ -106,6 +106,9 @@ func GetAPIServerCertSANs(c *platformv1.Cluster) []string {
 		if c.Spec.Features.HA.ThirdPartyHA != nil {
 			certSANs.Insert(c.Spec.Features.HA.ThirdPartyHA.VIP)
 		}
+		if c.Spec.Features.HA.KubeVIPHA != nil {
+			certSANs.Insert(c.Spec.Features.HA.KubeVIPHA.VIP)
+		}
 	}
 	for _, address := range c.Status.Addresses {
 		certSANs.Insert(address.Host)
@@ -138,3 +141,33 @@ func CalcNodeCidrSize(podSubnet string) (int32, bool) {
 	}
 	return int32(maskSize), isIPv6
 }
+
+// reasonRolledBack is the reason of the conditions raised while a config of
+// the cluster spec is rolled back.
+const reasonRolledBack = "RolledBack"
+
+// setRolledBackCondition raises the condition of conditionType with the
+// message while a config of the cluster spec is rolled back, and removes the
+// condition otherwise. The condition is true while it is raised, a false or
+// unknown condition would block the provider handlers of the cluster.
+func setRolledBackCondition(c *platformv1.Cluster, conditionType string, message string) {
+	if message == "" {
+		if c.GetCondition(conditionType) == nil {
+			return
+		}
+		conditions := make([]platformv1.ClusterCondition, 0, len(c.Status.Conditions))
+		for _, condition := range c.Status.Conditions {
+			if condition.Type != conditionType {
+				conditions = append(conditions, condition)
+			}
+		}
+		c.Status.Conditions = conditions
+		return
+	}
+	c.SetCondition(platformv1.ClusterCondition{
+		Type:    conditionType,
+		Status:  platformv1.ConditionTrue,
+		Reason:  reasonRolledBack,
+		Message: message,
+	}, false)
+}
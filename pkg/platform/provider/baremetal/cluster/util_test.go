@@ -18,7 +18,11 @@
 
 package cluster
 
-import "testing"
+import (
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
 
 func TestGetServiceCIDRAndNodeCIDRMaskSize(t *testing.T) {
 	type args struct {
@@ -156,3 +160,23 @@ func TestGetNodeCIDRMaskSize(t *testing.T) {
 		})
 	}
 }
+
+func TestSetRolledBackCondition(t *testing.T) {
+	c := &platformv1.Cluster{}
+	c.Status.Conditions = []platformv1.ClusterCondition{{Type: "EnsureDone", Status: platformv1.ConditionTrue}}
+
+	setRolledBackCondition(c, conditionTypeContainerRuntimeConfigRolledBack, "1.1.1.1: rolled back")
+	condition := c.GetCondition(conditionTypeContainerRuntimeConfigRolledBack)
+	if condition == nil || condition.Status != platformv1.ConditionTrue || condition.Message != "1.1.1.1: rolled back" {
+		t.Fatalf("setRolledBackCondition() raised %+v, want a true condition with the message", condition)
+	}
+	setRolledBackCondition(c, conditionTypeContainerRuntimeConfigRolledBack, "1.1.1.2: rolled back")
+	if len(c.Status.Conditions) != 2 || c.GetCondition(conditionTypeContainerRuntimeConfigRolledBack).Message != "1.1.1.2: rolled back" {
+		t.Errorf("setRolledBackCondition() = %+v, want the condition updated", c.Status.Conditions)
+	}
+
+	setRolledBackCondition(c, conditionTypeContainerRuntimeConfigRolledBack, "")
+	if len(c.Status.Conditions) != 1 || c.GetCondition(conditionTypeContainerRuntimeConfigRolledBack) != nil {
+		t.Errorf("setRolledBackCondition() = %+v, want the condition removed", c.Status.Conditions)
+	}
+}
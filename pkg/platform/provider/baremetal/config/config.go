@@ -57,6 +57,7 @@ type Config struct {
 	Scheduler                  Scheduler         `yaml:"scheduler"`
 	AuthzWebhook               AuthzWebhook      `yaml:"authzWebhook"`
 	Business                   Business          `yaml:"business"`
+	OSPackages                 OSPackages        `yaml:"osPackages"`
 	SupportOSList              []string          `yaml:"supportOSList"`
 }
 
@@ -120,3 +121,12 @@ type AuthzWebhook struct {
 type Business struct {
 	Enabled bool `yaml:"enabled"`
 }
+
+// OSPackages configures the offline package repository nodes install the
+// baseline OS packages from.
+type OSPackages struct {
+	// Repository is the base URL of a served repository, which must be laid out
+	// as <repository>/<family>/<arch>/. The bundled repository is copied to
+	// nodes over SSH when empty.
+	Repository string `yaml:"repository"`
+}
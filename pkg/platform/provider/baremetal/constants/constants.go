@@ -41,6 +41,9 @@ const (
 	KubernetesAuthzWebhookConfigFile    = KubernetesDir + AuthzWebhookConfigName
 	KubeadmConfigFileName               = KubernetesDir + "kubeadm-config.yaml"
 	KubeletKubeConfigFileName           = KubernetesDir + "kubelet.conf"
+	// KubeletConfigFile and KubeletFlagsEnvFile are written by kubeadm kubelet-start phase
+	KubeletConfigFile   = "/var/lib/kubelet/config.yaml"
+	KubeletFlagsEnvFile = "/var/lib/kubelet/kubeadm-flags.env"
 
 	KubeletPodManifestDir                = KubernetesDir + "manifests/"
 	EtcdPodManifestFile                  = KubeletPodManifestDir + "etcd.yaml"
@@ -48,6 +51,7 @@ const (
 	KubeControllerManagerPodManifestFile = KubeletPodManifestDir + "kube-controller-manager.yaml"
 	KubeSchedulerPodManifestFile         = KubeletPodManifestDir + "kube-scheduler.yaml"
 	KeepavlivedManifestFile              = KubeletPodManifestDir + "keepalived.yaml"
+	KubeVIPManifestFile                  = KubeletPodManifestDir + "kube-vip.yaml"
 
 	KubeadmPathInNodePackge = "kubernetes/node/bin/kubeadm"
 	KubeletPathInNodePackge = "kubernetes/node/bin/kubelet"
@@ -85,12 +89,22 @@ const (
 	APIServerCertName = CertificatesDir + "apiserver.crt"
 	// APIServerKeyName defines API's server key name
 	APIServerKeyName = CertificatesDir + "apiserver.key"
+	// APIServerKubeletClientCertName defines apiserver's kubelet client certificate name
+	APIServerKubeletClientCertName = CertificatesDir + "apiserver-kubelet-client.crt"
+	// FrontProxyClientCertName defines front proxy client certificate name
+	FrontProxyClientCertName = CertificatesDir + "front-proxy-client.crt"
 	// KubeletClientCurrent defines kubelet rotate certificates
 	KubeletClientCurrent = "/var/lib/kubelet/pki/kubelet-client-current.pem"
 	// EtcdCACertName defines etcd's CA certificate name
 	EtcdCACertName = CertificatesDir + "etcd/ca.crt"
 	// EtcdCAKeyName defines etcd's CA key name
 	EtcdCAKeyName = CertificatesDir + "etcd/ca.key"
+	// EtcdServerCertName defines etcd's server certificate name
+	EtcdServerCertName = CertificatesDir + "etcd/server.crt"
+	// EtcdPeerCertName defines etcd's peer certificate name
+	EtcdPeerCertName = CertificatesDir + "etcd/peer.crt"
+	// EtcdHealthcheckClientCertName defines etcd's healthcheck client certificate name
+	EtcdHealthcheckClientCertName = CertificatesDir + "etcd/healthcheck-client.crt"
 	// EtcdListenClientPort defines the port etcd listen on for client traffic
 	EtcdListenClientPort = 2379
 	// EtcdListenPeerPort defines the port etcd listen on for peer traffic
@@ -106,6 +120,29 @@ const (
 
 	// LabelNodeNeedUpgrade specifies that a node need upgrade.
 	LabelNodeNeedUpgrade = platformv1.GroupName + "/need-upgrade"
+	// AnnotationUpgradeFailures is the number of failed upgrades of a worker
+	// node since it was marked to be upgraded.
+	AnnotationUpgradeFailures = platformv1.GroupName + "/upgrade-failures"
+
+	// LabelRuntimeClassManaged specifies that a RuntimeClass is managed by the
+	// container runtime config of cluster.
+	LabelRuntimeClassManaged = platformv1.GroupName + "/runtime-class-managed"
+
+	// AnnotationContainerRuntimeConfigHash is the hash of the container
+	// runtime config last rolled out to a node.
+	AnnotationContainerRuntimeConfigHash = platformv1.GroupName + "/container-runtime-config-hash"
+	// AnnotationContainerRuntimeConfigFailedHash is the hash of the container
+	// runtime config rolled back on a node, which is not retried.
+	AnnotationContainerRuntimeConfigFailedHash = platformv1.GroupName + "/container-runtime-config-failed-hash"
+	// AnnotationControlPlaneExtraArgs is the user supplied extra args of the
+	// control plane components last rolled out, set on the kubeadm-config.
+	AnnotationControlPlaneExtraArgs = platformv1.GroupName + "/control-plane-extra-args"
+	// AnnotationControlPlaneExtraArgsFailedHash is the hash of the user
+	// supplied extra args rolled back, which is not retried.
+	AnnotationControlPlaneExtraArgsFailedHash = platformv1.GroupName + "/control-plane-extra-args-failed-hash"
+	// AnnotationAuditPolicyHash is the hash of the audit policy last rolled
+	// out to a master.
+	AnnotationAuditPolicyHash = platformv1.GroupName + "/audit-policy-hash"
 
 	// Provider
 	ProviderDir           = "provider/baremetal/"
@@ -119,6 +156,10 @@ const (
 	CSIOperatorManifest   = ManifestsDir + "csi-operator/csi-operator.yaml"
 	MetricsServerManifest = ManifestsDir + "metrics-server/metrics-server.yaml"
 	CiliumManifest        = SrcDir + "cilium/*.yaml"
+	CalicoManifest        = ManifestsDir + "calico/calico.yaml"
+	FlannelManifest       = ManifestsDir + "flannel/flannel.yaml"
+	KubeVIPManifest       = ManifestsDir + "kube-vip/kube-vip.yaml"
+	MetalLBManifest       = ManifestsDir + "metallb/metallb.yaml"
 
 	KUBERNETES                   = 1
 	DNSIPIndex                   = 10
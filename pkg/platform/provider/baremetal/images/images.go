@@ -43,6 +43,15 @@ type Components struct {
 	Ipamd          containerregistry.Image
 	Masq           containerregistry.Image
 	CiliumRouter   containerregistry.Image
+
+	CalicoNode            containerregistry.Image
+	CalicoCNI             containerregistry.Image
+	CalicoKubeControllers containerregistry.Image
+	Flannel               containerregistry.Image
+
+	KubeVIP           containerregistry.Image
+	MetalLBController containerregistry.Image
+	MetalLBSpeaker    containerregistry.Image
 }
 
 func (c Components) Get(name string) *containerregistry.Image {
@@ -79,6 +88,15 @@ var components = Components{
 	Ipamd:          containerregistry.Image{Name: "tke-eni-ipamd", Tag: "v3.3.3"},
 	Masq:           containerregistry.Image{Name: "ip-masq-agent", Tag: "v1.0.0"},
 	CiliumRouter:   containerregistry.Image{Name: "cilium-router", Tag: "v0.1.0"},
+
+	CalicoNode:            containerregistry.Image{Name: "calico-node", Tag: "v3.19.1"},
+	CalicoCNI:             containerregistry.Image{Name: "calico-cni", Tag: "v3.19.1"},
+	CalicoKubeControllers: containerregistry.Image{Name: "calico-kube-controllers", Tag: "v3.19.1"},
+	Flannel:               containerregistry.Image{Name: "flannel", Tag: "v0.14.0"},
+
+	KubeVIP:           containerregistry.Image{Name: "kube-vip", Tag: "v0.4.0"},
+	MetalLBController: containerregistry.Image{Name: "metallb-controller", Tag: "v0.12.1"},
+	MetalLBSpeaker:    containerregistry.Image{Name: "metallb-speaker", Tag: "v0.12.1"},
 }
 
 func List() []string {
@@ -43,13 +43,13 @@ import (
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeconfig"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubelet"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/ospackage"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/preflight"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/cmdstring"
-	containerregistryutil "tkestack.io/tke/pkg/util/containerregistry"
 	"tkestack.io/tke/pkg/util/hosts"
 )
 
@@ -60,23 +60,27 @@ const (
 )
 
 func (p *Provider) EnsureCopyFiles(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
 
 	for _, file := range cluster.Spec.Features.Files {
-		s, err := os.Stat(file.Src)
+		src, err := res.SourceForNode(machineSSH, file.Src)
+		if err != nil {
+			return err
+		}
+		s, err := os.Stat(src)
 		if err != nil {
 			return err
 		}
 		if s.Mode().IsDir() {
-			err = machineSSH.CopyDir(file.Src, file.Dst)
+			err = machineSSH.CopyDir(src, file.Dst)
 			if err != nil {
 				return err
 			}
 		} else {
-			err = machineSSH.CopyFile(file.Src, file.Dst)
+			err = machineSSH.CopyFile(src, file.Dst)
 			if err != nil {
 				return err
 			}
@@ -90,10 +94,11 @@ func (p *Provider) EnsurePreInstallHook(ctx context.Context, machine *platformv1
 
 	mc := []platformv1.ClusterMachine{
 		{
-			IP:       machine.Spec.IP,
-			Port:     machine.Spec.Port,
-			Username: machine.Spec.Username,
-			Password: machine.Spec.Password,
+			IP:             machine.Spec.IP,
+			Port:           machine.Spec.Port,
+			Username:       machine.Spec.Username,
+			Password:       machine.Spec.Password,
+			CredentialName: machine.Spec.CredentialName,
 		},
 	}
 	return util.ExcuteCustomizedHook(ctx, cluster, platformv1.HookPreInstall, mc)
@@ -103,17 +108,18 @@ func (p *Provider) EnsurePostInstallHook(ctx context.Context, machine *platformv
 
 	mc := []platformv1.ClusterMachine{
 		{
-			IP:       machine.Spec.IP,
-			Port:     machine.Spec.Port,
-			Username: machine.Spec.Username,
-			Password: machine.Spec.Password,
+			IP:             machine.Spec.IP,
+			Port:           machine.Spec.Port,
+			Username:       machine.Spec.Username,
+			Password:       machine.Spec.Password,
+			CredentialName: machine.Spec.CredentialName,
 		},
 	}
 	return util.ExcuteCustomizedHook(ctx, cluster, platformv1.HookPostInstall, mc)
 }
 
 func (p *Provider) EnsureClean(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -127,7 +133,7 @@ func (p *Provider) EnsureClean(ctx context.Context, machine *platformv1.Machine,
 }
 
 func (p *Provider) EnsurePreflight(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -136,12 +142,13 @@ func (p *Provider) EnsurePreflight(ctx context.Context, machine *platformv1.Mach
 	if err != nil {
 		return err
 	}
+	machine.Status.MachineInfo.Architecture = res.Arch(machineSSH)
 
 	return nil
 }
 
 func (p *Provider) EnsureRegistryHosts(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -169,7 +176,7 @@ func (p *Provider) EnsureRegistryHosts(ctx context.Context, machine *platformv1.
 }
 
 func (p *Provider) EnsureKernelModule(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	s, err := machine.Spec.SSH()
+	s, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -195,7 +202,7 @@ func (p *Provider) EnsureKernelModule(ctx context.Context, machine *platformv1.M
 }
 
 func (p *Provider) EnsureSysctl(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -226,7 +233,7 @@ func (p *Provider) EnsureSysctl(ctx context.Context, machine *platformv1.Machine
 }
 
 func (p *Provider) EnsureDisableSwap(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -239,8 +246,18 @@ func (p *Provider) EnsureDisableSwap(ctx context.Context, machine *platformv1.Ma
 	return nil
 }
 
+func (p *Provider) EnsureOSPackages(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	option := &ospackage.Option{Repository: p.config.OSPackages.Repository}
+	return ospackage.Install(machineSSH, option)
+}
+
 func (p *Provider) EnsureManifestDir(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -258,7 +275,7 @@ func (p *Provider) EnsureKubeconfig(ctx context.Context, machine *platformv1.Mac
 		return err
 	}
 
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -282,7 +299,7 @@ func (p *Provider) EnsureNvidiaDriver(ctx context.Context, machine *platformv1.M
 		return nil
 	}
 
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -295,7 +312,7 @@ func (p *Provider) EnsureNvidiaContainerRuntime(ctx context.Context, machine *pl
 		return nil
 	}
 
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -311,11 +328,11 @@ func (p *Provider) EnsureContainerRuntime(ctx context.Context, machine *platform
 }
 
 func (p *Provider) EnsureKubernetesImages(ctx context.Context, machine *platformv1.Machine, c *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
-	option := &image.Option{Version: c.Spec.Version, RegistryDomain: p.config.Registry.Domain, KubeImages: images.KubeNodeImages}
+	option := &image.Option{Version: c.Spec.Version, RegistryDomain: p.config.Registry.Domain, KubeImages: images.KubeNodeImages, AddonImages: image.NodeAddonImages(c)}
 	err = image.PullKubernetesImages(c, machineSSH, option)
 	if err != nil {
 		return err
@@ -325,23 +342,12 @@ func (p *Provider) EnsureKubernetesImages(ctx context.Context, machine *platform
 }
 
 func (p *Provider) EnsureContainerd(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	insecureRegistries := []string{p.config.Registry.Domain}
-	if machine.Spec.TenantID != "" {
-		insecureRegistries = append(insecureRegistries, machine.Spec.TenantID+"."+p.config.Registry.Domain)
-	}
-
-	option := &containerd.Option{
-		InsecureRegistries: insecureRegistries,
-		IsGPU:              gpu.IsEnable(machine.Spec.Labels),
-		SandboxImage:       images.Get().Pause.FullName(),
-		// for mirror, we just need domain in prefix
-		RegistryMirror: strings.Split(containerregistryutil.GetPrefix(), "/")[0],
-	}
+	option := containerd.MachineOption(p.config.Registry.Domain, &machine.Spec, cluster.Spec.ContainerRuntimeConfig)
 	err = containerd.Install(machineSSH, option)
 	if err != nil {
 		return err
@@ -351,7 +357,7 @@ func (p *Provider) EnsureContainerd(ctx context.Context, machine *platformv1.Mac
 }
 
 func (p *Provider) EnsureDocker(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -361,7 +367,9 @@ func (p *Provider) EnsureDocker(ctx context.Context, machine *platformv1.Machine
 		insecureRegistries = fmt.Sprintf(`%s,"%s"`, insecureRegistries, machine.Spec.TenantID+"."+p.config.Registry.Domain)
 	}
 
-	extraArgs := cluster.Spec.DockerExtraArgs
+	extraArgs := map[string]string{}
+	utilruntime.Must(mergo.Merge(&extraArgs, machine.Spec.DockerExtraArgs))
+	utilruntime.Must(mergo.Merge(&extraArgs, cluster.Spec.DockerExtraArgs))
 	utilruntime.Must(mergo.Merge(&extraArgs, p.config.Docker.ExtraArgs))
 	option := &docker.Option{
 		InsecureRegistries: insecureRegistries,
@@ -378,7 +386,7 @@ func (p *Provider) EnsureDocker(ctx context.Context, machine *platformv1.Machine
 }
 
 func (p *Provider) EnsureKubelet(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -392,7 +400,7 @@ func (p *Provider) EnsureKubelet(ctx context.Context, machine *platformv1.Machin
 }
 
 func (p *Provider) EnsureCNIPlugins(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -406,7 +414,7 @@ func (p *Provider) EnsureCNIPlugins(ctx context.Context, machine *platformv1.Mac
 }
 
 func (p *Provider) EnsureConntrackTools(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -420,7 +428,7 @@ func (p *Provider) EnsureConntrackTools(ctx context.Context, machine *platformv1
 }
 
 func (p *Provider) EnsureKubeadm(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -438,12 +446,12 @@ func (p *Provider) EnsureKubeadm(ctx context.Context, machine *platformv1.Machin
 }
 
 func (p *Provider) EnsureJoinPhasePreflight(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = kubeadm.Join(machineSSH, p.getKubeadmJoinConfig(cluster, machine.Spec.IP), "preflight", []string{constants.APIServerHostName})
+	err = kubeadm.Join(machineSSH, p.getKubeadmJoinConfig(cluster, machine), "preflight", []string{constants.APIServerHostName})
 	if err != nil {
 		return err
 	}
@@ -452,12 +460,12 @@ func (p *Provider) EnsureJoinPhasePreflight(ctx context.Context, machine *platfo
 }
 
 func (p *Provider) EnsureJoinPhaseKubeletStart(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = kubeadm.Join(machineSSH, p.getKubeadmJoinConfig(cluster, machine.Spec.IP), "kubelet-start", []string{constants.APIServerHostName})
+	err = kubeadm.Join(machineSSH, p.getKubeadmJoinConfig(cluster, machine), "kubelet-start", []string{constants.APIServerHostName})
 	if err != nil {
 		return err
 	}
@@ -504,7 +512,7 @@ func (p *Provider) EnsureNodeReady(ctx context.Context, machine *platformv1.Mach
 }
 
 func (p *Provider) EnsureInitAPIServerHost(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -517,6 +525,9 @@ func (p *Provider) EnsureInitAPIServerHost(ctx context.Context, machine *platfor
 		if cluster.Spec.Features.HA.ThirdPartyHA != nil {
 			apiserverIP = cluster.Spec.Features.HA.ThirdPartyHA.VIP
 		}
+		if cluster.Spec.Features.HA.KubeVIPHA != nil {
+			apiserverIP = cluster.Spec.Features.HA.KubeVIPHA.VIP
+		}
 	}
 	return remoteHosts.Set(apiserverIP)
 }
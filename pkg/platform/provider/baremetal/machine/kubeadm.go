@@ -27,24 +27,26 @@ import (
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/util/apiclient"
 )
 
-func (p *Provider) getKubeadmJoinConfig(c *v1.Cluster, machineIP string) *kubeadmv1beta2.JoinConfiguration {
+func (p *Provider) getKubeadmJoinConfig(c *v1.Cluster, machine *platformv1.Machine) *kubeadmv1beta2.JoinConfiguration {
 	apiServerEndpoint, err := c.Host()
 	if err != nil {
 		panic(err)
 	}
 
+	machineIP := machine.Spec.IP
 	nodeRegistration := kubeadmv1beta2.NodeRegistrationOptions{}
-	kubeletExtraArgs := p.getKubeletExtraArgs(c)
+	kubeletExtraArgs := p.getKubeletExtraArgs(c, machine)
 	if !utilsnet.IsIPv6String(c.Spec.Machines[0].IP) {
 		kubeletExtraArgs["node-labels"] = fmt.Sprintf("%s=%s", apiclient.LabelMachineIPV4, machineIP)
 	} else {
 		kubeletExtraArgs["node-labels"] = apiclient.GetNodeIPV6Label(machineIP)
 	}
-	if c.Cluster.Spec.Features.EnableCilium && c.Cluster.Spec.NetworkArgs["networkMode"] == "underlay" {
+	if util.NetworkType(c.Cluster) == platformv1.NetworkCilium && c.Cluster.Spec.NetworkArgs["networkMode"] == "underlay" {
 		if asn, ok := c.Cluster.Spec.NetworkArgs["asn"]; ok {
 			kubeletExtraArgs["node-labels"] = fmt.Sprintf("%s,%s=%s", kubeletExtraArgs["node-labels"], apiclient.LabelASNCilium, asn)
 		}
@@ -84,7 +86,7 @@ func (p *Provider) getKubeadmJoinConfig(c *v1.Cluster, machineIP string) *kubead
 	}
 }
 
-func (p *Provider) getKubeletExtraArgs(c *v1.Cluster) map[string]string {
+func (p *Provider) getKubeletExtraArgs(c *v1.Cluster, machine *platformv1.Machine) map[string]string {
 	args := map[string]string{}
 	if c.Cluster.Spec.Features.ContainerRuntime == platformv1.Docker {
 		args = map[string]string{
@@ -93,6 +95,8 @@ func (p *Provider) getKubeletExtraArgs(c *v1.Cluster) map[string]string {
 	}
 	// for containerd runtimes, no need to set pod-infra-container-image
 
+	// args of machine take precedence over the ones of cluster
+	utilruntime.Must(mergo.Merge(&args, machine.Spec.KubeletExtraArgs))
 	utilruntime.Must(mergo.Merge(&args, c.Spec.KubeletExtraArgs))
 	utilruntime.Must(mergo.Merge(&args, p.config.Kubelet.ExtraArgs))
 
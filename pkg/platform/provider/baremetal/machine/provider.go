@@ -63,9 +63,15 @@ func NewProvider() (*Provider, error) {
 		ValidateUpdateFunc: func(machine, oldMachine *platform.Machine) field.ErrorList {
 			allErrs := field.ErrorList{}
 			fldPath := field.NewPath("spec")
-			allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.IP, oldMachine.Spec.IP, fldPath.Child("ip"))...)
+			// The ip of a provisioned machine is set once by provisioner.
+			if oldMachine.Spec.IP != "" {
+				allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.IP, oldMachine.Spec.IP, fldPath.Child("ip"))...)
+			}
 			allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.Labels, oldMachine.Spec.Labels, fldPath.Child("labels"))...)
 			allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.Taints, oldMachine.Spec.Taints, fldPath.Child("taints"))...)
+			allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.KubeletExtraArgs, oldMachine.Spec.KubeletExtraArgs, fldPath.Child("kubeletExtraArgs"))...)
+			allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.DockerExtraArgs, oldMachine.Spec.DockerExtraArgs, fldPath.Child("dockerExtraArgs"))...)
+			allErrs = append(allErrs, validation.ValidateContainerRuntimeConfigUpdate(machine.Spec.ContainerRuntimeConfig, oldMachine.Spec.ContainerRuntimeConfig, fldPath.Child("containerRuntimeConfig"))...)
 			return allErrs
 		},
 		CreateHandlers: []machineprovider.Handler{
@@ -80,6 +86,7 @@ func NewProvider() (*Provider, error) {
 			p.EnsureSysctl,
 			p.EnsureDisableSwap,
 			p.EnsureManifestDir,
+			p.EnsureOSPackages,
 
 			p.EnsurePreflight, // wait basic setting done
 
@@ -132,7 +139,18 @@ func NewProvider() (*Provider, error) {
 	return p, nil
 }
 
-var _ machineprovider.Provider = &Provider{}
+var _ machineprovider.ProvisionableProvider = &Provider{}
+
+// WithProvisioner returns a provider named name, which joins hosts
+// provisioned by provisioner.
+func (p *Provider) WithProvisioner(name string, provisioner machineprovider.Provisioner) machineprovider.Provider {
+	delegate := *p.DelegateProvider
+	delegate.ProviderName = name
+	delegate.Provisioner = provisioner
+	result := *p
+	result.DelegateProvider = &delegate
+	return &result
+}
 
 func (p *Provider) Validate(machine *platform.Machine) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -146,6 +164,26 @@ func (p *Provider) Validate(machine *platform.Machine) field.ErrorList {
 			allErrs = append(allErrs, field.InternalError(fldPath, err))
 		}
 	}
+	allErrs = append(allErrs, validation.ValidateContainerRuntimeConfig(machine.Spec.ContainerRuntimeConfig, cluster.Spec.Features.ContainerRuntime, fldPath.Child("containerRuntimeConfig"), true)...)
+	// The host of machine does not exist until it is provisioned.
+	if p.Provisioner != nil {
+		return allErrs
+	}
 
 	return append(allErrs, validation.ValidateMachine(machine, cluster, p.platformClient)...)
 }
+
+// ValidateMachinePoolSpec validates the container runtime config of the
+// template of a machine pool with the runtime of its cluster.
+func (p *Provider) ValidateMachinePoolSpec(spec *platform.MachinePoolSpec, fldPath *field.Path) field.ErrorList {
+	cluster, err := p.platformClient.Clusters().Get(context.TODO(), spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return field.ErrorList{field.NotFound(fldPath.Child("clusterName"), spec.ClusterName)}
+		}
+		return field.ErrorList{field.InternalError(fldPath.Child("clusterName"), err)}
+	}
+
+	return validation.ValidateContainerRuntimeConfig(spec.Template.ContainerRuntimeConfig, cluster.Spec.Features.ContainerRuntime,
+		fldPath.Child("template", "containerRuntimeConfig"), true)
+}
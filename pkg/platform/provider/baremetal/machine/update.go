@@ -33,10 +33,11 @@ func (p *Provider) EnsurePreUpgradeHook(ctx context.Context, machine *platformv1
 
 	mc := []platformv1.ClusterMachine{
 		{
-			IP:       machine.Spec.IP,
-			Port:     machine.Spec.Port,
-			Username: machine.Spec.Username,
-			Password: machine.Spec.Password,
+			IP:             machine.Spec.IP,
+			Port:           machine.Spec.Port,
+			Username:       machine.Spec.Username,
+			Password:       machine.Spec.Password,
+			CredentialName: machine.Spec.CredentialName,
 		},
 	}
 	return util.ExcuteCustomizedHook(ctx, cluster, platformv1.HookPreUpgrade, mc)
@@ -47,7 +48,7 @@ func (p *Provider) EnsureUpgrade(ctx context.Context, machine *platformv1.Machin
 		return nil
 	}
 
-	machineSSH, err := machine.Spec.SSH()
+	machineSSH, err := machine.Spec.SSHWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -64,10 +65,26 @@ func (p *Provider) EnsureUpgrade(ctx context.Context, machine *platformv1.Machin
 		Version:                cluster.Spec.Version,
 		MaxUnready:             cluster.Spec.Features.Upgrade.Strategy.MaxUnready,
 		DrainNodeBeforeUpgrade: cluster.Spec.Features.Upgrade.Strategy.DrainNodeBeforeUpgrade,
+		RollbackOnFailure:      cluster.Spec.Features.Upgrade.Strategy.RollbackOnFailure,
 	}
 	logger := log.FromContext(ctx).WithName("Cluster upgrade")
-	upgraded, err := kubeadm.UpgradeNode(machineSSH, clientset, p.platformClient, logger, cluster, option)
+	upgraded, err := kubeadm.UpgradeNode(ctx, machineSSH, clientset, p.platformClient, logger, cluster, option)
 	if err != nil {
+		// the machine controller retries the upgrade with its backoff, the
+		// worker upgrade is paused to avoid failing more machines after the
+		// retries of transient errors are used up
+		failures, recordErr := kubeadm.RecordUpgradeFailure(ctx, p.platformClient, machine)
+		if recordErr != nil {
+			logger.Errorf("Record upgrade failure of %s error: %v", machine.Spec.IP, recordErr)
+			return err
+		}
+		logger.Errorf("Upgrade of %s failed %d times: %v", machine.Spec.IP, failures, err)
+		if failures < kubeadm.MaxUpgradeFailures {
+			return err
+		}
+		if markErr := kubeadm.MarkUpgradeFailed(p.platformClient, machine, err); markErr != nil {
+			logger.Errorf("Mark upgrade of %s failed error: %v", machine.Spec.IP, markErr)
+		}
 		return err
 	}
 	if !upgraded {
@@ -79,6 +96,17 @@ func (p *Provider) EnsureUpgrade(ctx context.Context, machine *platformv1.Machin
 		return err
 	}
 
+	if machine.Status.Upgrade != nil && machine.Status.Upgrade.Canary {
+		paused, err := kubeadm.PauseAfterCanary(p.platformClient, cluster.Cluster)
+		if err != nil {
+			return err
+		}
+		if paused {
+			logger.Infof("Canary machines are upgraded, worker upgrade of %s is paused", cluster.Name)
+			return nil
+		}
+	}
+
 	err = kubeadm.MarkNextUpgradeWorkerNode(clientset, p.platformClient, option.Version, cluster.Name)
 	if err != nil {
 		return err
@@ -91,10 +119,11 @@ func (p *Provider) EnsurePostUpgradeHook(ctx context.Context, machine *platformv
 
 	mc := []platformv1.ClusterMachine{
 		{
-			IP:       machine.Spec.IP,
-			Port:     machine.Spec.Port,
-			Username: machine.Spec.Username,
-			Password: machine.Spec.Password,
+			IP:             machine.Spec.IP,
+			Port:           machine.Spec.Port,
+			Username:       machine.Spec.Username,
+			Password:       machine.Spec.Password,
+			CredentialName: machine.Spec.CredentialName,
 		},
 	}
 	return util.ExcuteCustomizedHook(ctx, cluster, platformv1.HookPostUpgrade, mc)
@@ -0,0 +1,228 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package calico
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// ModeIPIP encapsulates pod traffic between nodes with IP-in-IP.
+	ModeIPIP = "ipip"
+	// ModeVXLAN encapsulates pod traffic between nodes with VXLAN.
+	ModeVXLAN = "vxlan"
+	// ModeBGP routes pod traffic without encapsulation, the pod routes are
+	// advertised to the peers by BGP.
+	ModeBGP = "bgp"
+
+	// DefaultASN is the AS number of nodes when not specified.
+	DefaultASN = 64512
+
+	ArgMode           = "mode"
+	ArgASN            = "asn"
+	ArgNodeToNodeMesh = "nodeToNodeMesh"
+	ArgBGPPeers       = "bgpPeers"
+)
+
+// Args are the network args accepted by calico.
+var Args = []string{ArgMode, ArgASN, ArgNodeToNodeMesh, ArgBGPPeers}
+
+var (
+	bgpConfigurationsResource = schema.GroupVersionResource{Group: "crd.projectcalico.org", Version: "v1", Resource: "bgpconfigurations"}
+	bgpPeersResource          = schema.GroupVersionResource{Group: "crd.projectcalico.org", Version: "v1", Resource: "bgppeers"}
+)
+
+// Peer is a global BGP peer of all nodes, e.g. a ToR switch.
+type Peer struct {
+	IP  string
+	ASN int
+}
+
+// Name returns the BGPPeer object name of the peer.
+func (p Peer) Name() string {
+	return "peer-" + strings.NewReplacer(".", "-", ":", "-").Replace(p.IP)
+}
+
+// Option is the calico configuration parsed from the cluster network args.
+type Option struct {
+	Mode           string
+	ASN            int
+	NodeToNodeMesh bool
+	Peers          []Peer
+}
+
+// Backend returns the calico networking backend of the mode.
+func (o *Option) Backend() string {
+	if o.Mode == ModeVXLAN {
+		return "vxlan"
+	}
+	return "bird"
+}
+
+// IPIPMode returns the IPIP mode of the default ip pool.
+func (o *Option) IPIPMode() string {
+	if o.Mode == ModeIPIP {
+		return "Always"
+	}
+	return "Never"
+}
+
+// VXLANMode returns the VXLAN mode of the default ip pool.
+func (o *Option) VXLANMode() string {
+	if o.Mode == ModeVXLAN {
+		return "Always"
+	}
+	return "Never"
+}
+
+// NewOption parses the calico option from the cluster network args.
+func NewOption(args map[string]string) (*Option, error) {
+	option := &Option{
+		Mode:           ModeIPIP,
+		ASN:            DefaultASN,
+		NodeToNodeMesh: true,
+	}
+	var err error
+	if mode, ok := args[ArgMode]; ok {
+		switch mode {
+		case ModeIPIP, ModeVXLAN, ModeBGP:
+			option.Mode = mode
+		default:
+			return nil, fmt.Errorf("unsupported %s %q, must be one of %s, %s, %s", ArgMode, mode, ModeIPIP, ModeVXLAN, ModeBGP)
+		}
+	}
+	if asn, ok := args[ArgASN]; ok {
+		if option.ASN, err = parseASN(asn); err != nil {
+			return nil, err
+		}
+	}
+	if mesh, ok := args[ArgNodeToNodeMesh]; ok {
+		if option.NodeToNodeMesh, err = strconv.ParseBool(mesh); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", ArgNodeToNodeMesh, mesh, err)
+		}
+	}
+	if peers, ok := args[ArgBGPPeers]; ok {
+		if option.Peers, err = ParsePeers(peers); err != nil {
+			return nil, err
+		}
+	}
+	if option.Mode == ModeVXLAN && len(option.Peers) > 0 {
+		return nil, fmt.Errorf("%s can not be used with %s %s", ArgBGPPeers, ArgMode, ModeVXLAN)
+	}
+	if option.Mode == ModeBGP && !option.NodeToNodeMesh && len(option.Peers) == 0 {
+		return nil, fmt.Errorf("%s is required when %s is disabled in %s mode", ArgBGPPeers, ArgNodeToNodeMesh, ModeBGP)
+	}
+
+	return option, nil
+}
+
+// ParsePeers parses the peers in the form of "ip:asn,ip:asn".
+func ParsePeers(s string) ([]Peer, error) {
+	var peers []Peer
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		i := strings.LastIndex(item, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid bgp peer %q, must be ip:asn", item)
+		}
+		ip := strings.Trim(item[:i], "[]")
+		if net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("invalid bgp peer %q, %q is not an ip", item, ip)
+		}
+		asn, err := parseASN(item[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid bgp peer %q: %w", item, err)
+		}
+		peers = append(peers, Peer{IP: ip, ASN: asn})
+	}
+
+	return peers, nil
+}
+
+func parseASN(s string) (int, error) {
+	asn, err := strconv.ParseUint(s, 10, 32)
+	if err != nil || asn == 0 {
+		return 0, fmt.Errorf("invalid asn %q", s)
+	}
+	return int(asn), nil
+}
+
+// ConfigureBGP creates or updates the default BGPConfiguration and the global
+// BGPPeers of the option. It requires the calico crds to be installed.
+func ConfigureBGP(ctx context.Context, client dynamic.Interface, option *Option) error {
+	if option.Mode == ModeVXLAN {
+		return nil
+	}
+	config := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "crd.projectcalico.org/v1",
+		"kind":       "BGPConfiguration",
+		"metadata":   map[string]interface{}{"name": "default"},
+		"spec": map[string]interface{}{
+			"asNumber":              int64(option.ASN),
+			"nodeToNodeMeshEnabled": option.NodeToNodeMesh,
+			"logSeverityScreen":     "Info",
+		},
+	}}
+	if err := createOrUpdate(ctx, client.Resource(bgpConfigurationsResource), config); err != nil {
+		return err
+	}
+	for _, peer := range option.Peers {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "crd.projectcalico.org/v1",
+			"kind":       "BGPPeer",
+			"metadata":   map[string]interface{}{"name": peer.Name()},
+			"spec": map[string]interface{}{
+				"peerIP":   peer.IP,
+				"asNumber": int64(peer.ASN),
+			},
+		}}
+		if err := createOrUpdate(ctx, client.Resource(bgpPeersResource), obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createOrUpdate(ctx context.Context, client dynamic.ResourceInterface, obj *unstructured.Unstructured) error {
+	existing, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		_, err = client.Create(ctx, obj, metav1.CreateOptions{})
+		return err
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	_, err = client.Update(ctx, obj, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package calico
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestParsePeers(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []Peer
+		wantErr bool
+	}{
+		{in: "10.0.0.1:65001", want: []Peer{{IP: "10.0.0.1", ASN: 65001}}},
+		{in: "10.0.0.1:65001, 10.0.0.2:65002,", want: []Peer{{IP: "10.0.0.1", ASN: 65001}, {IP: "10.0.0.2", ASN: 65002}}},
+		{in: "[fd00::1]:65001", want: []Peer{{IP: "fd00::1", ASN: 65001}}},
+		{in: "10.0.0.1", wantErr: true},
+		{in: "switch:65001", wantErr: true},
+		{in: "10.0.0.1:0", wantErr: true},
+		{in: "10.0.0.1:asn", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePeers(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePeers(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePeers(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewOption(t *testing.T) {
+	option, err := NewOption(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if option.Mode != ModeIPIP || option.ASN != DefaultASN || !option.NodeToNodeMesh {
+		t.Errorf("unexpected default option %+v", option)
+	}
+	if option.Backend() != "bird" || option.IPIPMode() != "Always" || option.VXLANMode() != "Never" {
+		t.Errorf("unexpected ipip settings %s %s %s", option.Backend(), option.IPIPMode(), option.VXLANMode())
+	}
+
+	option, err = NewOption(map[string]string{ArgMode: ModeVXLAN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if option.Backend() != "vxlan" || option.IPIPMode() != "Never" || option.VXLANMode() != "Always" {
+		t.Errorf("unexpected vxlan settings %s %s %s", option.Backend(), option.IPIPMode(), option.VXLANMode())
+	}
+
+	invalid := []map[string]string{
+		{ArgMode: "host-gw"},
+		{ArgASN: "-1"},
+		{ArgNodeToNodeMesh: "maybe"},
+		{ArgMode: ModeVXLAN, ArgBGPPeers: "10.0.0.1:65001"},
+		{ArgMode: ModeBGP, ArgNodeToNodeMesh: "false"},
+	}
+	for _, args := range invalid {
+		if _, err := NewOption(args); err == nil {
+			t.Errorf("expected an error for %v", args)
+		}
+	}
+}
+
+func TestConfigureBGP(t *testing.T) {
+	ctx := context.Background()
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	option := &Option{Mode: ModeBGP, ASN: 64512, Peers: []Peer{{IP: "10.0.0.1", ASN: 65001}}}
+
+	for i := 0; i < 2; i++ {
+		if err := ConfigureBGP(ctx, client, option); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config, err := client.Resource(bgpConfigurationsResource).Get(ctx, "default", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mesh, _, _ := unstructured.NestedBool(config.Object, "spec", "nodeToNodeMeshEnabled"); mesh {
+		t.Errorf("expected node to node mesh to be disabled")
+	}
+	peer, err := client.Resource(bgpPeersResource).Get(ctx, "peer-10-0-0-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip, _, _ := unstructured.NestedString(peer.Object, "spec", "peerIP"); ip != "10.0.0.1" {
+		t.Errorf("expected peer ip 10.0.0.1, got %v", ip)
+	}
+}
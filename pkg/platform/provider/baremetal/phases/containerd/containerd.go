@@ -20,14 +20,23 @@ package containerd
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"path"
+	"strings"
 
 	"tkestack.io/tke/pkg/util/template"
 
 	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/gpu"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
+	containerregistryutil "tkestack.io/tke/pkg/util/containerregistry"
 	"tkestack.io/tke/pkg/util/ssh"
 	"tkestack.io/tke/pkg/util/supervisor"
 )
@@ -37,13 +46,113 @@ type Option struct {
 	IsGPU              bool
 	Root               string
 	SandboxImage       string
-	RegistryMirror     string
+	// RegistryMirrors are the mirror endpoints by registry host.
+	RegistryMirrors map[string][]string
+	Snapshotter     string
+	CgroupDriver    platformv1.CgroupDriver
+	// Runtimes are the runtime types by the name of the extra runtime handlers.
+	Runtimes map[string]string
 }
 
 const (
-	containerdConfigFile = "/etc/containerd/config.toml"
+	// ConfigFile is the config file of containerd written by Install.
+	ConfigFile = "/etc/containerd/config.toml"
 )
 
+// RuntimeTypes are the containerd runtime types of the runtime handlers.
+var RuntimeTypes = map[platformv1.RuntimeType]string{
+	platformv1.RuntimeRunc:   "io.containerd.runc.v2",
+	platformv1.RuntimeKata:   "io.containerd.kata.v2",
+	platformv1.RuntimeGVisor: "io.containerd.runsc.v1",
+}
+
+// MergeConfig returns the container runtime config of a node, the config of
+// the machine is merged over the one of the cluster: its registry mirrors
+// replace the ones of the same host, its insecure registries are added and
+// its snapshotter takes precedence.
+func MergeConfig(cluster, machine *platformv1.ContainerRuntimeConfig) platformv1.ContainerRuntimeConfig {
+	var config platformv1.ContainerRuntimeConfig
+	if cluster != nil {
+		config = *cluster.DeepCopy()
+	}
+	if machine == nil {
+		return config
+	}
+
+	for _, mirror := range machine.RegistryMirrors {
+		replaced := false
+		for i := range config.RegistryMirrors {
+			if config.RegistryMirrors[i].Host == mirror.Host {
+				config.RegistryMirrors[i] = *mirror.DeepCopy()
+				replaced = true
+			}
+		}
+		if !replaced {
+			config.RegistryMirrors = append(config.RegistryMirrors, *mirror.DeepCopy())
+		}
+	}
+	for _, registry := range machine.InsecureRegistries {
+		if !funk.ContainsString(config.InsecureRegistries, registry) {
+			config.InsecureRegistries = append(config.InsecureRegistries, registry)
+		}
+	}
+	if machine.Snapshotter != "" {
+		config.Snapshotter = machine.Snapshotter
+	}
+
+	return config
+}
+
+// ConfigHash returns the hash of the container runtime config of a node,
+// which tells whether the config is changed since it was rolled out.
+func ConfigHash(config platformv1.ContainerRuntimeConfig) string {
+	data, _ := json.Marshal(config)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// MachineOption returns the option of containerd on a worker machine. It is
+// shared by the machine provider installing containerd and the cluster
+// provider reconfiguring it, so that both render the same config.
+func MachineOption(registryDomain string, machine *platformv1.MachineSpec, cluster *platformv1.ContainerRuntimeConfig) *Option {
+	insecureRegistries := []string{registryDomain}
+	if machine.TenantID != "" {
+		insecureRegistries = append(insecureRegistries, machine.TenantID+"."+registryDomain)
+	}
+
+	option := &Option{
+		InsecureRegistries: insecureRegistries,
+		IsGPU:              gpu.IsEnable(machine.Labels),
+		SandboxImage:       images.Get().Pause.FullName(),
+		// for mirror, we just need domain in prefix
+		RegistryMirrors: map[string][]string{strings.Split(containerregistryutil.GetPrefix(), "/")[0]: {"http://mirrors.tke.com"}},
+	}
+	option.ApplyConfig(MergeConfig(cluster, machine.ContainerRuntimeConfig))
+	return option
+}
+
+// ApplyConfig sets the options from the container runtime config, the
+// registry mirrors of the config take precedence over the ones of the option.
+func (o *Option) ApplyConfig(config platformv1.ContainerRuntimeConfig) {
+	if o.RegistryMirrors == nil {
+		o.RegistryMirrors = map[string][]string{}
+	}
+	for _, mirror := range config.RegistryMirrors {
+		o.RegistryMirrors[mirror.Host] = mirror.Endpoints
+	}
+	for _, registry := range config.InsecureRegistries {
+		if !funk.ContainsString(o.InsecureRegistries, registry) {
+			o.InsecureRegistries = append(o.InsecureRegistries, registry)
+		}
+	}
+	o.Snapshotter = config.Snapshotter
+	o.CgroupDriver = config.CgroupDriver
+	o.Runtimes = map[string]string{}
+	for _, runtimeClass := range config.RuntimeClasses {
+		o.Runtimes[runtimeClass.Name] = RuntimeTypes[runtimeClass.Type]
+	}
+}
+
 func Install(s ssh.Interface, option *Option) error {
 	// add path to sudoers
 	cmd := `sed -i '$a\Defaults secure_path = /usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin:/snap/bin' /etc/sudoers`
@@ -75,13 +184,13 @@ func Install(s ssh.Interface, option *Option) error {
 		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
 	}
 
-	data, err := template.ParseFile(path.Join(constants.SrcDir, "containerd/config.toml"), option)
+	data, err := Config(option)
 	if err != nil {
 		return err
 	}
-	err = s.WriteFile(bytes.NewReader(data), containerdConfigFile)
+	err = s.WriteFile(bytes.NewReader(data), ConfigFile)
 	if err != nil {
-		return errors.Wrapf(err, "write %s error", containerdConfigFile)
+		return errors.Wrapf(err, "write %s error", ConfigFile)
 	}
 
 	data, err = template.ParseFile(path.Join(constants.SrcDir, "containerd/containerd.service"), option)
@@ -101,3 +210,8 @@ func Install(s ssh.Interface, option *Option) error {
 
 	return nil
 }
+
+// Config renders the content of ConfigFile.
+func Config(option *Option) ([]byte, error) {
+	return template.ParseFile(path.Join(constants.SrcDir, "containerd/config.toml"), option)
+}
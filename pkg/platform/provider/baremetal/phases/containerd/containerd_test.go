@@ -0,0 +1,128 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package containerd
+
+import (
+	"reflect"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestMergeConfig(t *testing.T) {
+	cluster := &platformv1.ContainerRuntimeConfig{
+		RegistryMirrors: []platformv1.RegistryMirror{
+			{Host: "docker.io", Endpoints: []string{"https://mirror.a"}},
+		},
+		InsecureRegistries: []string{"registry.a"},
+		CgroupDriver:       platformv1.CgroupDriverCgroupfs,
+		RuntimeClasses:     []platformv1.RuntimeClass{{Name: "kata", Type: platformv1.RuntimeKata}},
+	}
+	tests := []struct {
+		name    string
+		cluster *platformv1.ContainerRuntimeConfig
+		machine *platformv1.ContainerRuntimeConfig
+		want    platformv1.ContainerRuntimeConfig
+	}{
+		{
+			name: "none",
+			want: platformv1.ContainerRuntimeConfig{},
+		},
+		{
+			name:    "cluster only",
+			cluster: cluster,
+			want:    *cluster,
+		},
+		{
+			name:    "machine override",
+			cluster: cluster,
+			machine: &platformv1.ContainerRuntimeConfig{
+				RegistryMirrors: []platformv1.RegistryMirror{
+					{Host: "docker.io", Endpoints: []string{"https://mirror.b"}},
+					{Host: "quay.io", Endpoints: []string{"https://mirror.c"}},
+				},
+				InsecureRegistries: []string{"registry.a", "registry.b"},
+				Snapshotter:        "native",
+			},
+			want: platformv1.ContainerRuntimeConfig{
+				RegistryMirrors: []platformv1.RegistryMirror{
+					{Host: "docker.io", Endpoints: []string{"https://mirror.b"}},
+					{Host: "quay.io", Endpoints: []string{"https://mirror.c"}},
+				},
+				InsecureRegistries: []string{"registry.a", "registry.b"},
+				Snapshotter:        "native",
+				CgroupDriver:       platformv1.CgroupDriverCgroupfs,
+				RuntimeClasses:     []platformv1.RuntimeClass{{Name: "kata", Type: platformv1.RuntimeKata}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeConfig(tt.cluster, tt.machine); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+	if cluster.RegistryMirrors[0].Endpoints[0] != "https://mirror.a" {
+		t.Errorf("MergeConfig() modified the config of the cluster")
+	}
+}
+
+func TestOption_ApplyConfig(t *testing.T) {
+	option := &Option{
+		InsecureRegistries: []string{"default.registry"},
+		RegistryMirrors:    map[string][]string{"default.registry": {"http://mirrors.tke.com"}},
+	}
+	option.ApplyConfig(platformv1.ContainerRuntimeConfig{
+		RegistryMirrors: []platformv1.RegistryMirror{
+			{Host: "docker.io", Endpoints: []string{"https://mirror.a"}},
+		},
+		InsecureRegistries: []string{"default.registry", "registry.a"},
+		Snapshotter:        "native",
+		RuntimeClasses: []platformv1.RuntimeClass{
+			{Name: "kata", Type: platformv1.RuntimeKata},
+			{Name: "gvisor", Type: platformv1.RuntimeGVisor},
+		},
+	})
+	want := &Option{
+		InsecureRegistries: []string{"default.registry", "registry.a"},
+		RegistryMirrors: map[string][]string{
+			"default.registry": {"http://mirrors.tke.com"},
+			"docker.io":        {"https://mirror.a"},
+		},
+		Snapshotter: "native",
+		Runtimes: map[string]string{
+			"kata":   "io.containerd.kata.v2",
+			"gvisor": "io.containerd.runsc.v1",
+		},
+	}
+	if !reflect.DeepEqual(option, want) {
+		t.Errorf("ApplyConfig() = %+v, want %+v", option, want)
+	}
+}
+
+func TestConfigHash(t *testing.T) {
+	config := MergeConfig(&platformv1.ContainerRuntimeConfig{Snapshotter: "native"}, nil)
+	if ConfigHash(config) != ConfigHash(*config.DeepCopy()) {
+		t.Errorf("ConfigHash() differs for the same config")
+	}
+	if ConfigHash(config) == ConfigHash(platformv1.ContainerRuntimeConfig{}) {
+		t.Errorf("ConfigHash() is unchanged after the config is changed")
+	}
+}
@@ -42,7 +42,8 @@ type Option struct {
 }
 
 const (
-	dockerDaemonFile = "/etc/docker/daemon.json"
+	// DaemonFile is the daemon config file of docker written by Install.
+	DaemonFile = "/etc/docker/daemon.json"
 )
 
 func Install(s ssh.Interface, option *Option) error {
@@ -66,13 +67,13 @@ func Install(s ssh.Interface, option *Option) error {
 		return err
 	}
 
-	data, err := template.ParseFile(path.Join(constants.ConfDir, "docker/daemon.json"), option)
+	data, err := DaemonConfig(option)
 	if err != nil {
 		return err
 	}
-	err = s.WriteFile(bytes.NewReader(data), dockerDaemonFile)
+	err = s.WriteFile(bytes.NewReader(data), DaemonFile)
 	if err != nil {
-		return errors.Wrapf(err, "write %s error", dockerDaemonFile)
+		return errors.Wrapf(err, "write %s error", DaemonFile)
 	}
 
 	data, err = template.ParseFile(path.Join(constants.ConfDir, "docker/docker.service"), option)
@@ -92,3 +93,8 @@ func Install(s ssh.Interface, option *Option) error {
 
 	return nil
 }
+
+// DaemonConfig renders the content of DaemonFile.
+func DaemonConfig(option *Option) ([]byte, error) {
+	return template.ParseFile(path.Join(constants.ConfDir, "docker/daemon.json"), option)
+}
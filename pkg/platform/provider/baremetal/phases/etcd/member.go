@@ -0,0 +1,175 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+const (
+	clientPort    = 2379
+	dialTimeout   = 10 * time.Second
+	statusTimeout = 5 * time.Second
+)
+
+// Member describes a member of the etcd cluster.
+type Member struct {
+	ID      uint64
+	Name    string
+	IP      string
+	Healthy bool
+}
+
+// NewClient returns an etcd client to the members on the given ips, with the
+// etcd client certificates of the cluster.
+func NewClient(credential *platformv1.ClusterCredential, ips []string) (*clientv3.Client, error) {
+	if credential == nil || credential.ETCDCACert == nil || credential.ETCDAPIClientCert == nil || credential.ETCDAPIClientKey == nil {
+		return nil, errors.New("etcd client certificates are missing")
+	}
+	cert, err := tls.X509KeyPair(credential.ETCDAPIClientCert, credential.ETCDAPIClientKey)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(credential.ETCDCACert) {
+		return nil, errors.New("invalid etcd ca certificate")
+	}
+
+	endpoints := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		endpoints = append(endpoints, "https://"+net.JoinHostPort(ip, strconv.Itoa(clientPort)))
+	}
+	return clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: dialTimeout,
+		TLS: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      pool,
+		},
+	})
+}
+
+// Members lists the etcd members with their health, a member is healthy when
+// its status can be read from its client url.
+func Members(ctx context.Context, db *clientv3.Client) ([]Member, error) {
+	resp, err := db.MemberList(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list etcd members error")
+	}
+	members := make([]Member, 0, len(resp.Members))
+	for _, m := range resp.Members {
+		member := Member{ID: m.ID, Name: m.Name}
+		if len(m.PeerURLs) != 0 {
+			if u, err := url.Parse(m.PeerURLs[0]); err == nil {
+				member.IP = u.Hostname()
+			}
+		}
+		for _, clientURL := range m.ClientURLs {
+			statusCtx, cancel := context.WithTimeout(ctx, statusTimeout)
+			_, err := db.Status(statusCtx, clientURL)
+			cancel()
+			if err == nil {
+				member.Healthy = true
+				break
+			}
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// CheckRemove returns an error if removing the member on ip would leave the
+// etcd cluster without quorum.
+func CheckRemove(members []Member, ip string) error {
+	found := false
+	healthy := 0
+	for _, m := range members {
+		if m.IP == ip {
+			found = true
+			continue
+		}
+		if m.Healthy {
+			healthy++
+		}
+	}
+	if !found {
+		return fmt.Errorf("%s is not an etcd member", ip)
+	}
+	if quorum := (len(members)-1)/2 + 1; healthy < quorum {
+		return fmt.Errorf("removing etcd member %s leaves %d healthy members, %d required", ip, healthy, quorum)
+	}
+
+	return nil
+}
+
+// CheckReplace returns an error if the etcd cluster loses quorum when a new
+// member joins or when the member on ip is removed afterwards.
+func CheckReplace(members []Member, ip string) error {
+	healthy := 0
+	for _, m := range members {
+		if m.Healthy {
+			healthy++
+		}
+	}
+	if quorum := len(members)/2 + 1; healthy < quorum {
+		return fmt.Errorf("etcd has %d healthy members, %d required", healthy, quorum)
+	}
+	// the new member is counted as healthy once it joins
+	healthy++
+	if quorum := (len(members)+1)/2 + 1; healthy < quorum {
+		return fmt.Errorf("joining an etcd member leaves %d healthy members, %d required", healthy, quorum)
+	}
+
+	return CheckRemove(append(members, Member{Healthy: true}), ip)
+}
+
+// RemoveMember removes the member on ip from the etcd cluster, it does nothing
+// if the member does not exist.
+func RemoveMember(ctx context.Context, db *clientv3.Client, ip string) error {
+	members, err := Members(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if m.IP != ip {
+			continue
+		}
+		if err := CheckRemove(members, ip); err != nil {
+			return err
+		}
+		if _, err := db.MemberRemove(ctx, m.ID); err != nil {
+			return errors.Wrapf(err, "remove etcd member %s error", m.Name)
+		}
+		return nil
+	}
+
+	return nil
+}
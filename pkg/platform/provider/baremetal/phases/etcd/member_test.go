@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcd
+
+import "testing"
+
+func members(healthy ...bool) []Member {
+	ips := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5"}
+	members := make([]Member, 0, len(healthy))
+	for i, h := range healthy {
+		members = append(members, Member{ID: uint64(i + 1), IP: ips[i], Healthy: h})
+	}
+	return members
+}
+
+func TestCheckReplace(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []Member
+		ip      string
+		wantErr bool
+	}{
+		{"healthy cluster", members(true, true, true), "10.0.0.1", false},
+		{"replace unhealthy member", members(false, true, true), "10.0.0.1", false},
+		{"replace healthy member with another one unhealthy", members(true, false, true), "10.0.0.1", false},
+		{"two unhealthy members", members(false, false, true), "10.0.0.1", true},
+		{"single member", members(true), "10.0.0.1", false},
+		{"two members without quorum", members(false, true), "10.0.0.1", true},
+		{"five members with two unhealthy", members(false, false, true, true, true), "10.0.0.1", false},
+		{"not a member", members(true, true, true), "10.0.0.9", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckReplace(tt.members, tt.ip); (err != nil) != tt.wantErr {
+				t.Errorf("CheckReplace() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckRemove(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []Member
+		ip      string
+		wantErr bool
+	}{
+		{"remove unhealthy member", members(false, true, true, true), "10.0.0.1", false},
+		{"remove healthy member", members(true, true, true), "10.0.0.1", false},
+		{"remove leaves no quorum", members(true, false, true), "10.0.0.1", true},
+		{"not a member", members(true, true, true), "10.0.0.9", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckRemove(tt.members, tt.ip); (err != nil) != tt.wantErr {
+				t.Errorf("CheckRemove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcd
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// RestoreDir is the directory holding the snapshot, the stopped static
+	// pod manifests and the original etcd data during a restore.
+	RestoreDir = "/var/lib/etcd-restore/"
+
+	restoreClusterToken = "tke-etcd-restore"
+)
+
+var (
+	restoreSnapshotFile = path.Join(RestoreDir, "snapshot.db")
+	// restoreBackupFile names the directory holding the original data of the
+	// restore in progress, it is removed once the restore has succeeded or
+	// has been rolled back, so that the backup of an earlier restore is never
+	// taken for the one of an interrupted restore.
+	restoreBackupFile = path.Join(RestoreDir, "backup")
+	etcdDataDir       = strings.TrimSuffix(constants.EtcdDataDir, "/")
+)
+
+// RestoreOption describes how to restore the local etcd of a cluster.
+type RestoreOption struct {
+	// Snapshot reads the content of the etcd snapshot, it is read once for
+	// every member.
+	Snapshot         io.ReadSeeker
+	ContainerRuntime platformv1.ContainerRuntimeType
+}
+
+type member struct {
+	s       ssh.Interface
+	name    string
+	peerURL string
+	// image of the running etcd, which provides etcdctl
+	image string
+	// backupDir holds the original data until the restore of all members has
+	// succeeded, it is on the same filesystem as the data so that moving the
+	// data is atomic.
+	backupDir string
+}
+
+// RestoreError is returned by Restore once the data of the members has been
+// touched, the original data has been put back unless the rollback failed.
+type RestoreError struct {
+	Err error
+}
+
+func (e *RestoreError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RestoreError) Unwrap() error {
+	return e.Err
+}
+
+// Restore replaces the data of every etcd member with the given snapshot.
+// Every master must be reachable before anything is touched, a restore that
+// leaves one member behind would split the cluster. Every step can be run
+// again after an interrupted restore, the original data of every member is
+// put back if the restore fails. An error other than RestoreError leaves the
+// members untouched, the restore can be retried.
+func Restore(masters []ssh.Interface, option *RestoreOption) error {
+	if len(masters) == 0 {
+		return errors.New("no master to restore")
+	}
+	for _, s := range masters {
+		if err := s.Ping(); err != nil {
+			return errors.Wrap(err, "all masters must be reachable to restore etcd")
+		}
+	}
+
+	backupDir := path.Join(RestoreDir, "data."+time.Now().Format("20060102150405"))
+	members := make([]member, 0, len(masters))
+	for _, s := range masters {
+		m, err := readMember(s, backupDir)
+		if err != nil {
+			return err
+		}
+		members = append(members, m)
+	}
+
+	if err := restoreMembers(members, option); err != nil {
+		log.Error("Restore etcd failed, rolling back to the original data", log.Err(err))
+		if rollbackErr := rollbackMembers(members); rollbackErr != nil {
+			return &RestoreError{Err: errors.Wrapf(err, "rollback failed: %v", rollbackErr)}
+		}
+		return &RestoreError{Err: err}
+	}
+
+	// a later restore must not resume this one, and the original data is
+	// not needed anymore
+	var errs []error
+	for _, m := range members {
+		cmd := fmt.Sprintf("rm -f %s && rm -rf %s", restoreBackupFile, m.backupDir)
+		if _, err := m.s.CombinedOutput(cmd); err != nil {
+			errs = append(errs, errors.Wrap(err, m.name))
+		}
+	}
+	if len(errs) > 0 {
+		return &RestoreError{Err: errors.Wrapf(utilerrors.NewAggregate(errs), "etcd has been restored but %s must be removed by hand", restoreBackupFile)}
+	}
+
+	return nil
+}
+
+// restoreMembers stops the control plane of every member and restores the
+// snapshot into its data directory.
+func restoreMembers(members []member, option *RestoreOption) error {
+	initialCluster := make([]string, 0, len(members))
+	for _, m := range members {
+		initialCluster = append(initialCluster, fmt.Sprintf("%s=%s", m.name, m.peerURL))
+	}
+
+	for _, m := range members {
+		cmd := fmt.Sprintf("mkdir -p %s && echo %s > %s", RestoreDir, m.backupDir, restoreBackupFile)
+		if _, err := m.s.CombinedOutput(cmd); err != nil {
+			return errors.Wrap(err, m.name)
+		}
+		if err := stopControlPlane(m.s); err != nil {
+			return errors.Wrap(err, m.name)
+		}
+	}
+
+	for _, m := range members {
+		log.Info("Restoring etcd member", log.String("name", m.name))
+		if _, err := option.Snapshot.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := m.s.WriteFile(option.Snapshot, restoreSnapshotFile); err != nil {
+			return errors.Wrap(err, m.name)
+		}
+		// the data left by an interrupted restore is dropped, the original
+		// one has been moved to the backup directory
+		cmd := fmt.Sprintf("if [ -d %s ]; then rm -rf %s; else mv -T %s %s; fi",
+			m.backupDir, etcdDataDir, etcdDataDir, m.backupDir)
+		if _, err := m.s.CombinedOutput(cmd); err != nil {
+			return errors.Wrap(err, m.name)
+		}
+		cmd = fmt.Sprintf("%s run --rm --net host -e ETCDCTL_API=3 -v /var/lib:/var/lib %s "+
+			"etcdctl snapshot restore %s --name %s --initial-cluster %s --initial-cluster-token %s --initial-advertise-peer-urls %s --data-dir %s",
+			containerCLI(option.ContainerRuntime), m.image,
+			restoreSnapshotFile, m.name, strings.Join(initialCluster, ","), restoreClusterToken, m.peerURL, etcdDataDir)
+		if out, err := m.s.CombinedOutput(cmd); err != nil {
+			return errors.Wrapf(err, "%s: %s", m.name, out)
+		}
+	}
+
+	for _, m := range members {
+		if err := startControlPlane(m.s); err != nil {
+			return errors.Wrap(err, m.name)
+		}
+	}
+
+	return nil
+}
+
+// rollbackMembers puts the original data back on every member whose data
+// has been moved and starts the control plane again, the restore can't be
+// resumed afterwards.
+func rollbackMembers(members []member) error {
+	var errs []error
+	for _, m := range members {
+		if err := stopControlPlane(m.s); err != nil {
+			errs = append(errs, errors.Wrap(err, m.name))
+			continue
+		}
+		cmd := fmt.Sprintf("if [ -d %s ]; then rm -rf %s && mv -T %s %s; fi && rm -f %s",
+			m.backupDir, etcdDataDir, m.backupDir, etcdDataDir, restoreBackupFile)
+		if _, err := m.s.CombinedOutput(cmd); err != nil {
+			errs = append(errs, errors.Wrap(err, m.name))
+			continue
+		}
+		if err := startControlPlane(m.s); err != nil {
+			errs = append(errs, errors.Wrap(err, m.name))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// readMember reads the etcd member of a master from its manifest. The member
+// keeps the backup directory of an interrupted restore, or uses backupDir.
+func readMember(s ssh.Interface, backupDir string) (member, error) {
+	data, err := s.ReadFile(constants.EtcdPodManifestFile)
+	if err != nil {
+		// the manifest may have been moved aside by a previous interrupted restore
+		data, err = s.ReadFile(path.Join(RestoreDir, path.Base(constants.EtcdPodManifestFile)))
+		if err != nil {
+			return member{}, err
+		}
+	}
+	jsonData, err := yaml.ToJSON(data)
+	if err != nil {
+		return member{}, err
+	}
+	pod := new(corev1.Pod)
+	if err := json.Unmarshal(jsonData, pod); err != nil {
+		return member{}, err
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return member{}, errors.New("no container in etcd manifest")
+	}
+
+	m := member{s: s, image: pod.Spec.Containers[0].Image, backupDir: backupDir}
+	interrupted, err := s.Exist(restoreBackupFile)
+	if err != nil {
+		return member{}, err
+	}
+	if interrupted {
+		data, err := s.ReadFile(restoreBackupFile)
+		if err != nil {
+			return member{}, err
+		}
+		m.backupDir = strings.TrimSpace(string(data))
+		if !strings.HasPrefix(m.backupDir, path.Join(RestoreDir, "data.")) {
+			return member{}, errors.Errorf("invalid backup directory %q in %s", m.backupDir, restoreBackupFile)
+		}
+	}
+	for _, arg := range pod.Spec.Containers[0].Command {
+		if v := strings.TrimPrefix(arg, "--name="); v != arg {
+			m.name = v
+		}
+		if v := strings.TrimPrefix(arg, "--initial-advertise-peer-urls="); v != arg {
+			m.peerURL = v
+		}
+	}
+	if m.name == "" || m.peerURL == "" {
+		return member{}, errors.New("can't get name or initial-advertise-peer-urls from etcd manifest")
+	}
+
+	return m, nil
+}
+
+// stopControlPlane moves the etcd and kube-apiserver manifests aside and
+// waits until kubelet stops etcd.
+func stopControlPlane(s ssh.Interface) error {
+	cmd := fmt.Sprintf("mkdir -p %s", RestoreDir)
+	for _, manifest := range []string{constants.KubeAPIServerPodManifestFile, constants.EtcdPodManifestFile} {
+		cmd += fmt.Sprintf(" && (test ! -f %s || mv %s %s)", manifest, manifest, RestoreDir)
+	}
+	if _, err := s.CombinedOutput(cmd); err != nil {
+		return err
+	}
+
+	return wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
+		_, _, exit, err := s.Execf("ss -lnt | grep -q ':%d '", constants.EtcdListenClientPort)
+		if err != nil {
+			return false, nil
+		}
+		return exit != 0, nil
+	})
+}
+
+// startControlPlane moves the etcd and kube-apiserver manifests back.
+func startControlPlane(s ssh.Interface) error {
+	var cmds []string
+	for _, manifest := range []string{constants.EtcdPodManifestFile, constants.KubeAPIServerPodManifestFile} {
+		stopped := path.Join(RestoreDir, path.Base(manifest))
+		cmds = append(cmds, fmt.Sprintf("(test ! -f %s || mv %s %s)", stopped, stopped, constants.KubeletPodManifestDir))
+	}
+	cmds = append(cmds, fmt.Sprintf("rm -f %s", restoreSnapshotFile))
+	_, err := s.CombinedOutput(strings.Join(cmds, " && "))
+	return err
+}
+
+func containerCLI(runtime platformv1.ContainerRuntimeType) string {
+	if runtime == platformv1.Docker {
+		return "docker"
+	}
+	return "nerdctl -n k8s.io"
+}
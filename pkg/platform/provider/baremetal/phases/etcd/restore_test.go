@@ -0,0 +1,120 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/util/ssh"
+	"tkestack.io/tke/pkg/util/ssh/sshtest"
+)
+
+// newMaster returns a master running the etcd member name, whose commands
+// containing failOn fail.
+func newMaster(name, failOn string) *sshtest.Fake {
+	return &sshtest.Fake{
+		Files: map[string]string{
+			constants.EtcdPodManifestFile: fmt.Sprintf(`apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - image: etcd:3.4.13
+    command:
+    - etcd
+    - --name=%s
+    - --initial-advertise-peer-urls=https://%s:2380
+`, name, name),
+		},
+		CombinedOutputFunc: func(cmd string) ([]byte, error) {
+			if failOn != "" && strings.Contains(cmd, failOn) {
+				return nil, errors.New("exit code 1")
+			}
+			return nil, nil
+		},
+		// the etcd client port is not listened
+		ExecFunc: func(cmd string) (string, string, int, error) {
+			return "", "", 1, nil
+		},
+	}
+}
+
+func TestRestore(t *testing.T) {
+	rollback := fmt.Sprintf("rm -rf %s && mv -T %sdata.", etcdDataDir, RestoreDir)
+	start := fmt.Sprintf("mv %setcd.yaml", RestoreDir)
+
+	a, b := newMaster("10.0.0.1", ""), newMaster("10.0.0.2", "")
+	err := Restore([]ssh.Interface{a, b}, &RestoreOption{Snapshot: strings.NewReader("snapshot")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []*sshtest.Fake{a, b} {
+		if m.Files[restoreSnapshotFile] != "snapshot" {
+			t.Errorf("snapshot %q is written, want the whole snapshot", m.Files[restoreSnapshotFile])
+		}
+		if !m.Ran("--initial-cluster 10.0.0.1=https://10.0.0.1:2380,10.0.0.2=https://10.0.0.2:2380") || !m.Ran(start) {
+			t.Errorf("etcd is not restored and started: %v", m.Cmds)
+		}
+		if m.Ran(rollback) {
+			t.Errorf("data is rolled back after a successful restore")
+		}
+		if !m.Ran("rm -f " + restoreBackupFile) {
+			t.Errorf("restore can be resumed after it succeeded: %v", m.Cmds)
+		}
+		if !m.Ran("rm -rf " + RestoreDir + "data.") {
+			t.Errorf("original data is kept after a successful restore: %v", m.Cmds)
+		}
+	}
+
+	a, b = newMaster("10.0.0.1", ""), newMaster("10.0.0.2", "snapshot restore")
+	err = Restore([]ssh.Interface{a, b}, &RestoreOption{Snapshot: strings.NewReader("snapshot")})
+	var restoreErr *RestoreError
+	if !errors.As(err, &restoreErr) {
+		t.Fatalf("Restore() error = %v, want a RestoreError when the restore of a member fails", err)
+	}
+	for _, m := range []*sshtest.Fake{a, b} {
+		if !m.Ran(rollback) || !m.Ran(start) {
+			t.Errorf("original data is not put back and started: %v", m.Cmds)
+		}
+	}
+}
+
+func TestRestoreResume(t *testing.T) {
+	interrupted := RestoreDir + "data.20210101000000"
+	a := newMaster("10.0.0.1", "")
+	a.Files[restoreBackupFile] = interrupted + "\n"
+	if err := Restore([]ssh.Interface{a}, &RestoreOption{Snapshot: strings.NewReader("snapshot")}); err != nil {
+		t.Fatal(err)
+	}
+	if !a.Ran(fmt.Sprintf("if [ -d %s ]; then rm -rf %s;", interrupted, etcdDataDir)) {
+		t.Errorf("interrupted restore is not resumed with its backup: %v", a.Cmds)
+	}
+
+	// the backup of a completed restore is left alone
+	b := newMaster("10.0.0.1", "")
+	if err := Restore([]ssh.Interface{b}, &RestoreOption{Snapshot: strings.NewReader("snapshot")}); err != nil {
+		t.Fatal(err)
+	}
+	if b.Ran(interrupted) {
+		t.Errorf("restore uses the backup of another restore: %v", b.Cmds)
+	}
+}
@@ -24,6 +24,9 @@ import (
 
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	galaxyimages "tkestack.io/tke/pkg/platform/provider/baremetal/phases/galaxy/images"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/util/ssh"
 )
@@ -32,6 +35,9 @@ type Option struct {
 	Version        string
 	RegistryDomain string
 	KubeImages     []string
+	// AddonImages are the images of addons running on every node, they are
+	// pulled together to check they support the architecture of the node.
+	AddonImages []string
 }
 
 func PullKubernetesImages(c *v1.Cluster, s ssh.Interface, option *Option) error {
@@ -39,6 +45,7 @@ func PullKubernetesImages(c *v1.Cluster, s ssh.Interface, option *Option) error
 	if len(images) == 0 {
 		return fmt.Errorf("images is empty")
 	}
+	images = append(images, option.AddonImages...)
 
 	for _, image := range images {
 		cmd := ""
@@ -53,6 +60,9 @@ func PullKubernetesImages(c *v1.Cluster, s ssh.Interface, option *Option) error
 					docker info:%s. see: https://docs.docker.com/config/daemon/systemd/#httphttps-proxy`,
 						err, option.RegistryDomain, option.RegistryDomain, output)
 				}
+				if isNoMatchingManifest(err) {
+					return fmt.Errorf("image %s has no manifest for the architecture %s of the node: %w", image, res.Arch(s), err)
+				}
 
 				return err
 			}
@@ -67,6 +77,9 @@ func PullKubernetesImages(c *v1.Cluster, s ssh.Interface, option *Option) error
 					containerd info:%s. see: https://github.com/containerd/containerd/issues/1990`,
 						err, option.RegistryDomain, option.RegistryDomain, output)
 				}
+				if isNoMatchingManifest(err) {
+					return fmt.Errorf("image %s has no manifest for the architecture %s of the node: %w", image, res.Arch(s), err)
+				}
 
 				return err
 			}
@@ -74,3 +87,35 @@ func PullKubernetesImages(c *v1.Cluster, s ssh.Interface, option *Option) error
 	}
 	return nil
 }
+
+// isNoMatchingManifest returns true if the image pulled is a manifest list
+// without the platform of the node.
+func isNoMatchingManifest(err error) bool {
+	// docker: no matching manifest for linux/arm64/v8 in the manifest list entries
+	// containerd: no match for platform in manifest: not found
+	return strings.Contains(err.Error(), "no matching manifest") ||
+		strings.Contains(err.Error(), "no match for platform")
+}
+
+// NodeAddonImages returns the images of the addons running on every node of
+// the cluster, which must support the architecture of all the nodes.
+func NodeAddonImages(c *v1.Cluster) []string {
+	var items []string
+
+	switch util.NetworkType(c.Cluster) {
+	case platformv1.NetworkGalaxy:
+		galaxy := galaxyimages.Get(galaxyimages.LatestVersion)
+		items = append(items, galaxy.GalaxyDaemon.FullName(), galaxy.Flannel.FullName(), galaxy.BridgeAgent.FullName())
+	case platformv1.NetworkCilium:
+		items = append(items, images.Get().Cilium.FullName(), images.Get().Masq.FullName(), images.Get().CiliumRouter.FullName())
+	case platformv1.NetworkCalico:
+		items = append(items, images.Get().CalicoNode.FullName(), images.Get().CalicoCNI.FullName())
+	case platformv1.NetworkFlannel:
+		items = append(items, images.Get().Flannel.FullName())
+	}
+	if c.Spec.Features.MetalLB != nil {
+		items = append(items, images.Get().MetalLBSpeaker.FullName())
+	}
+
+	return items
+}
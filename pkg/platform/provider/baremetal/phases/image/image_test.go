@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package image
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func TestNodeAddonImages(t *testing.T) {
+	tests := []struct {
+		name    string
+		network platformv1.NetworkType
+		metalLB *platformv1.MetalLB
+		want    []string
+	}{
+		{
+			name:    "calico",
+			network: platformv1.NetworkCalico,
+			want:    []string{images.Get().CalicoNode.FullName(), images.Get().CalicoCNI.FullName()},
+		},
+		{
+			name:    "flannel with metallb",
+			network: platformv1.NetworkFlannel,
+			metalLB: &platformv1.MetalLB{},
+			want:    []string{images.Get().Flannel.FullName(), images.Get().MetalLBSpeaker.FullName()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &v1.Cluster{Cluster: &platformv1.Cluster{}}
+			c.Spec.NetworkType = tt.network
+			c.Spec.Features.MetalLB = tt.metalLB
+			if got := NodeAddonImages(c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NodeAddonImages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoMatchingManifest(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("no matching manifest for linux/arm64/v8 in the manifest list entries"), true},
+		{errors.New(`pulling image: rpc error: code = NotFound desc = failed to pull and unpack image: no match for platform in manifest: not found`), true},
+		{errors.New("manifest unknown"), false},
+	}
+	for _, tt := range tests {
+		if got := isNoMatchingManifest(tt.err); got != tt.want {
+			t.Errorf("isNoMatchingManifest(%q) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
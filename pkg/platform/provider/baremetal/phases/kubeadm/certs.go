@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeadm
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	certutil "k8s.io/client-go/util/cert"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+// CertificateFile is a certificate on masters which expiration is tracked.
+type CertificateFile struct {
+	Name string
+	Path string
+	// Renewable is whether the certificate is renewed by kubeadm. The kubelet
+	// rotates its client certificate by itself.
+	Renewable bool
+}
+
+// CertificateFiles are the certificates on masters which expiration is tracked.
+var CertificateFiles = []CertificateFile{
+	{Name: "apiserver", Path: constants.APIServerCertName, Renewable: true},
+	{Name: "apiserver-kubelet-client", Path: constants.APIServerKubeletClientCertName, Renewable: true},
+	{Name: "apiserver-etcd-client", Path: constants.APIServerEtcdClientCertName, Renewable: true},
+	{Name: "front-proxy-client", Path: constants.FrontProxyClientCertName, Renewable: true},
+	{Name: "etcd-server", Path: constants.EtcdServerCertName, Renewable: true},
+	{Name: "etcd-peer", Path: constants.EtcdPeerCertName, Renewable: true},
+	{Name: "etcd-healthcheck-client", Path: constants.EtcdHealthcheckClientCertName, Renewable: true},
+	{Name: "kubelet-client", Path: constants.KubeletClientCurrent},
+}
+
+// ReadCertificateExpiration returns the expiration of the first certificate
+// in the file on the node.
+func ReadCertificateExpiration(s ssh.Interface, file string) (time.Time, error) {
+	data, err := s.ReadFile(file)
+	if err != nil {
+		return time.Time{}, err
+	}
+	certs, err := certutil.ParseCertsPEM(data)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse %s error: %w", file, err)
+	}
+
+	return certs[0].NotAfter, nil
+}
+
+// WaitForAPIServer waits until the kube-apiserver on the master is healthy.
+func WaitForAPIServer(s ssh.Interface) error {
+	return wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
+		_, _, exit, err := s.Execf("kubectl --kubeconfig %s get --raw=/healthz", constants.KubectlConfigFile)
+		if err != nil {
+			return false, nil
+		}
+		return exit == 0, nil
+	})
+}
@@ -57,7 +57,8 @@ type Option struct {
 }
 
 const (
-	kubeadmKubeletConf = "/usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf"
+	// KubeletConfFile is the kubelet systemd drop-in written by Install.
+	KubeletConfFile = "/usr/lib/systemd/system/kubelet.service.d/10-kubeadm.conf"
 
 	initCmd  = `kubeadm init phase {{.Phase}} --config={{.Config}}`
 	joinCmd  = `kubeadm join phase {{.Phase}} --config={{.Config}}`
@@ -90,18 +91,23 @@ func Install(s ssh.Interface, option *Option) error {
 		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmdStr, exit, stderr, err.Error())
 	}
 
-	data, err := template.ParseFile(path.Join(constants.ConfDir, "kubeadm/10-kubeadm.conf"), option)
+	data, err := KubeletConf(option)
 	if err != nil {
 		return err
 	}
-	err = s.WriteFile(bytes.NewReader(data), kubeadmKubeletConf)
+	err = s.WriteFile(bytes.NewReader(data), KubeletConfFile)
 	if err != nil {
-		return errors.Wrapf(err, "write %s error", kubeadmKubeletConf)
+		return errors.Wrapf(err, "write %s error", KubeletConfFile)
 	}
 
 	return nil
 }
 
+// KubeletConf renders the content of KubeletConfFile.
+func KubeletConf(option *Option) ([]byte, error) {
+	return template.ParseFile(path.Join(constants.ConfDir, "kubeadm/10-kubeadm.conf"), option)
+}
+
 func WriteInitConfig(s ssh.Interface, kubeadmConfig *InitConfig) error {
 	configData, err := kubeadmConfig.Marshal()
 	if err != nil {
@@ -322,11 +328,12 @@ type UpgradeOption struct {
 	Version                string
 	MaxUnready             *intstr.IntOrString
 	DrainNodeBeforeUpgrade *bool
+	RollbackOnFailure      *bool
 }
 
 // UpgradeNode upgrades node by kubeadm.
 // Refer: https://kubernetes.io/docs/tasks/administer-cluster/kubeadm/kubeadm-upgrade/
-func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient platformv1client.PlatformV1Interface, logger log.Logger, cluster *v1.Cluster, option UpgradeOption) (upgraded bool, err error) {
+func UpgradeNode(ctx context.Context, s ssh.Interface, client kubernetes.Interface, platformClient platformv1client.PlatformV1Interface, logger log.Logger, cluster *v1.Cluster, option UpgradeOption) (upgraded bool, err error) {
 	if option.NodeRole == NodeRoleWorker {
 		ok, err := checkMasterNodesVersion(client, option.Version)
 		if err != nil {
@@ -337,7 +344,7 @@ func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient pl
 		}
 	}
 
-	node, err := apiclient.GetNodeByMachineIP(context.TODO(), client, option.MachineIP)
+	node, err := apiclient.GetNodeByMachineIP(ctx, client, option.MachineIP)
 	if err != nil {
 		return upgraded, err
 	}
@@ -355,6 +362,29 @@ func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient pl
 		return false, err
 	}
 
+	// Step 0(option): backup binaries of worker node, roll them back if upgrade failed
+	if option.NodeRole == NodeRoleWorker &&
+		!sameMinor &&
+		(option.RollbackOnFailure == nil || *option.RollbackOnFailure) {
+		err = backupUpgradeFiles(s)
+		if err != nil {
+			return upgraded, err
+		}
+		defer func() {
+			if err == nil {
+				cleanUpgradeFiles(s)
+				return
+			}
+			logger.Infof("Start rollback binaries of %s", option.MachineIP)
+			if rollbackErr := rollbackUpgradeFiles(s); rollbackErr != nil {
+				err = fmt.Errorf("%v, rollback error: %w", err, rollbackErr)
+				return
+			}
+			logger.Infof("End rollback binaries of %s", option.MachineIP)
+			err = fmt.Errorf("%w: %v", ErrUpgradeRolledBack, err)
+		}()
+	}
+
 	// Step 1: install kubeadm
 	// ignore patch version for patch version kubeadm may not exist in platform-controller
 	if !sameMinor {
@@ -430,7 +460,7 @@ func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient pl
 	logger.Infof("End install kubelet to %s", option.MachineIP)
 
 	// Step 5: wait for node information to be updated
-	err = wait.PollImmediate(10*time.Second, 5*time.Minute, func() (bool, error) {
+	err = wait.PollImmediateWithContext(ctx, 10*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
 		logger.Infof("Wait node info of %s", option.MachineIP)
 		// ignore patch version for patch version kubelet may not exist in platform-controller
 		same, err := checkKubeletVersion(client, node.Name, option.Version, false)
@@ -448,6 +478,19 @@ func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient pl
 		return upgraded, err
 	}
 
+	// Step 6: wait for worker node to be healthy
+	if option.NodeRole == NodeRoleWorker {
+		logger.Infof("Start health check of %s", option.MachineIP)
+		if option.DrainNodeBeforeUpgrade != nil && *option.DrainNodeBeforeUpgrade {
+			_ = uncordonNode(s, node.Name)
+		}
+		err = waitNodeHealthy(ctx, client, node.Name, option.MaxUnready)
+		if err != nil {
+			return upgraded, err
+		}
+		logger.Infof("End health check of %s", option.MachineIP)
+	}
+
 	return true, nil
 }
 
@@ -579,13 +622,10 @@ func drainNodeCarefully(s ssh.Interface, client kubernetes.Interface, nodeName s
 			}
 		}
 	}
-	maxUnreadyThreshold, err := intstr.GetValueFromIntOrPercent(maxUnready, totalPods, true)
+	err = checkUnreadyPods(totalPods, unreadyPods, maxUnready)
 	if err != nil {
 		return err
 	}
-	if unreadyPods > maxUnreadyThreshold {
-		return fmt.Errorf("unready pods(%d) >= max unready threshold(%d %v/%d)", unreadyPods, maxUnreadyThreshold, maxUnready, totalPods)
-	}
 
 	// coredns must be ready, otherwise kubectl upgrade whill hang in waiting!
 	err = wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
@@ -630,37 +670,47 @@ func uncordonNode(s ssh.Interface, nodeName string) error {
 	return nil
 }
 
-// markNextUpgradeWorkerNode marks next wokrer node to be upgraded.
+// MarkNextUpgradeWorkerNode marks next wokrer node to be upgraded unless the
+// worker upgrade of cluster is paused or aborted.
 func MarkNextUpgradeWorkerNode(client kubernetes.Interface, platformClient platformv1client.PlatformV1Interface, version, clusterName string) error {
-	machines, err := platformClient.Machines().List(context.TODO(), metav1.ListOptions{
-		LabelSelector: fields.OneTermEqualSelector(constants.LabelNodeNeedUpgrade, WillUpgrade).String(),
-		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, clusterName).String(),
-	})
+	cluster, err := platformClient.Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
-	// No machines need to be upgraded.
-	if len(machines.Items) == 0 {
+	if cluster.Annotations[platformv1.WorkerUpgradeAnno] != "" {
 		return nil
 	}
+	machines, err := listClusterMachines(platformClient, clusterName)
+	if err != nil {
+		return err
+	}
 
-	// Get next upgraded machine by lowest name.
-	var nextMachineName string
-	for _, machine := range machines.Items {
-		if nextMachineName == "" {
-			nextMachineName = machine.Name
-		} else if strings.Compare(machine.Name, nextMachineName) < 0 {
-			nextMachineName = machine.Name
-		}
+	nextMachineName, canary := nextUpgradeWorkerMachine(machines, version, cluster.Spec.Features.Upgrade.Strategy.CanaryMachines)
+	// No machines need to be upgraded.
+	if nextMachineName == "" {
+		return nil
 	}
-	if nextMachineName != "" {
-		err = platformapiclient.PatchMachine(context.TODO(), platformClient, nextMachineName, func(machine *platformv1.Machine) {
-			machine.Status.Phase = platformv1.MachineUpgrading
-		})
-		if err != nil {
-			return err
+	upgrade := &platformv1.MachineUpgradeStatus{
+		Phase:     platformv1.MachineUpgradeRunning,
+		ToVersion: version,
+		Canary:    canary,
+		StartTime: metav1.Now(),
+	}
+	for _, machine := range machines {
+		if machine.Name != nextMachineName {
+			continue
+		}
+		if node, err := apiclient.GetNodeByMachineIP(context.TODO(), client, machine.Spec.IP); err == nil {
+			upgrade.FromVersion = node.Status.NodeInfo.KubeletVersion
 		}
 	}
+	err = platformapiclient.PatchMachine(context.TODO(), platformClient, nextMachineName, func(machine *platformv1.Machine) {
+		machine.Status.Phase = platformv1.MachineUpgrading
+		machine.Status.Upgrade = upgrade
+	})
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -669,7 +719,13 @@ func RemoveUpgradeLabel(platformClient platformv1client.PlatformV1Interface, mac
 	err := platformapiclient.PatchMachine(context.TODO(), platformClient, machine.Name, func(machine *platformv1.Machine) {
 		// Remove upgrade label
 		delete(machine.Labels, constants.LabelNodeNeedUpgrade)
+		delete(machine.Annotations, constants.AnnotationUpgradeFailures)
 		machine.Status.Phase = platformv1.MachineRunning
+		if machine.Status.Upgrade != nil {
+			machine.Status.Upgrade.Phase = platformv1.MachineUpgradeSucceeded
+			machine.Status.Upgrade.FinishTime = metav1.Now()
+			machine.Status.Upgrade.Message = ""
+		}
 	})
 	return err
 }
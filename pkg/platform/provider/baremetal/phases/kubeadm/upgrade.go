@@ -0,0 +1,357 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeadm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubelet"
+	platformapiclient "tkestack.io/tke/pkg/platform/util/apiclient"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const upgradeBackupSuffix = ".upgrade-backup"
+
+// ErrUpgradeRolledBack is wrapped by the error of UpgradeNode when binaries of
+// the failed node were rolled back.
+var ErrUpgradeRolledBack = errors.New("upgrade rolled back")
+
+// MaxUpgradeFailures is the number of failed upgrades of the same worker
+// node after which the worker upgrade is paused, failures before are retried
+// by the machine controller.
+const MaxUpgradeFailures = 3
+
+// upgradeFiles returns files replaced by upgrading a worker node.
+func upgradeFiles() []string {
+	return []string{
+		path.Join(constants.DstBinDir, "kubeadm"),
+		path.Join(constants.DstBinDir, "kubelet"),
+		path.Join(constants.DstBinDir, "kubectl"),
+		KubeletConfFile,
+		constants.KubeletConfigFile,
+	}
+}
+
+// UpgradeFailures returns the number of failed upgrades of machine recorded
+// by RecordUpgradeFailure.
+func UpgradeFailures(machine *platformv1.Machine) int {
+	failures, err := strconv.Atoi(machine.Annotations[constants.AnnotationUpgradeFailures])
+	if err != nil {
+		return 0
+	}
+	return failures
+}
+
+// RecordUpgradeFailure increases the number of failed upgrades in the
+// annotation of machine and returns it.
+func RecordUpgradeFailure(ctx context.Context, platformClient platformv1client.PlatformV1Interface, machine *platformv1.Machine) (int, error) {
+	var failures int
+	err := platformapiclient.PatchMachine(ctx, platformClient, machine.Name, func(machine *platformv1.Machine) {
+		// count on the latest machine, the cached one may miss the last failure
+		failures = UpgradeFailures(machine) + 1
+		if machine.Annotations == nil {
+			machine.Annotations = make(map[string]string)
+		}
+		machine.Annotations[constants.AnnotationUpgradeFailures] = strconv.Itoa(failures)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return failures, nil
+}
+
+// backupUpgradeFiles copies files replaced by upgrading, so they can be
+// restored by rollbackUpgradeFiles.
+func backupUpgradeFiles(s ssh.Interface) error {
+	for _, file := range upgradeFiles() {
+		cmd := fmt.Sprintf("if [ -f %[1]s ]; then cp -af %[1]s %[1]s%[2]s; fi", file, upgradeBackupSuffix)
+		if _, err := s.CombinedOutput(cmd); err != nil {
+			return fmt.Errorf("backup %q error: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+// rollbackUpgradeFiles restores files backed up by backupUpgradeFiles and restarts kubelet.
+func rollbackUpgradeFiles(s ssh.Interface) error {
+	_ = kubelet.ServiceOperate(s, kubelet.Stop)
+	for _, file := range upgradeFiles() {
+		cmd := fmt.Sprintf("if [ -f %[1]s%[2]s ]; then mv -f %[1]s%[2]s %[1]s; fi", file, upgradeBackupSuffix)
+		if _, err := s.CombinedOutput(cmd); err != nil {
+			return fmt.Errorf("restore %q error: %w", file, err)
+		}
+	}
+	if _, err := s.CombinedOutput("systemctl daemon-reload"); err != nil {
+		return err
+	}
+
+	return kubelet.ServiceOperate(s, kubelet.Start)
+}
+
+// cleanUpgradeFiles removes files backed up by backupUpgradeFiles.
+func cleanUpgradeFiles(s ssh.Interface) {
+	for _, file := range upgradeFiles() {
+		_, _ = s.CombinedOutput(fmt.Sprintf("rm -f %s%s", file, upgradeBackupSuffix))
+	}
+}
+
+// checkUnreadyPods checks whether unready pods exceed the max unready threshold.
+func checkUnreadyPods(totalPods, unreadyPods int, maxUnready *intstr.IntOrString) error {
+	maxUnreadyThreshold, err := intstr.GetValueFromIntOrPercent(maxUnready, totalPods, true)
+	if err != nil {
+		return err
+	}
+	if unreadyPods > maxUnreadyThreshold {
+		return fmt.Errorf("unready pods(%d) >= max unready threshold(%d %v/%d)", unreadyPods, maxUnreadyThreshold, maxUnready, totalPods)
+	}
+
+	return nil
+}
+
+// checkNodeHealthy checks node is ready and pods running on node are ready
+// within the max unready threshold.
+func checkNodeHealthy(client kubernetes.Interface, nodeName string, maxUnready *intstr.IntOrString) error {
+	node, err := client.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	ready := false
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			ready = condition.Status == corev1.ConditionTrue
+		}
+	}
+	if !ready {
+		return fmt.Errorf("node %s is not ready", nodeName)
+	}
+
+	pods, err := client.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return err
+	}
+	var totalPods, unreadyPods int
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != nodeName ||
+			pod.Status.Phase == corev1.PodSucceeded ||
+			pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		totalPods++
+		if !apiclient.IsPodReady(&pod) {
+			unreadyPods++
+		}
+	}
+
+	return checkUnreadyPods(totalPods, unreadyPods, maxUnready)
+}
+
+// waitNodeHealthy is the health gate after a worker node is upgraded.
+func waitNodeHealthy(ctx context.Context, client kubernetes.Interface, nodeName string, maxUnready *intstr.IntOrString) error {
+	var lastErr error
+	err := wait.PollImmediateWithContext(ctx, 10*time.Second, 5*time.Minute, func(ctx context.Context) (bool, error) {
+		lastErr = checkNodeHealthy(client, nodeName, maxUnready)
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		return fmt.Errorf("health check of node %s failed: %v", nodeName, lastErr)
+	}
+
+	return nil
+}
+
+// nextUpgradeWorkerMachine returns the next machine to be upgraded in machines
+// of one cluster and whether it belongs to the canary batch. Empty name is
+// returned when a machine is upgrading or no machine waits for upgrade.
+func nextUpgradeWorkerMachine(machines []platformv1.Machine, version string, canaryMachines int32) (string, bool) {
+	var nextMachineName string
+	var canaries int32
+	for _, machine := range machines {
+		if machine.Status.Phase == platformv1.MachineUpgrading {
+			return "", false
+		}
+		if isUpgradedCanary(&machine, version) {
+			canaries++
+		}
+		if machine.Labels[constants.LabelNodeNeedUpgrade] != WillUpgrade {
+			continue
+		}
+		// Get next upgraded machine by lowest name.
+		if nextMachineName == "" || machine.Name < nextMachineName {
+			nextMachineName = machine.Name
+		}
+	}
+
+	return nextMachineName, nextMachineName != "" && canaries < canaryMachines
+}
+
+// canaryBatchUpgraded reports whether all machines of the canary batch are upgraded to version.
+func canaryBatchUpgraded(machines []platformv1.Machine, version string, canaryMachines int32) bool {
+	if canaryMachines <= 0 {
+		return false
+	}
+	var canaries int32
+	for _, machine := range machines {
+		if isUpgradedCanary(&machine, version) {
+			canaries++
+		}
+	}
+
+	return canaries >= canaryMachines
+}
+
+func isUpgradedCanary(machine *platformv1.Machine, version string) bool {
+	upgrade := machine.Status.Upgrade
+	return upgrade != nil &&
+		upgrade.Canary &&
+		upgrade.ToVersion == version &&
+		upgrade.Phase == platformv1.MachineUpgradeSucceeded
+}
+
+func listClusterMachines(platformClient platformv1client.PlatformV1Interface, clusterName string) ([]platformv1.Machine, error) {
+	machines, err := platformClient.Machines().List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, clusterName).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return machines.Items, nil
+}
+
+// SetWorkerUpgradeControl sets the worker upgrade annotation of cluster.
+func SetWorkerUpgradeControl(platformClient platformv1client.PlatformV1Interface, clusterName, value string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				platformv1.WorkerUpgradeAnno: value,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = platformClient.Clusters().Patch(context.TODO(), clusterName, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// PauseAfterCanary pauses the worker upgrade of cluster if the canary batch
+// is upgraded, and returns whether it is paused.
+func PauseAfterCanary(platformClient platformv1client.PlatformV1Interface, cluster *platformv1.Cluster) (bool, error) {
+	strategy := cluster.Spec.Features.Upgrade.Strategy
+	if !strategy.PauseAfterCanary {
+		return false, nil
+	}
+	machines, err := listClusterMachines(platformClient, cluster.Name)
+	if err != nil {
+		return false, err
+	}
+	if !canaryBatchUpgraded(machines, cluster.Spec.Version, strategy.CanaryMachines) {
+		return false, nil
+	}
+	err = SetWorkerUpgradeControl(platformClient, cluster.Name, platformv1.WorkerUpgradePaused)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// MarkUpgradeFailed records the failed upgrade in machine status and pauses the
+// worker upgrade of cluster, the machine keeps need upgrade label to be retried
+// after resuming.
+func MarkUpgradeFailed(platformClient platformv1client.PlatformV1Interface, machine *platformv1.Machine, upgradeErr error) error {
+	err := platformapiclient.PatchMachine(context.TODO(), platformClient, machine.Name, func(machine *platformv1.Machine) {
+		machine.Status.Phase = platformv1.MachineRunning
+		if machine.Status.Upgrade == nil {
+			machine.Status.Upgrade = &platformv1.MachineUpgradeStatus{}
+		}
+		machine.Status.Upgrade.Phase = platformv1.MachineUpgradeFailed
+		if errors.Is(upgradeErr, ErrUpgradeRolledBack) {
+			machine.Status.Upgrade.Phase = platformv1.MachineUpgradeRolledBack
+		}
+		machine.Status.Upgrade.FinishTime = metav1.Now()
+		machine.Status.Upgrade.Message = upgradeErr.Error()
+		// the machine gets all retries again after the upgrade is resumed
+		delete(machine.Annotations, constants.AnnotationUpgradeFailures)
+	})
+	if err != nil {
+		return err
+	}
+
+	return SetWorkerUpgradeControl(platformClient, machine.Spec.ClusterName, platformv1.WorkerUpgradePaused)
+}
+
+// ReconcileWorkerUpgrade continues the worker upgrade of cluster after it is
+// resumed, or clears need upgrade label of remaining machines after it is aborted.
+func ReconcileWorkerUpgrade(client kubernetes.Interface, platformClient platformv1client.PlatformV1Interface, cluster *platformv1.Cluster) error {
+	switch cluster.Annotations[platformv1.WorkerUpgradeAnno] {
+	case platformv1.WorkerUpgradePaused:
+		return nil
+	case platformv1.WorkerUpgradeAborted:
+		requirement, err := labels.NewRequirement(constants.LabelNodeNeedUpgrade, selection.Exists, []string{})
+		if err != nil {
+			return err
+		}
+		machines, err := platformClient.Machines().List(context.TODO(), metav1.ListOptions{
+			LabelSelector: requirement.String(),
+			FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, cluster.Name).String(),
+		})
+		if err != nil {
+			return err
+		}
+		for _, machine := range machines.Items {
+			// let the upgrading machine finish
+			if machine.Status.Phase == platformv1.MachineUpgrading {
+				continue
+			}
+			err = platformapiclient.PatchMachine(context.TODO(), platformClient, machine.Name, func(machine *platformv1.Machine) {
+				delete(machine.Labels, constants.LabelNodeNeedUpgrade)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return MarkNextUpgradeWorkerNode(client, platformClient, cluster.Spec.Version, cluster.Name)
+	}
+}
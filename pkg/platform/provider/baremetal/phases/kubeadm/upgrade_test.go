@@ -0,0 +1,253 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeadm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	platformfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+)
+
+func newUpgradeMachine(name string, needUpgrade bool, phase platformv1.MachinePhase, upgrade *platformv1.MachineUpgradeStatus) platformv1.Machine {
+	machine := platformv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+		Status: platformv1.MachineStatus{
+			Phase:   phase,
+			Upgrade: upgrade,
+		},
+	}
+	if needUpgrade {
+		machine.Labels[constants.LabelNodeNeedUpgrade] = WillUpgrade
+	}
+	return machine
+}
+
+func Test_nextUpgradeWorkerMachine(t *testing.T) {
+	version := "1.21.4-tke.3"
+	succeededCanary := &platformv1.MachineUpgradeStatus{
+		Phase:     platformv1.MachineUpgradeSucceeded,
+		ToVersion: version,
+		Canary:    true,
+	}
+	tests := []struct {
+		name           string
+		machines       []platformv1.Machine
+		canaryMachines int32
+		wantName       string
+		wantCanary     bool
+	}{
+		{
+			"no machine need upgrade",
+			[]platformv1.Machine{
+				newUpgradeMachine("mc-b", false, platformv1.MachineRunning, nil),
+			},
+			1,
+			"",
+			false,
+		},
+		{
+			"lowest name without canary",
+			[]platformv1.Machine{
+				newUpgradeMachine("mc-b", true, platformv1.MachineRunning, nil),
+				newUpgradeMachine("mc-a", true, platformv1.MachineRunning, nil),
+			},
+			0,
+			"mc-a",
+			false,
+		},
+		{
+			"first machine is canary",
+			[]platformv1.Machine{
+				newUpgradeMachine("mc-b", true, platformv1.MachineRunning, nil),
+				newUpgradeMachine("mc-a", true, platformv1.MachineRunning, nil),
+			},
+			1,
+			"mc-a",
+			true,
+		},
+		{
+			"canary batch upgraded",
+			[]platformv1.Machine{
+				newUpgradeMachine("mc-a", false, platformv1.MachineRunning, succeededCanary),
+				newUpgradeMachine("mc-b", true, platformv1.MachineRunning, nil),
+			},
+			1,
+			"mc-b",
+			false,
+		},
+		{
+			"failed canary is retried as canary",
+			[]platformv1.Machine{
+				newUpgradeMachine("mc-a", true, platformv1.MachineRunning, &platformv1.MachineUpgradeStatus{
+					Phase:     platformv1.MachineUpgradeRolledBack,
+					ToVersion: version,
+					Canary:    true,
+				}),
+				newUpgradeMachine("mc-b", true, platformv1.MachineRunning, nil),
+			},
+			1,
+			"mc-a",
+			true,
+		},
+		{
+			"wait upgrading machine",
+			[]platformv1.Machine{
+				newUpgradeMachine("mc-a", true, platformv1.MachineRunning, nil),
+				newUpgradeMachine("mc-b", true, platformv1.MachineUpgrading, nil),
+			},
+			0,
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotName, gotCanary := nextUpgradeWorkerMachine(tt.machines, version, tt.canaryMachines)
+			if gotName != tt.wantName || gotCanary != tt.wantCanary {
+				t.Errorf("nextUpgradeWorkerMachine() = (%v, %v), want (%v, %v)", gotName, gotCanary, tt.wantName, tt.wantCanary)
+			}
+		})
+	}
+}
+
+func Test_canaryBatchUpgraded(t *testing.T) {
+	version := "1.21.4-tke.3"
+	canary := func(phase platformv1.MachineUpgradePhase, toVersion string) *platformv1.MachineUpgradeStatus {
+		return &platformv1.MachineUpgradeStatus{Phase: phase, ToVersion: toVersion, Canary: true}
+	}
+	machines := []platformv1.Machine{
+		newUpgradeMachine("mc-a", false, platformv1.MachineRunning, canary(platformv1.MachineUpgradeSucceeded, version)),
+		newUpgradeMachine("mc-b", false, platformv1.MachineRunning, canary(platformv1.MachineUpgradeSucceeded, "1.20.6-tke.2")),
+		newUpgradeMachine("mc-c", true, platformv1.MachineRunning, canary(platformv1.MachineUpgradeFailed, version)),
+	}
+	if canaryBatchUpgraded(machines, version, 0) {
+		t.Errorf("canaryBatchUpgraded() without canary should be false")
+	}
+	if !canaryBatchUpgraded(machines, version, 1) {
+		t.Errorf("canaryBatchUpgraded() with 1 canary should be true")
+	}
+	if canaryBatchUpgraded(machines, version, 2) {
+		t.Errorf("canaryBatchUpgraded() with 2 canaries should be false")
+	}
+}
+
+func Test_checkNodeHealthy(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	pod := func(name string, ready corev1.ConditionStatus, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem},
+			Spec:       corev1.PodSpec{NodeName: node.Name},
+			Status: corev1.PodStatus{
+				Phase:      phase,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			},
+		}
+	}
+	notReadyNode := node.DeepCopy()
+	notReadyNode.Status.Conditions[0].Status = corev1.ConditionFalse
+	zero := intstr.FromInt(0)
+	half := intstr.FromString("50%")
+
+	tests := []struct {
+		name       string
+		objects    []runtime.Object
+		maxUnready *intstr.IntOrString
+		wantErr    bool
+	}{
+		{
+			"node not ready",
+			[]runtime.Object{notReadyNode},
+			&zero,
+			true,
+		},
+		{
+			"all pods ready",
+			[]runtime.Object{node, pod("a", corev1.ConditionTrue, corev1.PodRunning), pod("b", corev1.ConditionFalse, corev1.PodSucceeded)},
+			&zero,
+			false,
+		},
+		{
+			"unready pods exceed threshold",
+			[]runtime.Object{node, pod("a", corev1.ConditionTrue, corev1.PodRunning), pod("b", corev1.ConditionFalse, corev1.PodRunning)},
+			&zero,
+			true,
+		},
+		{
+			"unready pods within threshold",
+			[]runtime.Object{node, pod("a", corev1.ConditionTrue, corev1.PodRunning), pod("b", corev1.ConditionFalse, corev1.PodRunning)},
+			&half,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(tt.objects...)
+			err := checkNodeHealthy(client, node.Name, tt.maxUnready)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkNodeHealthy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecordUpgradeFailure(t *testing.T) {
+	machine := newUpgradeMachine("mc-a", true, platformv1.MachineUpgrading, &platformv1.MachineUpgradeStatus{})
+	machine.Spec.ClusterName = "cls-a"
+	cluster := &platformv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cls-a"}}
+	client := platformfake.NewSimpleClientset(&machine, cluster).PlatformV1()
+
+	for want := 1; want <= MaxUpgradeFailures; want++ {
+		// the cached machine misses the failures recorded before
+		failures, err := RecordUpgradeFailure(context.Background(), client, &machine)
+		if err != nil {
+			t.Fatalf("RecordUpgradeFailure() error = %v", err)
+		}
+		if failures != want {
+			t.Errorf("RecordUpgradeFailure() = %d, want %d", failures, want)
+		}
+	}
+
+	if err := MarkUpgradeFailed(client, &machine, errors.New("health check failed")); err != nil {
+		t.Fatalf("MarkUpgradeFailed() error = %v", err)
+	}
+	got, err := client.Machines().Get(context.Background(), machine.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := UpgradeFailures(got); n != 0 {
+		t.Errorf("UpgradeFailures() = %d after the upgrade is marked failed, want 0", n)
+	}
+	if got.Status.Upgrade.Phase != platformv1.MachineUpgradeFailed {
+		t.Errorf("upgrade phase = %q, want %q", got.Status.Upgrade.Phase, platformv1.MachineUpgradeFailed)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubevip
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/util/ssh"
+	"tkestack.io/tke/pkg/util/template"
+)
+
+type Option struct {
+	IP        string
+	VIP       string
+	Interface string
+	BGP       *platformv1.BGPConfig
+}
+
+// Install writes the kube-vip static pod which announces the VIP of the
+// control plane from the master.
+func Install(s ssh.Interface, option *Option) error {
+	networkInterface := option.Interface
+	if networkInterface == "" {
+		networkInterface = ssh.GetNetworkInterface(s, option.IP)
+	}
+	if networkInterface == "" {
+		return fmt.Errorf("can't get network interface by %s", option.IP)
+	}
+
+	values := map[string]interface{}{
+		"Image":     images.Get().KubeVIP.FullName(),
+		"IP":        option.IP,
+		"VIP":       option.VIP,
+		"Interface": networkInterface,
+		"BGP":       option.BGP != nil,
+	}
+	if option.BGP != nil {
+		values["ASN"] = option.BGP.ASN
+		values["Peers"] = Peers(option.BGP.Peers)
+	}
+	data, err := template.ParseFile(constants.KubeVIPManifest, values)
+	if err != nil {
+		return errors.Wrap(err, option.IP)
+	}
+	err = s.WriteFile(bytes.NewReader(data), constants.KubeVIPManifestFile)
+	if err != nil {
+		return errors.Wrap(err, option.IP)
+	}
+
+	return nil
+}
+
+// Peers returns the bgp_peers of kube-vip in the form of
+// "address:asn:password:multihop,...".
+func Peers(peers []platformv1.BGPPeer) string {
+	items := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		items = append(items, fmt.Sprintf("%s:%d:%s:false", peer.Address, peer.ASN, peer.Password))
+	}
+	return strings.Join(items, ",")
+}
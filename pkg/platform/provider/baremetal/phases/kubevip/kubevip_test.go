@@ -0,0 +1,39 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubevip
+
+import (
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestPeers(t *testing.T) {
+	got := Peers([]platformv1.BGPPeer{
+		{Address: "10.0.0.1", ASN: 65001},
+		{Address: "10.0.0.2", ASN: 65002, Password: "secret"},
+	})
+	expected := "10.0.0.1:65001::false,10.0.0.2:65002:secret:false"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	if got := Peers(nil); got != "" {
+		t.Errorf("expected no peers, got %q", got)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package metallb
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+const (
+	namespace      = "metallb-system"
+	configMapName  = "config"
+	memberlistName = "memberlist"
+)
+
+type config struct {
+	Peers        []peer        `json:"peers,omitempty"`
+	AddressPools []addressPool `json:"address-pools"`
+}
+
+type peer struct {
+	PeerAddress string `json:"peer-address"`
+	PeerASN     int32  `json:"peer-asn"`
+	MyASN       int32  `json:"my-asn"`
+	Password    string `json:"password,omitempty"`
+}
+
+type addressPool struct {
+	Name      string   `json:"name"`
+	Protocol  string   `json:"protocol"`
+	Addresses []string `json:"addresses"`
+}
+
+// Config returns the metallb configuration of the feature.
+func Config(metalLB *platformv1.MetalLB) (string, error) {
+	c := config{}
+	if metalLB.BGP != nil {
+		for _, p := range metalLB.BGP.Peers {
+			c.Peers = append(c.Peers, peer{
+				PeerAddress: p.Address,
+				PeerASN:     p.ASN,
+				MyASN:       metalLB.BGP.ASN,
+				Password:    p.Password,
+			})
+		}
+	}
+	for _, pool := range metalLB.AddressPools {
+		protocol := pool.Protocol
+		if protocol == "" {
+			protocol = platformv1.MetalLBLayer2
+		}
+		c.AddressPools = append(c.AddressPools, addressPool{
+			Name:      pool.Name,
+			Protocol:  string(protocol),
+			Addresses: pool.Addresses,
+		})
+	}
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// Install installs metallb and applies the configuration of the feature.
+func Install(ctx context.Context, client kubernetes.Interface, metalLB *platformv1.MetalLB) error {
+	option := map[string]interface{}{
+		"ControllerImage": images.Get().MetalLBController.FullName(),
+		"SpeakerImage":    images.Get().MetalLBSpeaker.FullName(),
+	}
+	err := apiclient.CreateResourceWithFile(ctx, client, constants.MetalLBManifest, option)
+	if err != nil {
+		return err
+	}
+
+	data, err := Config(metalLB)
+	if err != nil {
+		return err
+	}
+	err = apiclient.CreateOrUpdateConfigMap(ctx, client, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: namespace},
+		Data:       map[string]string{"config": data},
+	})
+	if err != nil {
+		return err
+	}
+
+	return ensureMemberlistSecret(ctx, client)
+}
+
+// ensureMemberlistSecret creates the key speakers encrypt the memberlist
+// traffic with, an existing key is kept.
+func ensureMemberlistSecret(ctx context.Context, client kubernetes.Interface) error {
+	key := make([]byte, 128)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	_, err := client.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: memberlistName, Namespace: namespace},
+		StringData: map[string]string{"secretkey": base64.StdEncoding.EncodeToString(key)},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package metallb
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestConfig(t *testing.T) {
+	got, err := Config(&platformv1.MetalLB{
+		AddressPools: []platformv1.MetalLBAddressPool{
+			{Name: "default", Addresses: []string{"192.168.10.0/24"}},
+			{Name: "public", Protocol: platformv1.MetalLBBGP, Addresses: []string{"10.1.0.10-10.1.0.20"}},
+		},
+		BGP: &platformv1.BGPConfig{
+			ASN:   64500,
+			Peers: []platformv1.BGPPeer{{Address: "10.0.0.1", ASN: 64501}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `address-pools:
+- addresses:
+  - 192.168.10.0/24
+  name: default
+  protocol: layer2
+- addresses:
+  - 10.1.0.10-10.1.0.20
+  name: public
+  protocol: bgp
+peers:
+- my-asn: 64500
+  peer-address: 10.0.0.1
+  peer-asn: 64501
+`
+	if got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestEnsureMemberlistSecret(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	if err := ensureMemberlistSecret(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, memberlistName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ensureMemberlistSecret(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	kept, _ := client.CoreV1().Secrets(namespace).Get(ctx, memberlistName, metav1.GetOptions{})
+	if kept.StringData["secretkey"] != secret.StringData["secretkey"] {
+		t.Errorf("expected the memberlist key to be kept")
+	}
+}
@@ -0,0 +1,221 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ospackage
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
+	"tkestack.io/tke/pkg/spec"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// RepoName is the name of the offline package repository configured on nodes.
+	RepoName = "tke-os-packages"
+	// RepoDir is where the offline package repository is unpacked on nodes.
+	RepoDir = "/opt/tke/os-packages"
+
+	FamilyCentOS    = "centos"
+	FamilyOpenEuler = "openeuler"
+	FamilyUbuntu    = "ubuntu"
+)
+
+type Option struct {
+	// Repository is the base URL of a served offline package repository,
+	// which is used as <Repository>/<family>/<arch>/. The repository bundled
+	// in the provider resources is copied to nodes when empty.
+	Repository string
+}
+
+// Family returns the OS family of the node behind s, or empty if it is not
+// supported.
+func Family(s ssh.Interface) (string, error) {
+	data, err := s.ReadFile("/etc/os-release")
+	if err != nil {
+		return "", errors.Wrap(err, "read /etc/os-release error")
+	}
+	return ParseFamily(string(data)), nil
+}
+
+// ParseFamily returns the OS family described by the content of os-release.
+func ParseFamily(osRelease string) string {
+	var ids []string
+	for _, line := range strings.Split(osRelease, "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(kv) != 2 || (kv[0] != "ID" && kv[0] != "ID_LIKE") {
+			continue
+		}
+		ids = append(ids, strings.Fields(strings.ToLower(strings.Trim(kv[1], `"'`)))...)
+	}
+	for _, id := range ids {
+		switch id {
+		case "centos", "rhel", "fedora":
+			return FamilyCentOS
+		case "openeuler":
+			return FamilyOpenEuler
+		case "ubuntu", "debian":
+			return FamilyUbuntu
+		}
+	}
+
+	return ""
+}
+
+// Missing returns packages of spec.OSPackages which are not installed on the
+// node behind s.
+func Missing(s ssh.Interface, family string) ([]string, error) {
+	pkgs, ok := spec.OSPackages[family]
+	if !ok {
+		return nil, fmt.Errorf("unsupported os family %q", family)
+	}
+
+	var missing []string
+	for _, pkg := range pkgs {
+		cmd := "rpm -q %s"
+		if family == FamilyUbuntu {
+			cmd = "dpkg -s %s"
+		}
+		_, _, exit, err := s.Execf(cmd, pkg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "check package %s error", pkg)
+		}
+		if exit != 0 {
+			missing = append(missing, pkg)
+		}
+	}
+
+	return missing, nil
+}
+
+// Install installs packages of spec.OSPackages missing on the node behind s
+// from the offline package repository, the packages of nodes of an unknown OS
+// family are left to the user.
+func Install(s ssh.Interface, option *Option) error {
+	family, err := Family(s)
+	if err != nil {
+		return err
+	}
+	if family == "" {
+		log.Warn("Skip installing OS packages of unsupported os family")
+		return nil
+	}
+	missing, err := Missing(s, family)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	baseURL, err := repository(s, family, option)
+	if err != nil {
+		return err
+	}
+	if family == FamilyUbuntu {
+		err = installDeb(s, baseURL, missing)
+	} else {
+		err = installRPM(s, baseURL, missing)
+	}
+	if err != nil {
+		return err
+	}
+
+	missing, err = Missing(s, family)
+	if err != nil {
+		return err
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("packages %s are still missing", strings.Join(missing, ","))
+	}
+
+	return nil
+}
+
+func repository(s ssh.Interface, family string, option *Option) (string, error) {
+	if option.Repository != "" {
+		arch := res.Arch(s)
+		if arch == "" {
+			return "", errors.New("unsupported architecture of node")
+		}
+		return fmt.Sprintf("%s/%s/%s/", strings.TrimSuffix(option.Repository, "/"), family, arch), nil
+	}
+
+	dir := path.Join(RepoDir, family)
+	pkg := res.Package{
+		Name:      "os-packages-" + family,
+		Versions:  spec.OSPackagesVersions,
+		TargetDir: dir,
+	}
+	_, err := s.CombinedOutput(fmt.Sprintf("mkdir -p %s", dir))
+	if err != nil {
+		return "", err
+	}
+	err = pkg.InstallWithDefault(s)
+	if err != nil {
+		return "", errors.Wrap(err, "install offline package repository error")
+	}
+	return "file://" + dir + "/", nil
+}
+
+func installRPM(s ssh.Interface, baseURL string, pkgs []string) error {
+	// the repository is disabled, so that later yum runs of the user don't
+	// install its unsigned packages
+	repo := fmt.Sprintf("[%s]\nname=%s\nbaseurl=%s\nenabled=0\ngpgcheck=0\n", RepoName, RepoName, baseURL)
+	repoFile := fmt.Sprintf("/etc/yum.repos.d/%s.repo", RepoName)
+	err := s.WriteFile(strings.NewReader(repo), repoFile)
+	if err != nil {
+		return errors.Wrapf(err, "write %s error", repoFile)
+	}
+
+	cmd := fmt.Sprintf("yum install -y --disablerepo=* --enablerepo=%s %s", RepoName, strings.Join(pkgs, " "))
+	_, err = s.CombinedOutput(cmd)
+	if err != nil {
+		return errors.Wrap(err, "install packages error")
+	}
+
+	return nil
+}
+
+func installDeb(s ssh.Interface, baseURL string, pkgs []string) error {
+	// the source is kept out of sources.list.d, so that later apt runs of the
+	// user don't install its unsigned packages
+	source := fmt.Sprintf("deb [trusted=yes] %s ./\n", baseURL)
+	sourceFile := fmt.Sprintf("/etc/apt/%s.list", RepoName)
+	err := s.WriteFile(strings.NewReader(source), sourceFile)
+	if err != nil {
+		return errors.Wrapf(err, "write %s error", sourceFile)
+	}
+
+	// only the offline repository is used, and the package lists of the
+	// other sources are kept for the user
+	options := fmt.Sprintf("-o Dir::Etc::sourcelist=%s -o Dir::Etc::sourceparts=- -o APT::Get::List-Cleanup=0", sourceFile)
+	cmd := fmt.Sprintf("apt-get update %s && DEBIAN_FRONTEND=noninteractive apt-get install -y %s %s",
+		options, options, strings.Join(pkgs, " "))
+	_, err = s.CombinedOutput(cmd)
+	if err != nil {
+		return errors.Wrap(err, "install packages error")
+	}
+
+	return nil
+}
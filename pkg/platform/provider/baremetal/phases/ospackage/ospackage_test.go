@@ -0,0 +1,139 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ospackage
+
+import (
+	"strings"
+	"testing"
+
+	"tkestack.io/tke/pkg/util/ssh/sshtest"
+)
+
+// newNode answers os-release and arch of a node, every package is missing
+// until a command installs them.
+func newNode(osRelease string) *sshtest.Fake {
+	installed := false
+	return &sshtest.Fake{
+		Files: map[string]string{"/etc/os-release": osRelease},
+		CombinedOutputFunc: func(cmd string) ([]byte, error) {
+			if strings.Contains(cmd, "install -y") {
+				installed = true
+			}
+			return nil, nil
+		},
+		ExecFunc: func(cmd string) (string, string, int, error) {
+			if cmd == "arch" {
+				return "x86_64\n", "", 0, nil
+			}
+			if installed {
+				return "", "", 0, nil
+			}
+			return "", "", 1, nil
+		},
+	}
+}
+
+func TestParseFamily(t *testing.T) {
+	tests := []struct {
+		name      string
+		osRelease string
+		want      string
+	}{
+		{
+			name:      "centos",
+			osRelease: "NAME=\"CentOS Linux\"\nID=\"centos\"\nID_LIKE=\"rhel fedora\"\nVERSION_ID=\"7\"\n",
+			want:      FamilyCentOS,
+		},
+		{
+			name:      "tencentos like rhel",
+			osRelease: "NAME=\"TencentOS Server\"\nID=\"tencentos\"\nID_LIKE=\"rhel fedora centos\"\n",
+			want:      FamilyCentOS,
+		},
+		{
+			name:      "openeuler",
+			osRelease: "NAME=\"openEuler\"\nID=\"openEuler\"\nVERSION_ID=\"20.03\"\n",
+			want:      FamilyOpenEuler,
+		},
+		{
+			name:      "ubuntu",
+			osRelease: "NAME=\"Ubuntu\"\nID=ubuntu\nID_LIKE=debian\nVERSION_ID=\"20.04\"\n",
+			want:      FamilyUbuntu,
+		},
+		{
+			name:      "unknown",
+			osRelease: "NAME=\"Alpine Linux\"\nID=alpine\n",
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseFamily(tt.osRelease); got != tt.want {
+				t.Errorf("ParseFamily() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstall(t *testing.T) {
+	option := &Option{Repository: "http://mirror.local/os-packages"}
+
+	unknown := newNode("ID=alpine\n")
+	if err := Install(unknown, option); err != nil {
+		t.Fatalf("Install() of unknown os family error = %v", err)
+	}
+	if len(unknown.Cmds) != 0 {
+		t.Errorf("Install() of unknown os family ran %v", unknown.Cmds)
+	}
+
+	ubuntu := newNode("ID=ubuntu\n")
+	if err := Install(ubuntu, option); err != nil {
+		t.Fatal(err)
+	}
+	var installs []string
+	for _, cmd := range ubuntu.Cmds {
+		if strings.Contains(cmd, "apt-get") {
+			installs = append(installs, cmd)
+		}
+	}
+	if len(installs) != 1 {
+		t.Fatalf("Install() ran %v, want one apt-get command", installs)
+	}
+	for _, cmd := range strings.Split(installs[0], "&&") {
+		if !strings.Contains(cmd, "-o Dir::Etc::sourceparts=- -o APT::Get::List-Cleanup=0") {
+			t.Errorf("apt-get command %q uses other sources or drops their lists", cmd)
+		}
+	}
+	for file := range ubuntu.Files {
+		if strings.HasPrefix(file, "/etc/apt/sources.list.d/") {
+			t.Errorf("Install() leaves the offline source %s to other apt runs", file)
+		}
+	}
+
+	centos := newNode("ID=centos\n")
+	if err := Install(centos, option); err != nil {
+		t.Fatal(err)
+	}
+	repo := centos.Files["/etc/yum.repos.d/"+RepoName+".repo"]
+	if !strings.Contains(repo, "enabled=0") {
+		t.Errorf("Install() leaves the offline repository enabled for other yum runs: %q", repo)
+	}
+	if !centos.Ran("--enablerepo=" + RepoName) {
+		t.Errorf("Install() doesn't enable the offline repository: %v", centos.Cmds)
+	}
+}
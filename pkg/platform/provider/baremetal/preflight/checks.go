@@ -25,8 +25,14 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/ospackage"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/spec"
 	"tkestack.io/tke/pkg/util/ssh"
 )
 
@@ -43,7 +49,7 @@ var kernelParemeter = []string{
 
 func newCommonChecks(c *v1.Cluster, s ssh.Interface) []Checker {
 	var checks []Checker
-	if c.Cluster.Spec.Features.EnableCilium {
+	if util.NetworkType(c.Cluster) == platformv1.NetworkCilium {
 		checks = append(checks, []Checker{
 			KernelParameterCheck{Interface: s, KernelParameter: kernelParemeter},
 			KernelCheck{Interface: s, MinKernelVersion: 4, MinMajorVersion: 11},
@@ -56,6 +62,8 @@ func newCommonChecks(c *v1.Cluster, s ssh.Interface) []Checker {
 	checks = append(checks, []Checker{
 		IsPrivilegedUserCheck{Interface: s},
 		CPUArchCeck{Interface: s, Arch: 64},
+		ArchCheck{Interface: s, Archs: spec.Archs},
+		OSPackagesCheck{Interface: s},
 		KernelCheck{Interface: s, MinKernelVersion: 3, MinMajorVersion: 10},
 
 		KernelModuleCheck{Interface: s, Module: "iptable_nat"},
@@ -74,8 +82,9 @@ func newCommonChecks(c *v1.Cluster, s ssh.Interface) []Checker {
 	return checks
 }
 
-// RunMasterChecks checks for master
-func RunMasterChecks(c *v1.Cluster, s ssh.Interface) error {
+// RunMasterChecks checks for master, the checks named by ignoredChecks are
+// skipped.
+func RunMasterChecks(c *v1.Cluster, s ssh.Interface, ignoredChecks ...string) error {
 	checks := newCommonChecks(c, s)
 	checks = append(checks, []Checker{
 		NumCPUCheck{Interface: s, NumCPU: constants.MinNumCPU},
@@ -93,7 +102,15 @@ func RunMasterChecks(c *v1.Cluster, s ssh.Interface) error {
 		checks = append(checks, InPathCheck{Interface: s, executable: tool})
 	}
 
-	return RunChecks(checks)
+	ignored := sets.NewString(ignoredChecks...)
+	var remaining []Checker
+	for _, check := range checks {
+		if !ignored.Has(check.Name()) {
+			remaining = append(remaining, check)
+		}
+	}
+
+	return RunChecks(remaining)
 }
 
 // RunNodeChecks checks for node
@@ -212,6 +229,31 @@ func (cac CPUArchCeck) Check() (warnings, errorList []error) {
 	return warnings, errorList
 }
 
+// ArchCheck checks the architecture of the node is supported, every machine
+// of a cluster may have a different one.
+type ArchCheck struct {
+	ssh.Interface
+	Archs []string
+}
+
+// Name returns the label for ArchCheck
+func (ArchCheck) Name() string {
+	return "Arch"
+}
+
+// Check checks the architecture of the node
+func (ac ArchCheck) Check() (warnings, errorList []error) {
+	arch := res.Arch(ac)
+	for _, one := range ac.Archs {
+		if arch == one {
+			return warnings, errorList
+		}
+	}
+	machine, _ := ac.CombinedOutput("arch")
+	errorList = append(errorList, errors.Errorf("only support architecture %v, but current is %s", ac.Archs, strings.TrimSpace(string(machine))))
+	return warnings, errorList
+}
+
 // PortOpenCheck ensures the given port is available for use.
 type PortOpenCheck struct {
 	ssh.Interface
@@ -507,3 +549,34 @@ func (kmc KernelModuleCheck) Check() (warnings, errorList []error) {
 
 	return nil, errorList
 }
+
+// OSPackagesCheck checks the baseline OS packages are installed on the node.
+type OSPackagesCheck struct {
+	ssh.Interface
+}
+
+// Name returns the label for OSPackagesCheck
+func (OSPackagesCheck) Name() string {
+	return "OSPackages"
+}
+
+// Check reports the baseline OS packages missing on the node, nodes of an
+// unknown OS family are left to the user.
+func (oc OSPackagesCheck) Check() (warnings, errorList []error) {
+	family, err := ospackage.Family(oc)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if family == "" {
+		return nil, nil
+	}
+	missing, err := ospackage.Missing(oc, family)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if len(missing) != 0 {
+		return nil, []error{fmt.Errorf("packages %s are missing", strings.Join(missing, ","))}
+	}
+
+	return nil, nil
+}
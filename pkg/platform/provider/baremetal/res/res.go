@@ -117,7 +117,11 @@ func (p *Package) CopyToNode(s ssh.Interface, version string) (string, error) {
 }
 
 func (p *Package) ResourceForNode(s ssh.Interface, version string) (string, error) {
-	return p.Resource(Arch(s), version)
+	arch := Arch(s)
+	if arch == "" {
+		return "", errors.New("unsupported architecture of node")
+	}
+	return p.Resource(arch, version)
 }
 
 func (p *Package) Resource(arch, version string) (string, error) {
@@ -155,6 +159,21 @@ func (p *Package) NormalizeVersion(version string) (string, error) {
 	return "", errors.New("invalid version")
 }
 
+// SourceForNode returns the source path of a file for the node behind s, the
+// ArchPlaceholder in src is replaced by the architecture of the node.
+func SourceForNode(s ssh.Interface, src string) (string, error) {
+	if !strings.Contains(src, spec.ArchPlaceholder) {
+		return src, nil
+	}
+	arch := Arch(s)
+	if arch == "" {
+		return "", errors.New("unsupported architecture of node")
+	}
+	return strings.ReplaceAll(src, spec.ArchPlaceholder, arch), nil
+}
+
+// Arch returns the architecture of the node behind s, or empty if it is not
+// supported.
 func Arch(s ssh.Interface) string {
 	var arch string
 
@@ -80,7 +80,7 @@ func ExcuteCustomizedHook(ctx context.Context, c *v1.Cluster, htype platformv1.H
 	hook = fmt.Sprintf("%s %s", hook, buffer.String())
 
 	for _, machine := range machines {
-		machineSSH, err := machine.SSH()
+		machineSSH, err := machine.SSHWithContext(ctx)
 		if err != nil {
 			return err
 		}
@@ -110,3 +110,15 @@ func CleanFlannelInterfaces(cni string) error {
 	}
 	return err
 }
+
+// NetworkType returns the CNI of the cluster. Clusters created before the
+// network type selected the CNI fall back to the EnableCilium feature.
+func NetworkType(cluster *platformv1.Cluster) platformv1.NetworkType {
+	if cluster.Spec.NetworkType != "" {
+		return cluster.Spec.NetworkType
+	}
+	if cluster.Spec.Features.EnableCilium {
+		return platformv1.NetworkCilium
+	}
+	return platformv1.NetworkGalaxy
+}
@@ -19,16 +19,19 @@
 package validation
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
 	"math"
 	"net"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
 	appsv1alpha1 "github.com/clusternet/apis/apps/v1alpha1"
+	"github.com/thoas/go-funk"
 	"tkestack.io/tke/pkg/mesh/util/json"
 
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
@@ -39,6 +42,7 @@ import (
 	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/api/platform"
 	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/calico"
 	csioperatorimage "tkestack.io/tke/pkg/platform/provider/baremetal/phases/csioperator/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/gpu"
 	"tkestack.io/tke/pkg/platform/types"
@@ -57,6 +61,7 @@ var (
 	nodePodNumAvails        = []int32{16, 32, 64, 128, 256}
 	clusterServiceNumAvails = []int32{32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768}
 	supportedOSList         = []string{}
+	networkTypes            = []platform.NetworkType{platform.NetworkGalaxy, platform.NetworkCilium, platform.NetworkCalico, platform.NetworkFlannel}
 	reservePorts            = []int{
 		// kube-apiserver
 		6443,
@@ -80,6 +85,7 @@ var (
 // ValidateCluster validates a given Cluster.
 func ValidateCluster(platformClient platformv1client.PlatformV1Interface, obj *types.Cluster) field.ErrorList {
 	allErrs := ValidatClusterSpec(platformClient, obj.Name, obj.Cluster, field.NewPath("spec"), obj.Status.Phase, true)
+	allErrs = append(allErrs, ValidateNetwork(&obj.Spec, field.NewPath("spec"))...)
 	return allErrs
 }
 
@@ -89,13 +95,17 @@ func ValidateClusterUpdate(platformClient platformv1client.PlatformV1Interface,
 	allErrs := ValidatClusterSpec(platformClient, cluster.Name, cluster.Cluster, fldPath, cluster.Status.Phase, false)
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.NetworkDevice, oldCluster.Spec.NetworkDevice, fldPath.Child("networkDevice"))...)
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.ClusterCIDR, oldCluster.Spec.ClusterCIDR, fldPath.Child("clusterCIDR"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.NetworkType, oldCluster.Spec.NetworkType, fldPath.Child("networkType"))...)
+	if !reflect.DeepEqual(cluster.Spec.NetworkArgs, oldCluster.Spec.NetworkArgs) {
+		allErrs = append(allErrs, ValidateNetwork(&cluster.Spec, fldPath)...)
+	}
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.DNSDomain, oldCluster.Spec.DNSDomain, fldPath.Child("dnsDomain"))...)
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.DockerExtraArgs, oldCluster.Spec.DockerExtraArgs, fldPath.Child("dockerExtraArgs"))...)
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.KubeletExtraArgs, oldCluster.Spec.KubeletExtraArgs, fldPath.Child("kubeletExtraArgs"))...)
-	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.APIServerExtraArgs, oldCluster.Spec.APIServerExtraArgs, fldPath.Child("apiServerExtraArgs"))...)
-	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.ControllerManagerExtraArgs, oldCluster.Spec.ControllerManagerExtraArgs, fldPath.Child("controllerManagerExtraArgs"))...)
-	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(cluster.Spec.SchedulerExtraArgs, oldCluster.Spec.SchedulerExtraArgs, fldPath.Child("schedulerExtraArgs"))...)
+	allErrs = append(allErrs, ValidateControlPlaneExtraArgsUpdate(cluster.Cluster, oldCluster.Cluster, fldPath)...)
+	allErrs = append(allErrs, ValidateClusterContainerRuntimeConfigUpdate(cluster.Cluster, oldCluster.Cluster, fldPath)...)
 	allErrs = append(allErrs, ValidateClusterScale(cluster.Cluster, oldCluster.Cluster, fldPath.Child("machines"))...)
+	allErrs = append(allErrs, ValidateMasterReplacement(cluster.Cluster, oldCluster.Cluster, field.NewPath("metadata", "annotations").Key(platform.ReplaceMasterAnno))...)
 
 	return allErrs
 }
@@ -112,8 +122,8 @@ func ValidateClusterScale(cluster *platform.Cluster, oldCluster *platform.Cluste
 		allErrs = append(allErrs, field.Invalid(fldPath, cluster.Spec.Machines, "HA configuration should enabled for master scale"))
 		return allErrs
 	}
-	if ha.TKEHA == nil && ha.ThirdPartyHA == nil {
-		allErrs = append(allErrs, field.Invalid(fldPath, cluster.Spec.Machines, "tkestack HA or third party HA should enabled for master scale"))
+	if ha.TKEHA == nil && ha.ThirdPartyHA == nil && ha.KubeVIPHA == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, cluster.Spec.Machines, "tkestack HA, third party HA or kube-vip HA should enabled for master scale"))
 		return allErrs
 	}
 	_, err := util.PrepareClusterScale(cluster, oldCluster)
@@ -123,6 +133,84 @@ func ValidateClusterScale(cluster *platform.Cluster, oldCluster *platform.Cluste
 	return allErrs
 }
 
+// ValidateControlPlaneExtraArgsUpdate tests if the change of the extra args of
+// control plane components is valid, they are rolled out to running clusters
+// master by master.
+func ValidateControlPlaneExtraArgsUpdate(cluster *platform.Cluster, oldCluster *platform.Cluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, one := range []struct {
+		name          string
+		args, oldArgs map[string]string
+	}{
+		{"apiServerExtraArgs", cluster.Spec.APIServerExtraArgs, oldCluster.Spec.APIServerExtraArgs},
+		{"controllerManagerExtraArgs", cluster.Spec.ControllerManagerExtraArgs, oldCluster.Spec.ControllerManagerExtraArgs},
+		{"schedulerExtraArgs", cluster.Spec.SchedulerExtraArgs, oldCluster.Spec.SchedulerExtraArgs},
+	} {
+		if reflect.DeepEqual(one.args, one.oldArgs) {
+			continue
+		}
+		if oldCluster.Status.Phase != platform.ClusterRunning {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(one.name), fmt.Sprintf("can only be changed when cluster phase is %s", platform.ClusterRunning)))
+			continue
+		}
+		for k := range one.args {
+			if k == "" || strings.HasPrefix(k, "-") {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child(one.name).Key(k), k, "should be the flag name without leading dashes"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMasterReplacement tests if replacing a master of the cluster is valid.
+func ValidateMasterReplacement(cluster *platform.Cluster, oldCluster *platform.Cluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	ip := cluster.Annotations[platform.ReplaceMasterAnno]
+	oldIP := oldCluster.Annotations[platform.ReplaceMasterAnno]
+	if ip == "" {
+		return allErrs
+	}
+	if oldIP != "" {
+		if ip != oldIP {
+			allErrs = append(allErrs, field.Invalid(fldPath, ip, fmt.Sprintf("master %s is being replaced", oldIP)))
+		}
+		return allErrs
+	}
+
+	if oldCluster.Status.Phase != platform.ClusterRunning {
+		allErrs = append(allErrs, field.Invalid(fldPath, ip, fmt.Sprintf("cluster phase should be %s for master replacement", platform.ClusterRunning)))
+		return allErrs
+	}
+	found := false
+	for _, machine := range oldCluster.Spec.Machines {
+		if machine.IP == ip {
+			found = true
+			break
+		}
+	}
+	if !found {
+		allErrs = append(allErrs, field.NotFound(fldPath, ip))
+		return allErrs
+	}
+	if len(cluster.Spec.Machines) != len(oldCluster.Spec.Machines)+1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, ip, "exactly one new master should be added for master replacement"))
+		return allErrs
+	}
+	if len(cluster.Spec.Machines) < 3 {
+		allErrs = append(allErrs, field.Invalid(fldPath, ip, "at least two masters are required for master replacement"))
+		return allErrs
+	}
+	for _, machine := range cluster.Spec.Machines {
+		if machine.IP == ip {
+			return allErrs
+		}
+	}
+	allErrs = append(allErrs, field.Invalid(fldPath, ip, "replaced master should be kept in machines until the replacement is finished"))
+
+	return allErrs
+}
+
 // ValidatClusterSpec validates a given ClusterSpec.
 func ValidatClusterSpec(platformClient platformv1client.PlatformV1Interface, clusterName string, cls *platform.Cluster, fldPath *field.Path, phase platform.ClusterPhase, validateMachine bool) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -138,6 +226,7 @@ func ValidatClusterSpec(platformClient platformv1client.PlatformV1Interface, clu
 	}
 	allErrs = append(allErrs, ValidateClusterGPUMachines(cls.Spec.Machines, fldPath.Child("machines"))...)
 	allErrs = append(allErrs, ValidateClusterFeature(&cls.Spec, fldPath.Child("features"))...)
+	allErrs = append(allErrs, ValidateClusterContainerRuntimeConfig(cls, fldPath)...)
 
 	return allErrs
 }
@@ -230,7 +319,7 @@ func ValidateClusterMachines(cls *platform.Cluster, fldPath *field.Path) field.E
 			log.Infof("cls %s's %s %s is validated", cls.Spec.DisplayName, one.IP, AnywhereValidateItemTunnelConnectivity)
 		}
 		if isNeedValidateForDynamicItem(AnywhereValidateItemSSH, cls) {
-			sshErrs = append(sshErrs, ValidateSSH(fldPath.Index(i), one.IP, int(one.Port), one.Username, one.Password, one.PrivateKey, one.PassPhrase, proxy)...)
+			sshErrs = append(sshErrs, ValidateSSH(fldPath.Index(i), one.IP, int(one.Port), one.Username, one.Password, one.PrivateKey, one.PassPhrase, one.CredentialName, proxy)...)
 			log.Infof("cls %s's %s %s is validated", cls.Spec.DisplayName, one.IP, AnywhereValidateItemSSH)
 			// when get ssh err or last machine ssh is checked, ssh can be considered checked
 			if len(sshErrs) != 0 || i == len(cls.Spec.Machines)-1 {
@@ -909,6 +998,12 @@ func ValidateClusterFeature(spec *platform.ClusterSpec, fldPath *field.Path) fie
 	if features.IPVS != nil {
 		allErrs = append(allErrs, ValidateIPVS(spec, features.IPVS, fldPath.Child("ipvs"))...)
 	}
+	if features.HA != nil && features.HA.KubeVIPHA != nil {
+		allErrs = append(allErrs, ValidateKubeVIPHA(features.HA, fldPath.Child("ha"))...)
+	}
+	if features.MetalLB != nil {
+		allErrs = append(allErrs, ValidateMetalLB(features.MetalLB, fldPath.Child("metalLB"))...)
+	}
 
 	return allErrs
 }
@@ -927,6 +1022,155 @@ func ValidateIPVS(spec *platform.ClusterSpec, ipvs *bool, fldPath *field.Path) f
 	return allErrs
 }
 
+// ValidateKubeVIPHA validates the kube-vip HA, which is exclusive with the other HA.
+func ValidateKubeVIPHA(ha *platform.HA, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	kubeVIPPath := fldPath.Child("kubeVIP")
+	if ha.TKEHA != nil || ha.ThirdPartyHA != nil {
+		allErrs = append(allErrs, field.Forbidden(kubeVIPPath, "kube-vip HA can not be used with tkestack HA or third party HA"))
+	}
+	kubeVIP := ha.KubeVIPHA
+	if net.ParseIP(kubeVIP.VIP) == nil {
+		allErrs = append(allErrs, field.Invalid(kubeVIPPath.Child("vip"), kubeVIP.VIP, "must be a valid IP address"))
+	}
+	if kubeVIP.Mode != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(kubeVIP.Mode, kubeVIPPath.Child("mode"), []platform.KubeVIPMode{platform.KubeVIPARP, platform.KubeVIPBGP})...)
+	}
+	if kubeVIP.Mode == platform.KubeVIPBGP {
+		if kubeVIP.BGP == nil {
+			allErrs = append(allErrs, field.Required(kubeVIPPath.Child("bgp"), "bgp is required in BGP mode"))
+		} else {
+			allErrs = append(allErrs, ValidateBGPConfig(kubeVIP.BGP, kubeVIPPath.Child("bgp"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMetalLB validates the address pools and the peering of metallb.
+func ValidateMetalLB(metalLB *platform.MetalLB, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	poolsPath := fldPath.Child("addressPools")
+	if len(metalLB.AddressPools) == 0 {
+		allErrs = append(allErrs, field.Required(poolsPath, "at least one address pool is required"))
+	}
+	names := map[string]bool{}
+	needBGP := false
+	for i, pool := range metalLB.AddressPools {
+		poolPath := poolsPath.Index(i)
+		if pool.Name == "" {
+			allErrs = append(allErrs, field.Required(poolPath.Child("name"), ""))
+		} else if names[pool.Name] {
+			allErrs = append(allErrs, field.Duplicate(poolPath.Child("name"), pool.Name))
+		}
+		names[pool.Name] = true
+		if pool.Protocol != "" {
+			allErrs = append(allErrs, utilvalidation.ValidateEnum(pool.Protocol, poolPath.Child("protocol"), []platform.MetalLBProtocol{platform.MetalLBLayer2, platform.MetalLBBGP})...)
+		}
+		needBGP = needBGP || pool.Protocol == platform.MetalLBBGP
+		if len(pool.Addresses) == 0 {
+			allErrs = append(allErrs, field.Required(poolPath.Child("addresses"), ""))
+		}
+		for j, address := range pool.Addresses {
+			if err := validateAddressRange(address); err != nil {
+				allErrs = append(allErrs, field.Invalid(poolPath.Child("addresses").Index(j), address, err.Error()))
+			}
+		}
+	}
+	if metalLB.BGP != nil {
+		allErrs = append(allErrs, ValidateBGPConfig(metalLB.BGP, fldPath.Child("bgp"))...)
+	} else if needBGP {
+		allErrs = append(allErrs, field.Required(fldPath.Child("bgp"), "bgp is required by address pools in bgp protocol"))
+	}
+
+	return allErrs
+}
+
+// ValidateBGPConfig validates the AS numbers and the peers.
+func ValidateBGPConfig(bgp *platform.BGPConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if bgp.ASN <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("asn"), bgp.ASN, "must be greater than 0"))
+	}
+	if len(bgp.Peers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("peers"), "at least one peer is required"))
+	}
+	for i, peer := range bgp.Peers {
+		peerPath := fldPath.Child("peers").Index(i)
+		if net.ParseIP(peer.Address) == nil {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("address"), peer.Address, "must be a valid IP address"))
+		}
+		if peer.ASN <= 0 {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("asn"), peer.ASN, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAddressRange validates a CIDR or a range in the form of "first-last".
+func validateAddressRange(address string) error {
+	if !strings.Contains(address, "-") {
+		_, _, err := net.ParseCIDR(address)
+		return err
+	}
+	items := strings.SplitN(address, "-", 2)
+	first, last := net.ParseIP(strings.TrimSpace(items[0])), net.ParseIP(strings.TrimSpace(items[1]))
+	if first == nil || last == nil {
+		return fmt.Errorf("invalid address range")
+	}
+	if (first.To4() == nil) != (last.To4() == nil) {
+		return fmt.Errorf("the first and the last address must be in the same family")
+	}
+	if bytes.Compare(first.To16(), last.To16()) > 0 {
+		return fmt.Errorf("the first address must not be greater than the last address")
+	}
+	return nil
+}
+
+// ValidateNetwork validates the network type and the network args of the CNI.
+func ValidateNetwork(spec *platform.ClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	typePath := fldPath.Child("networkType")
+	argsPath := fldPath.Child("networkArgs")
+	if spec.NetworkType == "" {
+		return allErrs
+	}
+	allErrs = append(allErrs, utilvalidation.ValidateEnum(spec.NetworkType, typePath, networkTypes)...)
+	if spec.Features.EnableCilium && spec.NetworkType != platform.NetworkCilium {
+		allErrs = append(allErrs, field.Invalid(typePath, spec.NetworkType, "enableCilium requires network type cilium"))
+	}
+
+	switch spec.NetworkType {
+	case platform.NetworkCilium:
+		if mode, ok := spec.NetworkArgs["networkMode"]; ok {
+			allErrs = append(allErrs, utilvalidation.ValidateEnum(mode, argsPath.Key("networkMode"), []string{"overlay", "underlay"})...)
+		}
+	case platform.NetworkCalico:
+		allErrs = append(allErrs, validateNetworkArgKeys(spec.NetworkArgs, argsPath, calico.Args)...)
+		if _, err := calico.NewOption(spec.NetworkArgs); err != nil {
+			allErrs = append(allErrs, field.Invalid(argsPath, spec.NetworkArgs, err.Error()))
+		}
+	case platform.NetworkFlannel:
+		allErrs = append(allErrs, validateNetworkArgKeys(spec.NetworkArgs, argsPath, []string{"backendType"})...)
+		if backendType, ok := spec.NetworkArgs["backendType"]; ok {
+			allErrs = append(allErrs, utilvalidation.ValidateEnum(backendType, argsPath.Key("backendType"), []string{"vxlan", "host-gw"})...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateNetworkArgKeys(args map[string]string, fldPath *field.Path, keys []string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for key := range args {
+		if !funk.ContainsString(keys, key) {
+			allErrs = append(allErrs, field.NotSupported(fldPath, key, keys))
+		}
+	}
+	return allErrs
+}
+
 func isNeedValidateForDynamicItem(item string, cls *platform.Cluster) bool {
 	if _, ok := cls.Annotations[platform.AnywhereValidateAnno]; !ok {
 		// if AnywhereValidateAnno is not set, will skip dynamic validate
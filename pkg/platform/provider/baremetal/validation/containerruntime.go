@@ -0,0 +1,158 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+	utilvalidation "tkestack.io/tke/pkg/util/validation"
+)
+
+var (
+	snapshotters  = []string{"overlayfs", "native", "btrfs", "zfs"}
+	cgroupDrivers = []platform.CgroupDriver{platform.CgroupDriverSystemd, platform.CgroupDriverCgroupfs}
+	runtimeTypes  = []platform.RuntimeType{platform.RuntimeRunc, platform.RuntimeKata, platform.RuntimeGVisor}
+)
+
+// ValidateClusterContainerRuntimeConfig validates the container runtime config
+// of the cluster and the ones of its machines.
+func ValidateClusterContainerRuntimeConfig(cls *platform.Cluster, fldPath *field.Path) field.ErrorList {
+	runtime := cls.Spec.Features.ContainerRuntime
+	allErrs := ValidateContainerRuntimeConfig(cls.Spec.ContainerRuntimeConfig, runtime, fldPath.Child("containerRuntimeConfig"), false)
+	for i, machine := range cls.Spec.Machines {
+		allErrs = append(allErrs, ValidateContainerRuntimeConfig(machine.ContainerRuntimeConfig, runtime, fldPath.Child("machines").Index(i).Child("containerRuntimeConfig"), true)...)
+	}
+
+	return allErrs
+}
+
+// ValidateContainerRuntimeConfig validates a given container runtime config,
+// the cgroup driver and the runtime classes can not be set on a machine.
+func ValidateContainerRuntimeConfig(config *platform.ContainerRuntimeConfig, runtime platform.ContainerRuntimeType, fldPath *field.Path, isMachine bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+	if runtime == platform.Docker {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("only supported by %s", platform.Containerd)))
+		return allErrs
+	}
+
+	hosts := map[string]bool{}
+	for i, mirror := range config.RegistryMirrors {
+		mirrorPath := fldPath.Child("registryMirrors").Index(i)
+		if mirror.Host == "" || strings.ContainsAny(mirror.Host, "\" /") {
+			allErrs = append(allErrs, field.Invalid(mirrorPath.Child("host"), mirror.Host, "must be a registry host with an optional port"))
+		} else if hosts[mirror.Host] {
+			allErrs = append(allErrs, field.Duplicate(mirrorPath.Child("host"), mirror.Host))
+		}
+		hosts[mirror.Host] = true
+		if len(mirror.Endpoints) == 0 {
+			allErrs = append(allErrs, field.Required(mirrorPath.Child("endpoints"), "must specify at least one endpoint"))
+		}
+		for j, endpoint := range mirror.Endpoints {
+			u, err := url.Parse(endpoint)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Contains(endpoint, `"`) {
+				allErrs = append(allErrs, field.Invalid(mirrorPath.Child("endpoints").Index(j), endpoint, "must be a http or https URL"))
+			}
+		}
+	}
+
+	registries := map[string]bool{}
+	for i, registry := range config.InsecureRegistries {
+		registryPath := fldPath.Child("insecureRegistries").Index(i)
+		if registry == "" || strings.ContainsAny(registry, "\" /") {
+			allErrs = append(allErrs, field.Invalid(registryPath, registry, "must be a registry host with an optional port"))
+		} else if registries[registry] {
+			allErrs = append(allErrs, field.Duplicate(registryPath, registry))
+		}
+		registries[registry] = true
+	}
+
+	if config.Snapshotter != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(config.Snapshotter, fldPath.Child("snapshotter"), snapshotters)...)
+	}
+
+	if isMachine {
+		if config.CgroupDriver != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("cgroupDriver"), "can only be set on the cluster"))
+		}
+		if len(config.RuntimeClasses) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("runtimeClasses"), "can only be set on the cluster"))
+		}
+		return allErrs
+	}
+
+	if config.CgroupDriver != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(config.CgroupDriver, fldPath.Child("cgroupDriver"), cgroupDrivers)...)
+	}
+	names := map[string]bool{}
+	for i, runtimeClass := range config.RuntimeClasses {
+		classPath := fldPath.Child("runtimeClasses").Index(i)
+		for _, msg := range validation.IsDNS1123Label(runtimeClass.Name) {
+			allErrs = append(allErrs, field.Invalid(classPath.Child("name"), runtimeClass.Name, msg))
+		}
+		if runtimeClass.Name == string(platform.RuntimeRunc) {
+			allErrs = append(allErrs, field.Invalid(classPath.Child("name"), runtimeClass.Name, "is the default runtime handler"))
+		} else if names[runtimeClass.Name] {
+			allErrs = append(allErrs, field.Duplicate(classPath.Child("name"), runtimeClass.Name))
+		}
+		names[runtimeClass.Name] = true
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(runtimeClass.Type, classPath.Child("type"), runtimeTypes)...)
+	}
+
+	return allErrs
+}
+
+// ValidateContainerRuntimeConfigUpdate tests if an update to a container
+// runtime config is valid, the snapshotter and the cgroup driver of running
+// nodes can not be changed.
+func ValidateContainerRuntimeConfigUpdate(config, oldConfig *platform.ContainerRuntimeConfig, fldPath *field.Path) field.ErrorList {
+	if config == nil {
+		config = &platform.ContainerRuntimeConfig{}
+	}
+	if oldConfig == nil {
+		oldConfig = &platform.ContainerRuntimeConfig{}
+	}
+	allErrs := apimachineryvalidation.ValidateImmutableField(config.Snapshotter, oldConfig.Snapshotter, fldPath.Child("snapshotter"))
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(config.CgroupDriver, oldConfig.CgroupDriver, fldPath.Child("cgroupDriver"))...)
+
+	return allErrs
+}
+
+// ValidateClusterContainerRuntimeConfigUpdate tests if an update to the
+// container runtime config of the cluster and its existing masters is valid.
+func ValidateClusterContainerRuntimeConfigUpdate(cls *platform.Cluster, oldCls *platform.Cluster, fldPath *field.Path) field.ErrorList {
+	allErrs := ValidateContainerRuntimeConfigUpdate(cls.Spec.ContainerRuntimeConfig, oldCls.Spec.ContainerRuntimeConfig, fldPath.Child("containerRuntimeConfig"))
+	for i, machine := range cls.Spec.Machines {
+		for _, oldMachine := range oldCls.Spec.Machines {
+			if machine.IP == oldMachine.IP {
+				allErrs = append(allErrs, ValidateContainerRuntimeConfigUpdate(machine.ContainerRuntimeConfig, oldMachine.ContainerRuntimeConfig, fldPath.Child("machines").Index(i).Child("containerRuntimeConfig"))...)
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -57,7 +57,7 @@ func ValidateMachineSpec(spec *platform.MachineSpec, cluster *platformv1.Cluster
 		allErrs = append(allErrs, ValidateMachineWithCluster(context.TODO(), spec.IP, fldPath.Child("ip"), cluster, platformClient)...)
 	}
 
-	sshErrors := ValidateSSH(fldPath, spec.IP, int(spec.Port), spec.Username, spec.Password, spec.PrivateKey, spec.PassPhrase, nil)
+	sshErrors := ValidateSSH(fldPath, spec.IP, int(spec.Port), spec.Username, spec.Password, spec.PrivateKey, spec.PassPhrase, spec.CredentialName, nil)
 	if sshErrors != nil {
 		allErrs = append(allErrs, sshErrors...)
 	} else {
@@ -149,8 +149,9 @@ func ValidateWorkerTimeOffset(fldPath *field.Path, worker *ssh.SSH, masters []*s
 	return allErrs
 }
 
-// ValidateSSH validates a given ssh config.
-func ValidateSSH(fldPath *field.Path, ip string, port int, user string, password []byte, privateKey []byte, passPhrase []byte, proxy ssh.Proxy) field.ErrorList {
+// ValidateSSH validates a given ssh config, the login secret is read from
+// the referenced ssh credential when credentialName is not empty.
+func ValidateSSH(fldPath *field.Path, ip string, port int, user string, password []byte, privateKey []byte, passPhrase []byte, credentialName string, proxy ssh.Proxy) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	for _, msg := range validation.IsValidIP(ip) {
@@ -160,7 +161,7 @@ func ValidateSSH(fldPath *field.Path, ip string, port int, user string, password
 	for _, msg := range validation.IsValidPortNum(port) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), port, msg))
 	}
-	if password == nil && privateKey == nil {
+	if password == nil && privateKey == nil && credentialName == "" {
 		allErrs = append(allErrs, field.Required(fldPath, "must specify password or privateKey"))
 	}
 
@@ -169,15 +170,16 @@ func ValidateSSH(fldPath *field.Path, ip string, port int, user string, password
 	}
 
 	sshConfig := &ssh.Config{
-		User:        user,
-		Host:        ip,
-		Port:        port,
-		Password:    string(password),
-		PrivateKey:  privateKey,
-		PassPhrase:  passPhrase,
-		DialTimeOut: time.Second,
-		Retry:       0,
-		Proxy:       proxy,
+		User:           user,
+		Host:           ip,
+		Port:           port,
+		Password:       string(password),
+		PrivateKey:     privateKey,
+		PassPhrase:     passPhrase,
+		CredentialName: credentialName,
+		DialTimeOut:    time.Second,
+		Retry:          0,
+		Proxy:          proxy,
 	}
 	s, err := ssh.New(sshConfig)
 	if err != nil {
@@ -188,7 +190,7 @@ func ValidateSSH(fldPath *field.Path, ip string, port int, user string, password
 			allErrs = append(allErrs, field.Invalid(fldPath, "", err.Error()))
 		}
 		if strings.TrimSpace(string(output)) != "root" {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("user"), user, `must be root or set sudo without password`))
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("user"), s.User, `must be root or set sudo without password`))
 		}
 	}
 
@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package conformance tests the behaviors of cluster providers which are
+// relied on by tke-platform-api and tke-platform-controller, so that an
+// out-of-tree provider can be verified with the same tests as in-tree ones.
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/platform/types"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+// maxCreateRounds is the max number of OnCreate calls to make a cluster running.
+const maxCreateRounds = 100
+
+// Run runs the conformance tests of provider, newCluster returns a new
+// cluster which is valid for provider.
+func Run(t *testing.T, provider clusterprovider.Provider, newCluster func() *platform.Cluster) {
+	ctx := context.Background()
+	if provider.Name() == "" {
+		t.Fatalf("Name() is empty")
+	}
+	if err := provider.Setup(); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	defer func() {
+		if err := provider.Teardown(); err != nil {
+			t.Errorf("Teardown() error = %v", err)
+		}
+	}()
+
+	cluster := &types.Cluster{Cluster: newCluster(), ClusterCredential: &platform.ClusterCredential{}}
+	cluster.Status.Phase = platform.ClusterInitializing
+	if errs := provider.Validate(cluster); len(errs) != 0 {
+		t.Fatalf("Validate() errors = %v", errs.ToAggregate())
+	}
+	if err := provider.PreCreate(cluster); err != nil {
+		t.Fatalf("PreCreate() error = %v", err)
+	}
+	if errs := provider.ValidateUpdate(cluster, cluster); len(errs) != 0 {
+		t.Errorf("ValidateUpdate() of unchanged cluster errors = %v", errs.ToAggregate())
+	}
+	if err := provider.AfterCreate(cluster); err != nil {
+		t.Fatalf("AfterCreate() error = %v", err)
+	}
+
+	plan, err := provider.Plan(ctx, cluster)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if plan.Name != cluster.Name || plan.Phase != platformv1.ClusterInitializing {
+		t.Errorf("Plan() = %s in %s, want %s in %s", plan.Name, plan.Phase, cluster.Name, platformv1.ClusterInitializing)
+	}
+
+	c, err := toV1Cluster(cluster)
+	if err != nil {
+		t.Fatalf("convert cluster error = %v", err)
+	}
+	for i := 0; c.Status.Phase != platformv1.ClusterRunning; i++ {
+		if i == maxCreateRounds {
+			t.Fatalf("OnCreate() does not make cluster running in %d rounds", maxCreateRounds)
+		}
+		if err := provider.OnCreate(ctx, c); err != nil {
+			t.Fatalf("OnCreate() error = %v", err)
+		}
+		for _, condition := range c.Status.Conditions {
+			if condition.Status == platformv1.ConditionFalse {
+				t.Fatalf("OnCreate() condition %s failed: %s", condition.Type, condition.Message)
+			}
+		}
+	}
+	if !provider.OnFilter(ctx, c.Cluster) {
+		t.Errorf("OnFilter() of running cluster = false, want true")
+	}
+	if provider.NeedUpdate(c.Cluster, c.Cluster) {
+		t.Errorf("NeedUpdate() of unchanged cluster = true, want false")
+	}
+	if err := provider.OnRunning(ctx, c); err != nil {
+		t.Errorf("OnRunning() error = %v", err)
+	}
+	config, err := provider.GetRestConfig(ctx, c.Cluster, "")
+	if err != nil {
+		t.Fatalf("GetRestConfig() error = %v", err)
+	}
+	if config.Host == "" {
+		t.Errorf("GetRestConfig() returns empty host")
+	}
+
+	c.Status.Phase = platformv1.ClusterTerminating
+	if err := provider.OnDelete(ctx, c); err != nil {
+		t.Fatalf("OnDelete() error = %v", err)
+	}
+	if _, err := provider.GetRestConfig(ctx, c.Cluster, ""); err == nil {
+		t.Errorf("GetRestConfig() of deleted cluster error = nil, want error")
+	}
+}
+
+func toV1Cluster(cluster *types.Cluster) (*v1.Cluster, error) {
+	result := &v1.Cluster{
+		Cluster:           new(platformv1.Cluster),
+		ClusterCredential: new(platformv1.ClusterCredential),
+	}
+	if err := platformv1.Convert_platform_Cluster_To_v1_Cluster(cluster.Cluster, result.Cluster, nil); err != nil {
+		return nil, err
+	}
+	if err := platformv1.Convert_platform_ClusterCredential_To_v1_ClusterCredential(cluster.ClusterCredential, result.ClusterCredential, nil); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
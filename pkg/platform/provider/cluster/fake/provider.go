@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package fake implements an in-memory cluster provider, which is the
+// reference of out-of-tree providers served by plugins.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/rest"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/platform/types"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+const (
+	// ProviderName is the name of fake provider.
+	ProviderName = "Fake"
+
+	defaultDNSDomain = "cluster.local"
+	apiServerPort    = 6443
+)
+
+// Provider is a cluster provider which creates clusters in memory.
+type Provider struct {
+	*clusterprovider.DelegateProvider
+
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+var _ clusterprovider.Provider = &Provider{}
+
+// NewProvider creates a fake cluster provider.
+func NewProvider() *Provider {
+	p := &Provider{tokens: map[string]string{}}
+	p.DelegateProvider = &clusterprovider.DelegateProvider{
+		ProviderName: ProviderName,
+		ValidateFunc: p.validate,
+		PreCreateFunc: func(cluster *types.Cluster) error {
+			if cluster.Spec.DNSDomain == "" {
+				cluster.Spec.DNSDomain = defaultDNSDomain
+			}
+			return nil
+		},
+		CreateHandlers: []clusterprovider.Handler{
+			p.EnsureAddress,
+			p.EnsureCredential,
+		},
+		DeleteHandlers: []clusterprovider.Handler{
+			p.EnsureCleanCluster,
+		},
+	}
+
+	return p
+}
+
+// Clusters returns the number of clusters created by provider.
+func (p *Provider) Clusters() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.tokens)
+}
+
+func (p *Provider) validate(cluster *types.Cluster) field.ErrorList {
+	var allErrs field.ErrorList
+	if cluster.Spec.Version == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "version"), "must specify version"))
+	}
+	return allErrs
+}
+
+func (p *Provider) EnsureAddress(ctx context.Context, c *v1.Cluster) error {
+	c.Status.Addresses = []platformv1.ClusterAddress{{
+		Type: platformv1.AddressReal,
+		Host: fmt.Sprintf("%s.fake", c.Name),
+		Port: apiServerPort,
+	}}
+	return nil
+}
+
+func (p *Provider) EnsureCredential(ctx context.Context, c *v1.Cluster) error {
+	token := fmt.Sprintf("token-%s", c.Name)
+	p.mu.Lock()
+	p.tokens[c.Name] = token
+	p.mu.Unlock()
+
+	c.ClusterCredential.Token = &token
+	c.IsCredentialChanged = true
+	return nil
+}
+
+func (p *Provider) EnsureCleanCluster(ctx context.Context, c *v1.Cluster) error {
+	p.mu.Lock()
+	delete(p.tokens, c.Name)
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *Provider) GetRestConfig(ctx context.Context, cluster *platformv1.Cluster, username string) (*rest.Config, error) {
+	p.mu.Lock()
+	token, ok := p.tokens[cluster.Name]
+	p.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("cluster %s is not created by %s", cluster.Name, ProviderName)
+	}
+	host, err := (&v1.Cluster{Cluster: cluster}).HostForBootstrap()
+	if err != nil {
+		return nil, err
+	}
+	return &rest.Config{Host: "https://" + host, BearerToken: token}, nil
+}
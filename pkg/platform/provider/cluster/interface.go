@@ -30,6 +30,7 @@ import (
 	"tkestack.io/tke/pkg/util/log"
 
 	"github.com/thoas/go-funk"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/server/mux"
 	"k8s.io/client-go/rest"
@@ -38,6 +39,7 @@ import (
 	"tkestack.io/tke/pkg/platform/types"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/platform/util/credential"
+	"tkestack.io/tke/pkg/util/ssh"
 )
 
 const (
@@ -48,6 +50,9 @@ const (
 	ReasonFailedDelete = "FailedDelete"
 	ConditionTypeDone  = "EnsureDone"
 	defaultQPS         = -1
+
+	// handlerOutputLimit is the max bytes of ssh commands recorded for a failed handler.
+	handlerOutputLimit = 2048
 )
 
 type APIProvider interface {
@@ -58,6 +63,9 @@ type APIProvider interface {
 	MutateUpdate(cluster *types.Cluster, oldCluster *types.Cluster) ([]byte, field.ErrorList)
 	PreCreate(cluster *types.Cluster) error
 	AfterCreate(cluster *types.Cluster) error
+	// Plan returns the plan of the operation which would be done on cluster
+	// without mutating any host.
+	Plan(ctx context.Context, cluster *types.Cluster) (*platformv1.ClusterPlan, error)
 }
 
 type ControllerProvider interface {
@@ -86,6 +94,30 @@ type RestConfigProvider interface {
 	GetRestConfig(ctx context.Context, cluster *platformv1.Cluster, username string) (*rest.Config, error)
 }
 
+// Drift is a difference between the configuration on a machine and the one
+// rendered from the cluster spec.
+type Drift struct {
+	// Component is the drifted component, such as kube-apiserver or containerd.
+	Component string
+	Message   string
+}
+
+// DriftDetector is implemented by the providers which can detect and correct
+// the configuration drift of the masters and the worker machines of a cluster.
+type DriftDetector interface {
+	// DetectDrift compares the configuration on the machine with the one
+	// rendered from the cluster spec.
+	DetectDrift(ctx context.Context, cluster *v1.Cluster, machine platformv1.ClusterMachine) ([]Drift, error)
+	// CorrectDrift re-applies the configuration of the drifted components.
+	CorrectDrift(ctx context.Context, cluster *v1.Cluster, machine platformv1.ClusterMachine, drifts []Drift) error
+	// DetectMachineDrift compares the configuration on the worker machine
+	// with the one rendered from the cluster spec and the machine spec.
+	DetectMachineDrift(ctx context.Context, cluster *v1.Cluster, machine *platformv1.Machine) ([]Drift, error)
+	// CorrectMachineDrift re-applies the configuration of the drifted
+	// components on the worker machine.
+	CorrectMachineDrift(ctx context.Context, cluster *v1.Cluster, machine *platformv1.Machine, drifts []Drift) error
+}
+
 // Provider defines a set of response interfaces for specific cluster
 // types in cluster management.
 type Provider interface {
@@ -109,6 +141,28 @@ func (h Handler) Name() string {
 	return strings.TrimSuffix(name[i+1:], "-fm")
 }
 
+// runHandler runs handler and records the execution in handler history of
+// cluster. A succeeded execution is only recorded when recordSuccess is true
+// or it follows a failed execution of the same handler.
+func runHandler(ctx context.Context, handler Handler, cluster *v1.Cluster, recordSuccess bool) error {
+	recorder := ssh.NewOutputRecorder(handlerOutputLimit)
+	record := platformv1.HandlerRecord{
+		Name:      handler.Name(),
+		StartTime: metav1.Now(),
+	}
+	err := handler(ssh.WithOutputRecorder(ctx, recorder), cluster)
+	record.EndTime = metav1.Now()
+	if err != nil {
+		record.LastError = ssh.Redact(err.Error())
+		record.Output = recorder.String()
+	} else if !recordSuccess && !platformv1.LastHandlerFailed(cluster.Status.HandlerHistory, record.Name) {
+		return nil
+	}
+	cluster.RecordHandler(record)
+
+	return err
+}
+
 type DelegateProvider struct {
 	ProviderName string
 
@@ -117,6 +171,7 @@ type DelegateProvider struct {
 	MutateUpdateFunc   func(cluster *types.Cluster, oldCluster *types.Cluster) ([]byte, field.ErrorList)
 	PreCreateFunc      func(cluster *types.Cluster) error
 	AfterCreateFunc    func(cluster *types.Cluster) error
+	PlanFunc           func(ctx context.Context, cluster *v1.Cluster, plan *platformv1.ClusterPlan) error
 
 	CreateHandlers    []Handler
 	DeleteHandlers    []Handler
@@ -221,7 +276,7 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, cluster *v1.Cluster) er
 		ctx = log.FromContext(ctx).WithName("ClusterProvider.OnCreate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
-		err = handler(ctx, cluster)
+		err = runHandler(ctx, handler, cluster, true)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
 		if err != nil {
 			cluster.SetCondition(platformv1.ClusterCondition{
@@ -296,7 +351,7 @@ func (p *DelegateProvider) OnUpdate(ctx context.Context, cluster *v1.Cluster) er
 		ctx := log.FromContext(ctx).WithName("ClusterProvider.OnUpdate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
-		err = handler(ctx, cluster)
+		err = runHandler(ctx, handler, cluster, true)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
 		if err != nil {
 			cluster.SetCondition(platformv1.ClusterCondition{
@@ -396,7 +451,7 @@ func (p *DelegateProvider) houseKeeping(ctx context.Context, cluster *v1.Cluster
 		ctx := log.FromContext(ctx).WithName("ClusterProvider.OnUpdate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
-		err := handler(ctx, cluster)
+		err := runHandler(ctx, handler, cluster, false)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
 		if err != nil {
 			cluster.Status.Reason = ReasonFailedUpdate
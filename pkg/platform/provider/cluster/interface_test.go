@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func ensureFailed(ctx context.Context, c *v1.Cluster) error {
+	return errors.New("failed")
+}
+
+func ensureSucceeded(ctx context.Context, c *v1.Cluster) error {
+	return nil
+}
+
+func TestRunHandler(t *testing.T) {
+	cluster := &v1.Cluster{Cluster: &platformv1.Cluster{}}
+
+	_ = runHandler(context.Background(), ensureSucceeded, cluster, false)
+	if len(cluster.Status.HandlerHistory) != 0 {
+		t.Fatalf("succeeded housekeeping should not be recorded, got %+v", cluster.Status.HandlerHistory)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := runHandler(context.Background(), ensureFailed, cluster, true); err == nil {
+			t.Fatalf("runHandler() should return error of handler")
+		}
+	}
+	history := cluster.Status.HandlerHistory
+	if len(history) != 1 || history[0].Name != "ensureFailed" || history[0].Attempts != 3 || history[0].LastError != "failed" {
+		t.Fatalf("retries should be merged into one record, got %+v", history)
+	}
+
+	_ = runHandler(context.Background(), ensureSucceeded, cluster, true)
+	_ = runHandler(context.Background(), ensureFailed, cluster, true)
+	history = cluster.Status.HandlerHistory
+	if len(history) != 3 || history[1].Attempts != 1 || history[2].Attempts != 1 {
+		t.Fatalf("unexpected history %+v", history)
+	}
+
+	for i := 0; i < platformv1.MaxHandlerHistory; i++ {
+		_ = runHandler(context.Background(), ensureSucceeded, cluster, true)
+	}
+	if len(cluster.Status.HandlerHistory) != platformv1.MaxHandlerHistory {
+		t.Fatalf("history should be bounded to %d, got %d", platformv1.MaxHandlerHistory, len(cluster.Status.HandlerHistory))
+	}
+}
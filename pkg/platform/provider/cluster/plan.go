@@ -0,0 +1,91 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/thoas/go-funk"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/types"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+// Plan returns the handlers which would be executed in the phase of cluster,
+// and lets the provider check machines and render configs by PlanFunc.
+func (p *DelegateProvider) Plan(ctx context.Context, cluster *types.Cluster) (*platformv1.ClusterPlan, error) {
+	c, err := toV1Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	plan := &platformv1.ClusterPlan{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: platformv1.SchemeGroupVersion.String(),
+			Kind:       "ClusterPlan",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: c.Name},
+		Phase:      c.Status.Phase,
+	}
+	for _, handler := range p.getPlanHandlers(c.Status.Phase) {
+		plan.Steps = append(plan.Steps, platformv1.ClusterPlanStep{
+			Name:    handler.Name(),
+			Skipped: funk.ContainsString(c.Spec.Features.SkipConditions, handler.Name()),
+		})
+	}
+	if p.PlanFunc != nil {
+		if err := p.PlanFunc(ctx, c, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// getPlanHandlers returns the handlers executed by OnCreate and OnUpdate in phase.
+func (p *DelegateProvider) getPlanHandlers(phase platformv1.ClusterPhase) []Handler {
+	switch phase {
+	case platformv1.ClusterInitializing, platformv1.ClusterUpscaling:
+		return p.CreateHandlers
+	case platformv1.ClusterUpgrading:
+		return p.UpgradeHandlers
+	case platformv1.ClusterDownscaling:
+		return p.ScaleDownHandlers
+	case platformv1.ClusterRunning, platformv1.ClusterFailed:
+		return p.UpdateHandlers
+	}
+	return nil
+}
+
+// toV1Cluster converts a deep copy of cluster to the versioned cluster used by handlers.
+func toV1Cluster(cluster *types.Cluster) (*v1.Cluster, error) {
+	result := &v1.Cluster{
+		Cluster:           new(platformv1.Cluster),
+		ClusterCredential: new(platformv1.ClusterCredential),
+	}
+	if err := platformv1.Convert_platform_Cluster_To_v1_Cluster(cluster.Cluster.DeepCopy(), result.Cluster, nil); err != nil {
+		return nil, err
+	}
+	if cluster.ClusterCredential != nil {
+		if err := platformv1.Convert_platform_ClusterCredential_To_v1_ClusterCredential(cluster.ClusterCredential.DeepCopy(), result.ClusterCredential, nil); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
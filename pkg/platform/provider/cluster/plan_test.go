@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/types"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func TestDelegateProviderPlan(t *testing.T) {
+	p := &DelegateProvider{
+		CreateHandlers:  []Handler{ensureSucceeded, ensureFailed},
+		UpgradeHandlers: []Handler{ensureFailed},
+		PlanFunc: func(ctx context.Context, c *v1.Cluster, plan *platformv1.ClusterPlan) error {
+			c.Spec.Version = "mutated"
+			plan.Checks = append(plan.Checks, platformv1.ClusterPlanCheck{Name: "Preflight", Passed: true})
+			return nil
+		},
+	}
+	cluster := &types.Cluster{Cluster: &platform.Cluster{
+		Spec: platform.ClusterSpec{
+			Version:  "1.21.4-tke.1",
+			Features: platform.ClusterFeature{SkipConditions: []string{"ensureFailed"}},
+		},
+		Status: platform.ClusterStatus{Phase: platform.ClusterInitializing},
+	}}
+	cluster.Name = "cls-test"
+
+	plan, err := p.Plan(context.Background(), cluster)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	wantSteps := []platformv1.ClusterPlanStep{
+		{Name: "ensureSucceeded"},
+		{Name: "ensureFailed", Skipped: true},
+	}
+	if !reflect.DeepEqual(plan.Steps, wantSteps) {
+		t.Errorf("Plan() steps = %+v, want %+v", plan.Steps, wantSteps)
+	}
+	if plan.Name != "cls-test" || plan.Phase != platformv1.ClusterInitializing || len(plan.Checks) != 1 {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+	if cluster.Spec.Version != "1.21.4-tke.1" {
+		t.Errorf("Plan() should not mutate cluster, got version %s", cluster.Spec.Version)
+	}
+
+	cluster.Status.Phase = platform.ClusterUpgrading
+	plan, err = p.Plan(context.Background(), cluster)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Name != "ensureFailed" {
+		t.Errorf("Plan() of upgrading cluster steps = %+v", plan.Steps)
+	}
+}
@@ -0,0 +1,386 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/server/mux"
+	"k8s.io/client-go/rest"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/plugin"
+	"tkestack.io/tke/pkg/platform/types"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Methods of cluster provider served by a plugin, each one mirrors the method
+// of Provider with the same name.
+const (
+	PluginMethodSetup          = "setup"
+	PluginMethodTeardown       = "teardown"
+	PluginMethodValidate       = "validate"
+	PluginMethodValidateUpdate = "validateupdate"
+	PluginMethodMutateUpdate   = "mutateupdate"
+	PluginMethodPreCreate      = "precreate"
+	PluginMethodAfterCreate    = "aftercreate"
+	PluginMethodPlan           = "plan"
+	PluginMethodNeedUpdate     = "needupdate"
+	PluginMethodOnCreate       = "oncreate"
+	PluginMethodOnUpdate       = "onupdate"
+	PluginMethodOnDelete       = "ondelete"
+	PluginMethodOnFilter       = "onfilter"
+	PluginMethodOnRunning      = "onrunning"
+	PluginMethodGetRestConfig  = "getrestconfig"
+)
+
+// PluginCluster is a cluster exchanged with a plugin.
+type PluginCluster struct {
+	Cluster             *platformv1.Cluster           `json:"cluster"`
+	ClusterCredential   *platformv1.ClusterCredential `json:"clusterCredential,omitempty"`
+	IsCredentialChanged bool                          `json:"isCredentialChanged,omitempty"`
+	// RestConfig is the rest config of cluster if it is accessible.
+	RestConfig *PluginRestConfig `json:"restConfig,omitempty"`
+}
+
+// PluginRestConfig is the rest config of a cluster exchanged with a plugin.
+type PluginRestConfig struct {
+	Host        string `json:"host"`
+	BearerToken string `json:"bearerToken,omitempty"`
+	Insecure    bool   `json:"insecure,omitempty"`
+	CAData      []byte `json:"caData,omitempty"`
+	CertData    []byte `json:"certData,omitempty"`
+	KeyData     []byte `json:"keyData,omitempty"`
+}
+
+// PluginRequest is the request of a method of cluster provider plugin.
+type PluginRequest struct {
+	// +optional
+	Cluster *PluginCluster `json:"cluster,omitempty"`
+	// +optional
+	OldCluster *PluginCluster `json:"oldCluster,omitempty"`
+	// +optional
+	Username string `json:"username,omitempty"`
+}
+
+// PluginResponse is the response of a method of cluster provider plugin,
+// only the fields returned by the method are set.
+type PluginResponse struct {
+	// Cluster is the cluster mutated by the method.
+	// +optional
+	Cluster *PluginCluster `json:"cluster,omitempty"`
+	// +optional
+	Errors field.ErrorList `json:"errors,omitempty"`
+	// +optional
+	Patch []byte `json:"patch,omitempty"`
+	// +optional
+	Result bool `json:"result,omitempty"`
+	// +optional
+	Plan *platformv1.ClusterPlan `json:"plan,omitempty"`
+	// +optional
+	RestConfig *PluginRestConfig `json:"restConfig,omitempty"`
+	// Error is the error returned by the method.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// RegisterPlugins registers the cluster providers served by plugins in config.
+func RegisterPlugins(config *plugin.Config) error {
+	for _, one := range config.ClusterProviders {
+		if one.Name == "" {
+			return errors.New("name of cluster provider plugin is empty")
+		}
+		if _, err := GetProvider(one.Name); err == nil {
+			return fmt.Errorf("cluster provider %s is already registered", one.Name)
+		}
+		p, err := NewPluginProvider(one)
+		if err != nil {
+			return err
+		}
+		Register(p.Name(), p)
+	}
+	return nil
+}
+
+// PluginProvider is a cluster provider served by an out-of-tree plugin.
+type PluginProvider struct {
+	name   string
+	client *plugin.Client
+}
+
+var _ Provider = &PluginProvider{}
+
+// NewPluginProvider creates a cluster provider which calls the plugin described by config.
+func NewPluginProvider(config plugin.ProviderConfig) (*PluginProvider, error) {
+	client, err := plugin.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &PluginProvider{name: config.Name, client: client}, nil
+}
+
+func (p *PluginProvider) Name() string {
+	return p.name
+}
+
+func (p *PluginProvider) RegisterHandler(mux *mux.PathRecorderMux) {
+}
+
+func (p *PluginProvider) Setup() error {
+	_, err := p.call(context.Background(), PluginMethodSetup, &PluginRequest{})
+	return err
+}
+
+func (p *PluginProvider) Teardown() error {
+	_, err := p.call(context.Background(), PluginMethodTeardown, &PluginRequest{})
+	return err
+}
+
+func (p *PluginProvider) Validate(cluster *types.Cluster) field.ErrorList {
+	resp, err := p.callInternal(PluginMethodValidate, cluster, nil)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "type"), err)}
+	}
+	return resp.Errors
+}
+
+func (p *PluginProvider) ValidateUpdate(cluster *types.Cluster, oldCluster *types.Cluster) field.ErrorList {
+	resp, err := p.callInternal(PluginMethodValidateUpdate, cluster, oldCluster)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "type"), err)}
+	}
+	return resp.Errors
+}
+
+func (p *PluginProvider) MutateUpdate(cluster *types.Cluster, oldCluster *types.Cluster) ([]byte, field.ErrorList) {
+	resp, err := p.callInternal(PluginMethodMutateUpdate, cluster, oldCluster)
+	if err != nil {
+		return nil, field.ErrorList{field.InternalError(field.NewPath("spec", "type"), err)}
+	}
+	return resp.Patch, resp.Errors
+}
+
+func (p *PluginProvider) PreCreate(cluster *types.Cluster) error {
+	resp, err := p.callInternal(PluginMethodPreCreate, cluster, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Cluster.toInternal(cluster)
+}
+
+func (p *PluginProvider) AfterCreate(cluster *types.Cluster) error {
+	_, err := p.callInternal(PluginMethodAfterCreate, cluster, nil)
+	return err
+}
+
+func (p *PluginProvider) Plan(ctx context.Context, cluster *types.Cluster) (*platformv1.ClusterPlan, error) {
+	req, err := newPluginRequest(cluster, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.call(ctx, PluginMethodPlan, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Plan, nil
+}
+
+func (p *PluginProvider) NeedUpdate(old, new *platformv1.Cluster) bool {
+	resp, err := p.call(context.Background(), PluginMethodNeedUpdate, &PluginRequest{
+		Cluster:    &PluginCluster{Cluster: new},
+		OldCluster: &PluginCluster{Cluster: old},
+	})
+	if err != nil {
+		log.Error("call plugin NeedUpdate error", log.String("provider", p.name), log.Err(err))
+		return false
+	}
+	return resp.Result
+}
+
+func (p *PluginProvider) OnCreate(ctx context.Context, cluster *v1.Cluster) error {
+	return p.callV1(ctx, PluginMethodOnCreate, cluster)
+}
+
+func (p *PluginProvider) OnUpdate(ctx context.Context, cluster *v1.Cluster) error {
+	return p.callV1(ctx, PluginMethodOnUpdate, cluster)
+}
+
+func (p *PluginProvider) OnDelete(ctx context.Context, cluster *v1.Cluster) error {
+	return p.callV1(ctx, PluginMethodOnDelete, cluster)
+}
+
+func (p *PluginProvider) OnRunning(ctx context.Context, cluster *v1.Cluster) error {
+	return p.callV1(ctx, PluginMethodOnRunning, cluster)
+}
+
+func (p *PluginProvider) OnFilter(ctx context.Context, cluster *platformv1.Cluster) bool {
+	resp, err := p.call(ctx, PluginMethodOnFilter, &PluginRequest{Cluster: &PluginCluster{Cluster: cluster}})
+	if err != nil {
+		log.Error("call plugin OnFilter error", log.String("provider", p.name), log.Err(err))
+		return false
+	}
+	return resp.Result
+}
+
+func (p *PluginProvider) GetRestConfig(ctx context.Context, cluster *platformv1.Cluster, username string) (*rest.Config, error) {
+	resp, err := p.call(ctx, PluginMethodGetRestConfig, &PluginRequest{
+		Cluster:  &PluginCluster{Cluster: cluster},
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.RestConfig == nil {
+		return nil, fmt.Errorf("plugin %s returns no rest config", p.name)
+	}
+	return resp.RestConfig.toRestConfig(), nil
+}
+
+// call calls method of plugin, the error returned by the method is returned as error.
+func (p *PluginProvider) call(ctx context.Context, method string, req *PluginRequest) (*PluginResponse, error) {
+	resp := new(PluginResponse)
+	if err := p.client.Call(ctx, method, req, resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	return resp, nil
+}
+
+func (p *PluginProvider) callInternal(method string, cluster *types.Cluster, oldCluster *types.Cluster) (*PluginResponse, error) {
+	req, err := newPluginRequest(cluster, oldCluster)
+	if err != nil {
+		return nil, err
+	}
+	return p.call(context.Background(), method, req)
+}
+
+// callV1 calls method of plugin and updates cluster with the one mutated by plugin.
+func (p *PluginProvider) callV1(ctx context.Context, method string, cluster *v1.Cluster) error {
+	resp, err := p.call(ctx, method, &PluginRequest{Cluster: NewPluginCluster(cluster)})
+	if err != nil {
+		return err
+	}
+	if resp.Cluster == nil || resp.Cluster.Cluster == nil {
+		return fmt.Errorf("plugin %s returns no cluster for %s", p.name, method)
+	}
+	*cluster.Cluster = *resp.Cluster.Cluster
+	if resp.Cluster.ClusterCredential != nil {
+		if cluster.ClusterCredential == nil {
+			cluster.ClusterCredential = new(platformv1.ClusterCredential)
+		}
+		*cluster.ClusterCredential = *resp.Cluster.ClusterCredential
+	}
+	cluster.IsCredentialChanged = cluster.IsCredentialChanged || resp.Cluster.IsCredentialChanged
+	return nil
+}
+
+func newPluginRequest(cluster *types.Cluster, oldCluster *types.Cluster) (*PluginRequest, error) {
+	req := new(PluginRequest)
+	var err error
+	if req.Cluster, err = newInternalPluginCluster(cluster); err != nil {
+		return nil, err
+	}
+	if oldCluster != nil {
+		if req.OldCluster, err = newInternalPluginCluster(oldCluster); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+func newInternalPluginCluster(cluster *types.Cluster) (*PluginCluster, error) {
+	c, err := toV1Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &PluginCluster{Cluster: c.Cluster, ClusterCredential: c.ClusterCredential}, nil
+}
+
+// NewPluginCluster returns the cluster sent to a plugin, with the rest config
+// of cluster if it is accessible.
+func NewPluginCluster(cluster *v1.Cluster) *PluginCluster {
+	result := &PluginCluster{
+		Cluster:             cluster.Cluster,
+		ClusterCredential:   cluster.ClusterCredential,
+		IsCredentialChanged: cluster.IsCredentialChanged,
+	}
+	if config, err := cluster.RESTConfig(); err == nil {
+		result.RestConfig = newPluginRestConfig(config)
+	}
+	return result
+}
+
+// toInternal updates cluster with c.
+func (c *PluginCluster) toInternal(cluster *types.Cluster) error {
+	if c == nil || c.Cluster == nil {
+		return errors.New("plugin returns no cluster")
+	}
+	result := new(platform.Cluster)
+	if err := platformv1.Convert_v1_Cluster_To_platform_Cluster(c.Cluster, result, nil); err != nil {
+		return err
+	}
+	*cluster.Cluster = *result
+	return nil
+}
+
+// ToV1 returns the cluster used by handlers of provider.
+func (c *PluginCluster) ToV1() *v1.Cluster {
+	result := &v1.Cluster{
+		Cluster:             c.Cluster,
+		ClusterCredential:   c.ClusterCredential,
+		IsCredentialChanged: c.IsCredentialChanged,
+	}
+	if result.ClusterCredential == nil {
+		result.ClusterCredential = new(platformv1.ClusterCredential)
+	}
+	if c.RestConfig != nil {
+		result.RegisterRestConfig(c.RestConfig.toRestConfig())
+	}
+	return result
+}
+
+func newPluginRestConfig(config *rest.Config) *PluginRestConfig {
+	return &PluginRestConfig{
+		Host:        config.Host,
+		BearerToken: config.BearerToken,
+		Insecure:    config.Insecure,
+		CAData:      config.CAData,
+		CertData:    config.CertData,
+		KeyData:     config.KeyData,
+	}
+}
+
+func (c *PluginRestConfig) toRestConfig() *rest.Config {
+	return &rest.Config{
+		Host:        c.Host,
+		BearerToken: c.BearerToken,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: c.Insecure,
+			CAData:   c.CAData,
+			CertData: c.CertData,
+			KeyData:  c.KeyData,
+		},
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"k8s.io/client-go/rest"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/plugin"
+	"tkestack.io/tke/pkg/platform/types"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+// NewPluginServer returns a handler which serves provider as a plugin, so
+// that an out-of-tree provider can be implemented as an in-tree one, such as
+// with DelegateProvider, and loaded by PluginProvider.
+func NewPluginServer(provider Provider) http.Handler {
+	return &pluginServer{provider: provider}
+}
+
+type pluginServer struct {
+	provider Provider
+}
+
+func (s *pluginServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	in := new(PluginRequest)
+	plugin.Serve(w, req, in, func(ctx context.Context, method string) (interface{}, error) {
+		return s.serve(ctx, method, in)
+	})
+}
+
+func (s *pluginServer) serve(ctx context.Context, method string, req *PluginRequest) (*PluginResponse, error) {
+	resp := new(PluginResponse)
+	var err error
+	switch method {
+	case PluginMethodSetup:
+		err = s.provider.Setup()
+	case PluginMethodTeardown:
+		err = s.provider.Teardown()
+	case PluginMethodValidate, PluginMethodValidateUpdate, PluginMethodMutateUpdate,
+		PluginMethodPreCreate, PluginMethodAfterCreate, PluginMethodPlan:
+		cluster, oldCluster, e := req.toTypes(method)
+		if e != nil {
+			return nil, e
+		}
+		err = s.serveAPI(ctx, method, cluster, oldCluster, resp)
+	case PluginMethodOnCreate, PluginMethodOnUpdate, PluginMethodOnDelete, PluginMethodOnRunning:
+		if req.Cluster == nil || req.Cluster.Cluster == nil {
+			return nil, fmt.Errorf("cluster is required by %s", method)
+		}
+		cluster := req.Cluster.ToV1()
+		err = s.serveController(ctx, method, cluster)
+		resp.Cluster = &PluginCluster{
+			Cluster:             cluster.Cluster,
+			ClusterCredential:   cluster.ClusterCredential,
+			IsCredentialChanged: cluster.IsCredentialChanged,
+		}
+	case PluginMethodNeedUpdate:
+		if req.Cluster == nil || req.OldCluster == nil {
+			return nil, fmt.Errorf("cluster and old cluster are required by %s", method)
+		}
+		resp.Result = s.provider.NeedUpdate(req.OldCluster.Cluster, req.Cluster.Cluster)
+	case PluginMethodOnFilter:
+		if req.Cluster == nil {
+			return nil, fmt.Errorf("cluster is required by %s", method)
+		}
+		resp.Result = s.provider.OnFilter(ctx, req.Cluster.Cluster)
+	case PluginMethodGetRestConfig:
+		if req.Cluster == nil {
+			return nil, fmt.Errorf("cluster is required by %s", method)
+		}
+		var config *rest.Config
+		if config, err = s.provider.GetRestConfig(ctx, req.Cluster.Cluster, req.Username); err == nil {
+			resp.RestConfig = newPluginRestConfig(config)
+		}
+	default:
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp, nil
+}
+
+func (s *pluginServer) serveAPI(ctx context.Context, method string, cluster, oldCluster *types.Cluster, resp *PluginResponse) error {
+	var err error
+	switch method {
+	case PluginMethodValidate:
+		resp.Errors = s.provider.Validate(cluster)
+	case PluginMethodValidateUpdate:
+		resp.Errors = s.provider.ValidateUpdate(cluster, oldCluster)
+	case PluginMethodMutateUpdate:
+		resp.Patch, resp.Errors = s.provider.MutateUpdate(cluster, oldCluster)
+	case PluginMethodPreCreate:
+		if err = s.provider.PreCreate(cluster); err == nil {
+			resp.Cluster, err = newInternalPluginCluster(cluster)
+		}
+	case PluginMethodAfterCreate:
+		err = s.provider.AfterCreate(cluster)
+	case PluginMethodPlan:
+		resp.Plan, err = s.provider.Plan(ctx, cluster)
+	}
+	return err
+}
+
+func (s *pluginServer) serveController(ctx context.Context, method string, cluster *v1.Cluster) error {
+	switch method {
+	case PluginMethodOnCreate:
+		return s.provider.OnCreate(ctx, cluster)
+	case PluginMethodOnUpdate:
+		return s.provider.OnUpdate(ctx, cluster)
+	case PluginMethodOnDelete:
+		return s.provider.OnDelete(ctx, cluster)
+	default:
+		return s.provider.OnRunning(ctx, cluster)
+	}
+}
+
+// toTypes returns the clusters of request used by APIProvider, the old
+// cluster is only required by methods of update.
+func (req *PluginRequest) toTypes(method string) (cluster *types.Cluster, oldCluster *types.Cluster, err error) {
+	if req.Cluster == nil || req.Cluster.Cluster == nil {
+		return nil, nil, fmt.Errorf("cluster is required by %s", method)
+	}
+	if cluster, err = req.Cluster.toTypes(); err != nil {
+		return nil, nil, err
+	}
+	if method != PluginMethodValidateUpdate && method != PluginMethodMutateUpdate {
+		return cluster, nil, nil
+	}
+	if req.OldCluster == nil || req.OldCluster.Cluster == nil {
+		return nil, nil, fmt.Errorf("old cluster is required by %s", method)
+	}
+	if oldCluster, err = req.OldCluster.toTypes(); err != nil {
+		return nil, nil, err
+	}
+	return cluster, oldCluster, nil
+}
+
+// toTypes returns the cluster used by APIProvider.
+func (c *PluginCluster) toTypes() (*types.Cluster, error) {
+	result := &types.Cluster{Cluster: new(platform.Cluster)}
+	if err := platformv1.Convert_v1_Cluster_To_platform_Cluster(c.Cluster, result.Cluster, nil); err != nil {
+		return nil, err
+	}
+	if c.ClusterCredential != nil {
+		result.ClusterCredential = new(platform.ClusterCredential)
+		if err := platformv1.Convert_v1_ClusterCredential_To_platform_ClusterCredential(c.ClusterCredential, result.ClusterCredential, nil); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/platform"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/platform/provider/cluster/conformance"
+	"tkestack.io/tke/pkg/platform/provider/cluster/fake"
+	"tkestack.io/tke/pkg/platform/provider/plugin"
+	"tkestack.io/tke/pkg/platform/provider/plugin/plugintest"
+	"tkestack.io/tke/pkg/platform/types"
+)
+
+func newFakeCluster() *platform.Cluster {
+	return &platform.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cls-fake"},
+		Spec: platform.ClusterSpec{
+			Type:    fake.ProviderName,
+			Version: "1.21.4-tke.1",
+		},
+	}
+}
+
+func TestFakeProviderConformance(t *testing.T) {
+	conformance.Run(t, fake.NewProvider(), newFakeCluster)
+}
+
+func TestPluginProviderConformance(t *testing.T) {
+	address := plugintest.NewServer(t, clusterprovider.NewPluginServer(fake.NewProvider()))
+
+	p, err := clusterprovider.NewPluginProvider(plugin.ProviderConfig{
+		Name:    fake.ProviderName,
+		Address: address,
+	})
+	if err != nil {
+		t.Fatalf("NewPluginProvider() error = %v", err)
+	}
+	conformance.Run(t, p, newFakeCluster)
+}
+
+func TestPluginProviderValidate(t *testing.T) {
+	address := plugintest.NewServer(t, clusterprovider.NewPluginServer(fake.NewProvider()))
+
+	p, err := clusterprovider.NewPluginProvider(plugin.ProviderConfig{
+		Name:    fake.ProviderName,
+		Address: address,
+	})
+	if err != nil {
+		t.Fatalf("NewPluginProvider() error = %v", err)
+	}
+	cluster := newFakeCluster()
+	cluster.Spec.Version = ""
+	errs := p.Validate(&types.Cluster{Cluster: cluster})
+	if len(errs) != 1 || errs[0].Field != "spec.version" {
+		t.Errorf("Validate() = %v, want error of spec.version", errs)
+	}
+}
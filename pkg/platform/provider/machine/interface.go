@@ -31,11 +31,13 @@ import (
 	"tkestack.io/tke/pkg/util/log"
 
 	"github.com/thoas/go-funk"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/platform"
 
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/ssh"
 )
 
 const (
@@ -49,6 +51,9 @@ const (
 
 	ConditionTypeHealthCheck = "HealthCheck"
 	FailedHealthCheckReason  = "FailedHealthCheck"
+
+	// handlerOutputLimit is the max bytes of ssh commands recorded for a failed handler.
+	handlerOutputLimit = 2048
 )
 
 type APIProvider interface {
@@ -81,6 +86,26 @@ type Provider interface {
 	ControllerProvider
 }
 
+// Provisioner provisions the host of a machine, such as creating a VM by the
+// API of IaaS, before the machine joins the cluster.
+type Provisioner interface {
+	// Provision creates the host of machine if not exists, and sets the
+	// address and credential of the host in spec of machine. It may be
+	// called more than once for a machine.
+	Provision(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error
+	// Deprovision releases the host of machine after it leaves the cluster.
+	Deprovision(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error
+}
+
+// ProvisionableProvider is a provider whose handlers can run on hosts
+// provisioned by a Provisioner.
+type ProvisionableProvider interface {
+	Provider
+	// WithProvisioner returns a provider named name, which provisions the
+	// host of machine by provisioner before running the handlers of provider.
+	WithProvisioner(name string, provisioner Provisioner) Provider
+}
+
 var _ Provider = &DelegateProvider{}
 
 type Handler func(context.Context, *platformv1.Machine, *typesv1.Cluster) error
@@ -94,6 +119,24 @@ func (h Handler) Name() string {
 	return strings.TrimSuffix(name[i+1:], "-fm")
 }
 
+// runHandler runs handler and records the execution in handler history of machine.
+func runHandler(ctx context.Context, handler Handler, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	recorder := ssh.NewOutputRecorder(handlerOutputLimit)
+	record := platformv1.HandlerRecord{
+		Name:      handler.Name(),
+		StartTime: metav1.Now(),
+	}
+	err := handler(ssh.WithOutputRecorder(ctx, recorder), machine, cluster)
+	record.EndTime = metav1.Now()
+	if err != nil {
+		record.LastError = ssh.Redact(err.Error())
+		record.Output = recorder.String()
+	}
+	machine.RecordHandler(record)
+
+	return err
+}
+
 type DelegateProvider struct {
 	ProviderName string
 
@@ -105,8 +148,14 @@ type DelegateProvider struct {
 	CreateHandlers []Handler
 	DeleteHandlers []Handler
 	UpdateHandlers []Handler
+
+	// Provisioner provisions the host of machine before CreateHandlers run,
+	// and releases it after DeleteHandlers run.
+	Provisioner Provisioner
 }
 
+var _ ProvisionableProvider = &DelegateProvider{}
+
 func (p *DelegateProvider) Name() string {
 	if p.ProviderName == "" {
 		return "unknown"
@@ -114,6 +163,20 @@ func (p *DelegateProvider) Name() string {
 	return p.ProviderName
 }
 
+// WithProvisioner returns a copy of provider named name, which provisions
+// the host of machine by provisioner.
+func (p *DelegateProvider) WithProvisioner(name string, provisioner Provisioner) Provider {
+	result := *p
+	result.ProviderName = name
+	result.Provisioner = provisioner
+	return &result
+}
+
+// ProvisionsHosts reports whether the hosts of machines are provisioned by provider.
+func (p *DelegateProvider) ProvisionsHosts() bool {
+	return p.Provisioner != nil
+}
+
 func (p *DelegateProvider) Validate(machine *platform.Machine) field.ErrorList {
 	if p.ValidateFunc != nil {
 		return p.ValidateFunc(machine)
@@ -168,7 +231,7 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, machine *platformv1.Mac
 		ctx := log.FromContext(ctx).WithName("MachineProvider.OnCreate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
-		err = handler(ctx, machine, cluster)
+		err = runHandler(ctx, handler, machine, cluster)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
 		if err != nil {
 			machine.SetCondition(platformv1.MachineCondition{
@@ -209,7 +272,7 @@ func (p *DelegateProvider) OnUpdate(ctx context.Context, machine *platformv1.Mac
 		ctx := log.FromContext(ctx).WithName("MachineProvider.OnUpdate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
-		err := handler(ctx, machine, cluster)
+		err := runHandler(ctx, handler, machine, cluster)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
 		if err != nil {
 			machine.Status.Reason = ReasonFailedUpdate
@@ -236,6 +299,18 @@ func (p *DelegateProvider) OnDelete(ctx context.Context, machine *platformv1.Mac
 			return err
 		}
 	}
+	if p.Provisioner != nil {
+		ctx := log.FromContext(ctx).WithName("MachineProvider.OnDelete").WithName("Deprovision").WithContext(ctx)
+		log.FromContext(ctx).Info("Doing")
+		startTime := time.Now()
+		err := p.Provisioner.Deprovision(ctx, machine, cluster)
+		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
+		if err != nil {
+			cluster.Status.Reason = ReasonFailedDelete
+			cluster.Status.Message = fmt.Sprintf("Deprovision error: %v", err)
+			return err
+		}
+	}
 	cluster.Status.Reason = ""
 	cluster.Status.Message = ""
 
@@ -284,27 +359,49 @@ func (p *DelegateProvider) NeedUpdate(old, new *platformv1.Machine) bool {
 	return false
 }
 
+// EnsureProvision provisions the host of machine by provisioner of provider.
+func (p *DelegateProvider) EnsureProvision(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	if err := p.Provisioner.Provision(ctx, machine, cluster); err != nil {
+		return err
+	}
+	if machine.Spec.IP == "" {
+		return errors.New("provisioner sets no ip of machine")
+	}
+
+	return nil
+}
+
+// createHandlers returns the handlers of create, which start with
+// EnsureProvision if hosts are provisioned by provider.
+func (p *DelegateProvider) createHandlers() []Handler {
+	if p.Provisioner == nil {
+		return p.CreateHandlers
+	}
+	return append([]Handler{p.EnsureProvision}, p.CreateHandlers...)
+}
+
 func (p *DelegateProvider) getNextConditionType(conditionType string) string {
 	var (
 		i       int
 		handler Handler
 	)
-	for i, handler = range p.CreateHandlers {
+	handlers := p.createHandlers()
+	for i, handler = range handlers {
 		name := handler.Name()
 		if name == conditionType {
 			break
 		}
 	}
-	if i == len(p.CreateHandlers)-1 {
+	if i == len(handlers)-1 {
 		return ConditionTypeDone
 	}
-	next := p.CreateHandlers[i+1]
+	next := handlers[i+1]
 
 	return next.Name()
 }
 
 func (p *DelegateProvider) getCreateHandler(conditionType string) Handler {
-	for _, f := range p.CreateHandlers {
+	for _, f := range p.createHandlers() {
 		if conditionType == f.Name() {
 			return f
 		}
@@ -317,13 +414,14 @@ func (p *DelegateProvider) getCreateCurrentCondition(c *platformv1.Machine) (*pl
 	if c.Status.Phase == platformv1.MachineRunning {
 		return nil, errors.New("machine phase is running now")
 	}
-	if len(p.CreateHandlers) == 0 {
+	handlers := p.createHandlers()
+	if len(handlers) == 0 {
 		return nil, errors.New("no create handlers")
 	}
 
 	if len(c.Status.Conditions) == 0 {
 		return &platformv1.MachineCondition{
-			Type:    p.CreateHandlers[0].Name(),
+			Type:    handlers[0].Name(),
 			Status:  platformv1.ConditionUnknown,
 			Message: "waiting process",
 			Reason:  ReasonWaiting,
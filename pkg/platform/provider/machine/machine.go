@@ -22,6 +22,9 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
 )
 
 var (
@@ -68,3 +71,23 @@ func GetProvider(name string) (Provider, error) {
 
 	return provider, nil
 }
+
+// ProvisionsHosts reports whether the hosts of machines are provisioned by
+// provider, so that machines can be created without hosts.
+func ProvisionsHosts(provider Provider) bool {
+	p, ok := provider.(interface{ ProvisionsHosts() bool })
+	return ok && p.ProvisionsHosts()
+}
+
+// ValidateMachinePoolSpec validates the template of a machine pool by
+// provider, the pools of a provider not validating them are left to the
+// validation of their machines.
+func ValidateMachinePoolSpec(provider Provider, spec *platform.MachinePoolSpec, fldPath *field.Path) field.ErrorList {
+	p, ok := provider.(interface {
+		ValidateMachinePoolSpec(*platform.MachinePoolSpec, *field.Path) field.ErrorList
+	})
+	if !ok {
+		return nil
+	}
+	return p.ValidateMachinePoolSpec(spec, fldPath)
+}
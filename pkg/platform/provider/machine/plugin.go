@@ -0,0 +1,242 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package machine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/platform/provider/plugin"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Methods of machine provider served by a plugin, each one mirrors the method
+// of Provider or Provisioner with the same name.
+const (
+	PluginMethodValidate       = "validate"
+	PluginMethodValidateUpdate = "validateupdate"
+	PluginMethodPreCreate      = "precreate"
+	PluginMethodAfterCreate    = "aftercreate"
+	PluginMethodNeedUpdate     = "needupdate"
+	PluginMethodOnCreate       = "oncreate"
+	PluginMethodOnUpdate       = "onupdate"
+	PluginMethodOnDelete       = "ondelete"
+	PluginMethodOnHealthCheck  = "onhealthcheck"
+	PluginMethodProvision      = "provision"
+	PluginMethodDeprovision    = "deprovision"
+)
+
+// PluginRequest is the request of a method of machine provider plugin.
+type PluginRequest struct {
+	Machine *platformv1.Machine `json:"machine"`
+	// +optional
+	OldMachine *platformv1.Machine `json:"oldMachine,omitempty"`
+	// Cluster is the cluster of machine, which is only sent to methods of controller.
+	// +optional
+	Cluster *clusterprovider.PluginCluster `json:"cluster,omitempty"`
+}
+
+// PluginResponse is the response of a method of machine provider plugin,
+// only the fields returned by the method are set.
+type PluginResponse struct {
+	// Machine is the machine mutated by the method.
+	// +optional
+	Machine *platformv1.Machine `json:"machine,omitempty"`
+	// +optional
+	Errors field.ErrorList `json:"errors,omitempty"`
+	// +optional
+	Result bool `json:"result,omitempty"`
+	// Error is the error returned by the method.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// RegisterPlugins registers the machine providers served by plugins in
+// config. A plugin with base provider only provisions hosts, so base
+// provider must be registered before.
+func RegisterPlugins(config *plugin.Config) error {
+	for _, one := range config.MachineProviders {
+		if one.Name == "" {
+			return errors.New("name of machine provider plugin is empty")
+		}
+		if _, err := GetProvider(one.Name); err == nil {
+			return fmt.Errorf("machine provider %s is already registered", one.Name)
+		}
+		client, err := plugin.NewClient(one.ProviderConfig)
+		if err != nil {
+			return err
+		}
+		if one.Base == "" {
+			Register(one.Name, &PluginProvider{name: one.Name, client: client})
+			continue
+		}
+		base, err := GetProvider(one.Base)
+		if err != nil {
+			return err
+		}
+		provisionable, ok := base.(ProvisionableProvider)
+		if !ok {
+			return fmt.Errorf("machine provider %s can not join provisioned hosts", one.Base)
+		}
+		Register(one.Name, provisionable.WithProvisioner(one.Name, &PluginProvisioner{name: one.Name, client: client}))
+	}
+	return nil
+}
+
+// PluginProvider is a machine provider served by an out-of-tree plugin.
+type PluginProvider struct {
+	name   string
+	client *plugin.Client
+}
+
+var _ Provider = &PluginProvider{}
+
+// NewPluginProvider creates a machine provider which calls the plugin described by config.
+func NewPluginProvider(config plugin.ProviderConfig) (*PluginProvider, error) {
+	client, err := plugin.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &PluginProvider{name: config.Name, client: client}, nil
+}
+
+func (p *PluginProvider) Name() string {
+	return p.name
+}
+
+func (p *PluginProvider) Validate(machine *platform.Machine) field.ErrorList {
+	resp, err := p.callInternal(PluginMethodValidate, machine, nil)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "type"), err)}
+	}
+	return resp.Errors
+}
+
+func (p *PluginProvider) ValidateUpdate(machine *platform.Machine, oldMachine *platform.Machine) field.ErrorList {
+	resp, err := p.callInternal(PluginMethodValidateUpdate, machine, oldMachine)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "type"), err)}
+	}
+	return resp.Errors
+}
+
+func (p *PluginProvider) PreCreate(machine *platform.Machine) error {
+	resp, err := p.callInternal(PluginMethodPreCreate, machine, nil)
+	if err != nil {
+		return err
+	}
+	if resp.Machine == nil {
+		return fmt.Errorf("plugin %s returns no machine for %s", p.name, PluginMethodPreCreate)
+	}
+	return platformv1.Convert_v1_Machine_To_platform_Machine(resp.Machine, machine, nil)
+}
+
+func (p *PluginProvider) AfterCreate(machine *platform.Machine) error {
+	_, err := p.callInternal(PluginMethodAfterCreate, machine, nil)
+	return err
+}
+
+func (p *PluginProvider) NeedUpdate(old, new *platformv1.Machine) bool {
+	resp, err := call(context.Background(), p.client, PluginMethodNeedUpdate, &PluginRequest{Machine: new, OldMachine: old})
+	if err != nil {
+		log.Error("call plugin NeedUpdate error", log.String("provider", p.name), log.Err(err))
+		return false
+	}
+	return resp.Result
+}
+
+func (p *PluginProvider) OnCreate(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	return callController(ctx, p.client, PluginMethodOnCreate, machine, cluster)
+}
+
+func (p *PluginProvider) OnUpdate(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	return callController(ctx, p.client, PluginMethodOnUpdate, machine, cluster)
+}
+
+func (p *PluginProvider) OnDelete(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	return callController(ctx, p.client, PluginMethodOnDelete, machine, cluster)
+}
+
+func (p *PluginProvider) OnHealthCheck(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) *platformv1.Machine {
+	if err := callController(ctx, p.client, PluginMethodOnHealthCheck, machine, cluster); err != nil {
+		log.FromContext(ctx).Error(err, "Call plugin OnHealthCheck error", "provider", p.name)
+	}
+	return machine
+}
+
+func (p *PluginProvider) callInternal(method string, machine *platform.Machine, oldMachine *platform.Machine) (*PluginResponse, error) {
+	req := &PluginRequest{Machine: new(platformv1.Machine)}
+	if err := platformv1.Convert_platform_Machine_To_v1_Machine(machine, req.Machine, nil); err != nil {
+		return nil, err
+	}
+	if oldMachine != nil {
+		req.OldMachine = new(platformv1.Machine)
+		if err := platformv1.Convert_platform_Machine_To_v1_Machine(oldMachine, req.OldMachine, nil); err != nil {
+			return nil, err
+		}
+	}
+	return call(context.Background(), p.client, method, req)
+}
+
+// PluginProvisioner is a provisioner served by an out-of-tree plugin.
+type PluginProvisioner struct {
+	name   string
+	client *plugin.Client
+}
+
+var _ Provisioner = &PluginProvisioner{}
+
+func (p *PluginProvisioner) Provision(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	return callController(ctx, p.client, PluginMethodProvision, machine, cluster)
+}
+
+func (p *PluginProvisioner) Deprovision(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	return callController(ctx, p.client, PluginMethodDeprovision, machine, cluster)
+}
+
+// call calls method of plugin, the error returned by the method is returned as error.
+func call(ctx context.Context, client *plugin.Client, method string, req *PluginRequest) (*PluginResponse, error) {
+	resp := new(PluginResponse)
+	if err := client.Call(ctx, method, req, resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return resp, errors.New(resp.Error)
+	}
+	return resp, nil
+}
+
+// callController calls method of plugin and updates machine with the one
+// mutated by plugin, even if the method fails, so that its status is kept.
+func callController(ctx context.Context, client *plugin.Client, method string, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	resp, err := call(ctx, client, method, &PluginRequest{
+		Machine: machine,
+		Cluster: clusterprovider.NewPluginCluster(cluster),
+	})
+	if resp != nil && resp.Machine != nil {
+		*machine = *resp.Machine
+	}
+	return err
+}
@@ -0,0 +1,144 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package machine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/plugin"
+)
+
+// NewPluginServer returns a handler which serves provider as a plugin, so
+// that an out-of-tree provider can be implemented as an in-tree one and
+// loaded by PluginProvider.
+func NewPluginServer(provider Provider) http.Handler {
+	return &pluginServer{provider: provider}
+}
+
+// NewProvisionerPluginServer returns a handler which serves provisioner as a
+// plugin, which is loaded with a base provider by RegisterPlugins.
+func NewProvisionerPluginServer(provisioner Provisioner) http.Handler {
+	return &pluginServer{provisioner: provisioner}
+}
+
+type pluginServer struct {
+	provider    Provider
+	provisioner Provisioner
+}
+
+func (s *pluginServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	in := new(PluginRequest)
+	plugin.Serve(w, req, in, func(ctx context.Context, method string) (interface{}, error) {
+		return s.serve(ctx, method, in)
+	})
+}
+
+func (s *pluginServer) serve(ctx context.Context, method string, req *PluginRequest) (*PluginResponse, error) {
+	if req.Machine == nil {
+		return nil, fmt.Errorf("machine is required by %s", method)
+	}
+	switch method {
+	case PluginMethodProvision, PluginMethodDeprovision:
+		if s.provisioner == nil {
+			return nil, fmt.Errorf("unsupported method %s", method)
+		}
+	default:
+		if s.provider == nil {
+			return nil, fmt.Errorf("unsupported method %s", method)
+		}
+	}
+
+	resp := new(PluginResponse)
+	var err error
+	switch method {
+	case PluginMethodValidate, PluginMethodValidateUpdate, PluginMethodPreCreate, PluginMethodAfterCreate:
+		err = s.serveAPI(method, req, resp)
+	case PluginMethodNeedUpdate:
+		if req.OldMachine == nil {
+			return nil, fmt.Errorf("old machine is required by %s", method)
+		}
+		resp.Result = s.provider.NeedUpdate(req.OldMachine, req.Machine)
+	case PluginMethodOnCreate, PluginMethodOnUpdate, PluginMethodOnDelete, PluginMethodOnHealthCheck,
+		PluginMethodProvision, PluginMethodDeprovision:
+		if req.Cluster == nil || req.Cluster.Cluster == nil {
+			return nil, fmt.Errorf("cluster is required by %s", method)
+		}
+		err = s.serveController(ctx, method, req)
+		resp.Machine = req.Machine
+	default:
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp, nil
+}
+
+func (s *pluginServer) serveAPI(method string, req *PluginRequest, resp *PluginResponse) error {
+	machine := new(platform.Machine)
+	if err := platformv1.Convert_v1_Machine_To_platform_Machine(req.Machine, machine, nil); err != nil {
+		return err
+	}
+	switch method {
+	case PluginMethodValidate:
+		resp.Errors = s.provider.Validate(machine)
+	case PluginMethodValidateUpdate:
+		if req.OldMachine == nil {
+			return fmt.Errorf("old machine is required by %s", method)
+		}
+		oldMachine := new(platform.Machine)
+		if err := platformv1.Convert_v1_Machine_To_platform_Machine(req.OldMachine, oldMachine, nil); err != nil {
+			return err
+		}
+		resp.Errors = s.provider.ValidateUpdate(machine, oldMachine)
+	case PluginMethodPreCreate:
+		if err := s.provider.PreCreate(machine); err != nil {
+			return err
+		}
+		resp.Machine = new(platformv1.Machine)
+		return platformv1.Convert_platform_Machine_To_v1_Machine(machine, resp.Machine, nil)
+	case PluginMethodAfterCreate:
+		return s.provider.AfterCreate(machine)
+	}
+	return nil
+}
+
+func (s *pluginServer) serveController(ctx context.Context, method string, req *PluginRequest) error {
+	cluster := req.Cluster.ToV1()
+	switch method {
+	case PluginMethodOnCreate:
+		return s.provider.OnCreate(ctx, req.Machine, cluster)
+	case PluginMethodOnUpdate:
+		return s.provider.OnUpdate(ctx, req.Machine, cluster)
+	case PluginMethodOnDelete:
+		return s.provider.OnDelete(ctx, req.Machine, cluster)
+	case PluginMethodOnHealthCheck:
+		req.Machine = s.provider.OnHealthCheck(ctx, req.Machine, cluster)
+		return nil
+	case PluginMethodProvision:
+		return s.provisioner.Provision(ctx, req.Machine, cluster)
+	default:
+		return s.provisioner.Deprovision(ctx, req.Machine, cluster)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package machine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/plugin"
+	"tkestack.io/tke/pkg/platform/provider/plugin/plugintest"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+type fakeProvisioner struct {
+	deprovisioned []string
+}
+
+func (p *fakeProvisioner) Provision(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	machine.Spec.IP = "10.0.0.1"
+	return nil
+}
+
+func (p *fakeProvisioner) Deprovision(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	p.deprovisioned = append(p.deprovisioned, machine.Name)
+	return nil
+}
+
+func EnsureJoin(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	if machine.Spec.IP == "" {
+		return errors.New("machine has no ip")
+	}
+	return nil
+}
+
+func newTestProvider() *DelegateProvider {
+	return &DelegateProvider{
+		ProviderName: "Test",
+		ValidateFunc: func(machine *platform.Machine) field.ErrorList {
+			if machine.Spec.ClusterName == "" {
+				return field.ErrorList{field.Required(field.NewPath("spec", "clusterName"), "")}
+			}
+			return nil
+		},
+		CreateHandlers: []Handler{EnsureJoin},
+	}
+}
+
+func newTestCluster() *typesv1.Cluster {
+	return &typesv1.Cluster{
+		Cluster:           &platformv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cls-test"}},
+		ClusterCredential: &platformv1.ClusterCredential{},
+	}
+}
+
+// createMachine calls OnCreate until machine is running.
+func createMachine(t *testing.T, p Provider, machine *platformv1.Machine) {
+	for i := 0; machine.Status.Phase != platformv1.MachineRunning; i++ {
+		if i == 10 {
+			t.Fatalf("OnCreate() does not make machine running, conditions: %v", machine.Status.Conditions)
+		}
+		if err := p.OnCreate(context.Background(), machine, newTestCluster()); err != nil {
+			t.Fatalf("OnCreate() error = %v", err)
+		}
+	}
+}
+
+func TestDelegateProviderWithProvisioner(t *testing.T) {
+	base := newTestProvider()
+	machine := &platformv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "mc-test"}}
+	if err := base.OnCreate(context.Background(), machine.DeepCopy(), newTestCluster()); err == nil {
+		t.Errorf("OnCreate() without provisioner error = nil, want error of no ip")
+	}
+
+	provisioner := new(fakeProvisioner)
+	p := base.WithProvisioner("Provisioned", provisioner)
+	if p.Name() != "Provisioned" || base.Name() != "Test" {
+		t.Errorf("WithProvisioner() names = %s, %s, want Provisioned, Test", p.Name(), base.Name())
+	}
+	if !ProvisionsHosts(p) || ProvisionsHosts(base) {
+		t.Errorf("ProvisionsHosts() = %v, %v, want true, false", ProvisionsHosts(p), ProvisionsHosts(base))
+	}
+	createMachine(t, p, machine)
+	if machine.Spec.IP != "10.0.0.1" {
+		t.Errorf("OnCreate() ip = %q, want 10.0.0.1", machine.Spec.IP)
+	}
+	if got := machine.Status.Conditions[0].Type; got != "EnsureProvision" {
+		t.Errorf("OnCreate() first condition = %s, want EnsureProvision", got)
+	}
+	if err := p.OnDelete(context.Background(), machine, newTestCluster()); err != nil {
+		t.Fatalf("OnDelete() error = %v", err)
+	}
+	if len(provisioner.deprovisioned) != 1 {
+		t.Errorf("OnDelete() deprovisioned = %v, want [mc-test]", provisioner.deprovisioned)
+	}
+}
+
+func TestPluginProvider(t *testing.T) {
+	address := plugintest.NewServer(t, NewPluginServer(newTestProvider().WithProvisioner("Test", new(fakeProvisioner))))
+
+	p, err := NewPluginProvider(plugin.ProviderConfig{Name: "Test", Address: address})
+	if err != nil {
+		t.Fatalf("NewPluginProvider() error = %v", err)
+	}
+	if errs := p.Validate(&platform.Machine{}); len(errs) != 1 || errs[0].Field != "spec.clusterName" {
+		t.Errorf("Validate() = %v, want error of spec.clusterName", errs)
+	}
+	if errs := p.Validate(&platform.Machine{Spec: platform.MachineSpec{ClusterName: "cls-test"}}); len(errs) != 0 {
+		t.Errorf("Validate() = %v, want no error", errs)
+	}
+	machine := &platformv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "mc-test"}}
+	createMachine(t, p, machine)
+	if machine.Spec.IP != "10.0.0.1" {
+		t.Errorf("OnCreate() ip = %q, want 10.0.0.1", machine.Spec.IP)
+	}
+}
+
+func TestRegisterPlugins(t *testing.T) {
+	provisioner := new(fakeProvisioner)
+	address := plugintest.NewServer(t, NewProvisionerPluginServer(provisioner))
+	Register("TestBase", newTestProvider())
+
+	config := &plugin.Config{MachineProviders: []plugin.MachineProviderConfig{{
+		ProviderConfig: plugin.ProviderConfig{Name: "TestVM", Address: address},
+		Base:           "TestBase",
+	}}}
+	if err := RegisterPlugins(config); err != nil {
+		t.Fatalf("RegisterPlugins() error = %v", err)
+	}
+	if err := RegisterPlugins(config); err == nil {
+		t.Errorf("RegisterPlugins() twice error = nil, want error")
+	}
+	p, err := GetProvider("TestVM")
+	if err != nil {
+		t.Fatalf("GetProvider() error = %v", err)
+	}
+	machine := &platformv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "mc-test"}}
+	createMachine(t, p, machine)
+	if err := p.OnDelete(context.Background(), machine, newTestCluster()); err != nil {
+		t.Fatalf("OnDelete() error = %v", err)
+	}
+	if len(provisioner.deprovisioned) != 1 {
+		t.Errorf("OnDelete() deprovisioned = %v, want [mc-test]", provisioner.deprovisioned)
+	}
+}
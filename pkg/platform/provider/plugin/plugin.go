@@ -0,0 +1,208 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package plugin implements the transport of out-of-tree providers, which
+// are served by plugins over HTTP/JSON.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/transport"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// PathPrefix is the path prefix of methods served by a plugin. A plugin
+	// serves each method at POST <address>/v1/<method>.
+	PathPrefix = "/v1/"
+
+	defaultTimeout = 5 * time.Minute
+)
+
+// Config is the config file of out-of-tree providers.
+type Config struct {
+	// ClusterProviders are the cluster providers served by plugins.
+	// +optional
+	ClusterProviders []ProviderConfig `json:"clusterProviders,omitempty"`
+	// MachineProviders are the machine providers served by plugins.
+	// +optional
+	MachineProviders []MachineProviderConfig `json:"machineProviders,omitempty"`
+}
+
+// ProviderConfig is the config of a provider served by a plugin.
+type ProviderConfig struct {
+	// Name of the provider, which is the type of resources managed by it.
+	Name string `json:"name"`
+	// Address is the base URL of the plugin, such as https://10.0.0.1:8443,
+	// or its unix socket, such as unix:///var/run/tke/plugin.sock. Plain
+	// http is refused, since credentials of clusters are sent to plugins.
+	Address string `json:"address"`
+	// CAFile is the file of CA certificates to verify the plugin.
+	// +optional
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile are the client certificate to connect to the plugin.
+	// +optional
+	CertFile string `json:"certFile,omitempty"`
+	// +optional
+	KeyFile string `json:"keyFile,omitempty"`
+	// Timeout of a call to the plugin, 5m by default.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// MachineProviderConfig is the config of a machine provider served by a plugin.
+type MachineProviderConfig struct {
+	ProviderConfig `json:",inline"`
+	// Base is the name of an in-tree machine provider, such as Baremetal. If
+	// it is set, the plugin only provisions hosts of machines, which join the
+	// cluster by the handlers of base provider.
+	// +optional
+	Base string `json:"base,omitempty"`
+}
+
+// LoadConfig loads the config file of out-of-tree providers.
+func LoadConfig(file string) (*Config, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	config := new(Config)
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("parse plugin config %s error: %w", file, err)
+	}
+	return config, nil
+}
+
+// Client calls methods of a plugin.
+type Client struct {
+	address string
+	client  *http.Client
+}
+
+// NewClient creates a client of the plugin described by config. The plugin
+// must be served over https or a unix socket.
+func NewClient(config ProviderConfig) (*Client, error) {
+	if config.Address == "" {
+		return nil, fmt.Errorf("address of plugin %s is empty", config.Name)
+	}
+	u, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("parse address of plugin %s error: %w", config.Name, err)
+	}
+	timeout := config.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	switch u.Scheme {
+	case "https":
+		rt, err := transport.New(&transport.Config{
+			TLS: transport.TLSConfig{
+				CAFile:   config.CAFile,
+				CertFile: config.CertFile,
+				KeyFile:  config.KeyFile,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &Client{
+			address: strings.TrimSuffix(config.Address, "/"),
+			client:  &http.Client{Transport: rt, Timeout: timeout},
+		}, nil
+	case "unix":
+		if u.Path == "" {
+			return nil, fmt.Errorf("socket of plugin %s is empty", config.Name)
+		}
+		socket := u.Path
+		rt := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+		}
+		return &Client{
+			address: "http://unix",
+			client:  &http.Client{Transport: rt, Timeout: timeout},
+		}, nil
+	default:
+		return nil, fmt.Errorf("address of plugin %s must be https or a unix socket, got %q", config.Name, config.Address)
+	}
+}
+
+// Call calls method of the plugin with in as request body, and decodes the
+// response body into out.
+func (c *Client) Call(ctx context.Context, method string, in interface{}, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.address+PathPrefix+method, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("call plugin method %s error: %s: %s", method, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
+
+// ServeFunc serves method of a plugin, the request body is decoded before.
+type ServeFunc func(ctx context.Context, method string) (interface{}, error)
+
+// Serve decodes the request body into in, and writes the result of serve as
+// response. Errors returned by serve are errors of the protocol, such as an
+// unknown method, errors of the method should be carried by the result.
+func Serve(w http.ResponseWriter, req *http.Request, in interface{}, serve ServeFunc) {
+	if req.Method != http.MethodPost || !strings.HasPrefix(req.URL.Path, PathPrefix) {
+		http.Error(w, fmt.Sprintf("unsupported request %s %s", req.Method, req.URL.Path), http.StatusNotFound)
+		return
+	}
+	if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	out, err := serve(req.Context(), strings.TrimPrefix(req.URL.Path, PathPrefix))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(out)
+}
@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			"cluster providers",
+			"clusterProviders:\n- name: VM\n  address: https://127.0.0.1:8443\n  timeout: 10m\n",
+			&Config{ClusterProviders: []ProviderConfig{{
+				Name:    "VM",
+				Address: "https://127.0.0.1:8443",
+				Timeout: metav1.Duration{Duration: 10 * time.Minute},
+			}}},
+			false,
+		},
+		{
+			"machine providers",
+			"machineProviders:\n- name: VM\n  address: https://127.0.0.1:8443\n  base: Baremetal\n",
+			&Config{MachineProviders: []MachineProviderConfig{{
+				ProviderConfig: ProviderConfig{Name: "VM", Address: "https://127.0.0.1:8443"},
+				Base:           "Baremetal",
+			}}},
+			false,
+		},
+		{
+			"unknown field",
+			"clusterProvider:\n- name: VM\n",
+			nil,
+			true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(dir, string(rune('a'+i)))
+			if err := ioutil.WriteFile(file, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := LoadConfig(file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{"https", "https://127.0.0.1:8443", false},
+		{"unix socket", "unix:///var/run/tke/plugin.sock", false},
+		{"plain http", "http://127.0.0.1:8080", true},
+		{"empty socket", "unix://", true},
+		{"empty", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(ProviderConfig{Name: "VM", Address: tt.address})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
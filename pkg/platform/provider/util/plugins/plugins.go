@@ -0,0 +1,42 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package plugins registers the out-of-tree providers served by plugins.
+package plugins
+
+import (
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	machineprovider "tkestack.io/tke/pkg/platform/provider/machine"
+	"tkestack.io/tke/pkg/platform/provider/plugin"
+)
+
+// Register registers the cluster and machine providers in the plugin config
+// file. Nothing is registered if file is empty.
+func Register(file string) error {
+	if file == "" {
+		return nil
+	}
+	config, err := plugin.LoadConfig(file)
+	if err != nil {
+		return err
+	}
+	if err := clusterprovider.RegisterPlugins(config); err != nil {
+		return err
+	}
+	return machineprovider.RegisterPlugins(config)
+}
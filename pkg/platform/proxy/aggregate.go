@@ -0,0 +1,527 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	genericfilters "k8s.io/apiserver/pkg/endpoints/filters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"tkestack.io/tke/api/business"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	authfilter "tkestack.io/tke/pkg/auth/filter"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+)
+
+const (
+	// ClusterNameAnnotation is added to the objects listed or watched from all
+	// clusters, the value is the name of the cluster the object belongs to.
+	ClusterNameAnnotation = "platform.tkestack.io/cluster-name"
+	// maxConcurrentClusters limits the clusters requested at the same time.
+	maxConcurrentClusters = 16
+)
+
+// aggregateVersion holds the continue token or the resource version of every
+// cluster, it is encoded as the continue token or the resource version of the
+// list merged from all clusters.
+type aggregateVersion map[string]string
+
+func (v aggregateVersion) encode() string {
+	if len(v) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeAggregateVersion(s string) (aggregateVersion, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	v := aggregateVersion{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// parseResourceVersion decodes the resource version of every cluster, nil
+// is returned for the resource versions "" and "0" which are passed to every
+// cluster as is.
+func parseResourceVersion(resourceVersion string) (aggregateVersion, error) {
+	if resourceVersion == "" || resourceVersion == "0" {
+		return nil, nil
+	}
+	return decodeAggregateVersion(resourceVersion)
+}
+
+// resourceVersionFor returns the resource version to request the cluster with.
+func resourceVersionFor(versions aggregateVersion, resourceVersion string, clusterName string) string {
+	if versions == nil {
+		return resourceVersion
+	}
+	return versions[clusterName]
+}
+
+var (
+	// proxyAuthorizer decides which clusters the caller of a request of all
+	// clusters can access.
+	proxyAuthorizer authorizer.Authorizer
+	// proxyBusinessClient gets the clusters of the project of a request of
+	// all clusters.
+	proxyBusinessClient businessversionedclient.BusinessV1Interface
+)
+
+// SetAuthorizer sets the authorizer deciding which clusters the caller of a
+// request of all clusters can access, every cluster of the tenant is
+// accessible if it is not set.
+func SetAuthorizer(a authorizer.Authorizer) {
+	proxyAuthorizer = a
+}
+
+// SetBusinessClient sets the client getting the clusters of projects, a
+// request of all clusters with a project is rejected if it is not set.
+func SetBusinessClient(client businessversionedclient.BusinessV1Interface) {
+	proxyBusinessClient = client
+}
+
+// accessibleClusters returns the running clusters of the tenant of the caller
+// sorted by name. A request with a project only gets the clusters of the
+// project, the clusters the caller is not authorized to request the resource
+// of are left out.
+func accessibleClusters(ctx context.Context, platformClient platforminternalclient.PlatformInterface) ([]platform.Cluster, error) {
+	options := v1.ListOptions{}
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID != "" {
+		options.FieldSelector = fields.OneTermEqualSelector("spec.tenantID", tenantID).String()
+	}
+	projectClusters, err := clustersOfProject(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	clusterList, err := platformClient.Clusters().List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters []platform.Cluster
+	for _, cluster := range clusterList.Items {
+		if cluster.Status.Phase != platform.ClusterRunning {
+			continue
+		}
+		if cluster.Status.Locked != nil && *cluster.Status.Locked {
+			continue
+		}
+		if projectClusters != nil && !projectClusters.Has(cluster.Name) {
+			continue
+		}
+		authorized, err := clusterAuthorized(ctx, cluster.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !authorized {
+			continue
+		}
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters, nil
+}
+
+// clustersOfProject returns the clusters of the project of the request, nil
+// is returned if the request has no project.
+func clustersOfProject(ctx context.Context, tenantID string) (sets.String, error) {
+	projectName := genericfilter.GetValueFromGroups(authentication.Groups(ctx), "project")
+	if projectName == "" {
+		return nil, nil
+	}
+	if proxyBusinessClient == nil {
+		return nil, errors.NewBadRequest("requesting all clusters of a project is not supported without the business api")
+	}
+	project, err := proxyBusinessClient.Projects().Get(ctx, projectName, v1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || (tenantID != "" && project.Spec.TenantID != tenantID) {
+		return nil, errors.NewNotFound(business.Resource("projects"), projectName)
+	}
+	clusters := sets.NewString()
+	for name := range project.Spec.Clusters {
+		clusters.Insert(name)
+	}
+	return clusters, nil
+}
+
+// clusterAuthorized checks whether the caller is authorized to request the
+// resource of the cluster, the request is authorized as if it was sent to
+// the cluster by its name.
+func clusterAuthorized(ctx context.Context, clusterName string) (bool, error) {
+	if proxyAuthorizer == nil {
+		return true, nil
+	}
+	ctx = filter.WithClusterName(ctx, clusterName)
+	attributes, err := genericfilters.GetAuthorizerAttributes(ctx)
+	if err != nil {
+		return false, err
+	}
+	decision, _, err := authfilter.Authorize(ctx, proxyAuthorizer, attributes)
+	if decision == authorizer.DecisionAllow {
+		return true, nil
+	}
+	return false, err
+}
+
+// forEachCluster calls f for every cluster concurrently and waits for all
+// of them.
+func forEachCluster(clusters []platform.Cluster, f func(i int, cluster *platform.Cluster)) {
+	sem := make(chan struct{}, maxConcurrentClusters)
+	var wg sync.WaitGroup
+	for i := range clusters {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			f(i, &clusters[i])
+		}(i)
+	}
+	wg.Wait()
+}
+
+// clusterRESTClient returns the versioned rest client of the cluster for the
+// api version of the request.
+func clusterRESTClient(ctx context.Context, platformClient platforminternalclient.PlatformInterface, cluster *platform.Cluster, requestInfo *request.RequestInfo) (restclient.Interface, error) {
+	config, err := GetConfigForCluster(ctx, platformClient, cluster)
+	if err != nil {
+		return nil, err
+	}
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return RESTClientFor(clientSet, requestInfo.APIGroup, requestInfo.APIVersion), nil
+}
+
+// setClusterName records the cluster of the object in its annotations.
+func setClusterName(obj runtime.Object, clusterName string) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	annotations := make(map[string]string, len(accessor.GetAnnotations())+1)
+	for k, v := range accessor.GetAnnotations() {
+		annotations[k] = v
+	}
+	annotations[ClusterNameAnnotation] = clusterName
+	accessor.SetAnnotations(annotations)
+}
+
+// listAllClusters lists the resources of all clusters the caller can access
+// and merges them into one list. The limit applies to every cluster, the
+// continue token of the merged list holds the one of every cluster having
+// more items. A cluster failing to list is reported as a warning, the request
+// fails only if all clusters fail.
+func (s *Store) listAllClusters(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	requestInfo, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("unable to get request info from context")
+	}
+
+	fuzzyResourceName := filter.FuzzyResourceFrom(ctx)
+	options, fuzzyResourceName = apiserverutil.InterceptFuzzyResourceNameFromListOptions(options, fuzzyResourceName)
+	v1options := &v1.ListOptions{}
+	if err := proxyConvert.Convert(options, v1options, &conversion.Meta{}); err != nil {
+		return nil, fmt.Errorf("convert failed: %v", err)
+	}
+	versions, err := parseResourceVersion(v1options.ResourceVersion)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid resource version of all clusters: %v", err))
+	}
+	var continues aggregateVersion
+	if v1options.Continue != "" {
+		if continues, err = decodeAggregateVersion(v1options.Continue); err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid continue token of all clusters: %v", err))
+		}
+	}
+
+	clusters, err := accessibleClusters(ctx, s.PlatformClient)
+	if err != nil {
+		return nil, err
+	}
+	if continues != nil {
+		var remaining []platform.Cluster
+		for _, cluster := range clusters {
+			if _, ok := continues[cluster.Name]; ok {
+				remaining = append(remaining, cluster)
+			}
+		}
+		clusters = remaining
+	}
+
+	lists := make([]runtime.Object, len(clusters))
+	errs := make([]error, len(clusters))
+	forEachCluster(clusters, func(i int, cluster *platform.Cluster) {
+		client, err := clusterRESTClient(ctx, s.PlatformClient, cluster, requestInfo)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		clusterOptions := *v1options
+		clusterOptions.ResourceVersion = resourceVersionFor(versions, v1options.ResourceVersion, cluster.Name)
+		clusterOptions.Continue = continues[cluster.Name]
+		list := s.NewListFunc()
+		errs[i] = client.
+			Get().
+			NamespaceIfScoped(requestInfo.Namespace, requestInfo.Namespace != "" && requestInfo.Resource != "namespaces").
+			Resource(requestInfo.Resource).
+			SubResource(requestInfo.Subresource).
+			SpecificallyVersionedParams(&clusterOptions, platform.ParameterCodec, v1.SchemeGroupVersion).
+			Do(ctx).
+			Into(list)
+		lists[i] = list
+	})
+
+	result := s.NewListFunc()
+	var items []runtime.Object
+	resourceVersions := aggregateVersion{}
+	nextContinues := aggregateVersion{}
+	failed := 0
+	for i, cluster := range clusters {
+		if errs[i] != nil {
+			failed++
+			warning.AddWarning(ctx, "", fmt.Sprintf("cluster %s: %v", cluster.Name, errs[i]))
+			continue
+		}
+		objs, err := meta.ExtractList(lists[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			setClusterName(obj, cluster.Name)
+		}
+		items = append(items, objs...)
+		listMeta, err := meta.ListAccessor(lists[i])
+		if err != nil {
+			return nil, err
+		}
+		resourceVersions[cluster.Name] = listMeta.GetResourceVersion()
+		if listMeta.GetContinue() != "" {
+			nextContinues[cluster.Name] = listMeta.GetContinue()
+		}
+	}
+	if failed != 0 && failed == len(clusters) {
+		return nil, errs[0]
+	}
+
+	if err := meta.SetList(result, items); err != nil {
+		return nil, err
+	}
+	listMeta, err := meta.ListAccessor(result)
+	if err != nil {
+		return nil, err
+	}
+	listMeta.SetResourceVersion(resourceVersions.encode())
+	listMeta.SetContinue(nextContinues.encode())
+
+	filterFuzzyResourceName(result, fuzzyResourceName)
+
+	return result, nil
+}
+
+// watchAllClusters watches the resources of all clusters the caller can
+// access from the resource version of a list of all clusters. A cluster
+// failing to watch is reported as a warning, the watch is closed as soon as
+// the watch of any cluster is closed so that the caller lists again.
+func (s *Store) watchAllClusters(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	requestInfo, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("unable to get request info from context")
+	}
+
+	options.Watch = true
+	v1options := &v1.ListOptions{}
+	if err := proxyConvert.Convert(options, v1options, &conversion.Meta{}); err != nil {
+		return nil, fmt.Errorf("convert failed: %v", err)
+	}
+	// the resource version of a bookmark only belongs to one cluster
+	v1options.AllowWatchBookmarks = false
+	versions, err := parseResourceVersion(v1options.ResourceVersion)
+	if err != nil {
+		return nil, errors.NewResourceExpired(fmt.Sprintf("invalid resource version of all clusters: %v", err))
+	}
+
+	clusters, err := accessibleClusters(ctx, s.PlatformClient)
+	if err != nil {
+		return nil, err
+	}
+
+	watchers := make([]watch.Interface, len(clusters))
+	errs := make([]error, len(clusters))
+	forEachCluster(clusters, func(i int, cluster *platform.Cluster) {
+		client, err := clusterRESTClient(ctx, s.PlatformClient, cluster, requestInfo)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		clusterOptions := *v1options
+		clusterOptions.ResourceVersion = resourceVersionFor(versions, v1options.ResourceVersion, cluster.Name)
+		watchers[i], errs[i] = client.Get().
+			NamespaceIfScoped(requestInfo.Namespace, requestInfo.Namespace != "" && requestInfo.Resource != "namespaces").
+			Resource(requestInfo.Resource).
+			SubResource(requestInfo.Subresource).
+			SpecificallyVersionedParams(&clusterOptions, platform.ParameterCodec, v1.SchemeGroupVersion).
+			Watch(ctx)
+	})
+
+	w := &aggregateWatcher{
+		result:   make(chan watch.Event),
+		stopCh:   make(chan struct{}),
+		versions: aggregateVersion{},
+	}
+	for name, version := range versions {
+		w.versions[name] = version
+	}
+	var names []string
+	for i, cluster := range clusters {
+		if errs[i] != nil {
+			warning.AddWarning(ctx, "", fmt.Sprintf("cluster %s: %v", cluster.Name, errs[i]))
+			continue
+		}
+		names = append(names, cluster.Name)
+		w.watchers = append(w.watchers, watchers[i])
+	}
+	if len(w.watchers) == 0 && len(clusters) != 0 {
+		return nil, errs[0]
+	}
+	w.start(names)
+
+	return w, nil
+}
+
+// aggregateWatcher merges the watches of clusters into one. The resource
+// version of every event is the one of all clusters updated by the event, so
+// that the watch can be resumed from it.
+type aggregateWatcher struct {
+	result   chan watch.Event
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	watchers []watch.Interface
+
+	versionsLock sync.Mutex
+	versions     aggregateVersion
+}
+
+var _ watch.Interface = &aggregateWatcher{}
+
+func (w *aggregateWatcher) start(names []string) {
+	var wg sync.WaitGroup
+	for i := range w.watchers {
+		wg.Add(1)
+		go func(name string, watcher watch.Interface) {
+			defer wg.Done()
+			w.forward(name, watcher)
+		}(names[i], w.watchers[i])
+	}
+	go func() {
+		wg.Wait()
+		close(w.result)
+	}()
+}
+
+func (w *aggregateWatcher) forward(clusterName string, watcher watch.Interface) {
+	defer w.Stop()
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			if event.Type != watch.Error {
+				setClusterName(event.Object, clusterName)
+				w.setResourceVersion(event.Object, clusterName)
+			}
+			select {
+			case w.result <- event:
+			case <-w.stopCh:
+				return
+			}
+		}
+	}
+}
+
+// setResourceVersion records the resource version of the object in the one
+// of all clusters, and sets the latter to the object.
+func (w *aggregateWatcher) setResourceVersion(obj runtime.Object, clusterName string) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	w.versionsLock.Lock()
+	defer w.versionsLock.Unlock()
+	if w.versions == nil {
+		w.versions = aggregateVersion{}
+	}
+	w.versions[clusterName] = accessor.GetResourceVersion()
+	accessor.SetResourceVersion(w.versions.encode())
+}
+
+// Stop stops the watches of all clusters.
+func (w *aggregateWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+		for _, watcher := range w.watchers {
+			watcher.Stop()
+		}
+	})
+}
+
+// ResultChan returns the channel receiving the events of all clusters.
+func (w *aggregateWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
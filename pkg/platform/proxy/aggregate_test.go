@@ -0,0 +1,176 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	internalfake "tkestack.io/tke/api/client/clientset/internalversion/fake"
+	versionedfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+)
+
+func TestAggregateVersion(t *testing.T) {
+	if s := (aggregateVersion{}).encode(); s != "" {
+		t.Errorf("expected empty version, got %q", s)
+	}
+	v := aggregateVersion{"cls-a": "100", "cls-b": "eyJ2IjoibWV0YS5rOHMuaW8vdjEifQ"}
+	decoded, err := decodeAggregateVersion(v.encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, v) {
+		t.Errorf("expected %v, got %v", v, decoded)
+	}
+	if versions, err := parseResourceVersion("0"); err != nil || versions != nil {
+		t.Errorf("expected resource version 0 to be passed as is, got %v, %v", versions, err)
+	}
+	if _, err := parseResourceVersion("12345"); err == nil {
+		t.Error("expected error for resource version of a single cluster")
+	}
+}
+
+func TestAggregateWatcher(t *testing.T) {
+	a, b := watch.NewFake(), watch.NewFake()
+	w := &aggregateWatcher{
+		result:   make(chan watch.Event),
+		stopCh:   make(chan struct{}),
+		watchers: []watch.Interface{a, b},
+	}
+	w.start([]string{"cls-a", "cls-b"})
+
+	go a.Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-a", ResourceVersion: "10"}})
+	event := <-w.ResultChan()
+	pod := event.Object.(*corev1.Pod)
+	if pod.Name != "pod-a" || pod.Annotations[ClusterNameAnnotation] != "cls-a" {
+		t.Errorf("unexpected pod %s from cluster %q", pod.Name, pod.Annotations[ClusterNameAnnotation])
+	}
+	go b.Modify(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-b", ResourceVersion: "20"}})
+	event = <-w.ResultChan()
+	pod = event.Object.(*corev1.Pod)
+	if event.Type != watch.Modified || pod.Annotations[ClusterNameAnnotation] != "cls-b" {
+		t.Errorf("unexpected %s event of pod from cluster %q", event.Type, pod.Annotations[ClusterNameAnnotation])
+	}
+
+	// the watch is resumed from the resource version of the last event
+	versions, err := parseResourceVersion(pod.ResourceVersion)
+	if err != nil {
+		t.Fatalf("resource version %q of event can't be resumed from: %v", pod.ResourceVersion, err)
+	}
+	if want := (aggregateVersion{"cls-a": "10", "cls-b": "20"}); !reflect.DeepEqual(versions, want) {
+		t.Errorf("expected resource versions %v, got %v", want, versions)
+	}
+	if got := resourceVersionFor(versions, pod.ResourceVersion, "cls-a"); got != "10" {
+		t.Errorf("expected to resume cls-a from 10, got %q", got)
+	}
+
+	// the watch of one cluster being closed closes the merged watch
+	a.Stop()
+	if _, ok := <-w.ResultChan(); ok {
+		t.Error("expected merged watch to be closed")
+	}
+	if !b.IsStopped() {
+		t.Error("expected watch of other cluster to be stopped")
+	}
+}
+
+func TestAccessibleClusters(t *testing.T) {
+	var clusters []runtime.Object
+	for _, name := range []string{"cls-c", "cls-b", "cls-a"} {
+		clusters = append(clusters, &platform.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       platform.ClusterSpec{TenantID: "t1"},
+			Status:     platform.ClusterStatus{Phase: platform.ClusterRunning},
+		})
+	}
+	platformClient := internalfake.NewSimpleClientset(clusters...).Platform()
+	businessClient := versionedfake.NewSimpleClientset(&businessv1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "prj-a"},
+		Spec: businessv1.ProjectSpec{
+			TenantID: "t1",
+			Clusters: businessv1.ClusterHard{"cls-a": {}, "cls-b": {}},
+		},
+	}).BusinessV1()
+	// the caller is only authorized to list pods of cls-a and cls-c
+	SetAuthorizer(authorizer.AuthorizerFunc(func(ctx context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+		if a.GetResource() == "cluster:cls-a/pod:*" || a.GetResource() == "cluster:cls-c/pod:*" {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return authorizer.DecisionNoOpinion, "", nil
+	}))
+	defer SetAuthorizer(nil)
+
+	newContext := func(groups ...string) context.Context {
+		ctx := request.WithUser(context.Background(), &user.DefaultInfo{
+			Name:   "jack",
+			Groups: append(groups, "cluster:*"),
+			Extra:  map[string][]string{oidc.TenantIDKey: {"t1"}},
+		})
+		return request.WithRequestInfo(ctx, &request.RequestInfo{
+			IsResourceRequest: true,
+			Path:              "/api/v1/pods",
+			Verb:              "list",
+			APIVersion:        "v1",
+			Resource:          "pods",
+		})
+	}
+	names := func(clusters []platform.Cluster) string {
+		var names []string
+		for _, cluster := range clusters {
+			names = append(names, cluster.Name)
+		}
+		return strings.Join(names, ",")
+	}
+
+	got, err := accessibleClusters(newContext(), platformClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names(got) != "cls-a,cls-c" {
+		t.Errorf("accessibleClusters() = %s, want the authorized clusters", names(got))
+	}
+
+	if _, err := accessibleClusters(newContext("project:prj-a"), platformClient); err == nil {
+		t.Error("expected error for a project without the business client")
+	}
+	SetBusinessClient(businessClient)
+	defer SetBusinessClient(nil)
+	got, err = accessibleClusters(newContext("project:prj-a"), platformClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names(got) != "cls-a" {
+		t.Errorf("accessibleClusters() = %s, want the authorized clusters of the project", names(got))
+	}
+	if _, err := accessibleClusters(newContext("project:prj-b"), platformClient); err == nil {
+		t.Error("expected error for a missing project")
+	}
+}
@@ -0,0 +1,599 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// cacheAuthorizationTTL is how long an allowed access review is reused.
+	cacheAuthorizationTTL = 30 * time.Second
+	// cacheEvictionInterval is the interval to evict idle informers and
+	// informers over the object budget.
+	cacheEvictionInterval = time.Minute
+
+	cacheHitLabel  = "hit"
+	cacheMissLabel = "miss"
+)
+
+var (
+	cacheRequestsCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "tke_platform_proxy_cache_requests",
+			Help:           "Counter of proxy get and list requests eligible for the cache broken out by resource and result.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"resource", "result"},
+	)
+
+	cacheStalenessHistogram = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "tke_platform_proxy_cache_staleness_seconds",
+			Help:           "Seconds since the informer serving a request last heard from the cluster, watch bookmarks included.",
+			Buckets:        []float64{1, 5, 15, 30, 60, 120, 300, 600},
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"resource"},
+	)
+
+	cacheInformersGauge = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Name:           "tke_platform_proxy_cache_informers",
+			Help:           "Number of running proxy cache informers.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	cacheObjectsGauge = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Name:           "tke_platform_proxy_cache_objects",
+			Help:           "Number of objects held by the proxy cache informers.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+)
+
+func init() {
+	legacyregistry.MustRegister(cacheRequestsCounter)
+	legacyregistry.MustRegister(cacheStalenessHistogram)
+	legacyregistry.MustRegister(cacheInformersGauge)
+	legacyregistry.MustRegister(cacheObjectsGauge)
+}
+
+// CacheConfig is the configuration of the proxy cache.
+type CacheConfig struct {
+	// Resources are the resources served from the cache, in the form of
+	// resource.group, e.g. pods or deployments.apps. Their gets and lists
+	// without resource version are served from the cache as well, which may
+	// be slightly stale.
+	Resources []string
+	// MaxObjects is the number of objects held by all informers, no informer
+	// is started when it is reached and the least recently used informers are
+	// stopped when it is exceeded.
+	MaxObjects int
+	// IdleTimeout is how long an informer is kept without being used.
+	IdleTimeout time.Duration
+}
+
+// Cache serves get and list of the proxy storages from shared informers of
+// the clusters. The informers are started on first use with the admin
+// credential of the cluster, the access of the caller is checked by a
+// SelfSubjectAccessReview against the cluster.
+type Cache struct {
+	config         CacheConfig
+	resources      sets.String
+	platformClient platforminternalclient.PlatformInterface
+	authorizations *utilcache.Expiring
+
+	lock      sync.Mutex
+	informers map[cacheKey]*cacheInformer
+	// starting are the informers being started out of the lock
+	starting map[cacheKey]bool
+	stopped  bool
+}
+
+type cacheKey struct {
+	clusterName string
+	resource    schema.GroupVersionResource
+}
+
+type cacheInformer struct {
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	// lastUsed and lastHeard are unix nanoseconds
+	lastUsed  int64
+	lastHeard int64
+}
+
+var proxyCache *Cache
+
+// errCacheOverBudget is returned when no informer is started over the
+// object budget.
+var errCacheOverBudget = errors.New("proxy cache is over the object budget")
+
+// SetCache sets the cache used by the proxy storages, the proxy storages
+// always request the clusters if it is not set.
+func SetCache(c *Cache) {
+	proxyCache = c
+}
+
+// NewCache creates the proxy cache by given config.
+func NewCache(config CacheConfig, platformClient platforminternalclient.PlatformInterface) *Cache {
+	return &Cache{
+		config:         config,
+		resources:      sets.NewString(config.Resources...),
+		platformClient: platformClient,
+		authorizations: utilcache.NewExpiring(),
+		informers:      make(map[cacheKey]*cacheInformer),
+		starting:       make(map[cacheKey]bool),
+	}
+}
+
+// Run evicts the idle informers and the informers over the object budget
+// until stopCh is closed.
+func (c *Cache) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(cacheEvictionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			c.lock.Lock()
+			c.stopped = true
+			for key := range c.informers {
+				c.evict(key)
+			}
+			c.lock.Unlock()
+			return
+		case <-ticker.C:
+			c.evictIdleAndOverBudget(time.Now())
+		}
+	}
+}
+
+func (c *Cache) evictIdleAndOverBudget(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	keys := make([]cacheKey, 0, len(c.informers))
+	for key, i := range c.informers {
+		if c.config.IdleTimeout > 0 && now.Sub(time.Unix(0, atomic.LoadInt64(&i.lastUsed))) > c.config.IdleTimeout {
+			log.Info("Evict idle proxy cache informer", log.String("clusterName", key.clusterName), log.String("resource", key.resource.String()))
+			c.evict(key)
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	sizes := make(map[cacheKey]int, len(keys))
+	total := 0
+	for _, key := range keys {
+		sizes[key] = c.informers[key].objects()
+		total += sizes[key]
+	}
+	// least recently used first
+	sort.Slice(keys, func(i, j int) bool {
+		return atomic.LoadInt64(&c.informers[keys[i]].lastUsed) < atomic.LoadInt64(&c.informers[keys[j]].lastUsed)
+	})
+	for _, key := range keys {
+		if c.config.MaxObjects <= 0 || total <= c.config.MaxObjects {
+			break
+		}
+		log.Info("Evict proxy cache informer over object budget", log.String("clusterName", key.clusterName), log.String("resource", key.resource.String()), log.Int("objects", sizes[key]))
+		c.evict(key)
+		total -= sizes[key]
+	}
+
+	cacheInformersGauge.Set(float64(len(c.informers)))
+	cacheObjectsGauge.Set(float64(total))
+}
+
+// overBudget checks whether the informers hold MaxObjects objects already, no
+// more informer is started then.
+func (c *Cache) overBudget(informers []*cacheInformer) bool {
+	if c.config.MaxObjects <= 0 {
+		return false
+	}
+	total := 0
+	for _, i := range informers {
+		total += i.objects()
+	}
+	return total >= c.config.MaxObjects
+}
+
+// objects returns the number of objects held by the informer.
+func (i *cacheInformer) objects() int {
+	return len(i.informer.GetStore().ListKeys())
+}
+
+// evict stops the informer, the caller must hold the lock.
+func (c *Cache) evict(key cacheKey) {
+	close(c.informers[key].stopCh)
+	delete(c.informers, key)
+}
+
+// cacheRequest is a get or list of a proxy storage eligible for the cache.
+type cacheRequest struct {
+	store       *Store
+	requestInfo *request.RequestInfo
+	clusterName string
+	key         cacheKey
+}
+
+// newCacheRequest returns nil if the request is not for a cached resource.
+func (c *Cache) newCacheRequest(ctx context.Context, s *Store) *cacheRequest {
+	requestInfo, ok := request.RequestInfoFrom(ctx)
+	if !ok || requestInfo.Subresource != "" {
+		return nil
+	}
+	clusterName := filter.ClusterFrom(ctx)
+	if clusterName == "" || clusterName == filter.AllClusters {
+		return nil
+	}
+	resource := schema.GroupVersionResource{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion, Resource: requestInfo.Resource}
+	if !c.resources.Has(resource.GroupResource().String()) {
+		return nil
+	}
+	return &cacheRequest{
+		store:       s,
+		requestInfo: requestInfo,
+		clusterName: clusterName,
+		key:         cacheKey{clusterName: clusterName, resource: resource},
+	}
+}
+
+// informer returns the synced informer of the request, the informer is
+// started if it is not running and the object budget is not exceeded. The
+// objects are counted and the informer is started out of the lock, the
+// requests of the same informer are not served by the cache until it is
+// started.
+func (c *Cache) informer(r *cacheRequest) (*cacheInformer, bool) {
+	c.lock.Lock()
+	if i, ok := c.informers[r.key]; ok {
+		c.lock.Unlock()
+		atomic.StoreInt64(&i.lastUsed, time.Now().UnixNano())
+		return i, i.informer.HasSynced()
+	}
+	if c.stopped || c.starting[r.key] {
+		c.lock.Unlock()
+		return nil, false
+	}
+	c.starting[r.key] = true
+	running := make([]*cacheInformer, 0, len(c.informers))
+	for _, i := range c.informers {
+		running = append(running, i)
+	}
+	c.lock.Unlock()
+
+	var i *cacheInformer
+	err := errCacheOverBudget
+	if !c.overBudget(running) {
+		i, err = c.startInformer(r)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.starting, r.key)
+	if err == errCacheOverBudget {
+		return nil, false
+	}
+	if err != nil {
+		log.Warn("Failed to start proxy cache informer", log.String("clusterName", r.clusterName), log.String("resource", r.key.resource.String()), log.Err(err))
+		return nil, false
+	}
+	if c.stopped {
+		close(i.stopCh)
+		return nil, false
+	}
+	c.informers[r.key] = i
+	cacheInformersGauge.Set(float64(len(c.informers)))
+	return i, i.informer.HasSynced()
+}
+
+// startInformer starts an informer of the resource of the request with the
+// admin credential of the cluster.
+func (c *Cache) startInformer(r *cacheRequest) (*cacheInformer, error) {
+	ctx := context.Background()
+	cluster, err := c.platformClient.Clusters().Get(ctx, r.clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	clusterWrapper, err := clusterprovider.GetCluster(ctx, c.platformClient, cluster, clusterprovider.AdminUsername)
+	if err != nil {
+		return nil, err
+	}
+	config, err := clusterWrapper.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	client := RESTClientFor(clientSet, r.requestInfo.APIGroup, r.requestInfo.APIVersion)
+
+	i := &cacheInformer{stopCh: make(chan struct{})}
+	heard := func() {
+		atomic.StoreInt64(&i.lastHeard, time.Now().UnixNano())
+	}
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			result := r.store.NewListFunc()
+			err := client.Get().
+				Resource(r.requestInfo.Resource).
+				SpecificallyVersionedParams(&options, platform.ParameterCodec, metav1.SchemeGroupVersion).
+				Do(ctx).
+				Into(result)
+			if err == nil {
+				heard()
+			}
+			return result, err
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.Watch = true
+			w, err := client.Get().
+				Resource(r.requestInfo.Resource).
+				SpecificallyVersionedParams(&options, platform.ParameterCodec, metav1.SchemeGroupVersion).
+				Watch(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+				heard()
+				return in, true
+			}), nil
+		},
+	}
+	i.informer = cache.NewSharedIndexInformer(lw, r.store.NewFunc(), 0, cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+	})
+	atomic.StoreInt64(&i.lastUsed, time.Now().UnixNano())
+	go i.informer.Run(i.stopCh)
+
+	return i, nil
+}
+
+// authorized checks whether the caller can do the request in the cluster,
+// only the allowed reviews are cached.
+func (c *Cache) authorized(ctx context.Context, r *cacheRequest, verb string) bool {
+	key := authorizationKey(ctx, r, verb)
+	if _, ok := c.authorizations.Get(key); ok {
+		return true
+	}
+
+	cluster, err := c.platformClient.Clusters().Get(ctx, r.clusterName, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+	config, err := GetConfigForCluster(ctx, c.platformClient, cluster)
+	if err != nil {
+		return false
+	}
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return false
+	}
+	review, err := clientSet.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: r.requestInfo.Namespace,
+				Verb:      verb,
+				Group:     r.key.resource.Group,
+				Version:   r.key.resource.Version,
+				Resource:  r.key.resource.Resource,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil || !review.Status.Allowed {
+		return false
+	}
+	c.authorizations.Set(key, true, cacheAuthorizationTTL)
+	return true
+}
+
+// authorizationKey returns the key of the cached review of the caller.
+func authorizationKey(ctx context.Context, r *cacheRequest, verb string) string {
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	return strings.Join([]string{username, tenantID, strings.Join(authentication.Groups(ctx), ","),
+		r.clusterName, verb, r.key.resource.String(), r.requestInfo.Namespace}, "/")
+}
+
+// cacheable checks whether the request can be served by the cache with the
+// resource version semantics, a request with an exact resource version
+// always goes to the cluster. The resources configured to be cached are
+// served from the cache without resource version too, like with "0", as
+// most requests of the console carry none.
+func cacheable(match metav1.ResourceVersionMatch) bool {
+	return match != metav1.ResourceVersionMatchExact
+}
+
+// fresh checks whether the informer is not older than the resource version.
+func fresh(i *cacheInformer, resourceVersion string) bool {
+	if resourceVersion == "" || resourceVersion == "0" {
+		return true
+	}
+	requested, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		return false
+	}
+	synced, err := strconv.ParseUint(i.informer.LastSyncResourceVersion(), 10, 64)
+	if err != nil {
+		return false
+	}
+	return synced >= requested
+}
+
+// cachedFields are the fields selectable from the cache.
+var cachedFields = sets.NewString("metadata.name", "metadata.namespace")
+
+// selectable checks whether the field selector can be matched by the cache.
+func selectable(selector fields.Selector) bool {
+	if selector == nil {
+		return true
+	}
+	for _, requirement := range selector.Requirements() {
+		if !cachedFields.Has(requirement.Field) {
+			return false
+		}
+	}
+	return true
+}
+
+// serve checks the request and returns the synced informer to serve it. The
+// informer is only started for an authorized request, it lists and watches
+// with the credential of the cluster.
+func (c *Cache) serve(ctx context.Context, r *cacheRequest, verb, resourceVersion string, match metav1.ResourceVersionMatch) (*cacheInformer, bool) {
+	if !cacheable(match) || !c.authorized(ctx, r, verb) {
+		cacheRequestsCounter.WithLabelValues(r.key.resource.Resource, cacheMissLabel).Inc()
+		return nil, false
+	}
+	i, synced := c.informer(r)
+	if !synced || !fresh(i, resourceVersion) {
+		cacheRequestsCounter.WithLabelValues(r.key.resource.Resource, cacheMissLabel).Inc()
+		return nil, false
+	}
+	cacheRequestsCounter.WithLabelValues(r.key.resource.Resource, cacheHitLabel).Inc()
+	cacheStalenessHistogram.WithLabelValues(r.key.resource.Resource).Observe(time.Since(time.Unix(0, atomic.LoadInt64(&i.lastHeard))).Seconds())
+	return i, true
+}
+
+// cachedGet returns the object from the cache if the request can be served
+// by it.
+func (s *Store) cachedGet(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, bool) {
+	if proxyCache == nil || options == nil {
+		return nil, false
+	}
+	r := proxyCache.newCacheRequest(ctx, s)
+	if r == nil {
+		return nil, false
+	}
+	i, ok := proxyCache.serve(ctx, r, "get", options.ResourceVersion, "")
+	if !ok {
+		return nil, false
+	}
+
+	key := name
+	if r.requestInfo.Namespace != "" && s.Namespaced {
+		key = r.requestInfo.Namespace + "/" + name
+	}
+	obj, exists, err := i.informer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		// let the cluster return the not found error
+		return nil, false
+	}
+	return obj.(runtime.Object).DeepCopyObject(), true
+}
+
+// cachedList returns the list from the cache if the request can be served by
+// it. Like the watch cache of kube-apiserver the limit is ignored.
+func (s *Store) cachedList(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, bool) {
+	if proxyCache == nil || options == nil || options.Continue != "" || !selectable(options.FieldSelector) {
+		return nil, false
+	}
+	r := proxyCache.newCacheRequest(ctx, s)
+	if r == nil {
+		return nil, false
+	}
+	i, ok := proxyCache.serve(ctx, r, "list", options.ResourceVersion, options.ResourceVersionMatch)
+	if !ok {
+		return nil, false
+	}
+
+	var objs []interface{}
+	if r.requestInfo.Namespace != "" && s.Namespaced {
+		var err error
+		if objs, err = i.informer.GetIndexer().ByIndex(cache.NamespaceIndex, r.requestInfo.Namespace); err != nil {
+			return nil, false
+		}
+	} else {
+		objs = i.informer.GetStore().List()
+	}
+	labelSelector := options.LabelSelector
+	if labelSelector == nil {
+		labelSelector = labels.Everything()
+	}
+	fieldSelector := options.FieldSelector
+	if fieldSelector == nil {
+		fieldSelector = fields.Everything()
+	}
+	var items []runtime.Object
+	for _, obj := range objs {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, false
+		}
+		if !labelSelector.Matches(labels.Set(accessor.GetLabels())) {
+			continue
+		}
+		if !fieldSelector.Matches(fields.Set{"metadata.name": accessor.GetName(), "metadata.namespace": accessor.GetNamespace()}) {
+			continue
+		}
+		// the objects in the store are shared with the informer
+		items = append(items, obj.(runtime.Object).DeepCopyObject())
+	}
+	sort.Slice(items, func(a, b int) bool {
+		x, _ := meta.Accessor(items[a])
+		y, _ := meta.Accessor(items[b])
+		if x.GetNamespace() != y.GetNamespace() {
+			return x.GetNamespace() < y.GetNamespace()
+		}
+		return x.GetName() < y.GetName()
+	})
+
+	result := s.NewListFunc()
+	if err := meta.SetList(result, items); err != nil {
+		return nil, false
+	}
+	listMeta, err := meta.ListAccessor(result)
+	if err != nil {
+		return nil, false
+	}
+	listMeta.SetResourceVersion(i.informer.LastSyncResourceVersion())
+	return result, true
+}
@@ -0,0 +1,138 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestCacheable(t *testing.T) {
+	tests := []struct {
+		match    metav1.ResourceVersionMatch
+		expected bool
+	}{
+		{"", true},
+		{metav1.ResourceVersionMatchNotOlderThan, true},
+		{metav1.ResourceVersionMatchExact, false},
+	}
+	for _, test := range tests {
+		if got := cacheable(test.match); got != test.expected {
+			t.Errorf("match %q: expected %v, got %v", test.match, test.expected, got)
+		}
+	}
+}
+
+func TestSelectable(t *testing.T) {
+	if !selectable(fields.OneTermEqualSelector("metadata.name", "foo")) {
+		t.Error("expected metadata.name to be selectable")
+	}
+	if selectable(fields.OneTermEqualSelector("spec.nodeName", "node-1")) {
+		t.Error("expected spec.nodeName not to be selectable")
+	}
+}
+
+func TestEvictIdleAndOverBudget(t *testing.T) {
+	now := time.Now()
+	c := NewCache(CacheConfig{MaxObjects: 2, IdleTimeout: 10 * time.Minute}, nil)
+	add := func(clusterName string, objects int, lastUsed time.Time) cacheKey {
+		key := cacheKey{clusterName: clusterName, resource: schema.GroupVersionResource{Version: "v1", Resource: "pods"}}
+		informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &corev1.Pod{}, 0, cache.Indexers{})
+		for i := 0; i < objects; i++ {
+			_ = informer.GetStore().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: string(rune('a' + i))}})
+		}
+		c.informers[key] = &cacheInformer{informer: informer, stopCh: make(chan struct{}), lastUsed: lastUsed.UnixNano()}
+		return key
+	}
+	idle := add("cls-idle", 1, now.Add(-time.Hour))
+	old := add("cls-old", 2, now.Add(-time.Minute))
+	recent := add("cls-recent", 2, now)
+
+	c.evictIdleAndOverBudget(now)
+
+	if _, ok := c.informers[idle]; ok {
+		t.Error("expected idle informer to be evicted")
+	}
+	if _, ok := c.informers[old]; ok {
+		t.Error("expected least recently used informer to be evicted over budget")
+	}
+	if _, ok := c.informers[recent]; !ok {
+		t.Error("expected recently used informer to be kept")
+	}
+}
+
+func TestInformerOverBudget(t *testing.T) {
+	c := NewCache(CacheConfig{MaxObjects: 1}, nil)
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &corev1.Pod{}, 0, cache.Indexers{})
+	_ = informer.GetStore().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "a"}})
+	running := cacheKey{clusterName: "cls-a", resource: schema.GroupVersionResource{Version: "v1", Resource: "pods"}}
+	c.informers[running] = &cacheInformer{informer: informer, stopCh: make(chan struct{})}
+
+	// the informer is not started, which would request the nil platform client
+	r := &cacheRequest{clusterName: "cls-b", key: cacheKey{clusterName: "cls-b", resource: running.resource}}
+	if i, ok := c.informer(r); i != nil || ok {
+		t.Errorf("informer() = %v, %v, expected no informer over budget", i, ok)
+	}
+	if _, ok := c.informers[r.key]; ok || c.starting[r.key] {
+		t.Error("expected no informer to be started over budget")
+	}
+}
+
+func TestServeWithoutResourceVersion(t *testing.T) {
+	c := NewCache(CacheConfig{MaxObjects: 10}, nil)
+	r := &cacheRequest{
+		requestInfo: &request.RequestInfo{Verb: "list", Resource: "pods"},
+		clusterName: "cls-a",
+		key:         cacheKey{clusterName: "cls-a", resource: schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+	}
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{
+		ListFunc: func(metav1.ListOptions) (runtime.Object, error) {
+			return &corev1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: "10"}}, nil
+		},
+		WatchFunc: func(metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}, &corev1.Pod{}, 0, cache.Indexers{})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		t.Fatal("informer is not synced")
+	}
+	c.informers[r.key] = &cacheInformer{informer: informer, stopCh: make(chan struct{})}
+	ctx := context.Background()
+	c.authorizations.Set(authorizationKey(ctx, r, "list"), true, time.Minute)
+
+	if i, ok := c.serve(ctx, r, "list", "", ""); !ok || i.informer != informer {
+		t.Errorf("serve() = %v, %v, expected a request without resource version to be served from the cache", i, ok)
+	}
+	if _, ok := c.serve(ctx, r, "list", "10", metav1.ResourceVersionMatchExact); ok {
+		t.Error("expected a request with an exact resource version not to be served from the cache")
+	}
+}
@@ -62,12 +62,21 @@ func GetConfig(ctx context.Context, platformClient platforminternalclient.Platfo
 	if clusterName == "" {
 		return nil, errors.NewBadRequest("clusterName is required")
 	}
+	if clusterName == filter.AllClusters {
+		return nil, errors.NewBadRequest("requesting all clusters is only supported by list and watch")
+	}
 
 	cluster, err := platformClient.Clusters().Get(ctx, clusterName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
+	return GetConfigForCluster(ctx, platformClient, cluster)
+}
+
+// GetConfigForCluster returns the rest config to access the given cluster as
+// the user of the request.
+func GetConfigForCluster(ctx context.Context, platformClient platforminternalclient.PlatformInterface, cluster *platform.Cluster) (*rest.Config, error) {
 	if cluster.Status.Locked != nil && *cluster.Status.Locked {
 		return nil, fmt.Errorf("cluster %s has been locked", cluster.ObjectMeta.Name)
 	}
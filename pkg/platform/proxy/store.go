@@ -96,13 +96,21 @@ func (s *Store) ConvertToTable(ctx context.Context, object runtime.Object, table
 // List returns a list of items matching labels and field according to the
 // backend kubernetes api server.
 func (s *Store) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
-	client, requestInfo, err := RESTClient(ctx, s.PlatformClient)
-	if err != nil {
-		return nil, err
+	if filter.ClusterFrom(ctx) == filter.AllClusters {
+		return s.listAllClusters(ctx, options)
 	}
 
 	fuzzyResourceName := filter.FuzzyResourceFrom(ctx)
 	options, fuzzyResourceName = apiserverutil.InterceptFuzzyResourceNameFromListOptions(options, fuzzyResourceName)
+	if result, ok := s.cachedList(ctx, options); ok {
+		filterFuzzyResourceName(result, fuzzyResourceName)
+		return result, nil
+	}
+
+	client, requestInfo, err := RESTClient(ctx, s.PlatformClient)
+	if err != nil {
+		return nil, err
+	}
 	v1options := &v1.ListOptions{}
 	err = proxyConvert.Convert(options, v1options, &conversion.Meta{})
 	if err != nil {
@@ -121,37 +129,16 @@ func (s *Store) List(ctx context.Context, options *metainternalversion.ListOptio
 		return nil, err
 	}
 
-	if fuzzyResourceName != "" {
-		if _, ok := result.(v1.ListInterface); ok {
-			v := reflect.ValueOf(result).Elem()
-			if items := v.FieldByName("Items"); items != (reflect.Value{}) {
-				if items.Kind() == reflect.Slice || items.Kind() == reflect.Array {
-					newResult := make([]reflect.Value, 0)
-					for i := 0; i < items.Len(); i++ {
-						item := items.Index(i)
-						for j := 0; j < item.Type().NumField(); j++ {
-							itemChild := item.Field(j)
-							if metadata, ok := itemChild.Interface().(v1.ObjectMeta); ok {
-								if strings.Contains(strings.ToLower(metadata.Name), strings.ToLower(fuzzyResourceName)) {
-									newResult = append(newResult, item)
-								}
-								break
-							}
-						}
-					}
-					slice := reflect.MakeSlice(items.Type(), 0, 0)
-					newResultValue := reflect.Append(slice, newResult...)
-					v.FieldByName("Items").Set(newResultValue)
-				}
-			}
-		}
-	}
+	filterFuzzyResourceName(result, fuzzyResourceName)
 
 	return result, nil
 }
 
 // Get retrieves the item from storage.
 func (s *Store) Get(ctx context.Context, name string, options *v1.GetOptions) (runtime.Object, error) {
+	if result, ok := s.cachedGet(ctx, name, options); ok {
+		return result, nil
+	}
 	client, requestInfo, err := RESTClient(ctx, s.PlatformClient)
 	if err != nil {
 		return nil, err
@@ -177,6 +164,9 @@ func (s *Store) Get(ctx context.Context, name string, options *v1.GetOptions) (r
 // a matcher that matches by key. SelectionPredicate does this for you
 // automatically.
 func (s *Store) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	if filter.ClusterFrom(ctx) == filter.AllClusters {
+		return s.watchAllClusters(ctx, options)
+	}
 	client, requestInfo, err := RESTClient(ctx, s.PlatformClient)
 	if err != nil {
 		return nil, err
@@ -326,6 +316,36 @@ func (s *Store) DeleteCollection(ctx context.Context, options *v1.DeleteOptions,
 	return returnedObj, nil
 }
 
+// filterFuzzyResourceName removes the items whose name does not contain the
+// fuzzy resource name from the list.
+func filterFuzzyResourceName(result runtime.Object, fuzzyResourceName string) {
+	if fuzzyResourceName != "" {
+		if _, ok := result.(v1.ListInterface); ok {
+			v := reflect.ValueOf(result).Elem()
+			if items := v.FieldByName("Items"); items != (reflect.Value{}) {
+				if items.Kind() == reflect.Slice || items.Kind() == reflect.Array {
+					newResult := make([]reflect.Value, 0)
+					for i := 0; i < items.Len(); i++ {
+						item := items.Index(i)
+						for j := 0; j < item.Type().NumField(); j++ {
+							itemChild := item.Field(j)
+							if metadata, ok := itemChild.Interface().(v1.ObjectMeta); ok {
+								if strings.Contains(strings.ToLower(metadata.Name), strings.ToLower(fuzzyResourceName)) {
+									newResult = append(newResult, item)
+								}
+								break
+							}
+						}
+					}
+					slice := reflect.MakeSlice(items.Type(), 0, 0)
+					newResultValue := reflect.Append(slice, newResult...)
+					v.FieldByName("Items").Set(newResultValue)
+				}
+			}
+		}
+	}
+}
+
 // qualifiedResourceFromContext attempts to retrieve a GroupResource from the context's request info.
 // If the context has no request info, DefaultQualifiedResource is used.
 func (s *Store) qualifiedResourceFromContext(ctx context.Context) schema.GroupResource {
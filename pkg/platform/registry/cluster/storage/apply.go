@@ -111,6 +111,12 @@ func (r *ApplyREST) Connect(ctx context.Context, clusterName string, opts runtim
 	}
 
 	clusterOpts := opts.(*platform.ClusterApplyOptions)
+	if err := validateApplyOptions(clusterOpts); err != nil {
+		return nil, err
+	}
+	if clusterOpts.ServerSide {
+		return newServerSideApplyHandler(clusterName, client, dynamicClient, clusterOpts), nil
+	}
 
 	return &handler{
 		clusterName:       clusterName,
@@ -185,14 +191,27 @@ func (h *handler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
 }
 
 func (h *handler) decode(r io.Reader) error {
+	exts, groupVersionKinds, err := decodeObjects(r)
+	if err != nil {
+		return err
+	}
+	h.exts = append(h.exts, exts...)
+	h.groupVersionKinds = append(h.groupVersionKinds, groupVersionKinds...)
+	return nil
+}
+
+// decodeObjects decodes the multi-document yaml or json body into objects.
+func decodeObjects(r io.Reader) ([]*runtime.RawExtension, []*schema.GroupVersionKind, error) {
+	var exts []*runtime.RawExtension
+	var groupVersionKinds []*schema.GroupVersionKind
 	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
 	for {
 		ext := runtime.RawExtension{}
 		if err := decoder.Decode(&ext); err != nil {
 			if err == io.EOF {
-				return nil
+				return exts, groupVersionKinds, nil
 			}
-			return fmt.Errorf("error parsing: %v", err)
+			return nil, nil, fmt.Errorf("error parsing: %v", err)
 		}
 		ext.Raw = bytes.TrimSpace(ext.Raw)
 		if len(ext.Raw) == 0 || bytes.Equal(ext.Raw, []byte("null")) {
@@ -200,11 +219,11 @@ func (h *handler) decode(r io.Reader) error {
 		}
 		obj, gkv, err := unstructured.UnstructuredJSONScheme.Decode(ext.Raw, nil, nil)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		ext.Object = obj
-		h.groupVersionKinds = append(h.groupVersionKinds, gkv)
-		h.exts = append(h.exts, &ext)
+		groupVersionKinds = append(groupVersionKinds, gkv)
+		exts = append(exts, &ext)
 	}
 }
 
@@ -0,0 +1,468 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// ApplySetLabel marks an object applied to a cluster as a member of the
+	// apply set with the ID in value.
+	ApplySetLabel = "platform.tkestack.io/applyset"
+	// applySetIDAnnotation records the ID of the apply set on its parent.
+	applySetIDAnnotation = "platform.tkestack.io/applyset-id"
+	// applySetGroupResourcesAnnotation records the group resources which the
+	// members of an apply set may belong to, on the parent config map of the
+	// set in the cluster. Prune only lists these group resources.
+	applySetGroupResourcesAnnotation = "platform.tkestack.io/applyset-group-resources"
+	applySetParentPrefix             = "tke-applyset-"
+	defaultApplyFieldManager         = "tke-apply"
+)
+
+func validateApplyOptions(opts *platform.ClusterApplyOptions) error {
+	if !opts.ServerSide {
+		if opts.FieldManager != "" || opts.Force || opts.ApplySet != "" || opts.Prune || opts.DryRun {
+			return errors.NewBadRequest("fieldManager, force, applySet, prune and dryRun require serverSide")
+		}
+		return nil
+	}
+	if opts.NotUpdate {
+		return errors.NewBadRequest("notUpdate is not supported with serverSide")
+	}
+	if opts.Prune && opts.ApplySet == "" {
+		return errors.NewBadRequest("prune requires applySet")
+	}
+	if opts.ApplySet != "" {
+		if msgs := validation.IsDNS1123Label(opts.ApplySet); len(msgs) > 0 {
+			return errors.NewBadRequest(fmt.Sprintf("invalid applySet %q: %s", opts.ApplySet, strings.Join(msgs, ", ")))
+		}
+	}
+	return nil
+}
+
+// serverSideApplyHandler applies the objects of the payload by server-side
+// apply, and optionally prunes the members of the apply set which are no
+// longer in the payload.
+type serverSideApplyHandler struct {
+	client        kubernetes.Interface
+	dynamicClient dynamic.Interface
+	opts          *platform.ClusterApplyOptions
+	// applySetID is the label value of the members of the apply set, it is
+	// empty without apply set.
+	applySetID string
+}
+
+func newServerSideApplyHandler(clusterName string, client kubernetes.Interface, dynamicClient dynamic.Interface, opts *platform.ClusterApplyOptions) *serverSideApplyHandler {
+	h := &serverSideApplyHandler{
+		client:        client,
+		dynamicClient: dynamicClient,
+		opts:          opts,
+	}
+	if opts.ApplySet != "" {
+		h.applySetID = applySetID(clusterName, metav1.NamespaceSystem, opts.ApplySet)
+	}
+	return h
+}
+
+// applySetID derives the ID of the apply set from its owner, which is the
+// cluster and the parent of the set, so that the objects labeled by a set of
+// the same name elsewhere or by other tools are never members of it.
+func applySetID(clusterName, namespace, name string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{clusterName, namespace, applySetParentPrefix + name}, "/")))
+	return "applyset-" + base64.RawURLEncoding.EncodeToString(sum[:]) + "-v1"
+}
+
+type serverSideApplyObject struct {
+	obj      *unstructured.Unstructured
+	mapping  *meta.RESTMapping
+	resource dynamic.ResourceInterface
+}
+
+func (h *serverSideApplyHandler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	defer func() {
+		_ = req.Body.Close()
+	}()
+	exts, _, err := decodeObjects(req.Body)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(err.Error()).Status(), writer)
+		return
+	}
+	if len(exts) == 0 {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest("must special at lease one resource").Status(), writer)
+		return
+	}
+	result, code := h.apply(req.Context(), exts)
+	responsewriters.WriteRawJSON(code, result, writer)
+}
+
+// apply returns the result of every object and the status code of the first
+// failure. Nothing is applied if any object can not be resolved, and nothing
+// is pruned if any object fails to apply.
+func (h *serverSideApplyHandler) apply(ctx context.Context, exts []*runtime.RawExtension) (*platformv1.ClusterApplyResult, int) {
+	result := &platformv1.ClusterApplyResult{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterApplyResult",
+			APIVersion: platformv1.SchemeGroupVersion.String(),
+		},
+		DryRun: h.opts.DryRun,
+	}
+	code := http.StatusOK
+	fail := func(err error) {
+		if code == http.StatusOK {
+			code = errorCode(err)
+		}
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(h.client.Discovery()))
+	var objects []*serverSideApplyObject
+	for _, obj := range flattenObjects(exts) {
+		object, err := h.resolve(mapper, obj)
+		if err != nil {
+			result.Items = append(result.Items, applyObjectResult(obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName(), platformv1.ClusterApplyActionFailed, err))
+			fail(err)
+			continue
+		}
+		objects = append(objects, object)
+	}
+	if code != http.StatusOK {
+		return result, code
+	}
+
+	groupResources := sets.NewString()
+	for _, object := range objects {
+		groupResources.Insert(object.mapping.Resource.GroupResource().String())
+	}
+	previousGroupResources := sets.NewString()
+	if h.opts.ApplySet != "" {
+		// Record the group resources before applying, so that the members
+		// are still known to prune if the apply is interrupted.
+		previous, err := h.updateApplySetParent(ctx, groupResources, true)
+		if err != nil {
+			return result, errorCode(err)
+		}
+		previousGroupResources = previous
+	}
+
+	applied := sets.NewString()
+	for _, object := range objects {
+		action, err := h.applyObject(ctx, object)
+		if err != nil {
+			fail(err)
+		} else {
+			applied.Insert(applyObjectKey(object.mapping.Resource.GroupResource(), object.obj.GetNamespace(), object.obj.GetName()))
+		}
+		result.Items = append(result.Items, applyObjectResult(object.obj.GroupVersionKind(), object.obj.GetNamespace(), object.obj.GetName(), action, err))
+	}
+
+	if !h.opts.Prune || code != http.StatusOK {
+		return result, code
+	}
+	code = h.prune(ctx, mapper, previousGroupResources.Union(groupResources), applied, result)
+	if code == http.StatusOK {
+		if _, err := h.updateApplySetParent(ctx, groupResources, false); err != nil {
+			log.Warn("Failed to update the parent of apply set", log.String("applySet", h.opts.ApplySet), log.Err(err))
+		}
+	}
+	return result, code
+}
+
+// resolve maps the object to its resource in the cluster, and labels it as
+// a member of the apply set.
+func (h *serverSideApplyHandler) resolve(mapper meta.RESTMapper, obj *unstructured.Unstructured) (*serverSideApplyObject, error) {
+	if obj.GetName() == "" {
+		return nil, errors.NewBadRequest("name must be specified for server-side apply")
+	}
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+		return nil, err
+	}
+
+	var resource dynamic.ResourceInterface = h.dynamicClient.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(metav1.NamespaceDefault)
+		}
+		resource = h.dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+	} else {
+		obj.SetNamespace("")
+	}
+
+	if h.opts.ApplySet != "" {
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = make(map[string]string)
+		}
+		objLabels[ApplySetLabel] = h.applySetID
+		obj.SetLabels(objLabels)
+	}
+
+	return &serverSideApplyObject{
+		obj:      obj,
+		mapping:  mapping,
+		resource: resource,
+	}, nil
+}
+
+func (h *serverSideApplyHandler) applyObject(ctx context.Context, object *serverSideApplyObject) (platformv1.ClusterApplyAction, error) {
+	name := object.obj.GetName()
+	existing, err := object.resource.Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return "", err
+	}
+
+	data, err := object.obj.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	fieldManager := h.opts.FieldManager
+	if fieldManager == "" {
+		fieldManager = defaultApplyFieldManager
+	}
+	force := h.opts.Force
+	applied, err := object.resource.Patch(ctx, name, types.ApplyPatchType, data, metav1.PatchOptions{
+		DryRun:       h.dryRun(),
+		Force:        &force,
+		FieldManager: fieldManager,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case existing == nil:
+		return platformv1.ClusterApplyActionCreated, nil
+	case unchangedByApply(existing, applied):
+		return platformv1.ClusterApplyActionUnchanged, nil
+	default:
+		return platformv1.ClusterApplyActionConfigured, nil
+	}
+}
+
+// prune deletes the members of the apply set in the given group resources
+// which were not applied, and returns the status code of the first failure.
+func (h *serverSideApplyHandler) prune(ctx context.Context, mapper meta.RESTMapper, groupResources sets.String, applied sets.String, result *platformv1.ClusterApplyResult) int {
+	code := http.StatusOK
+	selector := labels.Set{ApplySetLabel: h.applySetID}.AsSelector().String()
+	propagation := metav1.DeletePropagationBackground
+
+	for _, groupResource := range groupResources.List() {
+		gvr, err := mapper.ResourceFor(schema.ParseGroupResource(groupResource).WithVersion(""))
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				// The resource has been removed from the cluster, so are its
+				// objects.
+				continue
+			}
+			return errorCode(err)
+		}
+		gvk, err := mapper.KindFor(gvr)
+		if err != nil {
+			return errorCode(err)
+		}
+
+		list, err := h.dynamicClient.Resource(gvr).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			result.Items = append(result.Items, applyObjectResult(gvk, "", "", platformv1.ClusterApplyActionFailed, err))
+			if code == http.StatusOK {
+				code = errorCode(err)
+			}
+			continue
+		}
+		for i := range list.Items {
+			item := &list.Items[i]
+			if item.GetDeletionTimestamp() != nil || applied.Has(applyObjectKey(gvr.GroupResource(), item.GetNamespace(), item.GetName())) {
+				continue
+			}
+			var resource dynamic.ResourceInterface = h.dynamicClient.Resource(gvr)
+			if item.GetNamespace() != "" {
+				resource = h.dynamicClient.Resource(gvr).Namespace(item.GetNamespace())
+			}
+			uid := item.GetUID()
+			err := resource.Delete(ctx, item.GetName(), metav1.DeleteOptions{
+				DryRun:            h.dryRun(),
+				Preconditions:     &metav1.Preconditions{UID: &uid},
+				PropagationPolicy: &propagation,
+			})
+			if errors.IsNotFound(err) {
+				continue
+			}
+			if err != nil && code == http.StatusOK {
+				code = errorCode(err)
+			}
+			result.Items = append(result.Items, applyObjectResult(gvk, item.GetNamespace(), item.GetName(), platformv1.ClusterApplyActionPruned, err))
+		}
+	}
+	return code
+}
+
+// updateApplySetParent records the group resources of the apply set on its
+// parent config map, merged with the recorded ones if merge is true, and
+// returns the previously recorded group resources.
+func (h *serverSideApplyHandler) updateApplySetParent(ctx context.Context, groupResources sets.String, merge bool) (sets.String, error) {
+	configMaps := h.client.CoreV1().ConfigMaps(metav1.NamespaceSystem)
+	parent, err := configMaps.Get(ctx, applySetParentPrefix+h.opts.ApplySet, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if h.opts.DryRun {
+			return sets.NewString(), nil
+		}
+		parent = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      applySetParentPrefix + h.opts.ApplySet,
+				Namespace: metav1.NamespaceSystem,
+				Annotations: map[string]string{
+					applySetIDAnnotation:             h.applySetID,
+					applySetGroupResourcesAnnotation: formatGroupResources(groupResources),
+				},
+			},
+		}
+		_, err = configMaps.Create(ctx, parent, metav1.CreateOptions{})
+		return sets.NewString(), err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := parent.Annotations[applySetIDAnnotation]; ok && id != h.applySetID {
+		return nil, errors.NewConflict(corev1.Resource("configmaps"), parent.Name,
+			fmt.Errorf("it is the parent of apply set %s", id))
+	}
+	previous := parseGroupResources(parent.Annotations[applySetGroupResourcesAnnotation])
+	desired := groupResources
+	if merge {
+		desired = previous.Union(groupResources)
+	}
+	if h.opts.DryRun || (desired.Equal(previous) && parent.Annotations[applySetIDAnnotation] == h.applySetID) {
+		return previous, nil
+	}
+	parent = parent.DeepCopy()
+	if parent.Annotations == nil {
+		parent.Annotations = make(map[string]string)
+	}
+	parent.Annotations[applySetIDAnnotation] = h.applySetID
+	parent.Annotations[applySetGroupResourcesAnnotation] = formatGroupResources(desired)
+	_, err = configMaps.Update(ctx, parent, metav1.UpdateOptions{})
+	return previous, err
+}
+
+func (h *serverSideApplyHandler) dryRun() []string {
+	if h.opts.DryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// flattenObjects returns the objects of the payload with lists expanded.
+func flattenObjects(exts []*runtime.RawExtension) []*unstructured.Unstructured {
+	var objs []*unstructured.Unstructured
+	for _, ext := range exts {
+		switch obj := ext.Object.(type) {
+		case *unstructured.Unstructured:
+			objs = append(objs, obj)
+		case *unstructured.UnstructuredList:
+			for i := range obj.Items {
+				objs = append(objs, &obj.Items[i])
+			}
+		}
+	}
+	return objs
+}
+
+// unchangedByApply reports whether the apply left the object as it was,
+// ignoring the metadata bumped by any write.
+func unchangedByApply(existing, applied *unstructured.Unstructured) bool {
+	strip := func(obj *unstructured.Unstructured) map[string]interface{} {
+		obj = obj.DeepCopy()
+		obj.SetResourceVersion("")
+		obj.SetManagedFields(nil)
+		return obj.Object
+	}
+	return apiequality.Semantic.DeepEqual(strip(existing), strip(applied))
+}
+
+func applyObjectKey(groupResource schema.GroupResource, namespace, name string) string {
+	return groupResource.String() + "/" + namespace + "/" + name
+}
+
+func applyObjectResult(gvk schema.GroupVersionKind, namespace, name string, action platformv1.ClusterApplyAction, err error) platformv1.ClusterApplyObjectResult {
+	result := platformv1.ClusterApplyObjectResult{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: namespace,
+		Name:      name,
+		Action:    action,
+	}
+	if err != nil {
+		result.Action = platformv1.ClusterApplyActionFailed
+		result.Message = err.Error()
+	}
+	return result
+}
+
+func formatGroupResources(groupResources sets.String) string {
+	return strings.Join(groupResources.List(), ",")
+}
+
+func parseGroupResources(value string) sets.String {
+	groupResources := sets.NewString()
+	for _, groupResource := range strings.Split(value, ",") {
+		if groupResource = strings.TrimSpace(groupResource); groupResource != "" {
+			groupResources.Insert(groupResource)
+		}
+	}
+	return groupResources
+}
+
+func errorCode(err error) int {
+	if status, ok := err.(errors.APIStatus); ok && status.Status().Code != 0 {
+		return int(status.Status().Code)
+	}
+	return http.StatusInternalServerError
+}
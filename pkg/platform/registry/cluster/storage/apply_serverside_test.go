@@ -0,0 +1,362 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestValidateApplyOptions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    platform.ClusterApplyOptions
+		wantErr bool
+	}{
+		{name: "legacy", opts: platform.ClusterApplyOptions{NotUpdate: true}},
+		{name: "legacy with prune", opts: platform.ClusterApplyOptions{Prune: true, ApplySet: "app"}, wantErr: true},
+		{name: "server side", opts: platform.ClusterApplyOptions{ServerSide: true, FieldManager: "gitops", Force: true, DryRun: true}},
+		{name: "server side with not update", opts: platform.ClusterApplyOptions{ServerSide: true, NotUpdate: true}, wantErr: true},
+		{name: "prune", opts: platform.ClusterApplyOptions{ServerSide: true, Prune: true, ApplySet: "app"}},
+		{name: "prune without apply set", opts: platform.ClusterApplyOptions{ServerSide: true, Prune: true}, wantErr: true},
+		{name: "invalid apply set", opts: platform.ClusterApplyOptions{ServerSide: true, ApplySet: "App_1"}, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := validateApplyOptions(&tc.opts); (err != nil) != tc.wantErr {
+				t.Errorf("validateApplyOptions() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupResources(t *testing.T) {
+	groupResources := sets.NewString("services", "deployments.apps")
+	value := formatGroupResources(groupResources)
+	if value != "deployments.apps,services" {
+		t.Errorf("formatGroupResources() = %q", value)
+	}
+	if parsed := parseGroupResources(value + ", "); !parsed.Equal(groupResources) {
+		t.Errorf("parseGroupResources() = %v, want %v", parsed.List(), groupResources.List())
+	}
+	if parsed := parseGroupResources(""); parsed.Len() != 0 {
+		t.Errorf("parseGroupResources(\"\") = %v, want empty", parsed.List())
+	}
+}
+
+func TestUnchangedByApply(t *testing.T) {
+	existing := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "app",
+			"namespace":       "default",
+			"resourceVersion": "1",
+		},
+		"data": map[string]interface{}{"key": "value"},
+	}}
+
+	applied := existing.DeepCopy()
+	applied.SetResourceVersion("2")
+	applied.SetManagedFields(nil)
+	if !unchangedByApply(existing, applied) {
+		t.Errorf("unchangedByApply() = false for a bumped resource version")
+	}
+
+	applied = existing.DeepCopy()
+	_ = unstructured.SetNestedField(applied.Object, "changed", "data", "key")
+	if unchangedByApply(existing, applied) {
+		t.Errorf("unchangedByApply() = true for changed data")
+	}
+}
+
+func TestFlattenObjects(t *testing.T) {
+	body := `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: a
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: b
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: c
+`
+	exts, _, err := decodeObjects(strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("decodeObjects() error = %v", err)
+	}
+	var names []string
+	for _, obj := range flattenObjects(exts) {
+		names = append(names, obj.GetKind()+"/"+obj.GetName())
+	}
+	if got := strings.Join(names, ","); got != "ConfigMap/a,ConfigMap/b,Service/c" {
+		t.Errorf("flattenObjects() = %s", got)
+	}
+}
+
+func TestApplySetID(t *testing.T) {
+	id := applySetID("cls-a", metav1.NamespaceSystem, "app")
+	if len(id) > 63 || !strings.HasPrefix(id, "applyset-") || !strings.HasSuffix(id, "-v1") {
+		t.Errorf("applySetID() = %q, want a valid label value", id)
+	}
+	if id != applySetID("cls-a", metav1.NamespaceSystem, "app") {
+		t.Errorf("applySetID() is not stable")
+	}
+	if id == applySetID("cls-b", metav1.NamespaceSystem, "app") || id == applySetID("cls-a", metav1.NamespaceSystem, "web") {
+		t.Errorf("applySetID() is the same for other owners")
+	}
+}
+
+var configMapsResource = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+// applyClient emulates the server-side apply and the dry-run of the api
+// server, which the fake dynamic client does not support.
+type applyClient struct {
+	dynamic.Interface
+}
+
+func (c applyClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return applyNamespaceableResource{
+		applyResource: applyResource{c.Interface.Resource(resource)},
+		resource:      c.Interface.Resource(resource),
+	}
+}
+
+type applyNamespaceableResource struct {
+	applyResource
+	resource dynamic.NamespaceableResourceInterface
+}
+
+func (r applyNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return applyResource{r.resource.Namespace(namespace)}
+}
+
+type applyResource struct {
+	dynamic.ResourceInterface
+}
+
+func (r applyResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if pt != types.ApplyPatchType {
+		return r.ResourceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+	}
+	obj := new(unstructured.Unstructured)
+	if err := obj.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	existing, err := r.Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if len(opts.DryRun) > 0 {
+			return obj, nil
+		}
+		return r.Create(ctx, obj, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	if len(opts.DryRun) > 0 {
+		return obj, nil
+	}
+	return r.Update(ctx, obj, metav1.UpdateOptions{})
+}
+
+func (r applyResource) Delete(ctx context.Context, name string, opts metav1.DeleteOptions, subresources ...string) error {
+	if len(opts.DryRun) > 0 {
+		_, err := r.Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+	return r.ResourceInterface.Delete(ctx, name, opts, subresources...)
+}
+
+func newApplyConfigMap(name, applySet string, data string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": metav1.NamespaceDefault,
+		},
+		"data": map[string]interface{}{"key": data},
+	}}
+	if applySet != "" {
+		obj.SetLabels(map[string]string{ApplySetLabel: applySet})
+	}
+	return obj
+}
+
+func newApplyHandler(opts *platform.ClusterApplyOptions) *serverSideApplyHandler {
+	id := applySetID("cls-test", metav1.NamespaceSystem, "app")
+	client := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      applySetParentPrefix + "app",
+			Namespace: metav1.NamespaceSystem,
+			Annotations: map[string]string{
+				applySetIDAnnotation:             id,
+				applySetGroupResourcesAnnotation: "configmaps",
+			},
+		},
+	})
+	client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "configmaps", Namespaced: true, Kind: "ConfigMap"}},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{configMapsResource: "ConfigMapList"},
+		newApplyConfigMap("a", id, "old"),
+		newApplyConfigMap("stale", id, "old"),
+		// a member of another set, and an object labeled with the bare
+		// name of the set by another tool
+		newApplyConfigMap("other", applySetID("cls-test", metav1.NamespaceSystem, "other"), "old"),
+		newApplyConfigMap("unowned", "app", "old"),
+	)
+	return newServerSideApplyHandler("cls-test", client, applyClient{dynamicClient}, opts)
+}
+
+func applyPayload(t *testing.T) []*runtime.RawExtension {
+	body := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+data:
+  key: new
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: b
+data:
+  key: new
+`
+	exts, _, err := decodeObjects(strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("decodeObjects() error = %v", err)
+	}
+	return exts
+}
+
+func applyActions(result *platformv1.ClusterApplyResult) map[string]platformv1.ClusterApplyAction {
+	actions := map[string]platformv1.ClusterApplyAction{}
+	for _, item := range result.Items {
+		actions[item.Name] = item.Action
+	}
+	return actions
+}
+
+func TestServerSideApply(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opts        platform.ClusterApplyOptions
+		wantActions map[string]platformv1.ClusterApplyAction
+		wantData    map[string]string
+	}{
+		{
+			name: "apply",
+			opts: platform.ClusterApplyOptions{ServerSide: true, ApplySet: "app"},
+			wantActions: map[string]platformv1.ClusterApplyAction{
+				"a": platformv1.ClusterApplyActionConfigured,
+				"b": platformv1.ClusterApplyActionCreated,
+			},
+			wantData: map[string]string{"a": "new", "b": "new", "stale": "old", "other": "old", "unowned": "old"},
+		},
+		{
+			name: "prune",
+			opts: platform.ClusterApplyOptions{ServerSide: true, ApplySet: "app", Prune: true},
+			wantActions: map[string]platformv1.ClusterApplyAction{
+				"a":     platformv1.ClusterApplyActionConfigured,
+				"b":     platformv1.ClusterApplyActionCreated,
+				"stale": platformv1.ClusterApplyActionPruned,
+			},
+			wantData: map[string]string{"a": "new", "b": "new", "other": "old", "unowned": "old"},
+		},
+		{
+			name: "dry run",
+			opts: platform.ClusterApplyOptions{ServerSide: true, ApplySet: "app", Prune: true, DryRun: true},
+			wantActions: map[string]platformv1.ClusterApplyAction{
+				"a":     platformv1.ClusterApplyActionConfigured,
+				"b":     platformv1.ClusterApplyActionCreated,
+				"stale": platformv1.ClusterApplyActionPruned,
+			},
+			wantData: map[string]string{"a": "old", "stale": "old", "other": "old", "unowned": "old"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newApplyHandler(&tc.opts)
+			result, code := h.apply(context.TODO(), applyPayload(t))
+			if code != http.StatusOK {
+				t.Fatalf("apply() code = %d, result = %+v", code, result)
+			}
+			if result.DryRun != tc.opts.DryRun {
+				t.Errorf("apply() dryRun = %v, want %v", result.DryRun, tc.opts.DryRun)
+			}
+			if got := applyActions(result); len(got) != len(tc.wantActions) {
+				t.Errorf("apply() actions = %v, want %v", got, tc.wantActions)
+			} else {
+				for name, action := range tc.wantActions {
+					if got[name] != action {
+						t.Errorf("apply() action of %s = %q, want %q", name, got[name], action)
+					}
+				}
+			}
+
+			list, err := h.dynamicClient.Resource(configMapsResource).Namespace(metav1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			data := map[string]string{}
+			for _, item := range list.Items {
+				data[item.GetName()], _, _ = unstructured.NestedString(item.Object, "data", "key")
+			}
+			if len(data) != len(tc.wantData) {
+				t.Errorf("config maps = %v, want %v", data, tc.wantData)
+			}
+			for name, value := range tc.wantData {
+				if data[name] != value {
+					t.Errorf("config map %s = %q, want %q", name, data[name], value)
+				}
+			}
+		})
+	}
+}
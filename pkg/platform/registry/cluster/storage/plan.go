@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+// PlanREST implements the plan of the operation which would be done on a
+// cluster, without mutating the cluster or any host.
+type PlanREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *PlanREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents plan parameters
+func (r *PlanREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler which plans the cluster in request body, or the
+// stored cluster if the body is empty.
+func (r *PlanREST) Connect(ctx context.Context, clusterName string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	var oldCluster *platform.Cluster
+	obj, err := r.store.Get(ctx, clusterName, &metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		oldCluster = obj.(*platform.Cluster)
+		if err := util.FilterCluster(ctx, oldCluster); err != nil {
+			return nil, err
+		}
+	}
+
+	return &planHandler{
+		name:           clusterName,
+		oldCluster:     oldCluster,
+		store:          r.store,
+		platformClient: r.platformClient,
+	}, nil
+}
+
+// New creates a new cluster plan object
+func (r *PlanREST) New() runtime.Object {
+	return &platform.ClusterPlan{}
+}
+
+type planHandler struct {
+	name           string
+	oldCluster     *platform.Cluster
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+func (h *planHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	plan, err := h.plan(req)
+	if err != nil {
+		if status, ok := err.(apierrors.APIStatus); ok {
+			responsewriters.WriteRawJSON(int(status.Status().Code), status.Status(), w)
+			return
+		}
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, apierrors.NewInternalError(err), w)
+		return
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, plan, w)
+}
+
+// plan prepares and validates the cluster as create or update does, then
+// returns the plan of its provider.
+func (h *planHandler) plan(req *http.Request) (*platformv1.ClusterPlan, error) {
+	ctx := req.Context()
+	cluster, err := decodeCluster(req)
+	if err != nil {
+		return nil, err
+	}
+	if cluster != nil && cluster.Name != h.name {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("name of cluster in body %q does not match %q", cluster.Name, h.name))
+	}
+
+	switch {
+	case h.oldCluster == nil && cluster == nil:
+		return nil, apierrors.NewNotFound(platform.Resource("clusters"), h.name)
+	case h.oldCluster == nil:
+		cluster.Status = platform.ClusterStatus{Phase: platform.ClusterInitializing}
+		if err := rest.BeforeCreate(h.store.CreateStrategy, ctx, cluster); err != nil {
+			return nil, err
+		}
+	case cluster == nil:
+		cluster = h.oldCluster.DeepCopy()
+	default:
+		cluster.Status = *h.oldCluster.Status.DeepCopy()
+		if err := rest.BeforeUpdate(h.store.UpdateStrategy, ctx, cluster, h.oldCluster); err != nil {
+			return nil, err
+		}
+	}
+
+	provider, err := clusterprovider.GetProvider(cluster.Spec.Type)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	clusterWrapper, err := clusterprovider.GetCluster(ctx, h.platformClient, cluster, clusterprovider.AdminUsername)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Plan(ctx, clusterWrapper)
+}
+
+// decodeCluster decodes the cluster in request body, nil is returned if the body is empty.
+func decodeCluster(req *http.Request) (*platform.Cluster, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	obj, err := runtime.Decode(platform.Codecs.UniversalDecoder(), data)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	cluster, ok := obj.(*platform.Cluster)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected Cluster in body, got %T", obj))
+	}
+	return cluster, nil
+}
@@ -56,6 +56,8 @@ type Storage struct {
 	Drain          *DrainREST
 	Proxy          *ProxyREST
 	APIResources   *APIResourcesREST
+	EtcdSnapshot   *EtcdSnapshotREST
+	Plan           *PlanREST
 }
 
 // NewStorage returns a Storage object that will work against clusters.
@@ -131,6 +133,14 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, platformClient pla
 			store:          store,
 			platformClient: platformClient,
 		},
+		EtcdSnapshot: &EtcdSnapshotREST{
+			store:          store,
+			platformClient: platformClient,
+		},
+		Plan: &PlanREST{
+			store:          store,
+			platformClient: platformClient,
+		},
 	}
 }
 
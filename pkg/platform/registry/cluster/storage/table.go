@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"tkestack.io/tke/api/platform"
 	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/util"
 	"tkestack.io/tke/pkg/util/printers"
 )
 
@@ -36,6 +37,10 @@ func AddHandlers(h printers.PrintHandler) {
 		{Name: "Version", Type: "string", Description: platformv1.ClusterStatus{}.SwaggerDoc()["version"]},
 		{Name: "Status", Type: "string", Description: platformv1.ClusterStatus{}.SwaggerDoc()["phase"]},
 		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Handler", Type: "string", Priority: 1, Description: platformv1.HandlerRecord{}.SwaggerDoc()["name"]},
+		{Name: "Attempts", Type: "integer", Priority: 1, Description: platformv1.HandlerRecord{}.SwaggerDoc()["attempts"]},
+		{Name: "Duration", Type: "string", Priority: 1, Description: "The time taken by the last handler."},
+		{Name: "Error", Type: "string", Priority: 1, Description: platformv1.HandlerRecord{}.SwaggerDoc()["lastError"]},
 	}
 	h.TableHandler(clusterColumnDefinitions, printClusterList)
 	h.TableHandler(clusterColumnDefinitions, printCluster)
@@ -58,5 +63,6 @@ func printCluster(cluster *platform.Cluster, options printers.PrintOptions) ([]m
 		Object: runtime.RawExtension{Object: cluster},
 	}
 	row.Cells = append(row.Cells, cluster.Name, cluster.Spec.Type, cluster.Status.Version, cluster.Status.Phase, printers.TranslateTimestampSince(cluster.CreationTimestamp))
+	row.Cells = append(row.Cells, util.HandlerRecordCells(cluster.Status.HandlerHistory)...)
 	return []metav1beta1.TableRow{row}, nil
 }
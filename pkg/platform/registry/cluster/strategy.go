@@ -86,12 +86,16 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	}
 	if cluster.Spec.Version != oldCluster.Spec.Version && cluster.Spec.Version != cluster.Status.Version {
 		cluster.Status.Phase = platform.ClusterUpgrading
+		// a new upgrade starts a new rolling upgrade of worker machines
+		delete(cluster.Annotations, platform.WorkerUpgradeAnno)
 	}
 	if len(cluster.Spec.Machines) > len(oldCluster.Spec.Machines) {
 		cluster.Status.Phase = platform.ClusterUpscaling
+		clusterutil.PrepareMasterReplacement(cluster, oldCluster)
 		cluster.Spec.ScalingMachines, _ = clusterutil.PrepareClusterScale(cluster, oldCluster)
 	}
-	if len(cluster.Spec.Machines) < len(oldCluster.Spec.Machines) {
+	// the replaced master has been removed by the provider at the end of upscaling
+	if len(cluster.Spec.Machines) < len(oldCluster.Spec.Machines) && !clusterutil.IsMasterReplaced(cluster, oldCluster) {
 		cluster.Status.Phase = platform.ClusterDownscaling
 		cluster.Spec.ScalingMachines, _ = clusterutil.PrepareClusterScale(cluster, oldCluster)
 	}
@@ -128,6 +132,14 @@ func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 		platform.ClusterFinalize,
 	}
 
+	if cluster.Spec.TemplateRef != nil && cluster.Spec.TemplateRef.Name != "" {
+		// errors of the template reference will be reported by validate
+		template, err := s.platformClient.ClusterTemplates().Get(ctx, cluster.Spec.TemplateRef.Name, metav1.GetOptions{})
+		if err == nil && template.Spec.TenantID == cluster.Spec.TenantID {
+			clusterutil.MergeClusterTemplate(cluster, template)
+		}
+	}
+
 	if cluster.Spec.DNSDomain == "" {
 		cluster.Spec.DNSDomain = "cluster.local"
 	}
@@ -187,7 +199,9 @@ func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.Error
 	if err != nil {
 		return field.ErrorList{field.InternalError(field.NewPath(""), err)}
 	}
-	return validation.ValidateCluster(clusterWrapper)
+	allErrs := validation.ValidateClusterMachineCredentials(ctx, &cluster.Spec, field.NewPath("spec", "machines"), s.platformClient)
+	allErrs = append(allErrs, validation.ValidateClusterTemplateRef(ctx, &cluster.Spec, field.NewPath("spec", "templateRef"), s.platformClient)...)
+	return append(allErrs, validation.ValidateCluster(clusterWrapper)...)
 }
 
 // AllowCreateOnUpdate is false for clusters
@@ -223,7 +237,8 @@ func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object)
 	if err != nil {
 		return field.ErrorList{field.InternalError(field.NewPath(""), err)}
 	}
-	return validation.ValidateClusterUpdate(clusterWrapper, oldClusterWrapper)
+	allErrs := validation.ValidateClusterMachineCredentials(ctx, &cluster.Spec, field.NewPath("spec", "machines"), s.platformClient)
+	return append(allErrs, validation.ValidateClusterUpdate(clusterWrapper, oldClusterWrapper)...)
 }
 
 // WarningsOnUpdate returns warnings for the given update.
@@ -247,20 +262,25 @@ func MatchCluster(label labels.Selector, field fields.Selector) storage.Selectio
 		Field:    field,
 		GetAttrs: GetAttrs,
 		IndexFields: []string{
-			"spec.tenantID", "spec.type", "spec.version", "status.locked", "status.version", "status.phase"},
+			"spec.tenantID", "spec.type", "spec.version", "spec.templateRef.name", "status.locked", "status.version", "status.phase"},
 	}
 }
 
 // ToSelectableFields returns a field set that represents the object
 func ToSelectableFields(cluster *platform.Cluster) fields.Set {
 	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&cluster.ObjectMeta, false)
+	var templateName string
+	if cluster.Spec.TemplateRef != nil {
+		templateName = cluster.Spec.TemplateRef.Name
+	}
 	specificFieldsSet := fields.Set{
-		"spec.tenantID":  cluster.Spec.TenantID,
-		"spec.type":      cluster.Spec.Type,
-		"spec.version":   cluster.Spec.Version,
-		"status.locked":  util.BoolPointerToSelectField(cluster.Status.Locked),
-		"status.version": cluster.Status.Version,
-		"status.phase":   string(cluster.Status.Phase),
+		"spec.tenantID":         cluster.Spec.TenantID,
+		"spec.type":             cluster.Spec.Type,
+		"spec.version":          cluster.Spec.Version,
+		"spec.templateRef.name": templateName,
+		"status.locked":         util.BoolPointerToSelectField(cluster.Status.Locked),
+		"status.version":        cluster.Status.Version,
+		"status.phase":          string(cluster.Status.Phase),
 	}
 	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
 }
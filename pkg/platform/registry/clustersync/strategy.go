@@ -0,0 +1,203 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clustersync
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/api/platform/validation"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for cluster sync.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating cluster sync objects.
+func NewStrategy(platformClient platforminternalclient.PlatformInterface) *Strategy {
+	return &Strategy{platform.Scheme, namesutil.Generator, platformClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldSync := old.(*platform.ClusterSync)
+	sync, _ := obj.(*platform.ClusterSync)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldSync.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update cluster sync information", log.String("oldTenantID", oldSync.Spec.TenantID), log.String("newTenantID", sync.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		sync.Spec.TenantID = tenantID
+	}
+
+	sync.Status = oldSync.Status
+	if !apiequality.Semantic.DeepEqual(sync.Spec, oldSync.Spec) {
+		sync.Generation = oldSync.Generation + 1
+		sync.Status.Phase = platform.ClusterSyncPending
+	}
+}
+
+// NamespaceScoped is false for cluster syncs
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	sync, _ := obj.(*platform.ClusterSync)
+	if len(tenantID) != 0 {
+		sync.Spec.TenantID = tenantID
+	}
+
+	if sync.Name == "" && sync.GenerateName == "" {
+		sync.GenerateName = "cs-"
+	}
+
+	sync.Generation = 1
+	sync.Status = platform.ClusterSyncStatus{
+		Phase: platform.ClusterSyncPending,
+	}
+}
+
+// Validate validates a new cluster sync
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateClusterSync(ctx, obj.(*platform.ClusterSync), s.platformClient)
+}
+
+// AllowCreateOnUpdate is false for cluster syncs
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end cluster sync.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateClusterSyncUpdate(ctx, obj.(*platform.ClusterSync), old.(*platform.ClusterSync), s.platformClient)
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	sync, ok := obj.(*platform.ClusterSync)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a cluster sync")
+	}
+	return sync.ObjectMeta.Labels, ToSelectableFields(sync), nil
+}
+
+// SelectionPredicate returns a generic matcher for a given label and field selector.
+func SelectionPredicate(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID", "status.phase"},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(sync *platform.ClusterSync) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&sync.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": sync.Spec.TenantID,
+		"status.phase":  string(sync.Status.Phase),
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of ClusterSync.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newSync := obj.(*platform.ClusterSync)
+	oldSync := old.(*platform.ClusterSync)
+	newSync.Spec = oldSync.Spec
+	newSync.Generation = oldSync.Generation
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return field.ErrorList{}
+}
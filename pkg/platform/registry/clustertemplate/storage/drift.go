@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+// DriftREST implements the REST endpoint to list the clusters created from an
+// older revision of a cluster template.
+type DriftREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+var _ = rest.Getter(&DriftREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *DriftREST) New() runtime.Object {
+	return &platform.ClusterList{}
+}
+
+// Get finds the clusters drifted from the current revision of the cluster
+// template and returns them.
+func (r *DriftREST) Get(ctx context.Context, templateName string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, templateName, options)
+	if err != nil {
+		return nil, err
+	}
+	template := obj.(*platform.ClusterTemplate)
+
+	clusters, err := r.platformClient.Clusters().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.templateRef.name", templateName).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	drifted := &platform.ClusterList{ListMeta: clusters.ListMeta}
+	for _, cluster := range clusters.Items {
+		if util.IsClusterTemplateDrifted(&cluster, template) {
+			drifted.Items = append(drifted.Items, cluster)
+		}
+	}
+
+	return drifted, nil
+}
@@ -0,0 +1,189 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clustertemplate
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/api/platform/validation"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// revisionHistoryLimit is the number of revisions kept in the status of a
+// cluster template.
+const revisionHistoryLimit = 10
+
+// Strategy implements verification logic for cluster template.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating cluster template objects.
+func NewStrategy(platformClient platforminternalclient.PlatformInterface) *Strategy {
+	return &Strategy{platform.Scheme, namesutil.Generator, platformClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldTemplate := old.(*platform.ClusterTemplate)
+	template, _ := obj.(*platform.ClusterTemplate)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldTemplate.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update cluster template information", log.String("oldTenantID", oldTemplate.Spec.TenantID), log.String("newTenantID", template.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		template.Spec.TenantID = tenantID
+	}
+
+	template.Status = oldTemplate.Status
+	if !apiequality.Semantic.DeepEqual(template.Spec, oldTemplate.Spec) {
+		template.Status.Revision++
+		recordRevision(template)
+	}
+}
+
+// NamespaceScoped is false for cluster templates
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	template, _ := obj.(*platform.ClusterTemplate)
+	if len(tenantID) != 0 {
+		template.Spec.TenantID = tenantID
+	}
+
+	if template.Name == "" && template.GenerateName == "" {
+		template.GenerateName = "ctpl-"
+	}
+
+	template.Status = platform.ClusterTemplateStatus{Revision: 1}
+	recordRevision(template)
+}
+
+// recordRevision appends the current spec of the template to its revisions,
+// dropping the oldest ones beyond the history limit.
+func recordRevision(template *platform.ClusterTemplate) {
+	template.Status.Revisions = append(template.Status.Revisions, platform.ClusterTemplateRevision{
+		Revision:          template.Status.Revision,
+		Spec:              *template.Spec.DeepCopy(),
+		CreationTimestamp: metav1.Now(),
+	})
+	if n := len(template.Status.Revisions); n > revisionHistoryLimit {
+		template.Status.Revisions = template.Status.Revisions[n-revisionHistoryLimit:]
+	}
+}
+
+// Validate validates a new cluster template
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateClusterTemplate(obj.(*platform.ClusterTemplate))
+}
+
+// AllowCreateOnUpdate is false for cluster templates
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end cluster template.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateClusterTemplateUpdate(obj.(*platform.ClusterTemplate), old.(*platform.ClusterTemplate))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	template, ok := obj.(*platform.ClusterTemplate)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a cluster template")
+	}
+	return template.ObjectMeta.Labels, ToSelectableFields(template), nil
+}
+
+// SelectionPredicate returns a generic matcher for a given label and field selector.
+func SelectionPredicate(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID", "spec.type"},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(template *platform.ClusterTemplate) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&template.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": template.Spec.TenantID,
+		"spec.type":     template.Spec.Type,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
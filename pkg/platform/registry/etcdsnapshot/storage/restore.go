@@ -0,0 +1,143 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+)
+
+// RestoreREST implements the restore of a cluster from an etcd snapshot.
+type RestoreREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *RestoreREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents restore parameters
+func (r *RestoreREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &platform.EtcdSnapshotRestoreOptions{}, false, ""
+}
+
+// Connect returns a handler which marks the etcd snapshot to be restored.
+func (r *RestoreREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	username, _ := authentication.UsernameAndTenantID(ctx)
+
+	return &restoreHandler{
+		snapshot:       obj.(*platform.EtcdSnapshot),
+		options:        opts.(*platform.EtcdSnapshotRestoreOptions),
+		username:       username,
+		platformClient: r.platformClient,
+	}, nil
+}
+
+// New creates a new etcd snapshot restore options object
+func (r *RestoreREST) New() runtime.Object {
+	return &platform.EtcdSnapshotRestoreOptions{}
+}
+
+type restoreHandler struct {
+	snapshot       *platform.EtcdSnapshot
+	options        *platform.EtcdSnapshotRestoreOptions
+	username       string
+	platformClient platforminternalclient.PlatformInterface
+}
+
+func (h *restoreHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if err := ValidateRestore(req.Context(), h.snapshot, h.options, h.platformClient); err != nil {
+		if status, ok := err.(apierrors.APIStatus); ok {
+			responsewriters.WriteRawJSON(int(status.Status().Code), status.Status(), w)
+			return
+		}
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, apierrors.NewInternalError(err), w)
+		return
+	}
+
+	snapshot := h.snapshot.DeepCopy()
+	snapshot.Status.Phase = platform.EtcdSnapshotRestoring
+	snapshot.Status.Reason = ""
+	snapshot.Status.Message = fmt.Sprintf("restore of cluster %s requested by %s", snapshot.Spec.ClusterName, h.username)
+	snapshot, err := h.platformClient.EtcdSnapshots().UpdateStatus(req.Context(), snapshot, metav1.UpdateOptions{})
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, apierrors.NewInternalError(err), w)
+		return
+	}
+	responsewriters.WriteRawJSON(http.StatusAccepted, snapshot, w)
+}
+
+// ValidateRestore checks whether the cluster can be restored from the given
+// etcd snapshot. A restore replaces the whole control-plane state, so it
+// requires an explicit confirmation and refuses to race with another restore.
+func ValidateRestore(ctx context.Context, snapshot *platform.EtcdSnapshot, options *platform.EtcdSnapshotRestoreOptions, platformClient platforminternalclient.PlatformInterface) error {
+	if options.Confirm != snapshot.Spec.ClusterName {
+		return apierrors.NewBadRequest(fmt.Sprintf("confirm must be the name of the cluster %s", snapshot.Spec.ClusterName))
+	}
+	if snapshot.DeletionTimestamp != nil {
+		return apierrors.NewConflict(platform.Resource("etcdsnapshots"), snapshot.Name, fmt.Errorf("etcd snapshot is being deleted"))
+	}
+	if snapshot.Status.Phase != platform.EtcdSnapshotCompleted {
+		return apierrors.NewConflict(platform.Resource("etcdsnapshots"), snapshot.Name, fmt.Errorf("etcd snapshot is %s, only completed snapshot can be restored", snapshot.Status.Phase))
+	}
+
+	cluster, err := platformClient.Clusters().Get(ctx, snapshot.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if cluster.Spec.Etcd == nil || cluster.Spec.Etcd.Local == nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("cluster %s does not use local etcd", cluster.Name))
+	}
+	if cluster.Status.Phase != platform.ClusterRunning && cluster.Status.Phase != platform.ClusterFailed {
+		return apierrors.NewConflict(platform.Resource("clusters"), cluster.Name, fmt.Errorf("cluster is %s, only running or failed cluster can be restored", cluster.Status.Phase))
+	}
+
+	snapshots, err := platformClient.EtcdSnapshots().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", cluster.Name).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, one := range snapshots.Items {
+		if one.Status.Phase == platform.EtcdSnapshotRestoring {
+			return apierrors.NewConflict(platform.Resource("clusters"), cluster.Name, fmt.Errorf("cluster is being restored from etcd snapshot %s", one.Name))
+		}
+	}
+
+	return nil
+}
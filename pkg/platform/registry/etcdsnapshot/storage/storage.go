@@ -0,0 +1,318 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	storageerr "k8s.io/apiserver/pkg/storage/errors"
+	"k8s.io/apiserver/pkg/util/dryrun"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/platform/registry/etcdsnapshot"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/printers"
+	printerstorage "tkestack.io/tke/pkg/util/printers/storage"
+)
+
+// Storage includes storage for etcd snapshots and all sub resources.
+type Storage struct {
+	EtcdSnapshot *REST
+	Status       *StatusREST
+	Finalize     *FinalizeREST
+	Restore      *RestoreREST
+}
+
+// NewStorage returns a Storage object that will work against etcd snapshots.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, platformClient platforminternalclient.PlatformInterface, privilegedUsername string) *Storage {
+	strategy := etcdsnapshot.NewStrategy(platformClient)
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &platform.EtcdSnapshot{} },
+		NewListFunc:              func() runtime.Object { return &platform.EtcdSnapshotList{} },
+		DefaultQualifiedResource: platform.Resource("etcdsnapshots"),
+		PredicateFunc:            etcdsnapshot.SelectionPredicate,
+		ReturnDeletedObject:      true,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		TableConvertor: printerstorage.TableConvertor{TableGenerator: printers.NewTableGenerator().With(AddHandlers)},
+	}
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    etcdsnapshot.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create etcd snapshot etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = etcdsnapshot.NewStatusStrategy(strategy)
+
+	finalizeStore := *store
+	finalizeStore.UpdateStrategy = etcdsnapshot.NewFinalizerStrategy(strategy)
+
+	return &Storage{
+		EtcdSnapshot: &REST{store, privilegedUsername},
+		Status:       &StatusREST{&statusStore},
+		Finalize:     &FinalizeREST{&finalizeStore},
+		Restore: &RestoreREST{
+			store:          store,
+			platformClient: platformClient,
+		},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return EtcdSnapshot
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	m := obj.(*platform.EtcdSnapshot)
+	if err := util.FilterEtcdSnapshot(ctx, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// REST implements a RESTStorage for etcd snapshots against etcd.
+type REST struct {
+	*registry.Store
+	privilegedUsername string
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"es"}
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Update finds a resource in the storage and updates it.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// sub resources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete enforces life-cycle rules for cluster termination
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	snapshot := obj.(*platform.EtcdSnapshot)
+	if snapshot.Status.Phase == platform.EtcdSnapshotRestoring {
+		return nil, false, apierrors.NewConflict(platform.Resource("etcdsnapshots"), name, fmt.Errorf("the cluster %s is being restored from this etcd snapshot", snapshot.Spec.ClusterName))
+	}
+	// Ensure we have a UID precondition
+	if options == nil {
+		options = metav1.NewDeleteOptions(0)
+	}
+	if options.Preconditions == nil {
+		options.Preconditions = &metav1.Preconditions{}
+	}
+	if options.Preconditions.UID == nil {
+		options.Preconditions.UID = &snapshot.UID
+	} else if *options.Preconditions.UID != snapshot.UID {
+		err = apierrors.NewConflict(
+			platform.Resource("etcdsnapshots"),
+			name,
+			fmt.Errorf("precondition failed: UID in precondition: %v, UID in object meta: %v", *options.Preconditions.UID, snapshot.UID),
+		)
+		return nil, false, err
+	}
+
+	// upon first request to delete, we switch the phase to start etcd snapshot termination
+	if snapshot.DeletionTimestamp.IsZero() {
+		key, err := r.Store.KeyFunc(ctx, name)
+		if err != nil {
+			return nil, false, err
+		}
+
+		preconditions := storage.Preconditions{UID: options.Preconditions.UID}
+
+		out := r.Store.NewFunc()
+		err = r.Store.Storage.GuaranteedUpdate(
+			ctx, key, out, false, &preconditions,
+			storage.SimpleUpdate(func(existing runtime.Object) (runtime.Object, error) {
+				existingSnapshot, ok := existing.(*platform.EtcdSnapshot)
+				if !ok {
+					// wrong type
+					return nil, fmt.Errorf("expected *platformAPI.EtcdSnapshot, got %v", existing)
+				}
+				if err := deleteValidation(ctx, existingSnapshot); err != nil {
+					return nil, err
+				}
+				// Set the deletion timestamp if needed
+				if existingSnapshot.DeletionTimestamp.IsZero() {
+					now := metav1.Now()
+					existingSnapshot.DeletionTimestamp = &now
+				}
+				// Set the etcd snapshot phase to terminating, if needed
+				if existingSnapshot.Status.Phase != platform.EtcdSnapshotTerminating {
+					existingSnapshot.Status.Phase = platform.EtcdSnapshotTerminating
+				}
+
+				// the current finalizers which are on namespace
+				currentFinalizers := map[string]bool{}
+				for _, f := range existingSnapshot.Finalizers {
+					currentFinalizers[f] = true
+				}
+				// the finalizers we should ensure on namespace
+				shouldHaveFinalizers := map[string]bool{
+					metav1.FinalizerOrphanDependents: apiserverutil.ShouldHaveOrphanFinalizer(options, currentFinalizers[metav1.FinalizerOrphanDependents]),
+					metav1.FinalizerDeleteDependents: apiserverutil.ShouldHaveDeleteDependentsFinalizer(options, currentFinalizers[metav1.FinalizerDeleteDependents]),
+				}
+				// determine whether there are changes
+				changeNeeded := false
+				for finalizer, shouldHave := range shouldHaveFinalizers {
+					changeNeeded = currentFinalizers[finalizer] != shouldHave || changeNeeded
+					if shouldHave {
+						currentFinalizers[finalizer] = true
+					} else {
+						delete(currentFinalizers, finalizer)
+					}
+				}
+				// make the changes if needed
+				if changeNeeded {
+					var newFinalizers []string
+					for f := range currentFinalizers {
+						newFinalizers = append(newFinalizers, f)
+					}
+					existingSnapshot.Finalizers = newFinalizers
+				}
+				return existingSnapshot, nil
+			}),
+			dryrun.IsDryRun(options.DryRun),
+			nil,
+		)
+
+		if err != nil {
+			err = storageerr.InterpretGetError(err, platform.Resource("etcdsnapshots"), name)
+			err = storageerr.InterpretUpdateError(err, platform.Resource("etcdsnapshots"), name)
+			if _, ok := err.(*apierrors.StatusError); !ok {
+				err = apierrors.NewInternalError(err)
+			}
+			return nil, false, err
+		}
+
+		return out, false, nil
+	}
+
+	// prior to final deletion, we must ensure that finalizers is empty
+	if len(snapshot.Spec.Finalizers) != 0 {
+		err = apierrors.NewConflict(platform.Resource("etcdsnapshots"), snapshot.Name, fmt.Errorf("the system is ensuring all content is removed from this etcd snapshot.  Upon completion, this etcd snapshot will automatically be purged by the system"))
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// DeleteCollection selects all resources in the storage matching given 'listOptions'
+// and deletes them.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions, listOptions *metainternal.ListOptions) (runtime.Object, error) {
+	return r.Store.DeleteCollection(ctx, deleteValidation, options, listOptions)
+}
+
+// StatusREST implements the REST endpoint for changing the status of a
+// replication controller.
+type StatusREST struct {
+	store *registry.Store
+}
+
+// StatusREST implements Patcher.
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// FinalizeREST implements the REST endpoint for finalizing a cluster.
+type FinalizeREST struct {
+	store *registry.Store
+}
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *FinalizeREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the status finalizers subset of an object.
+func (r *FinalizeREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status finalizers subset of an object.
+func (r *FinalizeREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
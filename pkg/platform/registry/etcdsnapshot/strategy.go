@@ -0,0 +1,240 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcdsnapshot
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/api/platform/validation"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for etcd snapshot.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating etcd snapshot objects.
+func NewStrategy(platformClient platforminternalclient.PlatformInterface) *Strategy {
+	return &Strategy{platform.Scheme, namesutil.Generator, platformClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldSnapshot := old.(*platform.EtcdSnapshot)
+	snapshot, _ := obj.(*platform.EtcdSnapshot)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldSnapshot.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update etcd snapshot information", log.String("oldTenantID", oldSnapshot.Spec.TenantID), log.String("newTenantID", snapshot.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		snapshot.Spec.TenantID = tenantID
+	}
+	snapshot.Status = oldSnapshot.Status
+}
+
+// NamespaceScoped is false for etcd snapshots
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	snapshot, _ := obj.(*platform.EtcdSnapshot)
+	if len(tenantID) != 0 {
+		snapshot.Spec.TenantID = tenantID
+	}
+
+	if snapshot.Name == "" && snapshot.GenerateName == "" {
+		snapshot.GenerateName = "es-"
+	}
+
+	snapshot.Spec.Finalizers = []platform.FinalizerName{
+		platform.EtcdSnapshotFinalize,
+	}
+	snapshot.Status = platform.EtcdSnapshotStatus{
+		Phase: platform.EtcdSnapshotPending,
+	}
+
+	// The target is recorded on the snapshot so that it can still be found
+	// after the etcd backup feature of cluster is changed.
+	target := snapshot.Spec.Target
+	if target.Local == nil && target.S3 == nil && target.Registry == nil && snapshot.Spec.ClusterName != "" {
+		cluster, err := s.platformClient.Clusters().Get(ctx, snapshot.Spec.ClusterName, metav1.GetOptions{})
+		if err == nil && cluster.Spec.Features.EtcdBackup != nil {
+			snapshot.Spec.Target = *cluster.Spec.Features.EtcdBackup.Target.DeepCopy()
+		}
+	}
+}
+
+// Validate validates a new etcd snapshot
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateEtcdSnapshot(ctx, obj.(*platform.EtcdSnapshot), s.platformClient)
+}
+
+// AllowCreateOnUpdate is false for etcd snapshots
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end etcd snapshot.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateEtcdSnapshotUpdate(ctx, obj.(*platform.EtcdSnapshot), old.(*platform.EtcdSnapshot))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	snapshot, ok := obj.(*platform.EtcdSnapshot)
+	if !ok {
+		return nil, nil, fmt.Errorf("not an etcd snapshot")
+	}
+	return snapshot.ObjectMeta.Labels, ToSelectableFields(snapshot), nil
+}
+
+// SelectionPredicate returns a generic matcher for a given label and field selector.
+func SelectionPredicate(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID", "spec.clusterName", "spec.type", "status.phase"},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(snapshot *platform.EtcdSnapshot) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&snapshot.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":    snapshot.Spec.TenantID,
+		"spec.clusterName": snapshot.Spec.ClusterName,
+		"spec.type":        string(snapshot.Spec.Type),
+		"status.phase":     string(snapshot.Status.Phase),
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of EtcdSnapshot.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newSnapshot := obj.(*platform.EtcdSnapshot)
+	oldSnapshot := old.(*platform.EtcdSnapshot)
+	newSnapshot.Spec = oldSnapshot.Spec
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return field.ErrorList{}
+}
+
+// FinalizeStrategy implements finalizer logic for EtcdSnapshot.
+type FinalizeStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &FinalizeStrategy{}
+
+// NewFinalizerStrategy create the FinalizeStrategy object by given strategy.
+func NewFinalizerStrategy(strategy *Strategy) *FinalizeStrategy {
+	return &FinalizeStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (FinalizeStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newSnapshot := obj.(*platform.EtcdSnapshot)
+	oldSnapshot := old.(*platform.EtcdSnapshot)
+	newSnapshot.Status = oldSnapshot.Status
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *FinalizeStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/printers"
+)
+
+// AddHandlers adds print handlers for default TKE types dealing with internal versions.
+// Refer kubernetes/pkg/printers/internalversion/printers.go:78
+func AddHandlers(h printers.PrintHandler) {
+	hostColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "IP", Type: "string", Description: platformv1.HostSpec{}.SwaggerDoc()["ip"]},
+		{Name: "Status", Type: "string", Description: platformv1.HostStatus{}.SwaggerDoc()["phase"]},
+		{Name: "OS", Type: "string", Description: platformv1.MachineSystemInfo{}.SwaggerDoc()["osImage"]},
+		{Name: "Kernel", Type: "string", Description: platformv1.MachineSystemInfo{}.SwaggerDoc()["kernelVersion"]},
+		{Name: "CPU", Type: "string", Description: platformv1.HostStatus{}.SwaggerDoc()["capacity"]},
+		{Name: "Memory", Type: "string", Description: platformv1.HostStatus{}.SwaggerDoc()["capacity"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+	h.TableHandler(hostColumnDefinitions, printHostList)
+	h.TableHandler(hostColumnDefinitions, printHost)
+}
+
+func printHostList(hostList *platform.HostList, options printers.PrintOptions) ([]metav1.TableRow, error) {
+	rows := make([]metav1.TableRow, 0, len(hostList.Items))
+	for i := range hostList.Items {
+		r, err := printHost(&hostList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printHost(host *platform.Host, options printers.PrintOptions) ([]metav1.TableRow, error) {
+	row := metav1.TableRow{
+		Object: runtime.RawExtension{Object: host},
+	}
+	cpu, memory := "<unknown>", "<unknown>"
+	if quantity, ok := host.Status.Capacity[corev1.ResourceCPU.String()]; ok {
+		cpu = quantity.String()
+	}
+	if quantity, ok := host.Status.Capacity[corev1.ResourceMemory.String()]; ok {
+		memory = quantity.String()
+	}
+	info := host.Status.MachineInfo
+	row.Cells = append(row.Cells, host.Name, host.Spec.IP, host.Status.Phase, info.OSImage, info.KernelVersion, cpu, memory, printers.TranslateTimestampSince(host.CreationTimestamp))
+	return []metav1beta1.TableRow{row}, nil
+}
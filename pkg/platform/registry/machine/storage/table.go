@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"tkestack.io/tke/api/platform"
 	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/util"
 	"tkestack.io/tke/pkg/util/printers"
 )
 
@@ -34,8 +35,13 @@ func AddHandlers(h printers.PrintHandler) {
 		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
 		{Name: "Type", Type: "string", Description: platformv1.MachineSpec{}.SwaggerDoc()["type"]},
 		{Name: "IP", Type: "string", Description: platformv1.MachineSpec{}.SwaggerDoc()["ip"]},
+		{Name: "Arch", Type: "string", Description: platformv1.MachineSystemInfo{}.SwaggerDoc()["architecture"]},
 		{Name: "Status", Type: "string", Description: platformv1.MachineStatus{}.SwaggerDoc()["phase"]},
 		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Handler", Type: "string", Priority: 1, Description: platformv1.HandlerRecord{}.SwaggerDoc()["name"]},
+		{Name: "Attempts", Type: "integer", Priority: 1, Description: platformv1.HandlerRecord{}.SwaggerDoc()["attempts"]},
+		{Name: "Duration", Type: "string", Priority: 1, Description: "The time taken by the last handler."},
+		{Name: "Error", Type: "string", Priority: 1, Description: platformv1.HandlerRecord{}.SwaggerDoc()["lastError"]},
 	}
 	h.TableHandler(machineColumnDefinitions, printMachineList)
 	h.TableHandler(machineColumnDefinitions, printMachine)
@@ -57,6 +63,7 @@ func printMachine(machine *platform.Machine, options printers.PrintOptions) ([]m
 	row := metav1.TableRow{
 		Object: runtime.RawExtension{Object: machine},
 	}
-	row.Cells = append(row.Cells, machine.Name, machine.Spec.Type, machine.Spec.IP, machine.Status.Phase, printers.TranslateTimestampSince(machine.CreationTimestamp))
+	row.Cells = append(row.Cells, machine.Name, machine.Spec.Type, machine.Spec.IP, machine.Status.MachineInfo.Architecture, machine.Status.Phase, printers.TranslateTimestampSince(machine.CreationTimestamp))
+	row.Cells = append(row.Cells, util.HandlerRecordCells(machine.Status.HandlerHistory)...)
 	return []metav1beta1.TableRow{row}, nil
 }
@@ -28,15 +28,23 @@ import (
 	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
 	"tkestack.io/tke/api/platform"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	auditpolicystorage "tkestack.io/tke/pkg/platform/registry/auditpolicy/storage"
 	clusterstorage "tkestack.io/tke/pkg/platform/registry/cluster/storage"
 	clusteraddontypestorage "tkestack.io/tke/pkg/platform/registry/clusteraddontype/storage"
 	clustercredentialstorage "tkestack.io/tke/pkg/platform/registry/clustercredential/storage"
+	clustersyncstorage "tkestack.io/tke/pkg/platform/registry/clustersync/storage"
+	clustertemplatestorage "tkestack.io/tke/pkg/platform/registry/clustertemplate/storage"
 	configmapstorage "tkestack.io/tke/pkg/platform/registry/configmap/storage"
 	cronhpastorage "tkestack.io/tke/pkg/platform/registry/cronhpa/storage"
 	csioperatorstorage "tkestack.io/tke/pkg/platform/registry/csioperator/storage"
+	etcdsnapshotstorage "tkestack.io/tke/pkg/platform/registry/etcdsnapshot/storage"
+	hoststorage "tkestack.io/tke/pkg/platform/registry/host/storage"
 	machinestorage "tkestack.io/tke/pkg/platform/registry/machine/storage"
+	machinepoolstorage "tkestack.io/tke/pkg/platform/registry/machinepool/storage"
+	multiclusterdeploymentstorage "tkestack.io/tke/pkg/platform/registry/multiclusterdeployment/storage"
 	persistenteventstorage "tkestack.io/tke/pkg/platform/registry/persistentevent/storage"
 	registrystorage "tkestack.io/tke/pkg/platform/registry/registry/storage"
+	sshcredentialstorage "tkestack.io/tke/pkg/platform/registry/sshcredential/storage"
 	tappcontrollertorage "tkestack.io/tke/pkg/platform/registry/tappcontroller/storage"
 )
 
@@ -84,6 +92,8 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["clusters/cronhpas"] = clusterREST.CronHPA
 		storageMap["clusters/addons"] = clusterREST.Addon
 		storageMap["clusters/addontypes"] = clusterREST.AddonType
+		storageMap["clusters/etcdsnapshots"] = clusterREST.EtcdSnapshot
+		storageMap["clusters/plan"] = clusterREST.Plan
 
 		machineREST := machinestorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
 		storageMap["machines"] = machineREST.Machine
@@ -117,6 +127,40 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		cronHPAREST := cronhpastorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
 		storageMap["cronhpas"] = cronHPAREST.CronHPA
 		storageMap["cronhpas/status"] = cronHPAREST.Status
+
+		etcdSnapshotREST := etcdsnapshotstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["etcdsnapshots"] = etcdSnapshotREST.EtcdSnapshot
+		storageMap["etcdsnapshots/status"] = etcdSnapshotREST.Status
+		storageMap["etcdsnapshots/finalize"] = etcdSnapshotREST.Finalize
+		storageMap["etcdsnapshots/restore"] = etcdSnapshotREST.Restore
+
+		machinePoolREST := machinepoolstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["machinepools"] = machinePoolREST.MachinePool
+		storageMap["machinepools/status"] = machinePoolREST.Status
+		storageMap["machinepools/finalize"] = machinePoolREST.Finalize
+
+		sshCredentialREST := sshcredentialstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["sshcredentials"] = sshCredentialREST.SSHCredential
+
+		hostREST := hoststorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["hosts"] = hostREST.Host
+		storageMap["hosts/status"] = hostREST.Status
+
+		clusterTemplateREST := clustertemplatestorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["clustertemplates"] = clusterTemplateREST.ClusterTemplate
+		storageMap["clustertemplates/drift"] = clusterTemplateREST.Drift
+
+		auditPolicyREST := auditpolicystorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["auditpolicies"] = auditPolicyREST.AuditPolicy
+		storageMap["auditpolicies/status"] = auditPolicyREST.Status
+
+		clusterSyncREST := clustersyncstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["clustersyncs"] = clusterSyncREST.ClusterSync
+		storageMap["clustersyncs/status"] = clusterSyncREST.Status
+
+		multiClusterDeploymentREST := multiclusterdeploymentstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
+		storageMap["multiclusterdeployments"] = multiClusterDeploymentREST.MultiClusterDeployment
+		storageMap["multiclusterdeployments/status"] = multiClusterDeploymentREST.Status
 	}
 
 	return storageMap
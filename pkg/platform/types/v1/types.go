@@ -67,6 +67,9 @@ func (c *Cluster) GetMainIP() string {
 		if c.Spec.Features.HA.ThirdPartyHA != nil {
 			mainIP = c.Spec.Features.HA.ThirdPartyHA.VIP
 		}
+		if c.Spec.Features.HA.KubeVIPHA != nil {
+			mainIP = c.Spec.Features.HA.KubeVIPHA.VIP
+		}
 	}
 	return mainIP
 }
@@ -24,7 +24,6 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -75,7 +74,7 @@ func PatchMachineOnce(ctx context.Context, client platformv1client.PlatformV1Int
 			return false, errors.Wrapf(err, "failed to marshal modified machine %q into JSON", machine.Name)
 		}
 
-		patchBytes, err := strategicpatch.CreateTwoWayMergePatch(oldData, newData, corev1.Node{})
+		patchBytes, err := strategicpatch.CreateTwoWayMergePatch(oldData, newData, platformv1.Machine{})
 		if err != nil {
 			return false, errors.Wrap(err, "failed to create two way merge patch")
 		}
@@ -181,6 +181,39 @@ func clusterAddress(cluster *platformv1.Cluster) (*platformv1.ClusterAddress, er
 	return address, nil
 }
 
+// PrepareMasterReplacement moves the master marked to be replaced away from
+// the first place of machines, because cluster level handlers run on the first
+// master. It swaps with the first master which already exists in oldCluster.
+func PrepareMasterReplacement(cluster *platform.Cluster, oldCluster *platform.Cluster) {
+	ip := cluster.Annotations[platform.ReplaceMasterAnno]
+	if ip == "" || len(cluster.Spec.Machines) == 0 || cluster.Spec.Machines[0].IP != ip {
+		return
+	}
+	for i := 1; i < len(cluster.Spec.Machines); i++ {
+		for _, machine := range oldCluster.Spec.Machines {
+			if machine.IP == cluster.Spec.Machines[i].IP {
+				cluster.Spec.Machines[0], cluster.Spec.Machines[i] = cluster.Spec.Machines[i], cluster.Spec.Machines[0]
+				return
+			}
+		}
+	}
+}
+
+// IsMasterReplaced returns whether the update removes the replaced master,
+// which completes the replacement instead of starting a scale down.
+func IsMasterReplaced(cluster *platform.Cluster, oldCluster *platform.Cluster) bool {
+	ip := oldCluster.Annotations[platform.ReplaceMasterAnno]
+	if ip == "" || cluster.Annotations[platform.ReplaceMasterAnno] != "" {
+		return false
+	}
+	for _, machine := range cluster.Spec.Machines {
+		if machine.IP == ip {
+			return false
+		}
+	}
+	return len(cluster.Spec.Machines) == len(oldCluster.Spec.Machines)-1
+}
+
 func PrepareClusterScale(cluster *platform.Cluster, oldCluster *platform.Cluster) ([]platform.ClusterMachine, error) {
 	allMachines, scalingMachines := []platform.ClusterMachine{}, []platform.ClusterMachine{}
 
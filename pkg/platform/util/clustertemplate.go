@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"github.com/imdario/mergo"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"tkestack.io/tke/api/platform"
+)
+
+// GetClusterTemplateRevision returns the spec of the given revision of the
+// template, an empty revision stands for the current one. It returns false if
+// the revision is not kept by the template anymore.
+func GetClusterTemplateRevision(template *platform.ClusterTemplate, revision int64) (*platform.ClusterTemplateSpec, bool) {
+	if revision == 0 || revision == template.Status.Revision {
+		return &template.Spec, true
+	}
+	for i := range template.Status.Revisions {
+		if template.Status.Revisions[i].Revision == revision {
+			return &template.Status.Revisions[i].Spec, true
+		}
+	}
+	return nil, false
+}
+
+// MergeClusterTemplate fills the fields of the cluster spec which are not set
+// with the defaults of the revision of the template referenced by the cluster,
+// or the current revision if none is, and records the merged revision in the
+// template reference of the cluster. Fields already defaulted by the API,
+// such as the container runtime, are considered as set.
+func MergeClusterTemplate(cluster *platform.Cluster, template *platform.ClusterTemplate) {
+	spec := &cluster.Spec
+	revision := template.Status.Revision
+	if spec.TemplateRef.Revision != 0 {
+		revision = spec.TemplateRef.Revision
+	}
+	templateSpec, ok := GetClusterTemplateRevision(template, revision)
+	if !ok {
+		return
+	}
+	defaults := templateSpec.DeepCopy()
+
+	if spec.Type == "" {
+		spec.Type = defaults.Type
+	}
+	if spec.Version == "" {
+		spec.Version = defaults.Version
+	}
+	if spec.NetworkType == "" {
+		spec.NetworkType = defaults.NetworkType
+	}
+	if spec.ServiceCIDR == nil {
+		spec.ServiceCIDR = defaults.ServiceCIDR
+	}
+	utilruntime.Must(mergo.Merge(&spec.Features, defaults.Features))
+	utilruntime.Must(mergo.Merge(&spec.Properties, defaults.Properties))
+	utilruntime.Must(mergo.Merge(&spec.DockerExtraArgs, defaults.DockerExtraArgs))
+	utilruntime.Must(mergo.Merge(&spec.KubeletExtraArgs, defaults.KubeletExtraArgs))
+	utilruntime.Must(mergo.Merge(&spec.APIServerExtraArgs, defaults.APIServerExtraArgs))
+	utilruntime.Must(mergo.Merge(&spec.ControllerManagerExtraArgs, defaults.ControllerManagerExtraArgs))
+	utilruntime.Must(mergo.Merge(&spec.SchedulerExtraArgs, defaults.SchedulerExtraArgs))
+	utilruntime.Must(mergo.Merge(&spec.NetworkArgs, defaults.NetworkArgs))
+	if len(spec.BootstrapApps) == 0 {
+		spec.BootstrapApps = defaults.BootstrapApps
+	}
+
+	spec.TemplateRef.Revision = revision
+}
+
+// IsClusterTemplateDrifted returns true if the cluster was created from an
+// older revision of the template than the current one.
+func IsClusterTemplateDrifted(cluster *platform.Cluster, template *platform.ClusterTemplate) bool {
+	ref := cluster.Spec.TemplateRef
+	if ref == nil || ref.Name != template.Name {
+		return false
+	}
+	return ref.Revision != template.Status.Revision
+}
@@ -0,0 +1,148 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/platform"
+)
+
+func TestMergeClusterTemplate(t *testing.T) {
+	ipvs := true
+	maxNodePodNum := int32(128)
+	template := &platform.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard"},
+		Spec: platform.ClusterTemplateSpec{
+			Type:        "Baremetal",
+			Version:     "1.20.4",
+			NetworkType: platform.NetworkType("calico"),
+			Features:    platform.ClusterFeature{IPVS: &ipvs, ContainerRuntime: platform.Containerd},
+			Properties:  platform.ClusterProperty{MaxNodePodNum: &maxNodePodNum},
+			APIServerExtraArgs: map[string]string{
+				"audit-log-maxage": "30",
+				"v":                "2",
+			},
+		},
+		Status: platform.ClusterTemplateStatus{Revision: 3},
+	}
+	cluster := &platform.Cluster{
+		Spec: platform.ClusterSpec{
+			Version:            "1.21.4",
+			Features:           platform.ClusterFeature{ContainerRuntime: platform.Docker},
+			APIServerExtraArgs: map[string]string{"v": "4"},
+			TemplateRef:        &platform.ClusterTemplateRef{Name: "standard"},
+		},
+	}
+
+	MergeClusterTemplate(cluster, template)
+
+	if cluster.Spec.Type != "Baremetal" || cluster.Spec.NetworkType != "calico" {
+		t.Errorf("unset fields are not defaulted from template: %+v", cluster.Spec)
+	}
+	if cluster.Spec.Version != "1.21.4" || cluster.Spec.Features.ContainerRuntime != platform.Docker {
+		t.Errorf("set fields are overridden by template: %+v", cluster.Spec)
+	}
+	if cluster.Spec.Features.IPVS == nil || !*cluster.Spec.Features.IPVS {
+		t.Errorf("features are not merged: %+v", cluster.Spec.Features)
+	}
+	if cluster.Spec.Properties.MaxNodePodNum == nil || *cluster.Spec.Properties.MaxNodePodNum != 128 {
+		t.Errorf("properties are not merged: %+v", cluster.Spec.Properties)
+	}
+	if want := map[string]string{"audit-log-maxage": "30", "v": "4"}; !reflect.DeepEqual(cluster.Spec.APIServerExtraArgs, want) {
+		t.Errorf("APIServerExtraArgs = %v, want %v", cluster.Spec.APIServerExtraArgs, want)
+	}
+	if cluster.Spec.TemplateRef.Revision != 3 {
+		t.Errorf("TemplateRef.Revision = %d, want 3", cluster.Spec.TemplateRef.Revision)
+	}
+
+	// the template must not share state with the merged cluster
+	cluster.Spec.APIServerExtraArgs["v"] = "6"
+	*cluster.Spec.Properties.MaxNodePodNum = 256
+	if template.Spec.APIServerExtraArgs["v"] != "2" || *template.Spec.Properties.MaxNodePodNum != 128 {
+		t.Errorf("template is modified by the merged cluster: %+v", template.Spec)
+	}
+}
+
+func TestMergeClusterTemplateRevision(t *testing.T) {
+	template := &platform.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard"},
+		Spec:       platform.ClusterTemplateSpec{Version: "1.21.4"},
+		Status: platform.ClusterTemplateStatus{
+			Revision: 2,
+			Revisions: []platform.ClusterTemplateRevision{
+				{Revision: 1, Spec: platform.ClusterTemplateSpec{Version: "1.20.4"}},
+				{Revision: 2, Spec: platform.ClusterTemplateSpec{Version: "1.21.4"}},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		revision     int64
+		wantVersion  string
+		wantRevision int64
+	}{
+		{"current revision", 0, "1.21.4", 2},
+		{"pinned revision", 1, "1.20.4", 1},
+		{"unknown revision", 5, "", 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &platform.Cluster{
+				Spec: platform.ClusterSpec{
+					TemplateRef: &platform.ClusterTemplateRef{Name: "standard", Revision: tt.revision},
+				},
+			}
+			MergeClusterTemplate(cluster, template)
+			if cluster.Spec.Version != tt.wantVersion {
+				t.Errorf("Version = %q, want %q", cluster.Spec.Version, tt.wantVersion)
+			}
+			if cluster.Spec.TemplateRef.Revision != tt.wantRevision {
+				t.Errorf("TemplateRef.Revision = %d, want %d", cluster.Spec.TemplateRef.Revision, tt.wantRevision)
+			}
+		})
+	}
+}
+
+func TestIsClusterTemplateDrifted(t *testing.T) {
+	template := &platform.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard"},
+		Status:     platform.ClusterTemplateStatus{Revision: 2},
+	}
+	tests := []struct {
+		name string
+		ref  *platform.ClusterTemplateRef
+		want bool
+	}{
+		{"no template", nil, false},
+		{"other template", &platform.ClusterTemplateRef{Name: "other", Revision: 1}, false},
+		{"current revision", &platform.ClusterTemplateRef{Name: "standard", Revision: 2}, false},
+		{"older revision", &platform.ClusterTemplateRef{Name: "standard", Revision: 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &platform.Cluster{Spec: platform.ClusterSpec{TemplateRef: tt.ref}}
+			if got := IsClusterTemplateDrifted(cluster, template); got != tt.want {
+				t.Errorf("IsClusterTemplateDrifted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package credential
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const resolveSSHCredentialTimeout = 10 * time.Second
+
+// NewSSHCredentialResolver returns a ssh.CredentialResolver which reads
+// SSHCredential objects through the given client, so that a rotated secret
+// takes effect on the next connection.
+func NewSSHCredentialResolver(client platformversionedclient.PlatformV1Interface) ssh.CredentialResolver {
+	return func(name string) (*ssh.Credential, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), resolveSSHCredentialTimeout)
+		defer cancel()
+		credential, err := client.SSHCredentials().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &ssh.Credential{
+			User:       credential.Spec.Username,
+			Password:   string(credential.Spec.Password),
+			PrivateKey: credential.Spec.PrivateKey,
+			PassPhrase: credential.Spec.PassPhrase,
+		}, nil
+	}
+}
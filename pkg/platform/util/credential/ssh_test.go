@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package credential
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+func TestSSHCredentialResolver(t *testing.T) {
+	client := fake.NewSimpleClientset(&platformv1.SSHCredential{
+		ObjectMeta: metav1.ObjectMeta{Name: "root-password"},
+		Spec: platformv1.SSHCredentialSpec{
+			Username: "root",
+			Password: []byte("secret"),
+		},
+	})
+	ssh.SetCredentialResolver(NewSSHCredentialResolver(client.PlatformV1()))
+	defer ssh.SetCredentialResolver(nil)
+
+	s, err := ssh.New(&ssh.Config{
+		Host:           "10.0.0.1",
+		Port:           22,
+		User:           "ignored",
+		CredentialName: "root-password",
+	})
+	if err != nil {
+		t.Fatalf("ssh.New() error = %v", err)
+	}
+	if s.User != "root" || s.Password != "secret" {
+		t.Errorf("ssh.New() user = %q, password = %q, want credential values", s.User, s.Password)
+	}
+
+	if _, err := ssh.New(&ssh.Config{Host: "10.0.0.1", Port: 22, CredentialName: "missing"}); err == nil {
+		t.Errorf("ssh.New() expected error for missing credential")
+	}
+}
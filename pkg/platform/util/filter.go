@@ -126,3 +126,106 @@ func FilterCronHPA(ctx context.Context, cronHPA *platform.CronHPA) error {
 	}
 	return nil
 }
+
+// FilterEtcdSnapshot is used to filter etcd snapshot that do not belong
+// to the tenant.
+func FilterEtcdSnapshot(ctx context.Context, snapshot *platform.EtcdSnapshot) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if snapshot.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("etcdsnapshot"), snapshot.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterMachinePool is used to filter machine pool that do not belong
+// to the tenant.
+func FilterMachinePool(ctx context.Context, pool *platform.MachinePool) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if pool.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("machinepool"), pool.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterSSHCredential is used to filter ssh credential that do not belong
+// to the tenant.
+func FilterSSHCredential(ctx context.Context, credential *platform.SSHCredential) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if credential.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("sshcredential"), credential.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterClusterTemplate is used to filter cluster template that do not belong
+// to the tenant.
+func FilterClusterTemplate(ctx context.Context, template *platform.ClusterTemplate) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if template.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("clustertemplate"), template.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterAuditPolicy is used to filter audit policy that do not belong to the
+// tenant.
+func FilterAuditPolicy(ctx context.Context, policy *platform.AuditPolicy) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if policy.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("auditpolicy"), policy.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterClusterSync is used to filter cluster sync that do not belong to the
+// tenant.
+func FilterClusterSync(ctx context.Context, sync *platform.ClusterSync) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if sync.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("clustersync"), sync.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterMultiClusterDeployment is used to filter multi cluster deployment that
+// do not belong to the tenant.
+func FilterMultiClusterDeployment(ctx context.Context, deployment *platform.MultiClusterDeployment) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if deployment.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("multiclusterdeployment"), deployment.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterHost is used to filter host that do not belong to the tenant.
+func FilterHost(ctx context.Context, host *platform.Host) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if host.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("host"), host.ObjectMeta.Name)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/util/printers"
+)
+
+// HandlerRecordCells returns table cells of the last handler record in
+// history, which are handler name, attempts, duration and last error.
+func HandlerRecordCells(history []platform.HandlerRecord) []interface{} {
+	if len(history) == 0 {
+		return []interface{}{"<none>", int64(0), "<none>", "<none>"}
+	}
+	record := history[len(history)-1]
+	lastError := record.LastError
+	if lastError == "" {
+		lastError = "<none>"
+	}
+	return []interface{}{record.Name, int64(record.Attempts), printers.TranslateDuration(record.StartTime, record.EndTime), lastError}
+}
@@ -23,6 +23,10 @@ import (
 	"tkestack.io/tke/pkg/app/version"
 )
 
+// ArchPlaceholder in the source path of a cluster file is replaced by the
+// architecture of each machine the file is copied to.
+const ArchPlaceholder = "${ARCH}"
+
 var (
 	TKEVersion    = version.Get().GitVersion
 	Archs         = []string{"amd64", "arm64"}
@@ -43,4 +47,13 @@ var (
 	ConntrackToolsVersions         = []string{"1.4.4"}
 	NvidiaDriverVersions           = []string{"440.31"}
 	NvidiaContainerRuntimeVersions = []string{"3.1.4"}
+	OSPackagesVersions             = []string{"1.0.0"}
+
+	// OSPackages is the baseline of packages every node requires, by OS
+	// family. They are bundled as an offline package repository per family.
+	OSPackages = map[string][]string{
+		"centos":    {"socat", "ipset", "ipvsadm", "ebtables", "ethtool", "nfs-utils", "chrony"},
+		"openeuler": {"socat", "ipset", "ipvsadm", "ebtables", "ethtool", "nfs-utils", "chrony"},
+		"ubuntu":    {"socat", "ipset", "ipvsadm", "ebtables", "ethtool", "nfs-common", "chrony"},
+	}
 )
@@ -35,6 +35,7 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	nodev1 "k8s.io/api/node/v1"
 	rbac "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	aaclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
@@ -255,6 +256,20 @@ func CreateOrUpdateClusterRoleBinding(ctx context.Context, client clientset.Inte
 	return nil
 }
 
+// CreateOrUpdateRuntimeClass creates a RuntimeClass if the target resource doesn't exist. If the resource exists already, this function will update the resource instead.
+func CreateOrUpdateRuntimeClass(ctx context.Context, client clientset.Interface, runtimeClass *nodev1.RuntimeClass) error {
+	if _, err := client.NodeV1().RuntimeClasses().Create(ctx, runtimeClass, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return errors.Wrap(err, "unable to create runtimeclass")
+		}
+
+		if _, err := client.NodeV1().RuntimeClasses().Update(ctx, runtimeClass, metav1.UpdateOptions{}); err != nil {
+			return errors.Wrap(err, "unable to update runtimeclass")
+		}
+	}
+	return nil
+}
+
 // PatchNodeOnce executes patchFn on the node object found by the node name.
 // This is a condition function meant to be used with wait.Poll. false, nil
 // implies it is safe to try again, an error indicates no more tries should be
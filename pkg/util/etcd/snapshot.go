@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcd
+
+import (
+	"context"
+	"io"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Snapshot streams a snapshot of the backend database of the first available
+// endpoint into w and returns the revision of the etcd when the snapshot was
+// taken.
+func Snapshot(ctx context.Context, db *clientv3.Client, w io.Writer) (int64, error) {
+	endpoints := db.Endpoints()
+	var (
+		revision int64
+		err      error
+	)
+	for _, endpoint := range endpoints {
+		var status *clientv3.StatusResponse
+		status, err = db.Status(ctx, endpoint)
+		if err != nil {
+			continue
+		}
+		revision = status.Header.Revision
+		// pin the snapshot to the endpoint whose revision we recorded
+		db.SetEndpoints(endpoint)
+		defer db.SetEndpoints(endpoints...)
+		break
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	rc, err := db.Snapshot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	if _, err := io.Copy(w, rc); err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
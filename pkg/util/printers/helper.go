@@ -44,3 +44,13 @@ func TranslateTimestampUntil(timestamp metav1.Time) string {
 
 	return duration.HumanDuration(time.Until(timestamp.Time))
 }
+
+// TranslateDuration returns the elapsed time between start and end in
+// human-readable approximation.
+func TranslateDuration(start, end metav1.Time) string {
+	if start.IsZero() || end.IsZero() {
+		return "<unknown>"
+	}
+
+	return duration.HumanDuration(end.Sub(start.Time))
+}
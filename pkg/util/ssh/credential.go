@@ -0,0 +1,79 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Credential is the login secret referenced by Config.CredentialName.
+type Credential struct {
+	User       string
+	Password   string
+	PrivateKey []byte
+	PassPhrase []byte
+}
+
+// CredentialResolver returns the credential with the given name.
+type CredentialResolver func(name string) (*Credential, error)
+
+var (
+	credentialResolverLock sync.RWMutex
+	credentialResolver     CredentialResolver
+)
+
+// SetCredentialResolver sets the resolver used to look up credentials
+// referenced by name when a ssh connection is created.
+func SetCredentialResolver(resolver CredentialResolver) {
+	credentialResolverLock.Lock()
+	defer credentialResolverLock.Unlock()
+	credentialResolver = resolver
+}
+
+func getCredentialResolver() CredentialResolver {
+	credentialResolverLock.RLock()
+	defer credentialResolverLock.RUnlock()
+	return credentialResolver
+}
+
+// resolveCredential fills the login secret of config from the referenced
+// credential, the referenced credential takes precedence over the inline one.
+func (c *Config) resolveCredential() error {
+	if c.CredentialName == "" {
+		return nil
+	}
+	resolver := getCredentialResolver()
+	if resolver == nil {
+		return errors.New("no ssh credential resolver is set")
+	}
+	credential, err := resolver(c.CredentialName)
+	if err != nil {
+		return fmt.Errorf("resolve ssh credential %q error: %w", c.CredentialName, err)
+	}
+	if credential.User != "" {
+		c.User = credential.User
+	}
+	c.Password = credential.Password
+	c.PrivateKey = credential.PrivateKey
+	c.PassPhrase = credential.PassPhrase
+
+	return nil
+}
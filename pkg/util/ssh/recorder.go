@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// recordedStderrLimit is the max bytes of stderr recorded for a command.
+	recordedStderrLimit = 256
+	// recordedStdoutLimit is the max bytes of stdout recorded for a command.
+	recordedStdoutLimit = 256
+	// stdoutTailLimit is the max bytes of stdout kept for redacting before
+	// the excerpt is cut from it.
+	stdoutTailLimit = 4096
+	redacted        = "[REDACTED]"
+)
+
+var (
+	pemBlockPattern = regexp.MustCompile(`(?s)-----BEGIN [A-Z0-9 ]+-----.*?(-----END [A-Z0-9 ]+-----|$)`)
+	secretPattern   = regexp.MustCompile(`(?i)((?:password|passwd|secret|token|key)[\w-]*\s*[=:]\s*)\S+`)
+	// encodedPattern matches the long base64 or hex strings like keys and
+	// tokens, the ones without digits are names and kept.
+	encodedPattern = regexp.MustCompile(`[A-Za-z0-9+=_-]{32,}`)
+	digitPattern   = regexp.MustCompile(`[0-9]`)
+	// bootstrapTokenPattern matches the kubeadm bootstrap tokens printed in
+	// join commands.
+	bootstrapTokenPattern = regexp.MustCompile(`\b[a-z0-9]{6}\.[a-z0-9]{16}\b`)
+)
+
+type recorderKey struct{}
+
+// OutputRecorder records the commands executed by the ssh clients it is
+// attached to. Only the name, the exit code and the redacted tails of stderr
+// and stdout of a command are recorded, never its arguments. The stdout of
+// reading files is never recorded, it may carry the content of files like
+// private keys.
+type OutputRecorder struct {
+	limit int
+
+	mu  sync.Mutex
+	buf []byte
+}
+
+// NewOutputRecorder returns a recorder which keeps at most limit bytes of records.
+func NewOutputRecorder(limit int) *OutputRecorder {
+	return &OutputRecorder{limit: limit}
+}
+
+// WithOutputRecorder returns a copy of ctx carrying r, the ssh clients created
+// by NewWithContext from the returned context record their commands to r.
+func WithOutputRecorder(ctx context.Context, r *OutputRecorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, r)
+}
+
+// OutputRecorderFrom returns the recorder carried by ctx, or nil if none.
+func OutputRecorderFrom(ctx context.Context) *OutputRecorder {
+	r, _ := ctx.Value(recorderKey{}).(*OutputRecorder)
+	return r
+}
+
+// String returns the records.
+func (r *OutputRecorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return string(r.buf)
+}
+
+func (r *OutputRecorder) write(host, cmd string, exit int, stderr string, stdout string) {
+	name := "unknown"
+	if fields := strings.Fields(cmd); len(fields) != 0 {
+		name = path.Base(fields[0])
+	}
+	record := fmt.Sprintf("[%s] %s exit %d", host, name, exit)
+	if stderr = excerpt(stderr, recordedStderrLimit); stderr != "" {
+		record += ": " + stderr
+	}
+	// cat prints the content of files
+	if stdout = excerpt(stdout, recordedStdoutLimit); stdout != "" && name != "cat" {
+		record += " stdout: " + stdout
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.buf = append(r.buf, record+"\n"...)
+	if len(r.buf) > r.limit {
+		r.buf = append([]byte(nil), r.buf[len(r.buf)-r.limit:]...)
+	}
+}
+
+// excerpt returns the last limit bytes of the redacted s on a single line.
+func excerpt(s string, limit int) string {
+	s = strings.Join(strings.Fields(Redact(s)), " ")
+	if len(s) > limit {
+		s = s[len(s)-limit:]
+	}
+	return s
+}
+
+// tailWriter keeps the last lines of the written data within limit bytes, a
+// line cut by the limit is dropped so that a secret is never kept partially
+// where Redact can't recognize it.
+type tailWriter struct {
+	limit     int
+	truncated bool
+	buf       []byte
+}
+
+func (w *tailWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	if len(w.buf) > 2*w.limit {
+		w.buf = append([]byte(nil), w.buf[len(w.buf)-w.limit:]...)
+		w.truncated = true
+	}
+	return len(p), nil
+}
+
+func (w *tailWriter) String() string {
+	buf, truncated := w.buf, w.truncated
+	if len(buf) > w.limit {
+		buf, truncated = buf[len(buf)-w.limit:], true
+	}
+	if truncated {
+		i := bytes.IndexByte(buf, '\n')
+		if i < 0 {
+			return ""
+		}
+		buf = buf[i+1:]
+	}
+	return string(buf)
+}
+
+// Redact replaces the PEM blocks, the values of secret like keys, the bootstrap
+// tokens and the long encoded strings in s.
+func Redact(s string) string {
+	s = pemBlockPattern.ReplaceAllString(s, redacted)
+	s = secretPattern.ReplaceAllString(s, "${1}"+redacted)
+	s = bootstrapTokenPattern.ReplaceAllString(s, redacted)
+	return encodedPattern.ReplaceAllStringFunc(s, func(encoded string) string {
+		if !digitPattern.MatchString(encoded) {
+			return encoded
+		}
+		return redacted
+	})
+}
@@ -20,6 +20,7 @@ package ssh
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"errors"
 	"fmt"
@@ -48,6 +49,7 @@ type SSH struct {
 	*Config
 	authMethods []ssh.AuthMethod
 	dialer      sshDialer
+	recorder    *OutputRecorder
 }
 
 var _ Interface = &SSH{}
@@ -60,6 +62,9 @@ type Config struct {
 	Password   string
 	PrivateKey []byte
 	PassPhrase []byte
+	// CredentialName references a credential resolved by the registered
+	// CredentialResolver when the connection is created.
+	CredentialName string
 	// 150 seconds is longer than the underlying default TCP backoff delay (127
 	// seconds). This timeout is only intended to catch otherwise uncaught hangs.
 	DialTimeOut time.Duration
@@ -72,8 +77,12 @@ func (c *Config) addr() string {
 }
 
 func New(c *Config) (*SSH, error) {
+	err := c.resolveCredential()
+	if err != nil {
+		return nil, err
+	}
 	validate := validator.New()
-	err := validate.Struct(c)
+	err = validate.Struct(c)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +124,17 @@ func New(c *Config) (*SSH, error) {
 	}, nil
 }
 
+// NewWithContext is like New, and the commands executed by the returned
+// client are recorded to the OutputRecorder carried by ctx.
+func NewWithContext(ctx context.Context, c *Config) (*SSH, error) {
+	s, err := New(c)
+	if err != nil {
+		return nil, err
+	}
+	s.recorder = OutputRecorderFrom(ctx)
+	return s, nil
+}
+
 func (s *SSH) CheckProxyTunnel() error {
 	if s.Proxy != nil {
 		return s.Proxy.CheckTunnel()
@@ -144,6 +164,28 @@ func (s *SSH) Execf(format string, a ...interface{}) (stdout string, stderr stri
 }
 
 func (s *SSH) Exec(cmd string) (stdout string, stderr string, exit int, err error) {
+	var bout bytes.Buffer
+	stderr, exit, err = s.run(cmd, &bout, true)
+	return bout.String(), stderr, exit, err
+}
+
+// StreamFile writes the content of the remote file into w without holding
+// all of it in memory.
+func (s *SSH) StreamFile(filename string, w io.Writer) error {
+	stderr, exit, err := s.run(fmt.Sprintf("cat %s", filename), w, false)
+	if err != nil {
+		return err
+	}
+	if exit != 0 {
+		return fmt.Errorf("read file %q error: exit code %d: stderr %s", filename, exit, stderr)
+	}
+	return nil
+}
+
+// run runs the command and writes its stdout into w, the tail of stdout is
+// recorded if recordStdout.
+func (s *SSH) run(cmd string, w io.Writer, recordStdout bool) (stderr string, exit int, err error) {
+	recorded := cmd
 	if s.Sudo {
 		cmd = fmt.Sprintf(`sudo bash << 'EOF'
 %s
@@ -154,14 +196,18 @@ EOF
 
 	session, closer, err := s.newSession()
 	if err != nil {
-		return "", "", 0, err
+		return "", 0, err
 	}
 	defer closer()
 
 	// Run the command.
 	code := 0
-	var bout, berr bytes.Buffer
-	session.Stdout, session.Stderr = &bout, &berr
+	var berr bytes.Buffer
+	tail := &tailWriter{limit: stdoutTailLimit}
+	if s.recorder != nil && recordStdout {
+		w = io.MultiWriter(w, tail)
+	}
+	session.Stdout, session.Stderr = w, &berr
 	if err = session.Run(cmd); err != nil {
 		// Check whether the command failed to run or didn't complete.
 		if exiterr, ok := err.(*ssh.ExitError); ok {
@@ -177,7 +223,10 @@ EOF
 			err = fmt.Errorf("failed running `%s` on %s@%s: '%v'", cmd, s.User, s.addr(), err)
 		}
 	}
-	return bout.String(), berr.String(), code, err
+	if s.recorder != nil {
+		s.recorder.write(s.Host, recorded, code, berr.String(), tail.String())
+	}
+	return berr.String(), code, err
 }
 
 func (s *SSH) CopyFile(src, dst string) error {
@@ -236,8 +285,18 @@ func (s *SSH) WriteFile(src io.Reader, dst string) error {
 	return s.writeFile(tmpfile, dst)
 }
 
+// ReadFile returns the content of the remote file, which is never recorded.
 func (s *SSH) ReadFile(filename string) ([]byte, error) {
-	return s.CombinedOutput(fmt.Sprintf("cat %s", filename))
+	var bout bytes.Buffer
+	cmd := fmt.Sprintf("cat %s", filename)
+	stderr, exit, err := s.run(cmd, &bout, false)
+	if err != nil {
+		return nil, fmt.Errorf("exec cmd %q eror: %w", cmd, err)
+	}
+	if exit != 0 {
+		return nil, fmt.Errorf("exec cmd %q eror: exit code %d: stderr %s", cmd, exit, stderr)
+	}
+	return bout.Bytes(), nil
 }
 
 func (s *SSH) Exist(filename string) (bool, error) {
@@ -0,0 +1,131 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2021 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package sshtest provides a fake ssh.Interface for tests.
+package sshtest
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+// Fake records the commands and files sent to a node. Every command succeeds
+// with an empty output and every file is missing unless the matching func is
+// set.
+type Fake struct {
+	// Cmds are the commands run by CombinedOutput, Exec and Execf in order.
+	Cmds []string
+	// Files are the contents written by WriteFile keyed by destination.
+	Files map[string]string
+
+	CombinedOutputFunc func(cmd string) ([]byte, error)
+	ExecFunc           func(cmd string) (stdout string, stderr string, exit int, err error)
+	ReadFileFunc       func(filename string) ([]byte, error)
+}
+
+var _ ssh.Interface = &Fake{}
+
+// Ping always succeeds.
+func (f *Fake) Ping() error { return nil }
+
+// CombinedOutput records cmd and answers it with CombinedOutputFunc.
+func (f *Fake) CombinedOutput(cmd string) ([]byte, error) {
+	f.Cmds = append(f.Cmds, cmd)
+	if f.CombinedOutputFunc == nil {
+		return nil, nil
+	}
+	return f.CombinedOutputFunc(cmd)
+}
+
+// Execf formats the command and runs it with Exec.
+func (f *Fake) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+// Exec records cmd and answers it with ExecFunc.
+func (f *Fake) Exec(cmd string) (string, string, int, error) {
+	f.Cmds = append(f.Cmds, cmd)
+	if f.ExecFunc == nil {
+		return "", "", 0, nil
+	}
+	return f.ExecFunc(cmd)
+}
+
+// CopyFile writes the content of the local file src to dst.
+func (f *Fake) CopyFile(src, dst string) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return f.WriteFile(file, dst)
+}
+
+// WriteFile records the content written to dst.
+func (f *Fake) WriteFile(src io.Reader, dst string) error {
+	data, err := ioutil.ReadAll(src)
+	if err != nil {
+		return err
+	}
+	if f.Files == nil {
+		f.Files = map[string]string{}
+	}
+	f.Files[dst] = string(data)
+	return nil
+}
+
+// ReadFile answers with ReadFileFunc, or the content written to filename.
+func (f *Fake) ReadFile(filename string) ([]byte, error) {
+	if f.ReadFileFunc != nil {
+		return f.ReadFileFunc(filename)
+	}
+	data, ok := f.Files[filename]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return []byte(data), nil
+}
+
+// ReadDir always returns an empty listing.
+func (f *Fake) ReadDir(dirname string) (string, error) { return "", nil }
+
+// Exist reports whether filename has been written.
+func (f *Fake) Exist(filename string) (bool, error) {
+	_, ok := f.Files[filename]
+	return ok, nil
+}
+
+// LookPath always fails, no binary is installed on the fake node.
+func (f *Fake) LookPath(file string) (string, error) {
+	return "", fmt.Errorf("%s: executable file not found in $PATH", file)
+}
+
+// Ran reports whether a command containing substr has been run.
+func (f *Fake) Ran(substr string) bool {
+	for _, cmd := range f.Cmds {
+		if strings.Contains(cmd, substr) {
+			return true
+		}
+	}
+	return false
+}